@@ -55,6 +55,23 @@ const (
 	// unknown when no event data has been collected yet.
 	StatusUnknown = "unknown"
 
+	// StatusBlocked is when the task is blocked from applying. This is
+	// determined based on status type.
+	//
+	// Task Status: Determined by the task's guardrails. A task is blocked
+	// when the changes of its plan exceeded the guardrails, regardless of its
+	// events. The task does not apply until the blocked apply is approved.
+	StatusBlocked = "blocked"
+
+	// StatusDeferred is when the task has changes waiting to be applied. This
+	// is determined based on status type.
+	//
+	// Task Status: Determined by the task's change windows. A task is
+	// deferred when its changes rendered while the change windows did not
+	// allow the task to apply, regardless of its events. The latest changes
+	// are applied when the change windows open.
+	StatusDeferred = "deferred"
+
 	logSystemName = "api"
 )
 
@@ -118,6 +135,8 @@ func NewAPI(conf Config) (*API, error) {
 		// Generated Endpoints
 		server := Handlers{
 			TaskLifeCycleHandler: NewTaskLifeCycleHandler(api.ctrl),
+			ExecutionHandler:     NewExecutionHandler(api.ctrl),
+			RolloutHandler:       NewRolloutHandler(api.ctrl),
 		}
 		oapigen.HandlerFromMux(server, r)
 	})
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/consul-terraform-sync/config"
 	mocks "github.com/hashicorp/consul-terraform-sync/mocks/server"
 	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/hashicorp/consul-terraform-sync/state/rollout"
 	"github.com/hashicorp/consul-terraform-sync/testutils"
 	"github.com/hashicorp/go-rootcerts"
 	"github.com/stretchr/testify/assert"
@@ -29,7 +30,7 @@ func TestServe(t *testing.T) {
 
 	port := testutils.FreePort(t)
 	// remove request_id from response to simplify testing
-	re := regexp.MustCompile(`"request_id":"(\w|-)+",?`)
+	re := regexp.MustCompile(`,?"request_id":"(\w|-)+",?`)
 	cases := []struct {
 		name       string
 		path       string
@@ -46,10 +47,11 @@ func TestServe(t *testing.T) {
 			"",
 			func(ctrl *mocks.Server) {
 				ctrl.On("Tasks", mock.Anything).Return([]config.TaskConfig{}, nil).
-					On("Events", mock.Anything, "").Return(map[string][]event.Event{}, nil)
+					On("Events", mock.Anything, "").Return(map[string][]event.Event{}, nil).
+					On("Paused", mock.Anything).Return(false)
 			},
 			http.StatusOK,
-			`{"task_summary":{"status":{"successful":0,"errored":0,"critical":0,"unknown":0},"enabled":{"true":0,"false":0}}}
+			`{"task_summary":{"status":{"successful":0,"errored":0,"critical":0,"unknown":0},"enabled":{"true":0,"false":0}},"paused":false}
 `,
 		}, {
 			"task status: all",
@@ -74,7 +76,9 @@ func TestServe(t *testing.T) {
 					Name:    &taskName,
 					Enabled: config.Bool(true),
 				}, nil).
-					On("Events", mock.Anything, taskName).Return(map[string][]event.Event{}, nil)
+					On("Events", mock.Anything, taskName).Return(map[string][]event.Event{}, nil).
+					On("TaskBlocked", mock.Anything, taskName).Return("", false).
+					On("TaskDeferred", mock.Anything, taskName).Return(time.Time{}, false)
 			},
 			http.StatusOK,
 			`{"task_b":{"task_name":"task_b","status":"unknown","enabled":true,"events_url":"","providers":null,"services":null}}
@@ -136,6 +140,77 @@ func TestServe(t *testing.T) {
 			},
 			http.StatusOK,
 			"{}\n",
+		}, {
+			"approve task",
+			"tasks/task_b/approve?override=true",
+			http.MethodPost,
+			"",
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, "task_b").Return(config.TaskConfig{}, nil)
+				ctrl.On("TaskBlocked", mock.Anything, "task_b").Return("reason", true)
+				ctrl.On("Paused", mock.Anything).Return(false)
+				ctrl.On("TaskApprove", mock.Anything, "task_b", true).Return(nil)
+			},
+			http.StatusOK,
+			"{}\n",
+		}, {
+			"rollback task",
+			"tasks/task_b/rollback?event_id=event",
+			http.MethodPost,
+			"",
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, "task_b").Return(config.TaskConfig{}, nil)
+				ctrl.On("TaskSnapshot", mock.Anything, "task_b", "event").Return(true)
+				ctrl.On("Paused", mock.Anything).Return(false)
+				ctrl.On("TaskRollback", mock.Anything, "task_b", "event").Return(nil)
+			},
+			http.StatusOK,
+			"{}\n",
+		}, {
+			"pause",
+			"pause",
+			http.MethodPost,
+			"",
+			func(ctrl *mocks.Server) {
+				ctrl.On("Pause", mock.Anything).Return()
+			},
+			http.StatusOK,
+			`{"paused":true}
+`,
+		}, {
+			"resume",
+			"resume",
+			http.MethodPost,
+			"",
+			func(ctrl *mocks.Server) {
+				ctrl.On("Resume", mock.Anything).Return()
+			},
+			http.StatusOK,
+			`{"paused":false}
+`,
+		}, {
+			"get rollout",
+			"rollouts/id",
+			http.MethodGet,
+			"",
+			func(ctrl *mocks.Server) {
+				ctrl.On("Rollout", mock.Anything, "id").Return(rollout.Rollout{
+					ID:        "id",
+					Module:    "org/module",
+					Version:   "1.1.0",
+					BatchSize: 1,
+					Status:    rollout.StatusRunning,
+					StartTime: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					Tasks: []rollout.Task{{
+						Name:            "task_a",
+						PreviousVersion: "1.0.0",
+						Status:          rollout.StatusUpgrading,
+					}},
+				}, nil)
+			},
+			http.StatusOK,
+			`{"rollout":{"batch_size":1,"id":"id","module":"org/module","start_time":"2021-01-01T00:00:00Z","status":"running","tasks":[{"name":"task_a","previous_version":"1.0.0","status":"upgrading"}],"version":"1.1.0"}}
+`,
 		},
 	}
 	for _, tc := range cases {
@@ -248,7 +323,8 @@ func TestServeWithTLS(t *testing.T) {
 
 	ctrl := new(mocks.Server)
 	ctrl.On("Tasks", mock.Anything).Return([]config.TaskConfig{}, nil).
-		On("Events", mock.Anything, "").Return(map[string][]event.Event{}, nil)
+		On("Events", mock.Anything, "").Return(map[string][]event.Event{}, nil).
+		On("Paused", mock.Anything).Return(false)
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -355,7 +431,8 @@ func TestServeWithMutualTLS(t *testing.T) {
 	}
 	ctrl := new(mocks.Server)
 	ctrl.On("Tasks", mock.Anything).Return([]config.TaskConfig{}, nil).
-		On("Events", mock.Anything, "").Return(map[string][]event.Event{}, nil)
+		On("Events", mock.Anything, "").Return(map[string][]event.Event{}, nil).
+		On("Paused", mock.Anything).Return(false)
 	api, err := NewAPI(Config{
 		Controller: ctrl,
 		Port:       port,
@@ -485,7 +562,8 @@ func TestServeWithMutualTLS_MultipleCA(t *testing.T) {
 	}
 	ctrl := new(mocks.Server)
 	ctrl.On("Tasks", mock.Anything).Return([]config.TaskConfig{}, nil).
-		On("Events", mock.Anything, "").Return(map[string][]event.Event{}, nil)
+		On("Events", mock.Anything, "").Return(map[string][]event.Event{}, nil).
+		On("Paused", mock.Anything).Return(false)
 	api, err := NewAPI(Config{
 		Controller: ctrl,
 		Port:       port,
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+)
+
+const executionSubsystemName = "execution"
+
+// ExecutionHandler handles the requests that pause and resume the execution
+// of all tasks
+type ExecutionHandler struct {
+	ctrl Server
+}
+
+// NewExecutionHandler returns a new ExecutionHandler
+func NewExecutionHandler(ctrl Server) *ExecutionHandler {
+	return &ExecutionHandler{
+		ctrl: ctrl,
+	}
+}
+
+// Pause stops tasks from applying until execution is resumed. Changes to the
+// tasks' dependencies continue to be watched while paused.
+func (h *ExecutionHandler) Pause(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.FromContext(ctx).Named(executionSubsystemName)
+	logger.Trace("pause request")
+
+	h.ctrl.Pause(ctx)
+
+	resp := oapigen.ExecutionResponse{
+		RequestId: requestIDFromContext(ctx),
+		Paused:    true,
+	}
+	writeResponse(w, r, http.StatusOK, resp)
+
+	logger.Trace("task execution paused", "pause_response", resp)
+}
+
+// Resume allows tasks to apply again after a pause. Tasks that had changes
+// while paused apply their latest changes.
+func (h *ExecutionHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.FromContext(ctx).Named(executionSubsystemName)
+	logger.Trace("resume request")
+
+	h.ctrl.Resume(ctx)
+
+	resp := oapigen.ExecutionResponse{
+		RequestId: requestIDFromContext(ctx),
+		Paused:    false,
+	}
+	writeResponse(w, r, http.StatusOK, resp)
+
+	logger.Trace("task execution resumed", "resume_response", resp)
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionHandler_Pause(t *testing.T) {
+	t.Parallel()
+
+	ctrl := new(mocks.Server)
+	ctrl.On("Pause", mock.Anything).Return()
+	handler := NewExecutionHandler(ctrl)
+
+	req, err := http.NewRequest(http.MethodPost, "/v1/pause", nil)
+	require.NoError(t, err)
+	resp := httptest.NewRecorder()
+
+	handler.Pause(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var actual oapigen.ExecutionResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&actual))
+	assert.True(t, actual.Paused)
+	ctrl.AssertExpectations(t)
+}
+
+func TestExecutionHandler_Resume(t *testing.T) {
+	t.Parallel()
+
+	ctrl := new(mocks.Server)
+	ctrl.On("Resume", mock.Anything).Return()
+	handler := NewExecutionHandler(ctrl)
+
+	req, err := http.NewRequest(http.MethodPost, "/v1/resume", nil)
+	require.NoError(t, err)
+	resp := httptest.NewRecorder()
+
+	handler.Resume(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var actual oapigen.ExecutionResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&actual))
+	assert.False(t, actual.Paused)
+	ctrl.AssertExpectations(t)
+}
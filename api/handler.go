@@ -19,6 +19,8 @@ const (
 // the handler to adhere to the generated server interface
 type Handlers struct {
 	*TaskLifeCycleHandler
+	*ExecutionHandler
+	*RolloutHandler
 }
 
 //go:generate oapi-codegen  -package oapigen -generate types -o oapigen/types.go openapi.yaml
@@ -20,6 +20,18 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Pauses task execution
+	// (POST /v1/pause)
+	Pause(w http.ResponseWriter, r *http.Request)
+	// Resumes task execution
+	// (POST /v1/resume)
+	Resume(w http.ResponseWriter, r *http.Request)
+	// Starts a staged rollout of a module version
+	// (POST /v1/rollouts)
+	CreateRollout(w http.ResponseWriter, r *http.Request)
+	// Gets the progress of a rollout
+	// (GET /v1/rollouts/{id})
+	GetRollout(w http.ResponseWriter, r *http.Request, id string)
 	// Gets all tasks
 	// (GET /v1/tasks)
 	GetAllTasks(w http.ResponseWriter, r *http.Request)
@@ -32,6 +44,12 @@ type ServerInterface interface {
 	// Gets a task by name
 	// (GET /v1/tasks/{name})
 	GetTaskByName(w http.ResponseWriter, r *http.Request, name string)
+	// Approves the blocked apply of a task
+	// (POST /v1/tasks/{name}/approve)
+	ApproveTaskByName(w http.ResponseWriter, r *http.Request, name string, params ApproveTaskByNameParams)
+	// Rolls back a task to a prior event
+	// (POST /v1/tasks/{name}/rollback)
+	RollbackTaskByName(w http.ResponseWriter, r *http.Request, name string, params RollbackTaskByNameParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -42,6 +60,77 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
 
+// Pause operation middleware
+func (siw *ServerInterfaceWrapper) Pause(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Pause(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// Resume operation middleware
+func (siw *ServerInterfaceWrapper) Resume(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Resume(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateRollout operation middleware
+func (siw *ServerInterfaceWrapper) CreateRollout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRollout(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetRollout operation middleware
+func (siw *ServerInterfaceWrapper) GetRollout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRollout(w, r, id)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // GetAllTasks operation middleware
 func (siw *ServerInterfaceWrapper) GetAllTasks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -140,6 +229,89 @@ func (siw *ServerInterfaceWrapper) GetTaskByName(w http.ResponseWriter, r *http.
 	handler(w, r.WithContext(ctx))
 }
 
+// ApproveTaskByName operation middleware
+func (siw *ServerInterfaceWrapper) ApproveTaskByName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameter("simple", false, "name", chi.URLParam(r, "name"), &name)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter name: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApproveTaskByNameParams
+
+	// ------------- Optional query parameter "override" -------------
+	if paramValue := r.URL.Query().Get("override"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "override", r.URL.Query(), &params.Override)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter override: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveTaskByName(w, r, name, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RollbackTaskByName operation middleware
+func (siw *ServerInterfaceWrapper) RollbackTaskByName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameter("simple", false, "name", chi.URLParam(r, "name"), &name)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter name: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RollbackTaskByNameParams
+
+	// ------------- Required query parameter "event_id" -------------
+	if paramValue := r.URL.Query().Get("event_id"); paramValue != "" {
+
+	} else {
+		http.Error(w, "Query argument event_id is required, but not found", http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "event_id", r.URL.Query(), &params.EventId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter event_id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackTaskByName(w, r, name, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // Handler creates http.Handler with routing matching OpenAPI spec.
 func Handler(si ServerInterface) http.Handler {
 	return HandlerWithOptions(si, ChiServerOptions{})
@@ -177,6 +349,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		HandlerMiddlewares: options.Middlewares,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/pause", wrapper.Pause)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/resume", wrapper.Resume)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/rollouts", wrapper.CreateRollout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/rollouts/{id}", wrapper.GetRollout)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/tasks", wrapper.GetAllTasks)
 	})
@@ -189,6 +373,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/tasks/{name}", wrapper.GetTaskByName)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/tasks/{name}/approve", wrapper.ApproveTaskByName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/tasks/{name}/rollback", wrapper.RollbackTaskByName)
+	})
 
 	return r
 }
@@ -196,53 +386,86 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaa2/bONb+K3zZF9jOrO9JmsbAfOik3Z1g206RZGY/1IFBkUcWJxKpIak4RuD97Qte",
-	"JEuWHMfZTqfAbgqktsTLuT58zmEeMJVZLgUIo/H0AWuaQEbcxx+LOAb1CRSXzH4njHHDpSDpJyVzUIaD",
-	"xtOYpBp6mIGmiuf2PZ7i6wRQ5Kaj3M1HsVTIKL5YgOJigQzRtwjugRZ2xgD3cF5b8wGDIFEKbtvmyv9M",
-	"wCSgkGntwDUKs5BUiHHtPg/QW4hJkRqNjHSzFqmMSLo1mUoR80WhwEt6fn1lZYJ7kuUp4KlRBfSwWeWA",
-	"pziSMgUi8LqHM3LfFtEqn5F7nhVZubyMkeEZWBGWhBtEYgMK0YSIBWhEFCAGBqgBhiKIpYKGrRJw9voy",
-	"quATjStVtLE7OE242KEJF9+qJpNRhyrr6omMfgNqrHLnxJBULq5A3XEK+lwKH8l7o7oZlIwYQkEYUC5E",
-	"KzkYHXeZVJAMdE4obI32qnfOkAzmGRiyW7CH9qxq6Qd8Cys8xXckLQB3GULBAu7zpjxLiAbfd0lTaJgT",
-	"Pc8kK1KYc5EXxoeIlz8kRbVQMNl2krhdfy+4stn8uZTgpstLT3ZLO0ppORdJgZYJp4mLLB96VdzZZx50",
-	"YIAu4s3zhGj3hUGugBIbvToEC4o5pI1YJBoR5K2CnFV6iBsLP8rO1jZAGEpAgR1ZCTYoF2yDHfXhOS9H",
-	"2Gf/ryDGU/xiuIHnYcDm4c5wXvcwlUIX6fz2bu8ibuA/fm3Mti+tXvsmX4VxzclPFL9D7nV3OGwJ+I1l",
-	"a05M0hycrfo2AzvGKqCF0tDInyD1vgT6gxLRSX/ziN0/uO0uyt3+Cy3/VIu9U0qqA22UgdZksaWySbi2",
-	"QEIEArsmKkd1nXJ10cpxO6W7BJ1L4c2wxbRK4R9LWa9h2BS0mXO2b8qlH3nxtiWs37Gx1s26hw+Jt/YB",
-	"sBnegOaX+jtkEmIqqNcoV/KOM6ioxzUoRWKpsnKiFDVm+pWOiXpuP3ZSHIrudaM+A6Ib07tAeuPlRiBH",
-	"0asjyk5H/dfx8Un/OD6e9KPJadSP6IS8io/PjsbwCvewtToxeIqLgrOu5L0sDkX9wETnwcS7CwipkJAG",
-	"cREroo0qqCkUVER2CXUmy4pN0cKFzoGWVUsbrPOUiC1sckYcGNCm79hvKilJ5zFPYbBQAIaLzdk/RZcQ",
-	"K9CJ3VAbYmAwGKDPnP0wYSej47Po+JSNX7EzeszGJ5SenJ2djGLGjhhMjqPTs9Pxq5uZeMqOuzd6dXZ0",
-	"PKEn9OgMTgicxKPR6SkBSo8mdBS/Hr8ej+Po9fjs6GYmZmKTPYUG5rJDQ+rNFjJNuVRbgABFDLghsUxT",
-	"ubQ7V5k2E9ZyA3QJWhaKAiLOyL6m4IJxn29LbpKtJfQqi2SqpzPRH/4VMdBGyRUiwkkjEFVgt1WQp4RC",
-	"BsI05V7yNLUVh/vSXDmIMLUTEHqBDvIkygptUFTtzLx8qtRvhjezZxjNcGuFGUYPdmP78y8LLQaEQY2f",
-	"H9CsGI2OqP/df/fzNXphiyW7f0PjzZQ++gnSVPYQyfn/1V+g8sUSoqe8ePfz9UY6zlD75wc0w08N2xlG",
-	"facFoJe3Qi5FKC1Jnqer7za7vkAvj1AhfKIyRIxRPCoMaJRwxkCEoWvrs08pEVM0tuFHGOuhkf3kZ/b8",
-	"4xAtg5nogh8T07kqxLxQaRtI3lmCkyuu7YmRrgbol8v3tjzeRNZ5KguGVCH8EUSlUu4YZtXZ4xBFFaJZ",
-	"1ybG5Ho6HJI8H5hytQGX9sEwW/WlWgyXUt060qTtk6UeqkK4X30S0bfwt8VP/Lfb8eTo+ORpJXKb0R+I",
-	"u0puwd73yP/7IMVe/uJmd5GX/7Rkp0bPCw1qziDmAtjh1XVLpAPZbczT1tDZbIYtiNj/ERcoaDm4Jgu9",
-	"kyE3lvhsy3bcwyTn1m7cQPao+EQpsnoe2f5zegY7I+H5Zcn/YuFrxkKXua6Jvt3rtFo7i9azvs5dgxEa",
-	"mtsdmwj9BkVEc+pQ1gJs2VP2Qehj1MqnFsOw6TA89LaxFRnRt+eehnsqg6efb3r4jihuF3PC3BE1xtNS",
-	"7oErBKy2d6C0F2Q8GA1Gjhw24st3O+d51WF/jJI3uvG+0bOxzZ5SYNOjaRioq92bFBkRSAFhVj9k4N6E",
-	"c5IqHsGmhds4sWzB6r+Uxm53Kesd/QYa7G7we8Ld2ddHsZJZyR7F4mndeln2ttp6Wy7m+4dxZ1XY1Lcz",
-	"ZNo99S0UfMxLW4WaD78uQQvBfy8A2QGlrG1/2CdvOjsnmzjutALXxq5aDnPb6GYF/ZeyWrWMXzf2/XwQ",
-	"/FTUZl5lyl6OZYUMo5tcy0grjr9marjPk7CKLiGitaS8WUv4O4nr0H2xOyFyR3jqUmBpiwhX3FTjt1dn",
-	"it+Bat9qpMQieyUwj13JqcE0veXhocNbDZh5LHp+DQM/kLyBPF0+rpnP+M552bEIbm142zv5C2u2xf5c",
-	"sFfpWYe2mx2HyFtIwUC9qXUAD/j6Ha96p2uHRmHygaqYcKA+JpY7dLclchN3y/Kt2rWHVbH3wLssHNo/",
-	"2zZP8JZ+pomeqbRrXNoZFbjuV2obbw9Tsg4ohzDkrpvw3EJNBWUeXoiC8pBh9WK4OlxqzbVSKqsCF7EM",
-	"zNAQakouaNXMed9ImXKx6FOpoC3Nm08X6K2kRQbC+LPe3Sq7Tmm/gvT+1UrQnnuVSdd68l1KO14DoM9+",
-	"Avp48Qa9+XRx87Ks1pfL5cD3Z22pziTVQ8HJkOT8O9zDKacQ4iUI/OHT+/5kMELvw5sedm2GqvpfcJMU",
-	"0YDKbJgQnXAqVT70G/Sro7OvV4IOo1RGw4xwMXx/cf7u49U7535uHAafX19ZQXEnIZU5CMuep/goQHVO",
-	"TOJ8O7wbD6vAW0BHK/USjOJwB7phJGs5kqa+247dFsq9uWB4iv8O5k2aXod3KqSR22MyGpWuDZ1bkucp",
-	"97xs+JsOZYCL8qfkwCZJ1+3awA1AKmjAfLQEWvqFZGhevHTI8IuA+9y3Sj122iG6yDKiVt5UumFJY0vC",
-	"AN7a3ZnkUnf45dx1PDUiSMDSzXbtraYj/KBrz9ZzokgGxtc328u95bbyAGFsaoJ2DlaFEJZ2o6siz6Uy",
-	"2rW5hFyGy3dVCF2j8FkGzHKudDUTRPieWGikhwm0kpmple+ZuQt7CxaWlvnBwFxfl3FNiWLAZiJQFRCs",
-	"JDS1Br1Tm1sdfi9ArTZlnT1FejU3gigyx0Tk0s1wK9SAsSIuN9XB9aNkqy8ariUD2BGsrnXpjITrSG5r",
-	"nfUfnEj78giVu3uGvHFAzzvRUkMvusuzyWj854jXqwrKmjTfWta3k7cj89e9DTwPH2xQrz0MWFrcBoQP",
-	"RN3aFTUXi1Ciuyx24y1mR8TWN1K4BHKlZXk4+5LLzXAXJRHMhN/GjqcQ7jSti0tM6AAbz9etM35cffRs",
-	"/1HI+VhWtyHwg2Ihmd1FfJXLoXpopkQjufeVxT6rGwk0eUI81BpVdV73tMvPde+ACN8qd3bFeUbUbfhb",
-	"udKz32KEl9HYCsPOI+5Q5tEI8t1x3UVMnh+fJY/4ihH61SH+m2dKweUrFOzdAs3wBxDdLrUw11kLuI48",
-	"qIqfP+RKGkllup4Ohw+J1GY9fbAcaI23OjZJxc6CufyNr3vsyJvaev365OR16NK5HZpvbWHg2tieq4Sv",
-	"rlxw2t2s/x0AAP//ChJ0nc0tAAA=",
+	"H4sIAAAAAAAC/+x8e28bN7b4V+Fv+gPS7tXLsh3HBvpHmuR2g23SIHG7f0SGwBmekVjPkFOSY0U30P3s",
+	"F4fkvCRKlhzb6100BZpIQx6eF8979DVKZF5IAcLo6OJrpJM55NT+86cyTUF9AMUlw8+UMW64FDT7oGQB",
+	"ynDQ0UVKMw29iIFOFC/weXQRXc6BxHY7Kex+kkpFjOKzGSguZsRQfU3gCyQl7hhEvahowfwagaBxBvbY",
+	"LuR/zsHMQRGzcQLXxO8iUhHGtf33gLyGlJaZ0cRIu2uWyZhma5sTKVI+KxU4TF9dfkKc4AvNiwyiC6NK",
+	"6EVmWUB0EcVSZkBFtOpFOf2yiSISn9MvPC/zCrxMieE5IAoLyg2hqQFFkjkVM9CEKiAMDCQGGIkhlQo6",
+	"vJqD5df9kBKd6qgmRRs8wVLCxRZKuHiqlIxHAVJW9Tcy/gMSg8S9ooZmcvYJ1A1PQL+SwmnyrVrdVUpG",
+	"DU1AGFD4qcGDJUchljbLdWf9Z7+BJePoqhdxA7ldsAHAf0GVokv8LGgOuqAJrB3veBlCQUgG0xwM3U5p",
+	"4Nwa9NfoGpbRRXRDsxKiEGcLqkzNyr1QKmCdfUlWagOqPxqH1iuYwZeiu2MB8eBvocWlhinV01yyMoMp",
+	"F0VpnFY7dPw9rgF5Ka/fa3vqnyVXwFBaHoOrkGKh7dTcwP4qtXnDkmqvJtYWa7w7S0IFeUbF8hlas2c0",
+	"y541CwfE7fOL2wCoENIQLpKsZECokNZabgW0YXgbWPjp/ytIo4vou2HjJIbeQwwbktcZ1oIRZto98IpI",
+	"QRZznsytBXEmprYv+J1zLjAgb9Pm+znV9gODQkFC0UppbxRIyiHr2ByqCSVOlYhVpR7hBt2Mwt0aBG6f",
+	"gwJc2bC0ArjJW5pltzN1Q6FWvYiK5d02Js7wTSucboWyzVAiLCl0mU2vb/ZQDF1m//i9s5sLA2IvvXpb",
+	"r+wAQFN26973uKizDZ+gBG/b+cmv627ek20Bfq3Cip/y2RthFAf9zirW28pEPZwbuubCxlEgyhxvpyeq",
+	"742ijlA4MwVa92fUwIIuEQqonAtquJjV3161fW9oQcgBocta835gD3tY17dmkSwPttiiNV192JAAEskg",
+	"HGilUuXUoOlyq6yh+sfvxDpfjTZGY6yES+awtKEWwmfVAunsXH1NyQ1VHGPgAfndg1BACqq1s20OK014",
+	"StBlaDA2uvJa8oeWIupFS5pnXcH7B2FJHxSc3CF2oGbeXZwv+xiiBOOGpFQaOu7fi/A2//9AcYTFfpcS",
+	"PppJ+EsN/x3UcF/1eaOUVAcqTA5a09kak8yca4xvqCCAMEm16jbzWq3bit1H0IUUjg1dRKBCfpd/dRT6",
+	"Q0GbKWe3bfnoVr59vYGsO7ED6wrxrIoRbVwP4GhBSw2sw9BtVYP7oKIFo1edHWJ/KJp6YNuijQ0MpNg7",
+	"7GyQrCKpu11lLUuVwDcee2+2f4csHtHS/1tLYzcXP7UgH5hH1lmfkSSnJpkTm1IC0ZwB+jAqSJ2y9Ahw",
+	"m0bHS+KKAZhKx0tiebKZ54WC3gXEUS+iBT8s7j2g+hHi1SF6tsmkZnknDf5e/1AHADat1qRQ8oYzqMt5",
+	"l6AUxUii2ihFq9r7SCl5+wbvyMod+Cm4tGyP5Dacw90lQ17bf5cceQ3E/lny2sZDU9217TdoBKYaEgXm",
+	"Vhi/4+JPbm0HTkiF11L6B7WWKc82lr4DQwcgbsiPP6KaszKxeDxk8THMg0fzGd/ChRD2TRDTARnHz48T",
+	"djbqv0hPTvsn6cm4H4/P4n6cjOnz9OT8+AieR73I5SPRRVSWNtbZQPejzDJ5ME9iNPpTzf+n68/G9QF4",
+	"GWdgo04QbGp4blfW6DBqoG+/DeDEu4FgNGJn6Qs4ifvHY3rSP0nY8/45G8f95+wFvIAjep6cnITgOF3q",
+	"wpJqNvSfhv55YKc2VJkDsdaGmlK3a0WqFAIf9iJdJgkAAxRBSnnmws0Gq2blBljrIhBq7fZ2hrxOnJdU",
+	"X4cc4g0ovZGrHQ2OBqNbsxSrPjXHKkC9tirUPOgwsCIhFF97dL2Of5MS1obiaN0Zvy/zGJRNra27NZKU",
+	"xUxRBoQaQm1HrtMaG/ci37ez0DZV+u6K9Q0C2OD9TobeKQt7hHSyF6nG4uyhybtStx0MsBfgjsQ3grFf",
+	"EVoU2bJqu2+rmnQ34sqXoaWFghsuSz3dogijkCKEbEsBgjmL4XR5PzvTfr5b2yxNAXxrXILMLw8NMXzr",
+	"e+rjz+0TC1IR15pLFdVGlYkpFdSd8wW0W+esbKYkuNAFJFW3brOaUGR0TQjung0MaNO37fZMJjSbpjyD",
+	"wUwBYNG+TssuyEdIFeg5HoicgcFgQD5z9uOYnY5OzuOTM3b0nJ0nJ+zoNElOz89PRyljxwzGJ/HZ+dnR",
+	"86uJ2OfE7Qc9Pz8+GSenyfE5nFI4TUejszMKSXI8Tkbpi6MXR0dp/OLo/PhqIiaiSS1KDcymDhoyxzaf",
+	"hihrIWcgQFHjCpgpXqoFnlynIROBnBuQj+ASVUIT10OlCpMLxl0ysuBmvgZCL/NYZvpiIvrD/yIMtFES",
+	"+7UWG0ESBXisgiKjCeQgTBfvBc8yUoCyH7qQPQoXuIGQ78hBkiR5qQ2J65OZw09V9E2iZvckIpNoA8Ik",
+	"Il/xYPzzv5h3GRCGdP78SCblaHScuP/33/x6Sb7DmjGe36G42dInf4cskz1CC/7/2g9I9WAB8T4P3vx6",
+	"2WDHGdn88yOZRPuq7SQifUsFkO+vhVwIP8tijeUPzanfke+PSSncRWWEGqN4XBrQZM4ZA+GXrlBmHzIq",
+	"LsgRqh9lrEdG+C+3s+e+9toymARTCJMmU1WKaamyTUPyRhhQheIa0+lsOSC/ffwFY4JGs15lsmRElcLl",
+	"54lUyrpRVifm1qKoUnQHaebGFPpiOKRFMTAVtAGX+MUwX/YxMlhIdW3rPxq/WeihKoX9X5/GyWv479nf",
+	"+R/XR+Pjk9P9soLNhuuBdlet+56/Efffu3B6JsXUSWLKkZE3NAvaakE0mF7DLK4JzXQ9Y+BmMyqrbSTh",
+	"RncqEppwQWIwC0BQnkgrFN3DaC2X2hApEkATQCpUBuS1H1hD8D4SXJvbym91eZYnIbf2rZNPidHTUoOa",
+	"Mki5AHb4TNEGSv/qYapQljuZTCID2uDfKEbPtsElnQWH5vyYzVRIMS2o1vj1nfqN91gxfJQG2EPOcPmL",
+	"NpWiw0s7BNM0Bt2nHDAx0nNeRL1oDjQz86lRVGhHTydy7Kx9xOJNqGL21+376/Y9xdsXUpW9EtHWyGjS",
+	"9jHtsrJnc4e3q9V6reMlianmSZWq1oPg7gJWdYtwscKlsTZ3feXq/C4diC4+X/WiahDBInND1VF0UeE9",
+	"sJ2GTnnDp7KrjZqNHVGeFvVY/K4SQGeEftXr8mbP4co1BoVmNuZlTgVRQBnSRwx88QMcuDCGZu66E1BQ",
+	"QfyHrXWBzhh+xxJun8qvoqbAMD5JlcyrDEzM9huxrytVm3RjPmPsMGgabDt16d2vvrXuAXZJab35QvMt",
+	"iJaC/1mC7RdWuG7KY0fJpdbjIBc4RpRpnf3aY3S3RfesDlJLDbpz7ueDDFydHrTrP5s4+Yfd9ATLlhrc",
+	"qyAVB8g/q/y6WccUvwHlYvAKEMe4WhuaoRrRTIqZ7dTiErf8ma7C5mpPELZLkbacoMHU2NX5DqFay4R3",
+	"iwHhtxhqTGqY3aGm28tkHRu1u4fmFr6jxVpVdqcw/GhW1U/1OtFRFachG7Rl1IA2d6UsXJurb2FjF6+2",
+	"eKCXBao3PN2y8GFFXiTpNWRg/qMoulsXxPgAYxdarh20hpHduB2XJ9xAKG8NALAQ7Xtnd+LNPtKSWRbT",
+	"5Po/SQP1HYm5oyAPa22Ge5qHEdm2+4dkgKFXFAv0CLXHcV7ADue6QIK1i4Z1ADGIgliFJzoOnzmykHDe",
+	"2M+SkMVcaqinj5vRYY+b92B2MEiDTfxvoJk5jjbHNM18PddzRw0ZNRQrnodkfIEZ3VBjaWXT1VT6/MjQ",
+	"xFQZEcIqeN9ImeG7FIlUsCmvlx/ektcyKXMQxkW89oVIO0bUr4Ob/qelSHr2US5tEyO1fX9crwHIZ7eB",
+	"vH/7krz88Pbq+6ruu1gsBm54CYu+TCZ6KDjygv8Q9aKMJ+BvlEf43Ydf+uPBiPzin/QiW7Cu68gzbuZl",
+	"PEhkPpxTPeeJVMXQHdCvA8i+XopkGGcyHuaUi+Evb1+9ef/pjeUxNyiZ6NXlJ0Q0CqZlsgBBCx5dRMc+",
+	"5qhlO7w5GtqZWPxQSB3oyn3Ax27yrO4I4XWgWeaa7AN839T2QLgoXal3YQcFPRORy74M3CNxadyuiWDS",
+	"Bka2i0BKYXjWOsBOqukyx/eSEQPs+Syw5kwzBZQticWa2aE4IQmkKSTG9QpQia0o37IK/QiVz5k7S/Z4",
+	"NKoUzHciEQvucqShnZhvK34zs+xyr7YN3G86x79fldNb3cDGcPVqM/O/7LwT7lnh7oJPPW+lbU9sOiPp",
+	"AUx+E/ClcC1F5+VwiS7znKplS3c6+Ea9yNCZrW8039n6Bmqjk/p2dfxon+/Sx6o75fgyINbbOZs9p6zp",
+	"I895Bp53E+G0sBW++2W261nmtfqhxu6reg7Ve9M97wmemPL5W/oUta9WlUPUz82U6O0K+JubJ9Jtp7qW",
+	"N1YFHdNoHqbzrQ3Y+UIbiQqGzhwb7ESmEwE0mTuEUaXdIp/Se0p9AlodmXKlTa/qqTcbUFH96BPDHJS6",
+	"Lj7BURHdCVaoYNRItSSFzHjCQWMhCr4kAEzbpt2spIop3Dcgb9r41QdOBNeupV8WjJom6KiwRPSsIgBr",
+	"cBVYfasRxk5tg7Ht++GVRqY049PtQyuAxE66aJ2WWbZ07PRSJNrIorEHbpetTFDiRmYmAuE2KLUkVjma",
+	"GiWqwHJVwQ0og47pV0RZiua8BIuLpUAZ0Ymww2YBu/BKATVQDT3VN/onyZb3dnfWpu0Cl+ddV3WNtFSQ",
+	"BiWuKo+32jBh4/tHdPs1/1hLkyrzNE3NJ0TNRtaG4uxDpRD2EneNRMv+1MZmw/wMv3K2QqRnYCnrKtDP",
+	"YBrtKaiiObiG0+d1Y/X2dXVlVL2B4wP7jlzdB+BsQ+Ztn/Gt47mrqzv5wHtXoEJJ90b1E9Sgn8E4s1wh",
+	"6XSnEdt2palza68sa8SDURxuQHeyHAzK64hpw0L9DOZlll36Zw8mum4dYkusoYnyFLAnK7c2Jys5uc/4",
+	"wmQ4jnBeAG2GgIXdvdVVXLqm086b/ppjAw0Ezs0w0FbAftR8QD6VRSHRQKFzEnLhfxAER21anag8B8ap",
+	"gWw5EegQcbGPO/yGpMaZqaV9bndaf9kNUgTDXlZCFbPRtfFxEavMUWtWcyIqo/RnCWrZWCUs/nXMkJ9o",
+	"EHJhd1gI0dUWc3P/PrVduN0WGBvpmbSHEx3dM2a3xOzV6S4CagTQc0LE0Mahbu/ZeHT0r0GvV/dFW9g8",
+	"tVu/eXkDN79tnodfUalXzgxkYALtz3dUYfJKsNbhO832Ftv1aLNjqm1k7IJnmjf1Rxf12h12ZjaGiXDH",
+	"+Ejavftno1dvEwLGxrVZUBg/Ld/7mfBdJud91aT1iu8JC0YYguawZ4yxpbu7urpTFNpKpB8wcw50qbbp",
+	"eU7Vtf+dtkqyT1HDK23cUMOgizs08ugo+Xa9DgUmd9fPKo54RA19dBP/5CMlL3L3Svh+RnNIXUt7e03G",
+	"97xdMONKeTZ67kQncSaTa/triwktNdjShi2JuFoHsFZVZCLaRY/auOJyTeiM8nZJQ7dDJ6y1IJyqzOir",
+	"3O6MiWg/qooZHi8E3iP2Z+gWXENrCh2w7F8T0JrWkDegFGcwEbLwrrzaVKGGzt6WJuaQXFc/LdkiLuAH",
+	"PDfvftEqeT3UPett/a1TSZpCrneHe5AfCj4r1nYi0PWJyY2p1qtvLfY+sI9aHw7ZZki8BFm7cPcUbUrn",
+	"4lcXac0A7GlklG/y77QyGfdnKRDMvuNgx99avWFkWLgq7OutTbXSJlDWHU5EobhUBG5AmJa94ZoUXIim",
+	"nopIogmjyXX1EoVPtfB01iMaw7ACkRNJ/fZFsNcWmkC0lm1A3uDH9s/QEgXaSAW63SFx5w8mwpZBXXFb",
+	"G6IgAWEcKbpNu62Oxl0ajAw2TrwovsHVYynTSvPRbFBTarOkVx/WxN3GraY+ZH8skOnehTmgZ3Cenp30",
+	"2YvTuH8SH4/68Qk77p/FIxin53DG4HmAiqdurzYmb7ZGPi2leuI2C2nSHtPGZZKWBQhZLP/LI2H1R4MR",
+	"nHGwrwaAqucOvhZKGpnIbHUxHH6dS21WF1+xNLSK1kYq57UJ9Nxz70Tar21NS609fnF6+sLP4NoTuk/n",
+	"xhStl1L8R/zLUXe1+r8BAIXtu6lgXQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -6,6 +6,59 @@ package oapigen
 import (
 	"encoding/json"
 	"fmt"
+	"time"
+)
+
+// Defines values for ConfigEntriesModuleInputKind.
+const (
+	ConfigEntriesModuleInputKindIngressGateway ConfigEntriesModuleInputKind = "ingress-gateway"
+
+	ConfigEntriesModuleInputKindServiceDefaults ConfigEntriesModuleInputKind = "service-defaults"
+
+	ConfigEntriesModuleInputKindTerminatingGateway ConfigEntriesModuleInputKind = "terminating-gateway"
+)
+
+// Defines values for ConsulKVConditionDecode.
+const (
+	ConsulKVConditionDecodeJson ConsulKVConditionDecode = "json"
+
+	ConsulKVConditionDecodeYaml ConsulKVConditionDecode = "yaml"
+)
+
+// Defines values for ConsulKVModuleInputDecode.
+const (
+	ConsulKVModuleInputDecodeJson ConsulKVModuleInputDecode = "json"
+
+	ConsulKVModuleInputDecodeYaml ConsulKVModuleInputDecode = "yaml"
+)
+
+// Defines values for RolloutStatus.
+const (
+	RolloutStatusFailed RolloutStatus = "failed"
+
+	RolloutStatusRunning RolloutStatus = "running"
+
+	RolloutStatusSucceeded RolloutStatus = "succeeded"
+)
+
+// Defines values for RolloutTaskStatus.
+const (
+	RolloutTaskStatusFailed RolloutTaskStatus = "failed"
+
+	RolloutTaskStatusPending RolloutTaskStatus = "pending"
+
+	RolloutTaskStatusSucceeded RolloutTaskStatus = "succeeded"
+
+	RolloutTaskStatusUpgrading RolloutTaskStatus = "upgrading"
+)
+
+// Defines values for ServicesConditionTriggerOn.
+const (
+	ServicesConditionTriggerOnAny ServicesConditionTriggerOn = "any"
+
+	ServicesConditionTriggerOnHealthTransition ServicesConditionTriggerOn = "health_transition"
+
+	ServicesConditionTriggerOnMembership ServicesConditionTriggerOn = "membership"
 )
 
 // The buffer period for triggering task execution.
@@ -23,8 +76,11 @@ type BufferPeriod struct {
 // CatalogServicesCondition defines model for CatalogServicesCondition.
 type CatalogServicesCondition struct {
 	Datacenter       *string                            `json:"datacenter,omitempty"`
+	Datacenters      *[]string                          `json:"datacenters,omitempty"`
 	Namespace        *string                            `json:"namespace,omitempty"`
 	NodeMeta         *CatalogServicesCondition_NodeMeta `json:"node_meta,omitempty"`
+	Partition        *string                            `json:"partition,omitempty"`
+	Peer             *string                            `json:"peer,omitempty"`
 	Regexp           string                             `json:"regexp"`
 	UseAsModuleInput *bool                              `json:"use_as_module_input,omitempty"`
 }
@@ -34,31 +90,69 @@ type CatalogServicesCondition_NodeMeta struct {
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// The conditions composed by an 'any' or 'all' condition. The composed conditions cannot include another 'any' or 'all' condition.
+type CompositeCondition struct {
+	// The condition on which to trigger the task to execute. If the task has the deprecated services field configured as a module input, it is represented here as condition.services.
+	Conditions Condition `json:"conditions"`
+}
+
 // The condition on which to trigger the task to execute. If the task has the deprecated services field configured as a module input, it is represented here as condition.services.
 type Condition struct {
+	// The conditions composed by an 'any' or 'all' condition. The composed conditions cannot include another 'any' or 'all' condition.
+	All *CompositeCondition `json:"all,omitempty"`
+
+	// The conditions composed by an 'any' or 'all' condition. The composed conditions cannot include another 'any' or 'all' condition.
+	Any             *CompositeCondition       `json:"any,omitempty"`
 	CatalogServices *CatalogServicesCondition `json:"catalog_services,omitempty"`
 	ConsulKv        *ConsulKVCondition        `json:"consul_kv,omitempty"`
+	Intentions      *IntentionsCondition      `json:"intentions,omitempty"`
+	Nodes           *NodesCondition           `json:"nodes,omitempty"`
 	Schedule        *ScheduleCondition        `json:"schedule,omitempty"`
 	Services        *ServicesCondition        `json:"services,omitempty"`
 }
 
+// ConfigEntriesModuleInput defines model for ConfigEntriesModuleInput.
+type ConfigEntriesModuleInput struct {
+	Datacenter *string                      `json:"datacenter,omitempty"`
+	Kind       ConfigEntriesModuleInputKind `json:"kind"`
+	Names      *[]string                    `json:"names,omitempty"`
+	Namespace  *string                      `json:"namespace,omitempty"`
+}
+
+// ConfigEntriesModuleInputKind defines model for ConfigEntriesModuleInput.Kind.
+type ConfigEntriesModuleInputKind string
+
 // ConsulKVCondition defines model for ConsulKVCondition.
 type ConsulKVCondition struct {
-	Datacenter       *string `json:"datacenter,omitempty"`
-	Namespace        *string `json:"namespace,omitempty"`
-	Path             string  `json:"path"`
-	Recurse          *bool   `json:"recurse,omitempty"`
-	UseAsModuleInput *bool   `json:"use_as_module_input,omitempty"`
+	Datacenter *string `json:"datacenter,omitempty"`
+
+	// The format to decode the KV values as so that they are typed values of the consul_kv variable. Values are passed as strings if not set.
+	Decode           *ConsulKVConditionDecode `json:"decode,omitempty"`
+	Namespace        *string                  `json:"namespace,omitempty"`
+	Partition        *string                  `json:"partition,omitempty"`
+	Path             string                   `json:"path"`
+	Recurse          *bool                    `json:"recurse,omitempty"`
+	UseAsModuleInput *bool                    `json:"use_as_module_input,omitempty"`
 }
 
+// The format to decode the KV values as so that they are typed values of the consul_kv variable. Values are passed as strings if not set.
+type ConsulKVConditionDecode string
+
 // ConsulKVModuleInput defines model for ConsulKVModuleInput.
 type ConsulKVModuleInput struct {
 	Datacenter *string `json:"datacenter,omitempty"`
-	Namespace  *string `json:"namespace,omitempty"`
-	Path       string  `json:"path"`
-	Recurse    *bool   `json:"recurse,omitempty"`
+
+	// The format to decode the KV values as so that they are typed values of the consul_kv variable. Values are passed as strings if not set.
+	Decode    *ConsulKVModuleInputDecode `json:"decode,omitempty"`
+	Namespace *string                    `json:"namespace,omitempty"`
+	Partition *string                    `json:"partition,omitempty"`
+	Path      string                     `json:"path"`
+	Recurse   *bool                      `json:"recurse,omitempty"`
 }
 
+// The format to decode the KV values as so that they are typed values of the consul_kv variable. Values are passed as strings if not set.
+type ConsulKVModuleInputDecode string
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"message"`
@@ -70,15 +164,112 @@ type ErrorResponse struct {
 	RequestId RequestID `json:"request_id"`
 }
 
+// ExecutionResponse defines model for ExecutionResponse.
+type ExecutionResponse struct {
+	Paused    bool      `json:"paused"`
+	RequestId RequestID `json:"request_id"`
+}
+
+// IntentionsCondition defines model for IntentionsCondition.
+type IntentionsCondition struct {
+	Datacenter *string `json:"datacenter,omitempty"`
+
+	// The services to match on one side of an intention, either by regexp or by names.
+	DestinationServices *IntentionsServices `json:"destination_services,omitempty"`
+	Namespace           *string             `json:"namespace,omitempty"`
+
+	// The services to match on one side of an intention, either by regexp or by names.
+	SourceServices   *IntentionsServices `json:"source_services,omitempty"`
+	UseAsModuleInput *bool               `json:"use_as_module_input,omitempty"`
+}
+
+// IntentionsModuleInput defines model for IntentionsModuleInput.
+type IntentionsModuleInput struct {
+	Datacenter *string `json:"datacenter,omitempty"`
+
+	// The services to match on one side of an intention, either by regexp or by names.
+	DestinationServices *IntentionsServices `json:"destination_services,omitempty"`
+	Namespace           *string             `json:"namespace,omitempty"`
+
+	// The services to match on one side of an intention, either by regexp or by names.
+	SourceServices *IntentionsServices `json:"source_services,omitempty"`
+}
+
+// The services to match on one side of an intention, either by regexp or by names.
+type IntentionsServices struct {
+	Names  *[]string `json:"names,omitempty"`
+	Regexp *string   `json:"regexp,omitempty"`
+}
+
 // The additional module input(s) that the tasks provides to the Terraform module on execution. If the task has the deprecated services field configured as a module input, it is represented here as module_input.services.
 type ModuleInput struct {
-	ConsulKv *ConsulKVModuleInput `json:"consul_kv,omitempty"`
-	Services *ServicesModuleInput `json:"services,omitempty"`
+	ConfigEntries *ConfigEntriesModuleInput `json:"config_entries,omitempty"`
+	ConsulKv      *ConsulKVModuleInput      `json:"consul_kv,omitempty"`
+	Intentions    *IntentionsModuleInput    `json:"intentions,omitempty"`
+	Nodes         *NodesModuleInput         `json:"nodes,omitempty"`
+	Services      *ServicesModuleInput      `json:"services,omitempty"`
+
+	// The Vault KV secrets whose values are passed to the module as a sensitive variable.
+	VaultSecrets *VaultSecretsModuleInput `json:"vault_secrets,omitempty"`
+}
+
+// NodesCondition defines model for NodesCondition.
+type NodesCondition struct {
+	Datacenter       *string `json:"datacenter,omitempty"`
+	Filter           *string `json:"filter,omitempty"`
+	UseAsModuleInput *bool   `json:"use_as_module_input,omitempty"`
+}
+
+// NodesModuleInput defines model for NodesModuleInput.
+type NodesModuleInput struct {
+	Datacenter *string `json:"datacenter,omitempty"`
+	Filter     *string `json:"filter,omitempty"`
 }
 
 // RequestID defines model for RequestID.
 type RequestID string
 
+// Rollout defines model for Rollout.
+type Rollout struct {
+	BatchSize int           `json:"batch_size"`
+	EndTime   *time.Time    `json:"end_time,omitempty"`
+	Id        string        `json:"id"`
+	Module    string        `json:"module"`
+	StartTime time.Time     `json:"start_time"`
+	Status    RolloutStatus `json:"status"`
+	Tasks     []RolloutTask `json:"tasks"`
+	Version   string        `json:"version"`
+}
+
+// RolloutStatus defines model for Rollout.Status.
+type RolloutStatus string
+
+// RolloutRequest defines model for RolloutRequest.
+type RolloutRequest struct {
+	// Number of tasks to upgrade at a time.
+	BatchSize *int   `json:"batch_size,omitempty"`
+	Module    string `json:"module"`
+	Version   string `json:"version"`
+}
+
+// RolloutResponse defines model for RolloutResponse.
+type RolloutResponse struct {
+	Error     *Error    `json:"error,omitempty"`
+	RequestId RequestID `json:"request_id"`
+	Rollout   *Rollout  `json:"rollout,omitempty"`
+}
+
+// RolloutTask defines model for RolloutTask.
+type RolloutTask struct {
+	Error           *string           `json:"error,omitempty"`
+	Name            string            `json:"name"`
+	PreviousVersion string            `json:"previous_version"`
+	Status          RolloutTaskStatus `json:"status"`
+}
+
+// RolloutTaskStatus defines model for RolloutTask.Status.
+type RolloutTaskStatus string
+
 // Run defines model for Run.
 type Run struct {
 	// Whether or not infrastructure changes were detected during task inspection.
@@ -92,16 +283,24 @@ type Run struct {
 // ScheduleCondition defines model for ScheduleCondition.
 type ScheduleCondition struct {
 	Cron string `json:"cron"`
+
+	// When set, the task is also triggered by changes to its module inputs in between scheduled runs, at most once per interval. Disabled by default.
+	OnChangeInterval *string `json:"on_change_interval,omitempty"`
 }
 
 // ServicesCondition defines model for ServicesCondition.
 type ServicesCondition struct {
 	CtsUserDefinedMeta *ServicesCondition_CtsUserDefinedMeta `json:"cts_user_defined_meta,omitempty"`
 	Datacenter         *string                               `json:"datacenter,omitempty"`
+	Datacenters        *[]string                             `json:"datacenters,omitempty"`
 	Filter             *string                               `json:"filter,omitempty"`
+	IncludeNonPassing  *bool                                 `json:"include_non_passing,omitempty"`
 	Names              *[]string                             `json:"names,omitempty"`
 	Namespace          *string                               `json:"namespace,omitempty"`
+	Partition          *string                               `json:"partition,omitempty"`
+	Peer               *string                               `json:"peer,omitempty"`
 	Regexp             *string                               `json:"regexp,omitempty"`
+	TriggerOn          *ServicesConditionTriggerOn           `json:"trigger_on,omitempty"`
 	UseAsModuleInput   *bool                                 `json:"use_as_module_input,omitempty"`
 }
 
@@ -110,13 +309,20 @@ type ServicesCondition_CtsUserDefinedMeta struct {
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// ServicesConditionTriggerOn defines model for ServicesCondition.TriggerOn.
+type ServicesConditionTriggerOn string
+
 // ServicesModuleInput defines model for ServicesModuleInput.
 type ServicesModuleInput struct {
 	CtsUserDefinedMeta *ServicesModuleInput_CtsUserDefinedMeta `json:"cts_user_defined_meta,omitempty"`
 	Datacenter         *string                                 `json:"datacenter,omitempty"`
+	Datacenters        *[]string                               `json:"datacenters,omitempty"`
 	Filter             *string                                 `json:"filter,omitempty"`
+	IncludeNonPassing  *bool                                   `json:"include_non_passing,omitempty"`
 	Names              *[]string                               `json:"names,omitempty"`
 	Namespace          *string                                 `json:"namespace,omitempty"`
+	Partition          *string                                 `json:"partition,omitempty"`
+	Peer               *string                                 `json:"peer,omitempty"`
 	Regexp             *string                                 `json:"regexp,omitempty"`
 }
 
@@ -151,7 +357,7 @@ type Task struct {
 	// The list of provider names that the task's module uses.
 	Providers *[]string `json:"providers,omitempty"`
 
-	// Enterprise only. The version of Terraform to use for the Terraform Cloud workspace associated with the task. This is only available when used with the Terraform Cloud driver. Defaults to the latest version if not set.
+	// The version of Terraform to use for the task. With the Terraform driver, the version is installed alongside the driver's default version. With the Terraform Cloud driver, the version is set for the workspace associated with the task. Defaults to the driver's version if not set.
 	TerraformVersion *string `json:"terraform_version,omitempty"`
 
 	// The map of variables that are provided to the task's module.
@@ -161,6 +367,12 @@ type Task struct {
 	Version *string `json:"version,omitempty"`
 }
 
+// TaskApproveResponse defines model for TaskApproveResponse.
+type TaskApproveResponse struct {
+	Error     *Error    `json:"error,omitempty"`
+	RequestId RequestID `json:"request_id"`
+}
+
 // TaskDeleteResponse defines model for TaskDeleteResponse.
 type TaskDeleteResponse struct {
 	Error     *Error    `json:"error,omitempty"`
@@ -180,6 +392,12 @@ type TaskResponse struct {
 	Task      *Task     `json:"task,omitempty"`
 }
 
+// TaskRollbackResponse defines model for TaskRollbackResponse.
+type TaskRollbackResponse struct {
+	Error     *Error    `json:"error,omitempty"`
+	RequestId RequestID `json:"request_id"`
+}
+
 // TasksResponse defines model for TasksResponse.
 type TasksResponse struct {
 	RequestId RequestID `json:"request_id"`
@@ -191,6 +409,14 @@ type VariableMap struct {
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// The Vault KV secrets whose values are passed to the module as a sensitive variable.
+type VaultSecretsModuleInput struct {
+	Paths []string `json:"paths"`
+}
+
+// CreateRolloutJSONBody defines parameters for CreateRollout.
+type CreateRolloutJSONBody RolloutRequest
+
 // CreateTaskJSONBody defines parameters for CreateTask.
 type CreateTaskJSONBody TaskRequest
 
@@ -205,6 +431,21 @@ type CreateTaskParams struct {
 // CreateTaskParamsRun defines parameters for CreateTask.
 type CreateTaskParamsRun string
 
+// ApproveTaskByNameParams defines parameters for ApproveTaskByName.
+type ApproveTaskByNameParams struct {
+	// Whether to apply the task without checking the guardrails.
+	Override *bool `json:"override,omitempty"`
+}
+
+// RollbackTaskByNameParams defines parameters for RollbackTaskByName.
+type RollbackTaskByNameParams struct {
+	// ID of the event of the successful run to roll back to.
+	EventId string `json:"event_id"`
+}
+
+// CreateRolloutJSONRequestBody defines body for CreateRollout for application/json ContentType.
+type CreateRolloutJSONRequestBody CreateRolloutJSONBody
+
 // CreateTaskJSONRequestBody defines body for CreateTask for application/json ContentType.
 type CreateTaskJSONRequestBody CreateTaskJSONBody
 
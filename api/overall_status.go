@@ -15,6 +15,9 @@ const (
 // OverallStatus is the overall status information for cts and across all the tasks
 type OverallStatus struct {
 	TaskSummary TaskSummary `json:"task_summary"`
+
+	// Paused is true when the execution of all tasks is paused
+	Paused bool `json:"paused"`
 }
 
 // TaskSummary holds data that summarizes the tasks configured with CTS
@@ -103,6 +106,7 @@ func (h *overallStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 
 		err = jsonResponse(w, http.StatusOK, OverallStatus{
 			TaskSummary: taskSummary,
+			Paused:      h.ctrl.Paused(ctx),
 		})
 		if err != nil {
 			logger.Error("error, could not generate json error response", "error", err)
@@ -61,6 +61,7 @@ func TestOverallStatus_ServeHTTP(t *testing.T) {
 						False: 1,
 					},
 				},
+				Paused: true,
 			},
 		},
 		{
@@ -93,7 +94,8 @@ func TestOverallStatus_ServeHTTP(t *testing.T) {
 		"critical_d": {{Success: false}, {Success: false}, {Success: true}},
 	}
 	ctrl.On("Events", mock.Anything, "").Return(events, nil).
-		On("Tasks", mock.Anything).Return(confs, nil)
+		On("Tasks", mock.Anything).Return(confs, nil).
+		On("Paused", mock.Anything).Return(true)
 
 	handler := newOverallStatusHandler(ctrl, "v1")
 
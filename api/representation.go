@@ -84,15 +84,21 @@ func (tr TaskRequest) ToTaskConfig() (config.TaskConfig, error) {
 		if tr.Task.ModuleInput.Services != nil {
 			input := &config.ServicesModuleInputConfig{
 				ServicesMonitorConfig: config.ServicesMonitorConfig{
-					Regexp:     tr.Task.ModuleInput.Services.Regexp,
-					Datacenter: tr.Task.ModuleInput.Services.Datacenter,
-					Namespace:  tr.Task.ModuleInput.Services.Namespace,
-					Filter:     tr.Task.ModuleInput.Services.Filter,
+					Regexp:            tr.Task.ModuleInput.Services.Regexp,
+					Datacenter:        tr.Task.ModuleInput.Services.Datacenter,
+					Namespace:         tr.Task.ModuleInput.Services.Namespace,
+					Partition:         tr.Task.ModuleInput.Services.Partition,
+					Peer:              tr.Task.ModuleInput.Services.Peer,
+					Filter:            tr.Task.ModuleInput.Services.Filter,
+					IncludeNonPassing: tr.Task.ModuleInput.Services.IncludeNonPassing,
 				},
 			}
 			if tr.Task.ModuleInput.Services.Names != nil {
 				input.Names = *tr.Task.ModuleInput.Services.Names
 			}
+			if tr.Task.ModuleInput.Services.Datacenters != nil {
+				input.Datacenters = *tr.Task.ModuleInput.Services.Datacenters
+			}
 			if tr.Task.ModuleInput.Services.CtsUserDefinedMeta != nil {
 				input.CTSUserDefinedMeta = tr.Task.ModuleInput.Services.CtsUserDefinedMeta.AdditionalProperties
 			}
@@ -105,60 +111,65 @@ func (tr TaskRequest) ToTaskConfig() (config.TaskConfig, error) {
 					Recurse:    tr.Task.ModuleInput.ConsulKv.Recurse,
 					Path:       &tr.Task.ModuleInput.ConsulKv.Path,
 					Namespace:  tr.Task.ModuleInput.ConsulKv.Namespace,
+					Partition:  tr.Task.ModuleInput.ConsulKv.Partition,
 				},
 			}
+			if tr.Task.ModuleInput.ConsulKv.Decode != nil {
+				input.Decode = config.String(string(*tr.Task.ModuleInput.ConsulKv.Decode))
+			}
 			inputs = append(inputs, input)
 		}
-		tc.ModuleInputs = &inputs
-	}
-
-	// Convert condition
-	if tr.Task.Condition.Services != nil {
-		cond := &config.ServicesConditionConfig{
-			ServicesMonitorConfig: config.ServicesMonitorConfig{
-				Datacenter: tr.Task.Condition.Services.Datacenter,
-				Namespace:  tr.Task.Condition.Services.Namespace,
-				Filter:     tr.Task.Condition.Services.Filter,
-			},
-			UseAsModuleInput: tr.Task.Condition.Services.UseAsModuleInput,
-		}
-		if tr.Task.Condition.Services.Names != nil && len(*tr.Task.Condition.Services.Names) > 0 {
-			cond.Names = *tr.Task.Condition.Services.Names
-		} else {
-			cond.Regexp = tr.Task.Condition.Services.Regexp
-		}
-		if tr.Task.Condition.Services.CtsUserDefinedMeta != nil {
-			cond.ServicesMonitorConfig.CTSUserDefinedMeta =
-				tr.Task.Condition.Services.CtsUserDefinedMeta.AdditionalProperties
+		if tr.Task.ModuleInput.Intentions != nil {
+			input := &config.IntentionsModuleInputConfig{
+				IntentionsMonitorConfig: config.IntentionsMonitorConfig{
+					Datacenter:          tr.Task.ModuleInput.Intentions.Datacenter,
+					Namespace:           tr.Task.ModuleInput.Intentions.Namespace,
+					SourceServices:      intentionsServicesConfigFromOapigen(tr.Task.ModuleInput.Intentions.SourceServices),
+					DestinationServices: intentionsServicesConfigFromOapigen(tr.Task.ModuleInput.Intentions.DestinationServices),
+				},
+			}
+			inputs = append(inputs, input)
 		}
-		tc.Condition = cond
-	} else if tr.Task.Condition.ConsulKv != nil {
-		tc.Condition = &config.ConsulKVConditionConfig{
-			ConsulKVMonitorConfig: config.ConsulKVMonitorConfig{
-				Datacenter: tr.Task.Condition.ConsulKv.Datacenter,
-				Recurse:    tr.Task.Condition.ConsulKv.Recurse,
-				Path:       &tr.Task.Condition.ConsulKv.Path,
-				Namespace:  tr.Task.Condition.ConsulKv.Namespace,
-			},
-			UseAsModuleInput: tr.Task.Condition.ConsulKv.UseAsModuleInput,
+		if tr.Task.ModuleInput.Nodes != nil {
+			input := &config.NodesModuleInputConfig{
+				NodesMonitorConfig: config.NodesMonitorConfig{
+					Datacenter: tr.Task.ModuleInput.Nodes.Datacenter,
+					Filter:     tr.Task.ModuleInput.Nodes.Filter,
+				},
+			}
+			inputs = append(inputs, input)
 		}
-	} else if tr.Task.Condition.CatalogServices != nil {
-		cond := &config.CatalogServicesConditionConfig{
-			CatalogServicesMonitorConfig: config.CatalogServicesMonitorConfig{
-				Regexp:           config.String(tr.Task.Condition.CatalogServices.Regexp),
-				UseAsModuleInput: tr.Task.Condition.CatalogServices.UseAsModuleInput,
-				Datacenter:       tr.Task.Condition.CatalogServices.Datacenter,
-				Namespace:        tr.Task.Condition.CatalogServices.Namespace,
-			},
+		if tr.Task.ModuleInput.ConfigEntries != nil {
+			input := &config.ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig: config.ConfigEntriesMonitorConfig{
+					Kind:       config.String(string(tr.Task.ModuleInput.ConfigEntries.Kind)),
+					Datacenter: tr.Task.ModuleInput.ConfigEntries.Datacenter,
+					Namespace:  tr.Task.ModuleInput.ConfigEntries.Namespace,
+				},
+			}
+			if tr.Task.ModuleInput.ConfigEntries.Names != nil {
+				input.Names = *tr.Task.ModuleInput.ConfigEntries.Names
+			}
+			inputs = append(inputs, input)
 		}
-		if tr.Task.Condition.CatalogServices.NodeMeta != nil {
-			cond.NodeMeta = tr.Task.Condition.CatalogServices.NodeMeta.AdditionalProperties
+		if tr.Task.ModuleInput.VaultSecrets != nil {
+			input := &config.VaultSecretsModuleInputConfig{
+				VaultSecretsMonitorConfig: config.VaultSecretsMonitorConfig{
+					Paths: tr.Task.ModuleInput.VaultSecrets.Paths,
+				},
+			}
+			inputs = append(inputs, input)
 		}
+		tc.ModuleInputs = &inputs
+	}
+
+	// Convert condition
+	cond, err := conditionConfigFromOapigen(tr.Task.Condition)
+	if err != nil {
+		return config.TaskConfig{}, err
+	}
+	if cond != nil {
 		tc.Condition = cond
-	} else if tr.Task.Condition.Schedule != nil {
-		tc.Condition = &config.ScheduleConditionConfig{
-			Cron: &tr.Task.Condition.Schedule.Cron,
-		}
 	}
 
 	if tr.Task.BufferPeriod != nil {
@@ -269,25 +280,36 @@ func oapigenTaskFromConfigTask(tc config.TaskConfig) oapigen.Task {
 			case *config.ServicesModuleInputConfig:
 				if len(input.Names) > 0 {
 					task.ModuleInput.Services = &oapigen.ServicesModuleInput{
-						Names:      &input.Names,
-						Datacenter: input.Datacenter,
-						Namespace:  input.Namespace,
-						Filter:     input.Filter,
+						Names:             &input.Names,
+						Datacenter:        input.Datacenter,
+						Namespace:         input.Namespace,
+						Filter:            input.Filter,
+						IncludeNonPassing: input.IncludeNonPassing,
 						CtsUserDefinedMeta: &oapigen.ServicesModuleInput_CtsUserDefinedMeta{
 							AdditionalProperties: input.CTSUserDefinedMeta,
 						},
 					}
 				} else {
 					task.ModuleInput.Services = &oapigen.ServicesModuleInput{
-						Regexp:     input.Regexp,
-						Datacenter: input.Datacenter,
-						Namespace:  input.Namespace,
-						Filter:     input.Filter,
+						Regexp:            input.Regexp,
+						Datacenter:        input.Datacenter,
+						Namespace:         input.Namespace,
+						Filter:            input.Filter,
+						IncludeNonPassing: input.IncludeNonPassing,
 						CtsUserDefinedMeta: &oapigen.ServicesModuleInput_CtsUserDefinedMeta{
 							AdditionalProperties: input.CTSUserDefinedMeta,
 						},
 					}
 				}
+				if len(input.Datacenters) > 0 {
+					task.ModuleInput.Services.Datacenters = &input.Datacenters
+				}
+				if config.StringVal(input.Partition) != "" {
+					task.ModuleInput.Services.Partition = input.Partition
+				}
+				if config.StringVal(input.Peer) != "" {
+					task.ModuleInput.Services.Peer = input.Peer
+				}
 			case *config.ConsulKVModuleInputConfig:
 				task.ModuleInput.ConsulKv = &oapigen.ConsulKVModuleInput{
 					Datacenter: input.Datacenter,
@@ -295,29 +317,120 @@ func oapigenTaskFromConfigTask(tc config.TaskConfig) oapigen.Task {
 					Path:       *input.Path,
 					Namespace:  input.Namespace,
 				}
+				if config.StringVal(input.Partition) != "" {
+					task.ModuleInput.ConsulKv.Partition = input.Partition
+				}
+				if decode := config.StringVal(input.Decode); decode != "" {
+					d := oapigen.ConsulKVModuleInputDecode(decode)
+					task.ModuleInput.ConsulKv.Decode = &d
+				}
+			case *config.IntentionsModuleInputConfig:
+				task.ModuleInput.Intentions = &oapigen.IntentionsModuleInput{
+					Datacenter:          input.Datacenter,
+					Namespace:           input.Namespace,
+					SourceServices:      oapigenIntentionsServicesFromConfig(input.SourceServices),
+					DestinationServices: oapigenIntentionsServicesFromConfig(input.DestinationServices),
+				}
+			case *config.NodesModuleInputConfig:
+				task.ModuleInput.Nodes = &oapigen.NodesModuleInput{
+					Datacenter: input.Datacenter,
+					Filter:     input.Filter,
+				}
+			case *config.ConfigEntriesModuleInputConfig:
+				configEntries := &oapigen.ConfigEntriesModuleInput{
+					Kind:       oapigen.ConfigEntriesModuleInputKind(config.StringVal(input.Kind)),
+					Datacenter: input.Datacenter,
+					Namespace:  input.Namespace,
+				}
+				if len(input.Names) > 0 {
+					configEntries.Names = &input.Names
+				}
+				task.ModuleInput.ConfigEntries = configEntries
+			case *config.VaultSecretsModuleInputConfig:
+				task.ModuleInput.VaultSecrets = &oapigen.VaultSecretsModuleInput{
+					Paths: input.Paths,
+				}
+			}
+		}
+	}
+
+	oapigenConditionFromConfig(&task.Condition, tc.Condition)
+
+	if tc.BufferPeriod != nil {
+		max := config.TimeDurationVal(tc.BufferPeriod.Max).String()
+		min := config.TimeDurationVal(tc.BufferPeriod.Min).String()
+		task.BufferPeriod = &oapigen.BufferPeriod{
+			Enabled: tc.BufferPeriod.Enabled,
+			Max:     &max,
+			Min:     &min,
+		}
+	}
+
+	// Tasks created via API cannot configure the `services` field, but tasks
+	// created via CTS config file can currently configure `services` (deprecated).
+	// Handle `services` by converting to condition or module_input. There is
+	// config validation so that `services` cannot be configured when
+	// `condition "services"` or `module_input "services"` is configured.
+	// Use-case: returning tasks with `services via Get Task API
+	if tc.DeprecatedServices != nil && len(tc.DeprecatedServices) > 0 {
+		_, noCondition := tc.Condition.(*config.NoConditionConfig)
+		if tc.Condition == nil || noCondition {
+			task.Condition.Services = &oapigen.ServicesCondition{
+				Names:            &tc.DeprecatedServices,
+				UseAsModuleInput: config.Bool(true),
+			}
+		} else {
+			if tc.ModuleInputs == nil {
+				task.ModuleInput = new(oapigen.ModuleInput)
+			}
+			task.ModuleInput.Services = &oapigen.ServicesModuleInput{
+				Names: &tc.DeprecatedServices,
 			}
 		}
 	}
 
-	switch cond := tc.Condition.(type) {
+	// Enterprise
+	task.TerraformVersion = tc.TFVersion
+
+	return task
+}
+
+// oapigenConditionFromConfig sets the API representation of the condition
+// on the given API condition object
+func oapigenConditionFromConfig(c *oapigen.Condition, condition config.ConditionConfig) {
+	switch cond := condition.(type) {
 	case *config.ServicesConditionConfig:
 		services := &oapigen.ServicesCondition{
-			Datacenter: cond.Datacenter,
-			Namespace:  cond.Namespace,
-			Filter:     cond.Filter,
+			Datacenter:        cond.Datacenter,
+			Namespace:         cond.Namespace,
+			Filter:            cond.Filter,
+			IncludeNonPassing: cond.IncludeNonPassing,
 			CtsUserDefinedMeta: &oapigen.ServicesCondition_CtsUserDefinedMeta{
 				AdditionalProperties: cond.CTSUserDefinedMeta,
 			},
 			UseAsModuleInput: cond.UseAsModuleInput,
 		}
+		if cond.TriggerOn != nil {
+			triggerOn := oapigen.ServicesConditionTriggerOn(*cond.TriggerOn)
+			services.TriggerOn = &triggerOn
+		}
 		if len(cond.Names) > 0 {
 			services.Names = &cond.Names
 		} else {
 			services.Regexp = cond.Regexp
 		}
-		task.Condition.Services = services
+		if len(cond.Datacenters) > 0 {
+			services.Datacenters = &cond.Datacenters
+		}
+		if config.StringVal(cond.Partition) != "" {
+			services.Partition = cond.Partition
+		}
+		if config.StringVal(cond.Peer) != "" {
+			services.Peer = cond.Peer
+		}
+		c.Services = services
 	case *config.CatalogServicesConditionConfig:
-		task.Condition.CatalogServices = &oapigen.CatalogServicesCondition{
+		catalogServices := &oapigen.CatalogServicesCondition{
 			Regexp:           *cond.Regexp,
 			UseAsModuleInput: cond.UseAsModuleInput,
 			Datacenter:       cond.Datacenter,
@@ -326,55 +439,229 @@ func oapigenTaskFromConfigTask(tc config.TaskConfig) oapigen.Task {
 				AdditionalProperties: cond.NodeMeta,
 			},
 		}
+		if len(cond.Datacenters) > 0 {
+			catalogServices.Datacenters = &cond.Datacenters
+		}
+		if config.StringVal(cond.Partition) != "" {
+			catalogServices.Partition = cond.Partition
+		}
+		if config.StringVal(cond.Peer) != "" {
+			catalogServices.Peer = cond.Peer
+		}
+		c.CatalogServices = catalogServices
 	case *config.ConsulKVConditionConfig:
-		task.Condition.ConsulKv = &oapigen.ConsulKVCondition{
+		c.ConsulKv = &oapigen.ConsulKVCondition{
 			Datacenter:       cond.Datacenter,
 			Recurse:          cond.Recurse,
 			Path:             *cond.Path,
 			Namespace:        cond.Namespace,
 			UseAsModuleInput: cond.UseAsModuleInput,
 		}
+		if config.StringVal(cond.Partition) != "" {
+			c.ConsulKv.Partition = cond.Partition
+		}
+		if decode := config.StringVal(cond.Decode); decode != "" {
+			d := oapigen.ConsulKVConditionDecode(decode)
+			c.ConsulKv.Decode = &d
+		}
+	case *config.IntentionsConditionConfig:
+		c.Intentions = &oapigen.IntentionsCondition{
+			Datacenter:          cond.Datacenter,
+			Namespace:           cond.Namespace,
+			SourceServices:      oapigenIntentionsServicesFromConfig(cond.SourceServices),
+			DestinationServices: oapigenIntentionsServicesFromConfig(cond.DestinationServices),
+			UseAsModuleInput:    cond.UseAsModuleInput,
+		}
+	case *config.NodesConditionConfig:
+		c.Nodes = &oapigen.NodesCondition{
+			Datacenter:       cond.Datacenter,
+			Filter:           cond.Filter,
+			UseAsModuleInput: cond.UseAsModuleInput,
+		}
 	case *config.ScheduleConditionConfig:
-		task.Condition.Schedule = &oapigen.ScheduleCondition{
+		c.Schedule = &oapigen.ScheduleCondition{
 			Cron: *cond.Cron,
 		}
+		if interval := config.TimeDurationVal(cond.OnChangeInterval); interval > 0 {
+			c.Schedule.OnChangeInterval = config.String(interval.String())
+		}
+	case *config.CompositeConditionConfig:
+		composite := &oapigen.CompositeCondition{}
+		for _, nested := range cond.Conditions {
+			oapigenConditionFromConfig(&composite.Conditions, nested)
+		}
+		if cond.IsAll() {
+			c.All = composite
+		} else {
+			c.Any = composite
+		}
 	}
+}
 
-	if tc.BufferPeriod != nil {
-		max := config.TimeDurationVal(tc.BufferPeriod.Max).String()
-		min := config.TimeDurationVal(tc.BufferPeriod.Min).String()
-		task.BufferPeriod = &oapigen.BufferPeriod{
-			Enabled: tc.BufferPeriod.Enabled,
-			Max:     &max,
-			Min:     &min,
-		}
+// conditionConfigFromOapigen converts the API representation of a task's
+// condition to its config representation. Returns nil if no condition is set.
+func conditionConfigFromOapigen(c oapigen.Condition) (config.ConditionConfig, error) {
+	conds, err := conditionConfigsFromOapigen(c)
+	if err != nil || len(conds) == 0 {
+		return nil, err
 	}
+	return conds[0], nil
+}
 
-	// Tasks created via API cannot configure the `services` field, but tasks
-	// created via CTS config file can currently configure `services` (deprecated).
-	// Handle `services` by converting to condition or module_input. There is
-	// config validation so that `services` cannot be configured when
-	// `condition "services"` or `module_input "services"` is configured.
-	// Use-case: returning tasks with `services via Get Task API
-	if tc.DeprecatedServices != nil && len(tc.DeprecatedServices) > 0 {
-		_, noCondition := tc.Condition.(*config.NoConditionConfig)
-		if tc.Condition == nil || noCondition {
-			task.Condition.Services = &oapigen.ServicesCondition{
-				Names:            &tc.DeprecatedServices,
-				UseAsModuleInput: config.Bool(true),
-			}
+// conditionConfigsFromOapigen converts each of the conditions set in the API
+// representation to its config representation
+func conditionConfigsFromOapigen(c oapigen.Condition) ([]config.ConditionConfig, error) {
+	var conds []config.ConditionConfig
+	if c.Any != nil {
+		nested, err := conditionConfigsFromOapigen(c.Any.Conditions)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, &config.CompositeConditionConfig{
+			Operator:   config.String("any"),
+			Conditions: nested,
+		})
+	}
+	if c.All != nil {
+		nested, err := conditionConfigsFromOapigen(c.All.Conditions)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, &config.CompositeConditionConfig{
+			Operator:   config.String("all"),
+			Conditions: nested,
+		})
+	}
+	if c.Services != nil {
+		cond := &config.ServicesConditionConfig{
+			ServicesMonitorConfig: config.ServicesMonitorConfig{
+				Datacenter:        c.Services.Datacenter,
+				Namespace:         c.Services.Namespace,
+				Partition:         c.Services.Partition,
+				Peer:              c.Services.Peer,
+				Filter:            c.Services.Filter,
+				IncludeNonPassing: c.Services.IncludeNonPassing,
+			},
+			UseAsModuleInput: c.Services.UseAsModuleInput,
+		}
+		if c.Services.Datacenters != nil {
+			cond.Datacenters = *c.Services.Datacenters
+		}
+		if c.Services.TriggerOn != nil {
+			cond.TriggerOn = config.String(string(*c.Services.TriggerOn))
+		}
+		if c.Services.Names != nil && len(*c.Services.Names) > 0 {
+			cond.Names = *c.Services.Names
 		} else {
-			if tc.ModuleInputs == nil {
-				task.ModuleInput = new(oapigen.ModuleInput)
-			}
-			task.ModuleInput.Services = &oapigen.ServicesModuleInput{
-				Names: &tc.DeprecatedServices,
+			cond.Regexp = c.Services.Regexp
+		}
+		if c.Services.CtsUserDefinedMeta != nil {
+			cond.ServicesMonitorConfig.CTSUserDefinedMeta =
+				c.Services.CtsUserDefinedMeta.AdditionalProperties
+		}
+		conds = append(conds, cond)
+	}
+	if c.ConsulKv != nil {
+		cond := &config.ConsulKVConditionConfig{
+			ConsulKVMonitorConfig: config.ConsulKVMonitorConfig{
+				Datacenter: c.ConsulKv.Datacenter,
+				Recurse:    c.ConsulKv.Recurse,
+				Path:       &c.ConsulKv.Path,
+				Namespace:  c.ConsulKv.Namespace,
+				Partition:  c.ConsulKv.Partition,
+			},
+			UseAsModuleInput: c.ConsulKv.UseAsModuleInput,
+		}
+		if c.ConsulKv.Decode != nil {
+			cond.Decode = config.String(string(*c.ConsulKv.Decode))
+		}
+		conds = append(conds, cond)
+	}
+	if c.Intentions != nil {
+		conds = append(conds, &config.IntentionsConditionConfig{
+			IntentionsMonitorConfig: config.IntentionsMonitorConfig{
+				Datacenter:          c.Intentions.Datacenter,
+				Namespace:           c.Intentions.Namespace,
+				SourceServices:      intentionsServicesConfigFromOapigen(c.Intentions.SourceServices),
+				DestinationServices: intentionsServicesConfigFromOapigen(c.Intentions.DestinationServices),
+			},
+			UseAsModuleInput: c.Intentions.UseAsModuleInput,
+		})
+	}
+	if c.Nodes != nil {
+		conds = append(conds, &config.NodesConditionConfig{
+			NodesMonitorConfig: config.NodesMonitorConfig{
+				Datacenter: c.Nodes.Datacenter,
+				Filter:     c.Nodes.Filter,
+			},
+			UseAsModuleInput: c.Nodes.UseAsModuleInput,
+		})
+	}
+	if c.CatalogServices != nil {
+		cond := &config.CatalogServicesConditionConfig{
+			CatalogServicesMonitorConfig: config.CatalogServicesMonitorConfig{
+				Regexp:           config.String(c.CatalogServices.Regexp),
+				UseAsModuleInput: c.CatalogServices.UseAsModuleInput,
+				Datacenter:       c.CatalogServices.Datacenter,
+				Namespace:        c.CatalogServices.Namespace,
+				Partition:        c.CatalogServices.Partition,
+				Peer:             c.CatalogServices.Peer,
+			},
+		}
+		if c.CatalogServices.NodeMeta != nil {
+			cond.NodeMeta = c.CatalogServices.NodeMeta.AdditionalProperties
+		}
+		if c.CatalogServices.Datacenters != nil {
+			cond.Datacenters = *c.CatalogServices.Datacenters
+		}
+		conds = append(conds, cond)
+	}
+	if c.Schedule != nil {
+		cond := &config.ScheduleConditionConfig{
+			Cron: &c.Schedule.Cron,
+		}
+		if c.Schedule.OnChangeInterval != nil {
+			interval, err := time.ParseDuration(*c.Schedule.OnChangeInterval)
+			if err != nil {
+				return nil, err
 			}
+			cond.OnChangeInterval = &interval
 		}
+		conds = append(conds, cond)
 	}
 
-	// Enterprise
-	task.TerraformVersion = tc.TFVersion
+	return conds, nil
+}
 
-	return task
+// intentionsServicesConfigFromOapigen converts the services filter of an
+// intentions condition or module input to its config representation
+func intentionsServicesConfigFromOapigen(s *oapigen.IntentionsServices) *config.IntentionsServicesConfig {
+	if s == nil {
+		return nil
+	}
+
+	c := &config.IntentionsServicesConfig{
+		Regexp: s.Regexp,
+	}
+	if s.Names != nil {
+		c.Names = *s.Names
+	}
+	return c
+}
+
+// oapigenIntentionsServicesFromConfig converts the services filter of an
+// intentions condition or module input to its API representation
+func oapigenIntentionsServicesFromConfig(c *config.IntentionsServicesConfig) *oapigen.IntentionsServices {
+	if c == nil {
+		return nil
+	}
+
+	s := &oapigen.IntentionsServices{
+		Regexp: c.Regexp,
+	}
+	if len(c.Names) > 0 {
+		names := c.Names
+		s.Names = &names
+	}
+	return s
 }
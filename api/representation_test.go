@@ -126,6 +126,9 @@ tup = ["abc", 123, true]`),
 }
 
 func TestRequest_oapigenTaskFromConfigTask(t *testing.T) {
+	decodeJSON := oapigen.ConsulKVConditionDecodeJson
+	decodeYAML := oapigen.ConsulKVModuleInputDecodeYaml
+
 	cases := []struct {
 		name       string
 		taskConfig config.TaskConfig
@@ -271,6 +274,7 @@ func TestRequest_oapigenTaskFromConfigTask(t *testing.T) {
 						Recurse:    config.Bool(true),
 						Datacenter: config.String("dc2"),
 						Namespace:  config.String("ns2"),
+						Decode:     config.String("json"),
 					},
 					UseAsModuleInput: config.Bool(true),
 				},
@@ -282,11 +286,237 @@ func TestRequest_oapigenTaskFromConfigTask(t *testing.T) {
 						Recurse:          config.Bool(true),
 						Datacenter:       config.String("dc2"),
 						Namespace:        config.String("ns2"),
+						Decode:           &decodeJSON,
 						UseAsModuleInput: config.Bool(true),
 					},
 				},
 			},
 		},
+		{
+			name: "with_health_aware_services_condition",
+			taskConfig: config.TaskConfig{
+				Condition: &config.ServicesConditionConfig{
+					ServicesMonitorConfig: config.ServicesMonitorConfig{
+						Names:             []string{"api"},
+						TriggerOn:         config.String(config.ServicesTriggerHealthTransition),
+						IncludeNonPassing: config.Bool(true),
+					},
+					UseAsModuleInput: config.Bool(true),
+				},
+			},
+			expected: oapigen.Task{
+				Condition: oapigen.Condition{
+					Services: &oapigen.ServicesCondition{
+						Names:              &[]string{"api"},
+						TriggerOn:          triggerOnPtr(oapigen.ServicesConditionTriggerOnHealthTransition),
+						IncludeNonPassing:  config.Bool(true),
+						CtsUserDefinedMeta: &oapigen.ServicesCondition_CtsUserDefinedMeta{},
+						UseAsModuleInput:   config.Bool(true),
+					},
+				},
+			},
+		},
+		{
+			name: "with_multi_datacenter_catalog_services_condition",
+			taskConfig: config.TaskConfig{
+				Condition: &config.CatalogServicesConditionConfig{
+					CatalogServicesMonitorConfig: config.CatalogServicesMonitorConfig{
+						Regexp:           config.String(".*"),
+						Datacenters:      []string{"all"},
+						UseAsModuleInput: config.Bool(true),
+					},
+				},
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.ServicesModuleInputConfig{
+						ServicesMonitorConfig: config.ServicesMonitorConfig{
+							Names:       []string{"api"},
+							Datacenters: []string{"dc1", "dc2"},
+						},
+					},
+				},
+			},
+			expected: oapigen.Task{
+				Condition: oapigen.Condition{
+					CatalogServices: &oapigen.CatalogServicesCondition{
+						Regexp:           ".*",
+						Datacenters:      &[]string{"all"},
+						UseAsModuleInput: config.Bool(true),
+						NodeMeta:         &oapigen.CatalogServicesCondition_NodeMeta{},
+					},
+				},
+				ModuleInput: &oapigen.ModuleInput{
+					Services: &oapigen.ServicesModuleInput{
+						Names:              &[]string{"api"},
+						Datacenters:        &[]string{"dc1", "dc2"},
+						CtsUserDefinedMeta: &oapigen.ServicesModuleInput_CtsUserDefinedMeta{},
+					},
+				},
+			},
+		},
+		{
+			name: "with_partition_and_peer",
+			taskConfig: config.TaskConfig{
+				Condition: &config.ServicesConditionConfig{
+					ServicesMonitorConfig: config.ServicesMonitorConfig{
+						Names:     []string{"api"},
+						Partition: config.String("ap1"),
+						Peer:      config.String("cluster-02"),
+					},
+					UseAsModuleInput: config.Bool(true),
+				},
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.ConsulKVModuleInputConfig{
+						ConsulKVMonitorConfig: config.ConsulKVMonitorConfig{
+							Path:      config.String("key"),
+							Partition: config.String("ap1"),
+							Decode:    config.String("yaml"),
+						},
+					},
+				},
+			},
+			expected: oapigen.Task{
+				Condition: oapigen.Condition{
+					Services: &oapigen.ServicesCondition{
+						Names:              &[]string{"api"},
+						Partition:          config.String("ap1"),
+						Peer:               config.String("cluster-02"),
+						CtsUserDefinedMeta: &oapigen.ServicesCondition_CtsUserDefinedMeta{},
+						UseAsModuleInput:   config.Bool(true),
+					},
+				},
+				ModuleInput: &oapigen.ModuleInput{
+					ConsulKv: &oapigen.ConsulKVModuleInput{
+						Path:      "key",
+						Partition: config.String("ap1"),
+						Decode:    &decodeYAML,
+					},
+				},
+			},
+		},
+		{
+			name: "with_intentions_condition",
+			taskConfig: config.TaskConfig{
+				Condition: &config.IntentionsConditionConfig{
+					IntentionsMonitorConfig: config.IntentionsMonitorConfig{
+						Datacenter: config.String("dc2"),
+						Namespace:  config.String("ns2"),
+						SourceServices: &config.IntentionsServicesConfig{
+							Regexp: config.String("^web"),
+						},
+						DestinationServices: &config.IntentionsServicesConfig{
+							Names: []string{"api"},
+						},
+					},
+					UseAsModuleInput: config.Bool(false),
+				},
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.IntentionsModuleInputConfig{
+						IntentionsMonitorConfig: config.IntentionsMonitorConfig{
+							SourceServices: &config.IntentionsServicesConfig{
+								Names: []string{"web"},
+							},
+						},
+					},
+				},
+			},
+			expected: oapigen.Task{
+				Condition: oapigen.Condition{
+					Intentions: &oapigen.IntentionsCondition{
+						Datacenter: config.String("dc2"),
+						Namespace:  config.String("ns2"),
+						SourceServices: &oapigen.IntentionsServices{
+							Regexp: config.String("^web"),
+						},
+						DestinationServices: &oapigen.IntentionsServices{
+							Names: &[]string{"api"},
+						},
+						UseAsModuleInput: config.Bool(false),
+					},
+				},
+				ModuleInput: &oapigen.ModuleInput{
+					Intentions: &oapigen.IntentionsModuleInput{
+						SourceServices: &oapigen.IntentionsServices{
+							Names: &[]string{"web"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "with_nodes_condition",
+			taskConfig: config.TaskConfig{
+				Condition: &config.NodesConditionConfig{
+					NodesMonitorConfig: config.NodesMonitorConfig{
+						Datacenter: config.String("dc2"),
+						Filter:     config.String("Meta.env == production"),
+					},
+					UseAsModuleInput: config.Bool(true),
+				},
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.NodesModuleInputConfig{
+						NodesMonitorConfig: config.NodesMonitorConfig{
+							Datacenter: config.String("dc1"),
+						},
+					},
+				},
+			},
+			expected: oapigen.Task{
+				Condition: oapigen.Condition{
+					Nodes: &oapigen.NodesCondition{
+						Datacenter:       config.String("dc2"),
+						Filter:           config.String("Meta.env == production"),
+						UseAsModuleInput: config.Bool(true),
+					},
+				},
+				ModuleInput: &oapigen.ModuleInput{
+					Nodes: &oapigen.NodesModuleInput{
+						Datacenter: config.String("dc1"),
+					},
+				},
+			},
+		},
+		{
+			name: "with_config_entries_module_input",
+			taskConfig: config.TaskConfig{
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.ConfigEntriesModuleInputConfig{
+						ConfigEntriesMonitorConfig: config.ConfigEntriesMonitorConfig{
+							Kind:       config.String("terminating-gateway"),
+							Names:      []string{"egress"},
+							Datacenter: config.String("dc1"),
+						},
+					},
+				},
+			},
+			expected: oapigen.Task{
+				ModuleInput: &oapigen.ModuleInput{
+					ConfigEntries: &oapigen.ConfigEntriesModuleInput{
+						Kind:       oapigen.ConfigEntriesModuleInputKindTerminatingGateway,
+						Names:      &[]string{"egress"},
+						Datacenter: config.String("dc1"),
+					},
+				},
+			},
+		},
+		{
+			name: "with_vault_secrets_module_input",
+			taskConfig: config.TaskConfig{
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.VaultSecretsModuleInputConfig{
+						VaultSecretsMonitorConfig: config.VaultSecretsMonitorConfig{
+							Paths: []string{"secret/data/app", "kv/db"},
+						},
+					},
+				},
+			},
+			expected: oapigen.Task{
+				ModuleInput: &oapigen.ModuleInput{
+					VaultSecrets: &oapigen.VaultSecretsModuleInput{
+						Paths: []string{"secret/data/app", "kv/db"},
+					},
+				},
+			},
+		},
 		{
 			name: "with_schedule_condition",
 			taskConfig: config.TaskConfig{
@@ -298,6 +528,49 @@ func TestRequest_oapigenTaskFromConfigTask(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with_schedule_condition_on_change_interval",
+			taskConfig: config.TaskConfig{
+				Condition: &config.ScheduleConditionConfig{
+					Cron:             config.String("*/10 * * * * * *"),
+					OnChangeInterval: config.TimeDuration(5 * time.Minute),
+				},
+			},
+			expected: oapigen.Task{
+				Condition: oapigen.Condition{
+					Schedule: &oapigen.ScheduleCondition{
+						Cron:             "*/10 * * * * * *",
+						OnChangeInterval: config.String("5m0s"),
+					},
+				},
+			},
+		},
+		{
+			name: "with_any_condition",
+			taskConfig: config.TaskConfig{
+				Condition: &config.CompositeConditionConfig{
+					Operator: config.String("any"),
+					Conditions: []config.ConditionConfig{
+						&config.ScheduleConditionConfig{Cron: config.String("*/10 * * * * * *")},
+						&config.ConsulKVConditionConfig{
+							ConsulKVMonitorConfig: config.ConsulKVMonitorConfig{
+								Path: config.String("key"),
+							},
+						},
+					},
+				},
+			},
+			expected: oapigen.Task{
+				Condition: oapigen.Condition{
+					Any: &oapigen.CompositeCondition{
+						Conditions: oapigen.Condition{
+							Schedule: &oapigen.ScheduleCondition{Cron: "*/10 * * * * * *"},
+							ConsulKv: &oapigen.ConsulKVCondition{Path: "key"},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "with_module_inputs",
 			taskConfig: config.TaskConfig{
@@ -459,6 +732,9 @@ func TestRequest_oapigenTaskFromConfigTask(t *testing.T) {
 }
 
 func TestTaskRequest_ToTaskConfig(t *testing.T) {
+	decodeJSON := oapigen.ConsulKVConditionDecodeJson
+	decodeYAML := oapigen.ConsulKVModuleInputDecodeYaml
+
 	cases := []struct {
 		name               string
 		request            *TaskRequest
@@ -661,6 +937,7 @@ func TestTaskRequest_ToTaskConfig(t *testing.T) {
 							Recurse:          config.Bool(true),
 							Datacenter:       config.String("dc2"),
 							Namespace:        config.String("ns2"),
+							Decode:           &decodeJSON,
 							UseAsModuleInput: config.Bool(true),
 						},
 					},
@@ -682,11 +959,334 @@ func TestTaskRequest_ToTaskConfig(t *testing.T) {
 						Recurse:    config.Bool(true),
 						Datacenter: config.String("dc2"),
 						Namespace:  config.String("ns2"),
+						Decode:     config.String("json"),
 					},
 					UseAsModuleInput: config.Bool(true),
 				},
 			},
 		},
+		{
+			name: "with_health_aware_services",
+			request: &TaskRequest{
+				Task: oapigen.Task{
+					Name:   "task",
+					Module: "path",
+					ModuleInput: &oapigen.ModuleInput{
+						Services: &oapigen.ServicesModuleInput{
+							Regexp:            config.String("^web"),
+							IncludeNonPassing: config.Bool(true),
+						},
+					},
+					Condition: oapigen.Condition{
+						Services: &oapigen.ServicesCondition{
+							Names:     &[]string{"api"},
+							TriggerOn: triggerOnPtr(oapigen.ServicesConditionTriggerOnMembership),
+						},
+					},
+				},
+			},
+			taskConfigExpected: config.TaskConfig{
+				Name: config.String("task"),
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.ServicesModuleInputConfig{
+						ServicesMonitorConfig: config.ServicesMonitorConfig{
+							Regexp:            config.String("^web"),
+							IncludeNonPassing: config.Bool(true),
+						},
+					},
+				},
+				Module: config.String("path"),
+				Condition: &config.ServicesConditionConfig{
+					ServicesMonitorConfig: config.ServicesMonitorConfig{
+						Names:     []string{"api"},
+						TriggerOn: config.String(config.ServicesTriggerMembership),
+					},
+				},
+			},
+		},
+		{
+			name: "with_multi_datacenter_services",
+			request: &TaskRequest{
+				Task: oapigen.Task{
+					Name:   "task",
+					Module: "path",
+					ModuleInput: &oapigen.ModuleInput{
+						Services: &oapigen.ServicesModuleInput{
+							Regexp:      config.String("^web"),
+							Datacenters: &[]string{"all"},
+						},
+					},
+					Condition: oapigen.Condition{
+						CatalogServices: &oapigen.CatalogServicesCondition{
+							Regexp:      "^web",
+							Datacenters: &[]string{"dc1", "dc2"},
+						},
+					},
+				},
+			},
+			taskConfigExpected: config.TaskConfig{
+				Name: config.String("task"),
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.ServicesModuleInputConfig{
+						ServicesMonitorConfig: config.ServicesMonitorConfig{
+							Regexp:      config.String("^web"),
+							Datacenters: []string{"all"},
+						},
+					},
+				},
+				Module: config.String("path"),
+				Condition: &config.CatalogServicesConditionConfig{
+					CatalogServicesMonitorConfig: config.CatalogServicesMonitorConfig{
+						Regexp:      config.String("^web"),
+						Datacenters: []string{"dc1", "dc2"},
+					},
+				},
+			},
+		},
+		{
+			name: "with_partition_and_peer",
+			request: &TaskRequest{
+				Task: oapigen.Task{
+					Name:   "task",
+					Module: "path",
+					ModuleInput: &oapigen.ModuleInput{
+						Services: &oapigen.ServicesModuleInput{
+							Names:     &[]string{"api"},
+							Partition: config.String("ap1"),
+							Peer:      config.String("cluster-02"),
+						},
+					},
+					Condition: oapigen.Condition{
+						ConsulKv: &oapigen.ConsulKVCondition{
+							Path:      "key",
+							Partition: config.String("ap1"),
+						},
+					},
+				},
+			},
+			taskConfigExpected: config.TaskConfig{
+				Name: config.String("task"),
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.ServicesModuleInputConfig{
+						ServicesMonitorConfig: config.ServicesMonitorConfig{
+							Names:     []string{"api"},
+							Partition: config.String("ap1"),
+							Peer:      config.String("cluster-02"),
+						},
+					},
+				},
+				Module: config.String("path"),
+				Condition: &config.ConsulKVConditionConfig{
+					ConsulKVMonitorConfig: config.ConsulKVMonitorConfig{
+						Path:      config.String("key"),
+						Partition: config.String("ap1"),
+					},
+				},
+			},
+		},
+		{
+			name: "with_intentions_condition",
+			request: &TaskRequest{
+				Task: oapigen.Task{
+					Name:   "task",
+					Module: "path",
+					ModuleInput: &oapigen.ModuleInput{
+						Intentions: &oapigen.IntentionsModuleInput{
+							DestinationServices: &oapigen.IntentionsServices{
+								Regexp: config.String("^api"),
+							},
+						},
+					},
+					Condition: oapigen.Condition{
+						Intentions: &oapigen.IntentionsCondition{
+							Datacenter: config.String("dc2"),
+							SourceServices: &oapigen.IntentionsServices{
+								Names: &[]string{"web"},
+							},
+							UseAsModuleInput: config.Bool(true),
+						},
+					},
+				},
+			},
+			taskConfigExpected: config.TaskConfig{
+				Name: config.String("task"),
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.IntentionsModuleInputConfig{
+						IntentionsMonitorConfig: config.IntentionsMonitorConfig{
+							DestinationServices: &config.IntentionsServicesConfig{
+								Regexp: config.String("^api"),
+							},
+						},
+					},
+				},
+				Module: config.String("path"),
+				Condition: &config.IntentionsConditionConfig{
+					IntentionsMonitorConfig: config.IntentionsMonitorConfig{
+						Datacenter: config.String("dc2"),
+						SourceServices: &config.IntentionsServicesConfig{
+							Names: []string{"web"},
+						},
+					},
+					UseAsModuleInput: config.Bool(true),
+				},
+			},
+		},
+		{
+			name: "with_nodes_condition",
+			request: &TaskRequest{
+				Task: oapigen.Task{
+					Name:   "task",
+					Module: "path",
+					ModuleInput: &oapigen.ModuleInput{
+						Nodes: &oapigen.NodesModuleInput{
+							Datacenter: config.String("dc1"),
+						},
+					},
+					Condition: oapigen.Condition{
+						Nodes: &oapigen.NodesCondition{
+							Filter:           config.String("Meta.env == production"),
+							UseAsModuleInput: config.Bool(false),
+						},
+					},
+				},
+			},
+			taskConfigExpected: config.TaskConfig{
+				Name: config.String("task"),
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.NodesModuleInputConfig{
+						NodesMonitorConfig: config.NodesMonitorConfig{
+							Datacenter: config.String("dc1"),
+						},
+					},
+				},
+				Module: config.String("path"),
+				Condition: &config.NodesConditionConfig{
+					NodesMonitorConfig: config.NodesMonitorConfig{
+						Filter: config.String("Meta.env == production"),
+					},
+					UseAsModuleInput: config.Bool(false),
+				},
+			},
+		},
+		{
+			name: "with_all_condition",
+			request: &TaskRequest{
+				Task: oapigen.Task{
+					Name:   "task",
+					Module: "path",
+					Condition: oapigen.Condition{
+						All: &oapigen.CompositeCondition{
+							Conditions: oapigen.Condition{
+								Schedule: &oapigen.ScheduleCondition{Cron: "*/10 * * * * * *"},
+								Nodes:    &oapigen.NodesCondition{},
+							},
+						},
+					},
+				},
+			},
+			taskConfigExpected: config.TaskConfig{
+				Name:   config.String("task"),
+				Module: config.String("path"),
+				Condition: &config.CompositeConditionConfig{
+					Operator: config.String("all"),
+					Conditions: []config.ConditionConfig{
+						&config.NodesConditionConfig{},
+						&config.ScheduleConditionConfig{
+							Cron: config.String("*/10 * * * * * *"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "with_schedule_condition_on_change_interval",
+			request: &TaskRequest{
+				Task: oapigen.Task{
+					Name:   "task",
+					Module: "path",
+					Condition: oapigen.Condition{
+						Schedule: &oapigen.ScheduleCondition{
+							Cron:             "*/10 * * * * * *",
+							OnChangeInterval: config.String("5m"),
+						},
+					},
+				},
+			},
+			taskConfigExpected: config.TaskConfig{
+				Name:   config.String("task"),
+				Module: config.String("path"),
+				Condition: &config.ScheduleConditionConfig{
+					Cron:             config.String("*/10 * * * * * *"),
+					OnChangeInterval: config.TimeDuration(5 * time.Minute),
+				},
+			},
+		},
+		{
+			name: "with_config_entries_module_input",
+			request: &TaskRequest{
+				Task: oapigen.Task{
+					Name:   "task",
+					Module: "path",
+					Condition: oapigen.Condition{
+						Schedule: &oapigen.ScheduleCondition{Cron: "*/10 * * * * * *"},
+					},
+					ModuleInput: &oapigen.ModuleInput{
+						ConfigEntries: &oapigen.ConfigEntriesModuleInput{
+							Kind:      oapigen.ConfigEntriesModuleInputKindIngressGateway,
+							Names:     &[]string{"ingress"},
+							Namespace: config.String("ns"),
+						},
+					},
+				},
+			},
+			taskConfigExpected: config.TaskConfig{
+				Name:   config.String("task"),
+				Module: config.String("path"),
+				Condition: &config.ScheduleConditionConfig{
+					Cron: config.String("*/10 * * * * * *"),
+				},
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.ConfigEntriesModuleInputConfig{
+						ConfigEntriesMonitorConfig: config.ConfigEntriesMonitorConfig{
+							Kind:      config.String("ingress-gateway"),
+							Names:     []string{"ingress"},
+							Namespace: config.String("ns"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "with_vault_secrets_module_input",
+			request: &TaskRequest{
+				Task: oapigen.Task{
+					Name:   "task",
+					Module: "path",
+					Condition: oapigen.Condition{
+						Schedule: &oapigen.ScheduleCondition{Cron: "*/10 * * * * * *"},
+					},
+					ModuleInput: &oapigen.ModuleInput{
+						VaultSecrets: &oapigen.VaultSecretsModuleInput{
+							Paths: []string{"secret/data/app"},
+						},
+					},
+				},
+			},
+			taskConfigExpected: config.TaskConfig{
+				Name:   config.String("task"),
+				Module: config.String("path"),
+				Condition: &config.ScheduleConditionConfig{
+					Cron: config.String("*/10 * * * * * *"),
+				},
+				ModuleInputs: &config.ModuleInputConfigs{
+					&config.VaultSecretsModuleInputConfig{
+						VaultSecretsMonitorConfig: config.VaultSecretsMonitorConfig{
+							Paths: []string{"secret/data/app"},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "with_schedule_condition",
 			request: &TaskRequest{
@@ -734,6 +1334,7 @@ func TestTaskRequest_ToTaskConfig(t *testing.T) {
 							Recurse:    config.Bool(true),
 							Datacenter: config.String("dc"),
 							Namespace:  config.String("ns"),
+							Decode:     &decodeYAML,
 						},
 					},
 				},
@@ -756,6 +1357,7 @@ func TestTaskRequest_ToTaskConfig(t *testing.T) {
 							Recurse:    config.Bool(true),
 							Datacenter: config.String("dc"),
 							Namespace:  config.String("ns"),
+							Decode:     config.String("yaml"),
 						},
 					},
 				},
@@ -831,6 +1433,21 @@ func TestTaskRequest_ToRequestTaskConfig_Error(t *testing.T) {
 			},
 			contains: "invalid duration",
 		},
+		{
+			name: "invalid on_change_interval",
+			request: &TaskRequest{
+				Task: oapigen.Task{
+					Name: "test-name",
+					Condition: oapigen.Condition{
+						Schedule: &oapigen.ScheduleCondition{
+							Cron:             "*/10 * * * * * *",
+							OnChangeInterval: config.String("invalid"),
+						},
+					},
+				},
+			},
+			contains: "invalid duration",
+		},
 	}
 
 	for _, tc := range cases {
@@ -906,3 +1523,7 @@ func TestTaskResponse_taskResponseFromTaskConfig(t *testing.T) {
 	actual := taskResponseFromTaskConfig(tc.taskConfig, "e9926514-79b8-a8fc-8761-9b6aaccf1e15")
 	assert.Equal(t, tc.expectedResponse, actual)
 }
+
+func triggerOnPtr(t oapigen.ServicesConditionTriggerOn) *oapigen.ServicesConditionTriggerOn {
+	return &t
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/state/rollout"
+)
+
+const rolloutSubsystemName = "rollout"
+
+// RolloutHandler handles the requests that roll out module versions across
+// tasks
+type RolloutHandler struct {
+	ctrl Server
+}
+
+// NewRolloutHandler returns a new RolloutHandler
+func NewRolloutHandler(ctrl Server) *RolloutHandler {
+	return &RolloutHandler{
+		ctrl: ctrl,
+	}
+}
+
+// CreateRollout starts upgrading the module version of the tasks that use the
+// module in batches. The response is returned once the rollout is started,
+// and its progress can be retrieved with GetRollout.
+func (h *RolloutHandler) CreateRollout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := requestIDFromContext(ctx)
+	logger := logging.FromContext(ctx).Named(rolloutSubsystemName)
+	logger.Trace("create rollout request received, reading request")
+
+	var req oapigen.RolloutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error("bad request", "error", err, "create_rollout_request", r.Body)
+		sendError(w, r, http.StatusBadRequest,
+			fmt.Errorf("error decoding the request: %v", err))
+		return
+	}
+	logger.Trace("create rollout request", "create_rollout_request", req)
+
+	if h.ctrl.Paused(ctx) {
+		err := fmt.Errorf("task execution is paused, a rollout cannot " +
+			"start until execution is resumed")
+		logger.Trace("task execution paused", "error", err)
+		sendError(w, r, http.StatusConflict, err)
+		return
+	}
+
+	batchSize := 1
+	if req.BatchSize != nil {
+		batchSize = *req.BatchSize
+	}
+
+	ro, err := h.ctrl.RolloutCreate(ctx, req.Module, req.Version, batchSize)
+	if err != nil {
+		logger.Trace("unable to start rollout", "error", err)
+		sendError(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	resp := oapigen.RolloutResponse{
+		RequestId: requestID,
+		Rollout:   oapigenRolloutFromRollout(ro),
+	}
+	writeResponse(w, r, http.StatusAccepted, resp)
+
+	logger.Trace("rollout started", "create_rollout_response", resp)
+}
+
+// GetRollout returns the progress of a rollout
+func (h *RolloutHandler) GetRollout(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	requestID := requestIDFromContext(ctx)
+	logger := logging.FromContext(ctx).Named(rolloutSubsystemName).With("rollout_id", id)
+	logger.Trace("get rollout request")
+
+	ro, err := h.ctrl.Rollout(ctx, id)
+	if err != nil {
+		logger.Trace("rollout not found", "error", err)
+		sendError(w, r, http.StatusNotFound, err)
+		return
+	}
+
+	resp := oapigen.RolloutResponse{
+		RequestId: requestID,
+		Rollout:   oapigenRolloutFromRollout(ro),
+	}
+	writeResponse(w, r, http.StatusOK, resp)
+
+	logger.Trace("rollout retrieved", "get_rollout_response", resp)
+}
+
+// oapigenRolloutFromRollout converts a rollout to the API representation
+func oapigenRolloutFromRollout(ro rollout.Rollout) *oapigen.Rollout {
+	resp := &oapigen.Rollout{
+		Id:        ro.ID,
+		Module:    ro.Module,
+		Version:   ro.Version,
+		BatchSize: ro.BatchSize,
+		Status:    oapigen.RolloutStatus(ro.Status),
+		StartTime: ro.StartTime,
+		Tasks:     make([]oapigen.RolloutTask, len(ro.Tasks)),
+	}
+	if !ro.EndTime.IsZero() {
+		endTime := ro.EndTime
+		resp.EndTime = &endTime
+	}
+
+	for i, t := range ro.Tasks {
+		task := oapigen.RolloutTask{
+			Name:            t.Name,
+			PreviousVersion: t.PreviousVersion,
+			Status:          oapigen.RolloutTaskStatus(t.Status),
+		}
+		if t.Error != "" {
+			taskErr := t.Error
+			task.Error = &taskErr
+		}
+		resp.Tasks[i] = task
+	}
+	return resp
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/server"
+	"github.com/hashicorp/consul-terraform-sync/state/rollout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRolloutHandler_CreateRollout(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name       string
+		body       string
+		mockServer func(*mocks.Server)
+		statusCode int
+	}{
+		{
+			"happy_path",
+			`{"module": "org/module", "version": "1.1.0", "batch_size": 2}`,
+			func(ctrl *mocks.Server) {
+				ctrl.On("Paused", mock.Anything).Return(false)
+				ctrl.On("RolloutCreate", mock.Anything, "org/module", "1.1.0", 2).
+					Return(rollout.Rollout{ID: "id", Status: rollout.StatusRunning}, nil)
+			},
+			http.StatusAccepted,
+		},
+		{
+			"default_batch_size",
+			`{"module": "org/module", "version": "1.1.0"}`,
+			func(ctrl *mocks.Server) {
+				ctrl.On("Paused", mock.Anything).Return(false)
+				ctrl.On("RolloutCreate", mock.Anything, "org/module", "1.1.0", 1).
+					Return(rollout.Rollout{ID: "id", Status: rollout.StatusRunning}, nil)
+			},
+			http.StatusAccepted,
+		},
+		{
+			"bad_request",
+			`{"module": `,
+			func(ctrl *mocks.Server) {},
+			http.StatusBadRequest,
+		},
+		{
+			"paused",
+			`{"module": "org/module", "version": "1.1.0"}`,
+			func(ctrl *mocks.Server) {
+				ctrl.On("Paused", mock.Anything).Return(true)
+			},
+			http.StatusConflict,
+		},
+		{
+			"create_error",
+			`{"module": "org/module", "version": "1.1.0"}`,
+			func(ctrl *mocks.Server) {
+				ctrl.On("Paused", mock.Anything).Return(false)
+				ctrl.On("RolloutCreate", mock.Anything, "org/module", "1.1.0", 1).
+					Return(rollout.Rollout{}, fmt.Errorf("rollout already running"))
+			},
+			http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := new(mocks.Server)
+			tc.mockServer(ctrl)
+			handler := NewRolloutHandler(ctrl)
+
+			req, err := http.NewRequest(http.MethodPost, "/v1/rollouts",
+				bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+			resp := httptest.NewRecorder()
+
+			handler.CreateRollout(resp, req)
+			assert.Equal(t, tc.statusCode, resp.Code)
+			ctrl.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRolloutHandler_GetRollout(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name       string
+		mockServer func(*mocks.Server)
+		statusCode int
+	}{
+		{
+			"happy_path",
+			func(ctrl *mocks.Server) {
+				ctrl.On("Rollout", mock.Anything, "id").
+					Return(rollout.Rollout{ID: "id"}, nil)
+			},
+			http.StatusOK,
+		},
+		{
+			"not_found",
+			func(ctrl *mocks.Server) {
+				ctrl.On("Rollout", mock.Anything, "id").
+					Return(rollout.Rollout{}, fmt.Errorf("DNE"))
+			},
+			http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := new(mocks.Server)
+			tc.mockServer(ctrl)
+			handler := NewRolloutHandler(ctrl)
+
+			req, err := http.NewRequest(http.MethodGet, "/v1/rollouts/id", nil)
+			require.NoError(t, err)
+			resp := httptest.NewRecorder()
+
+			handler.GetRollout(resp, req, "id")
+			assert.Equal(t, tc.statusCode, resp.Code)
+			ctrl.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOapigenRolloutFromRollout(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	end := start.Add(time.Minute)
+	ro := rollout.Rollout{
+		ID:        "id",
+		Module:    "org/module",
+		Version:   "1.1.0",
+		BatchSize: 1,
+		Status:    rollout.StatusFailed,
+		StartTime: start,
+		EndTime:   end,
+		Tasks: []rollout.Task{
+			{Name: "task_a", PreviousVersion: "1.0.0", Status: rollout.StatusSucceeded},
+			{Name: "task_b", PreviousVersion: "1.0.0", Status: rollout.StatusFailed, Error: "error"},
+		},
+	}
+
+	taskErr := "error"
+	expected := &oapigen.Rollout{
+		Id:        "id",
+		Module:    "org/module",
+		Version:   "1.1.0",
+		BatchSize: 1,
+		Status:    oapigen.RolloutStatusFailed,
+		StartTime: start,
+		EndTime:   &end,
+		Tasks: []oapigen.RolloutTask{
+			{Name: "task_a", PreviousVersion: "1.0.0", Status: oapigen.RolloutTaskStatusSucceeded},
+			{Name: "task_b", PreviousVersion: "1.0.0", Status: oapigen.RolloutTaskStatusFailed, Error: &taskErr},
+		},
+	}
+	assert.Equal(t, expected, oapigenRolloutFromRollout(ro))
+
+	// a running rollout has no end time
+	ro.EndTime = time.Time{}
+	assert.Nil(t, oapigenRolloutFromRollout(ro).EndTime)
+}
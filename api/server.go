@@ -2,9 +2,11 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/hashicorp/consul-terraform-sync/state/rollout"
 )
 
 //go:generate mockery --name=Server --filename=server.go --output=../mocks/server
@@ -14,12 +16,24 @@ type Server interface {
 	Config() config.Config
 	Events(ctx context.Context, taskName string) (map[string][]event.Event, error)
 
+	Pause(ctx context.Context)
+	Paused(ctx context.Context) bool
+	Resume(ctx context.Context)
+
+	Rollout(ctx context.Context, id string) (rollout.Rollout, error)
+	RolloutCreate(ctx context.Context, module, version string, batchSize int) (rollout.Rollout, error)
+
 	Task(ctx context.Context, taskName string) (config.TaskConfig, error)
+	TaskApprove(ctx context.Context, taskName string, override bool) error
+	TaskBlocked(ctx context.Context, taskName string) (string, bool)
 	TaskCreate(context.Context, config.TaskConfig) (config.TaskConfig, error)
 	TaskCreateAndRun(context.Context, config.TaskConfig) (config.TaskConfig, error)
+	TaskDeferred(ctx context.Context, taskName string) (time.Time, bool)
 	TaskDelete(ctx context.Context, taskName string) error
 	// TODO: update signatures to return a new run object
 	TaskInspect(context.Context, config.TaskConfig) (bool, string, string, error)
+	TaskRollback(ctx context.Context, taskName, eventID string) error
+	TaskSnapshot(ctx context.Context, taskName, eventID string) bool
 	// TODO: update signature with an update config object since only a subset of
 	// options can be changed and determine the location of sharable objects
 	// across packages
@@ -117,10 +117,13 @@ func TestStatus(t *testing.T) {
 			taskName: es,
 		}
 		ctrl.On("Task", mock.Anything, taskName).Return(conf, nil).
-			On("Events", mock.Anything, taskName).Return(eventResp, nil)
+			On("Events", mock.Anything, taskName).Return(eventResp, nil).
+			On("TaskBlocked", mock.Anything, taskName).Return("", false).
+			On("TaskDeferred", mock.Anything, taskName).Return(time.Time{}, false)
 	}
 	ctrl.On("Tasks", mock.Anything).Return(confs, nil)
 	ctrl.On("Events", mock.Anything, "").Return(events, nil)
+	ctrl.On("Paused", mock.Anything).Return(false)
 
 	// start up server
 	port := testutils.FreePort(t)
@@ -290,7 +293,8 @@ func TestWaitForAPI(t *testing.T) {
 	t.Run("available", func(t *testing.T) {
 		ctrl := new(mocks.Server)
 		ctrl.On("Tasks", mock.Anything).Return([]config.TaskConfig{}, nil).
-			On("Events", mock.Anything, "").Return(map[string][]event.Event{}, nil)
+			On("Events", mock.Anything, "").Return(map[string][]event.Event{}, nil).
+			On("Paused", mock.Anything).Return(false)
 
 		// start up server
 		port := testutils.FreePort(t)
@@ -126,6 +126,14 @@ func (h *taskHandler) updateTask(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if runOp == RunOptionNow && h.ctrl.Paused(ctx) {
+		err = fmt.Errorf("task execution is paused, task '%s' cannot be run "+
+			"until execution is resumed", taskName)
+		logger.Trace("task execution paused", "error", err)
+		jsonErrorResponse(ctx, w, http.StatusConflict, err)
+		return
+	}
+
 	tc.Enabled = conf.Enabled
 	if runOp == RunOptionInspect {
 		logger.Info("generating inspect plan if task becomes enabled")
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+)
+
+const approveTaskSubsystemName = "approvetask"
+
+// ApproveTaskByName applies a task that is blocked by its guardrails. The
+// task's changes are applied before the response is returned.
+func (h *TaskLifeCycleHandler) ApproveTaskByName(w http.ResponseWriter, r *http.Request, name string, params oapigen.ApproveTaskByNameParams) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ctx := r.Context()
+	requestID := requestIDFromContext(ctx)
+	logger := logging.FromContext(r.Context()).Named(approveTaskSubsystemName).With("task_name", name)
+	logger.Trace("approve task request", "approve_task_params", params)
+
+	// Check if task exists
+	_, err := h.ctrl.Task(ctx, name)
+	if err != nil {
+		logger.Trace("task not found", "error", err)
+		sendError(w, r, http.StatusNotFound, err)
+		return
+	}
+
+	if _, ok := h.ctrl.TaskBlocked(ctx, name); !ok {
+		err := fmt.Errorf("task '%s' is not blocked by its guardrails and "+
+			"does not need to be approved", name)
+		logger.Trace("task not blocked", "error", err)
+		sendError(w, r, http.StatusConflict, err)
+		return
+	}
+
+	if h.ctrl.Paused(ctx) {
+		err := fmt.Errorf("task execution is paused, task '%s' cannot be "+
+			"approved until execution is resumed", name)
+		logger.Trace("task execution paused", "error", err)
+		sendError(w, r, http.StatusConflict, err)
+		return
+	}
+
+	override := params.Override != nil && *params.Override
+	if override {
+		logger.Info("overriding guardrails for task")
+	} else {
+		logger.Info("approving task")
+	}
+
+	err = h.ctrl.TaskApprove(ctx, name, override)
+	if err != nil {
+		sendError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := oapigen.TaskApproveResponse{RequestId: requestID}
+	writeResponse(w, r, http.StatusOK, resp)
+
+	logger.Trace("task approved", "approve_task_response", resp)
+}
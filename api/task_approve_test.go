@@ -0,0 +1,106 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskLifeCycleHandler_ApproveTaskByName(t *testing.T) {
+	t.Parallel()
+	taskName := "task"
+	override := true
+	cases := []struct {
+		name       string
+		params     oapigen.ApproveTaskByNameParams
+		mockServer func(*mocks.Server)
+		statusCode int
+	}{
+		{
+			"happy_path",
+			oapigen.ApproveTaskByNameParams{},
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, taskName).Return(config.TaskConfig{}, nil)
+				ctrl.On("TaskBlocked", mock.Anything, taskName).Return("reason", true)
+				ctrl.On("Paused", mock.Anything).Return(false)
+				ctrl.On("TaskApprove", mock.Anything, taskName, false).Return(nil)
+			},
+			http.StatusOK,
+		},
+		{
+			"override",
+			oapigen.ApproveTaskByNameParams{Override: &override},
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, taskName).Return(config.TaskConfig{}, nil)
+				ctrl.On("TaskBlocked", mock.Anything, taskName).Return("reason", true)
+				ctrl.On("Paused", mock.Anything).Return(false)
+				ctrl.On("TaskApprove", mock.Anything, taskName, true).Return(nil)
+			},
+			http.StatusOK,
+		},
+		{
+			"task_not_found",
+			oapigen.ApproveTaskByNameParams{},
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, taskName).Return(config.TaskConfig{}, fmt.Errorf("DNE"))
+			},
+			http.StatusNotFound,
+		},
+		{
+			"task_not_blocked",
+			oapigen.ApproveTaskByNameParams{},
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, taskName).Return(config.TaskConfig{}, nil)
+				ctrl.On("TaskBlocked", mock.Anything, taskName).Return("", false)
+			},
+			http.StatusConflict,
+		},
+		{
+			"paused",
+			oapigen.ApproveTaskByNameParams{},
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, taskName).Return(config.TaskConfig{}, nil)
+				ctrl.On("TaskBlocked", mock.Anything, taskName).Return("reason", true)
+				ctrl.On("Paused", mock.Anything).Return(true)
+			},
+			http.StatusConflict,
+		},
+		{
+			"task_errored",
+			oapigen.ApproveTaskByNameParams{},
+			func(ctrl *mocks.Server) {
+				err := fmt.Errorf("guardrails blocked tf-apply")
+				ctrl.On("Task", mock.Anything, taskName).Return(config.TaskConfig{}, nil)
+				ctrl.On("TaskBlocked", mock.Anything, taskName).Return("reason", true)
+				ctrl.On("Paused", mock.Anything).Return(false)
+				ctrl.On("TaskApprove", mock.Anything, taskName, false).Return(err)
+			},
+			http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := new(mocks.Server)
+			tc.mockServer(ctrl)
+			handler := NewTaskLifeCycleHandler(ctrl)
+
+			path := fmt.Sprintf("/v1/tasks/%s/approve", taskName)
+			req, err := http.NewRequest(http.MethodPost, path, nil)
+			require.NoError(t, err)
+			resp := httptest.NewRecorder()
+
+			handler.ApproveTaskByName(resp, req, taskName, tc.params)
+			assert.Equal(t, tc.statusCode, resp.Code)
+			ctrl.AssertExpectations(t)
+		})
+	}
+}
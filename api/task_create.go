@@ -52,6 +52,13 @@ func (h *TaskLifeCycleHandler) CreateTask(w http.ResponseWriter, r *http.Request
 		tc, err = h.ctrl.TaskCreate(ctx, trc)
 	} else if *params.Run == RunOptionNow {
 		logger.Trace("run now option")
+		if h.ctrl.Paused(ctx) {
+			err := fmt.Errorf("task execution is paused, task '%s' cannot "+
+				"be run until execution is resumed", req.Task.Name)
+			logger.Trace("task execution paused", "error", err)
+			sendError(w, r, http.StatusConflict, err)
+			return
+		}
 		tc, err = h.ctrl.TaskCreateAndRun(ctx, trc)
 	} else if *params.Run == RunOptionInspect {
 		logger.Trace("run inspect option")
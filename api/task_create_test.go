@@ -102,7 +102,8 @@ func TestTaskLifeCycleHandler_CreateTask(t *testing.T) {
 			ctrl := new(mocks.Server)
 			ctrl.On("Task", mock.Anything, testTaskName).Return(config.TaskConfig{}, fmt.Errorf("DNE")).
 				On("TaskCreate", mock.Anything, tc.mockReturn).Return(tc.mockReturn, nil).
-				On("TaskCreateAndRun", mock.Anything, tc.mockReturn).Return(tc.mockReturn, nil)
+				On("TaskCreateAndRun", mock.Anything, tc.mockReturn).Return(tc.mockReturn, nil).
+				On("Paused", mock.Anything).Return(false)
 			handler := NewTaskLifeCycleHandler(ctrl)
 
 			resp := runTestCreateTask(t, handler, tc.run, tc.statusCode, tc.request)
@@ -226,6 +227,18 @@ func TestTaskLifeCycleHandler_CreateTask_InternalError(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestTaskLifeCycleHandler_CreateTask_Paused(t *testing.T) {
+	t.Parallel()
+
+	ctrl := new(mocks.Server)
+	ctrl.On("Task", mock.Anything, testTaskName).Return(config.TaskConfig{}, fmt.Errorf("DNE"))
+	ctrl.On("Paused", mock.Anything).Return(true)
+	handler := NewTaskLifeCycleHandler(ctrl)
+
+	runTestCreateTask(t, handler, "now", http.StatusConflict, testTaskJSON)
+	ctrl.AssertNotCalled(t, "TaskCreateAndRun", mock.Anything, mock.Anything)
+}
+
 func generateExpectedResponse(t *testing.T, req string) oapigen.TaskResponse {
 	var treq oapigen.TaskRequest
 	err := json.Unmarshal([]byte(req), &treq)
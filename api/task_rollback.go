@@ -0,0 +1,68 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+)
+
+const rollbackTaskSubsystemName = "rollbacktask"
+
+// RollbackTaskByName applies the snapshot of a task's run for a prior event
+// and pins the task to it until the task is re-enabled. The snapshot is
+// applied before the response is returned.
+func (h *TaskLifeCycleHandler) RollbackTaskByName(w http.ResponseWriter, r *http.Request, name string, params oapigen.RollbackTaskByNameParams) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ctx := r.Context()
+	requestID := requestIDFromContext(ctx)
+	logger := logging.FromContext(r.Context()).Named(rollbackTaskSubsystemName).With("task_name", name)
+	logger.Trace("rollback task request", "rollback_task_params", params)
+
+	// Check if task exists
+	_, err := h.ctrl.Task(ctx, name)
+	if err != nil {
+		logger.Trace("task not found", "error", err)
+		sendError(w, r, http.StatusNotFound, err)
+		return
+	}
+
+	if params.EventId == "" {
+		err := fmt.Errorf("event_id is required to roll back task '%s'", name)
+		logger.Trace("missing event ID", "error", err)
+		sendError(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if !h.ctrl.TaskSnapshot(ctx, name, params.EventId) {
+		err := fmt.Errorf("task '%s' cannot be rolled back to event '%s'. "+
+			"Only recent events of successful runs can be rolled back to",
+			name, params.EventId)
+		logger.Trace("snapshot not found", "error", err)
+		sendError(w, r, http.StatusNotFound, err)
+		return
+	}
+
+	if h.ctrl.Paused(ctx) {
+		err := fmt.Errorf("task execution is paused, task '%s' cannot be "+
+			"rolled back until execution is resumed", name)
+		logger.Trace("task execution paused", "error", err)
+		sendError(w, r, http.StatusConflict, err)
+		return
+	}
+
+	logger.Info("rolling back task", "event_id", params.EventId)
+	err = h.ctrl.TaskRollback(ctx, name, params.EventId)
+	if err != nil {
+		sendError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := oapigen.TaskRollbackResponse{RequestId: requestID}
+	writeResponse(w, r, http.StatusOK, resp)
+
+	logger.Trace("task rolled back", "rollback_task_response", resp)
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskLifeCycleHandler_RollbackTaskByName(t *testing.T) {
+	t.Parallel()
+	taskName := "task"
+	eventID := "event"
+	cases := []struct {
+		name       string
+		params     oapigen.RollbackTaskByNameParams
+		mockServer func(*mocks.Server)
+		statusCode int
+	}{
+		{
+			"happy_path",
+			oapigen.RollbackTaskByNameParams{EventId: eventID},
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, taskName).Return(config.TaskConfig{}, nil)
+				ctrl.On("TaskSnapshot", mock.Anything, taskName, eventID).Return(true)
+				ctrl.On("Paused", mock.Anything).Return(false)
+				ctrl.On("TaskRollback", mock.Anything, taskName, eventID).Return(nil)
+			},
+			http.StatusOK,
+		},
+		{
+			"task_not_found",
+			oapigen.RollbackTaskByNameParams{EventId: eventID},
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, taskName).Return(config.TaskConfig{}, fmt.Errorf("DNE"))
+			},
+			http.StatusNotFound,
+		},
+		{
+			"missing_event_id",
+			oapigen.RollbackTaskByNameParams{},
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, taskName).Return(config.TaskConfig{}, nil)
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"snapshot_not_found",
+			oapigen.RollbackTaskByNameParams{EventId: eventID},
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, taskName).Return(config.TaskConfig{}, nil)
+				ctrl.On("TaskSnapshot", mock.Anything, taskName, eventID).Return(false)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"paused",
+			oapigen.RollbackTaskByNameParams{EventId: eventID},
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, taskName).Return(config.TaskConfig{}, nil)
+				ctrl.On("TaskSnapshot", mock.Anything, taskName, eventID).Return(true)
+				ctrl.On("Paused", mock.Anything).Return(true)
+			},
+			http.StatusConflict,
+		},
+		{
+			"task_errored",
+			oapigen.RollbackTaskByNameParams{EventId: eventID},
+			func(ctrl *mocks.Server) {
+				err := fmt.Errorf("error tf-apply")
+				ctrl.On("Task", mock.Anything, taskName).Return(config.TaskConfig{}, nil)
+				ctrl.On("TaskSnapshot", mock.Anything, taskName, eventID).Return(true)
+				ctrl.On("Paused", mock.Anything).Return(false)
+				ctrl.On("TaskRollback", mock.Anything, taskName, eventID).Return(err)
+			},
+			http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := new(mocks.Server)
+			tc.mockServer(ctrl)
+			handler := NewTaskLifeCycleHandler(ctrl)
+
+			path := fmt.Sprintf("/v1/tasks/%s/rollback", taskName)
+			req, err := http.NewRequest(http.MethodPost, path, nil)
+			require.NoError(t, err)
+			resp := httptest.NewRecorder()
+
+			handler.RollbackTaskByName(resp, req, taskName, tc.params)
+			assert.Equal(t, tc.statusCode, resp.Code)
+			ctrl.AssertExpectations(t)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/logging"
@@ -23,6 +24,14 @@ type TaskStatus struct {
 	EventsURL string        `json:"events_url"`
 	Events    []event.Event `json:"events,omitempty"`
 
+	// BlockedReason describes the guardrails that the task's plan exceeded
+	// when the task is blocked
+	BlockedReason string `json:"blocked_reason,omitempty"`
+
+	// DeferredUntil is the time the change windows are next checked when the
+	// task's changes are deferred
+	DeferredUntil *time.Time `json:"deferred_until,omitempty"`
+
 	// Providers and Services are deprecated in v0.5. These are configuration
 	// details about the task rather than status information. Users should
 	// switch to using the Get Task API to request the task's provider and
@@ -100,6 +109,7 @@ func (h *taskStatusHandler) getTaskStatus(w http.ResponseWriter, r *http.Request
 			return
 		}
 		status := makeTaskStatus(events, task, h.version)
+		h.setDriverStatus(r, &status)
 
 		if filter != "" && status.Status != filter {
 			continue
@@ -120,13 +130,16 @@ func (h *taskStatusHandler) getTaskStatus(w http.ResponseWriter, r *http.Request
 				jsonErrorResponse(ctx, w, http.StatusNotFound, err)
 				return
 			}
-			statuses[taskName] = makeTaskStatusUnknown(task)
+			status := makeTaskStatusUnknown(task)
+			h.setDriverStatus(r, &status)
+			statuses[taskName] = status
 		}
 	}
 
 	// if user requested all tasks and status filter applicable, check driver
 	// for tasks without events
-	if taskName == "" && (filter == "" || filter == StatusUnknown) {
+	if taskName == "" && (filter == "" || filter == StatusUnknown ||
+		filter == StatusBlocked || filter == StatusDeferred) {
 		tasks, err := h.ctrl.Tasks(ctx)
 		if err != nil {
 			logger.Trace("error getting tasks", "error", err)
@@ -135,7 +148,12 @@ func (h *taskStatusHandler) getTaskStatus(w http.ResponseWriter, r *http.Request
 		}
 		for _, task := range tasks {
 			if _, ok := data[*task.Name]; !ok {
-				statuses[*task.Name] = makeTaskStatusUnknown(task)
+				status := makeTaskStatusUnknown(task)
+				h.setDriverStatus(r, &status)
+				if filter != "" && status.Status != filter {
+					continue
+				}
+				statuses[*task.Name] = status
 			}
 		}
 	}
@@ -177,6 +195,24 @@ func makeTaskStatus(events []event.Event, task config.TaskConfig,
 	}
 }
 
+// setDriverStatus sets the status of a task that is deferred by its change
+// windows or blocked by its guardrails. Blocked takes precedence since a
+// blocked task does not apply even when its change windows open.
+func (h *taskStatusHandler) setDriverStatus(r *http.Request, status *TaskStatus) {
+	if until, ok := h.ctrl.TaskDeferred(r.Context(), status.TaskName); ok {
+		status.Status = StatusDeferred
+		if !until.IsZero() {
+			status.DeferredUntil = &until
+		}
+	}
+
+	if reason, ok := h.ctrl.TaskBlocked(r.Context(), status.TaskName); ok {
+		status.Status = StatusBlocked
+		status.BlockedReason = reason
+		status.DeferredUntil = nil
+	}
+}
+
 // makeTaskStatusUnknown returns a task status for tasks that do not have events
 // but still exist within CTS. Example: a task that has been disabled from the start
 func makeTaskStatusUnknown(task config.TaskConfig) TaskStatus {
@@ -280,12 +316,13 @@ func statusFilter(r *http.Request) (string, error) {
 	value := keys[0]
 	value = strings.ToLower(value)
 	switch value {
-	case StatusSuccessful, StatusErrored, StatusCritical, StatusUnknown:
+	case StatusSuccessful, StatusErrored, StatusCritical, StatusUnknown,
+		StatusBlocked, StatusDeferred:
 		return value, nil
 	default:
 		return "", fmt.Errorf("unsupported status parameter value. only "+
-			"supporting status values %s, %s, %s, and %s but got %s",
+			"supporting status values %s, %s, %s, %s, %s, and %s but got %s",
 			StatusSuccessful, StatusErrored, StatusCritical, StatusUnknown,
-			value)
+			StatusBlocked, StatusDeferred, value)
 	}
 }
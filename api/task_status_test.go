@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/config"
 	serverMocks "github.com/hashicorp/consul-terraform-sync/mocks/server"
@@ -42,6 +43,7 @@ func TestTaskStatus_ServeHTTP(t *testing.T) {
 		"task_a": {{Success: true}},                                     // successful
 		"task_b": {{Success: false}, {Success: false}, {Success: true}}, // critical
 		"task_c": {{Success: false}, {Success: true}, {Success: true}},  // errored
+		"task_e": {{Success: false}, {Success: true}},                   // blocked
 	}
 
 	disabledTask := config.TaskConfig{
@@ -55,7 +57,10 @@ func TestTaskStatus_ServeHTTP(t *testing.T) {
 		"task_b": createTaskConf("task_b", true),
 		"task_c": createTaskConf("task_c", true),
 		"task_d": disabledTask,
+		"task_e": createTaskConf("task_e", true),
+		"task_f": createTaskConf("task_f", true), // deferred
 	}
+	deferredUntil := time.Date(2026, time.January, 5, 22, 0, 0, 0, time.UTC)
 
 	ctrl := new(serverMocks.Server)
 	confs := make([]config.TaskConfig, 0, len(configs))
@@ -67,6 +72,16 @@ func TestTaskStatus_ServeHTTP(t *testing.T) {
 		}
 		ctrl.On("Events", mock.Anything, taskName).Return(eventResp, nil).
 			On("Task", mock.Anything, taskName).Return(conf, nil)
+		if taskName == "task_e" {
+			ctrl.On("TaskBlocked", mock.Anything, taskName).Return("guardrails reason", true)
+		} else {
+			ctrl.On("TaskBlocked", mock.Anything, taskName).Return("", false)
+		}
+		if taskName == "task_f" {
+			ctrl.On("TaskDeferred", mock.Anything, taskName).Return(deferredUntil, true)
+		} else {
+			ctrl.On("TaskDeferred", mock.Anything, taskName).Return(time.Time{}, false)
+		}
 	}
 	ctrl.On("Events", mock.Anything, "task_nonexistent").Return(nil, nil).
 		On("Task", mock.Anything, "task_nonexistent").Return(config.TaskConfig{}, fmt.Errorf("DNE"))
@@ -119,6 +134,22 @@ func TestTaskStatus_ServeHTTP(t *testing.T) {
 					Providers: []string{"null"},
 					EventsURL: "",
 				},
+				"task_e": {
+					TaskName:      "task_e",
+					Status:        StatusBlocked,
+					Enabled:       true,
+					Providers:     []string{},
+					Services:      []string{},
+					EventsURL:     "/v1/status/tasks/task_e?include=events",
+					BlockedReason: "guardrails reason",
+				},
+				"task_f": {
+					TaskName:      "task_f",
+					Status:        StatusDeferred,
+					Enabled:       true,
+					EventsURL:     "",
+					DeferredUntil: &deferredUntil,
+				},
 			},
 		},
 		{
@@ -162,6 +193,55 @@ func TestTaskStatus_ServeHTTP(t *testing.T) {
 					EventsURL: "",
 					Events:    nil,
 				},
+				"task_e": {
+					TaskName:      "task_e",
+					Status:        StatusBlocked,
+					Enabled:       true,
+					Providers:     []string{},
+					Services:      []string{},
+					EventsURL:     "/v1/status/tasks/task_e?include=events",
+					Events:        events["task_e"],
+					BlockedReason: "guardrails reason",
+				},
+				"task_f": {
+					TaskName:      "task_f",
+					Status:        StatusDeferred,
+					Enabled:       true,
+					EventsURL:     "",
+					DeferredUntil: &deferredUntil,
+				},
+			},
+		},
+		{
+			"all task statuses filtered by status deferred",
+			"/v1/status/tasks?status=deferred",
+			http.MethodGet,
+			http.StatusOK,
+			map[string]TaskStatus{
+				"task_f": {
+					TaskName:      "task_f",
+					Status:        StatusDeferred,
+					Enabled:       true,
+					EventsURL:     "",
+					DeferredUntil: &deferredUntil,
+				},
+			},
+		},
+		{
+			"all task statuses filtered by status blocked",
+			"/v1/status/tasks?status=blocked",
+			http.MethodGet,
+			http.StatusOK,
+			map[string]TaskStatus{
+				"task_e": {
+					TaskName:      "task_e",
+					Status:        StatusBlocked,
+					Enabled:       true,
+					Providers:     []string{},
+					Services:      []string{},
+					EventsURL:     "/v1/status/tasks/task_e?include=events",
+					BlockedReason: "guardrails reason",
+				},
 			},
 		},
 		{
@@ -121,6 +121,7 @@ func TestTask_updateTask(t *testing.T) {
 			`{"enabled": true}`,
 			func(ctrl *mocks.Server) {
 				ctrl.On("Task", mock.Anything, "task_a").Return(config.TaskConfig{}, nil).
+					On("Paused", mock.Anything).Return(false).
 					On("TaskUpdate", mock.Anything, mock.Anything, "now").Return(true, "", "", nil)
 			},
 			http.StatusOK,
@@ -185,11 +186,23 @@ func TestTask_updateTask(t *testing.T) {
 			`{"enabled": true}`,
 			func(ctrl *mocks.Server) {
 				ctrl.On("Task", mock.Anything, "task_a").Return(config.TaskConfig{}, nil).
+					On("Paused", mock.Anything).Return(false).
 					On("TaskUpdate", mock.Anything, mock.Anything, "now").Return(false, "", "", fmt.Errorf("update error"))
 			},
 			http.StatusInternalServerError,
 			UpdateTaskResponse{},
 		},
+		{
+			"paused with run-now",
+			"/v1/tasks/task_a?run=now",
+			`{"enabled": true}`,
+			func(ctrl *mocks.Server) {
+				ctrl.On("Task", mock.Anything, "task_a").Return(config.TaskConfig{}, nil).
+					On("Paused", mock.Anything).Return(true)
+			},
+			http.StatusConflict,
+			UpdateTaskResponse{},
+		},
 		{
 			"invalid run option",
 			"/v1/tasks/task_a?run=bad-run-option",
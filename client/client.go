@@ -2,29 +2,54 @@ package client
 
 import (
 	"context"
+	"errors"
 	"io"
+
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 //go:generate mockery --name=Client --filename=client.go  --output=../mocks/client
 
+// ErrAlreadyManaged is returned by Import when the resource address is already
+// managed in the state, so that repeated imports can be skipped
+var ErrAlreadyManaged = errors.New("resource already managed by Terraform")
+
 // Client describes the interface for a driver's client that interacts
 // with network infrastructure.
 type Client interface {
 	// SetEnv Set the environment for the client
 	SetEnv(map[string]string) error
 
+	// SetVars sets the values of input variables for the client's commands.
+	// The values are not written to the working directory, which is used for
+	// sensitive variables.
+	SetVars(map[string]interface{}) error
+
 	// SetStdout Set the standard out for the client
 	SetStdout(w io.Writer)
 
 	// Init initializes the client and environment
 	Init(ctx context.Context) error
 
-	// Apply makes a request to apply changes
+	// Import makes a request to import existing infrastructure with the given
+	// ID into the state at the resource address. Returns ErrAlreadyManaged if
+	// the address is already in the state.
+	Import(ctx context.Context, address, id string) error
+
+	// Apply makes a request to apply changes. The plan of the most recent
+	// Plan is applied when it has not been discarded.
 	Apply(ctx context.Context) error
 
 	// Plan makes a request to generate a plan of proposed changes
 	Plan(ctx context.Context) (bool, error)
 
+	// ShowPlan returns the structured plan of the most recent Plan
+	ShowPlan(ctx context.Context) (*tfjson.Plan, error)
+
+	// DiscardPlan discards the plan of the most recent Plan so that it is
+	// not applied by a later Apply
+	DiscardPlan() error
+
 	// Validate verifies that the generated configurations are valid
 	Validate(ctx context.Context) error
 
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 var _ Client = (*Printer)(nil)
@@ -59,6 +61,17 @@ func (p *Printer) SetEnv(map[string]string) error {
 	return nil
 }
 
+// SetVars logs out 'setting variables'. The values are not logged.
+func (p *Printer) SetVars(vars map[string]interface{}) error {
+	names := make([]string, 0, len(vars))
+	for k := range vars {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	p.logger.Info("setting variables for workspace", "variables", names)
+	return nil
+}
+
 // SetStdout logs out 'set standard out'
 func (p *Printer) SetStdout(io.Writer) {
 	p.logger.Info("setting standard out for workspace")
@@ -76,12 +89,30 @@ func (p *Printer) Apply(context.Context) error {
 	return nil
 }
 
+// Import logs out 'import'
+func (p *Printer) Import(_ context.Context, address, id string) error {
+	p.logger.Info("importing resource to workspace", "address", address, "id", id)
+	return nil
+}
+
 // Plan logs out 'plan'
 func (p *Printer) Plan(context.Context) (bool, error) {
 	p.logger.Info("planning workspace")
 	return true, nil
 }
 
+// ShowPlan logs out 'show plan' and returns an empty plan
+func (p *Printer) ShowPlan(context.Context) (*tfjson.Plan, error) {
+	p.logger.Info("showing plan for workspace")
+	return &tfjson.Plan{}, nil
+}
+
+// DiscardPlan logs out 'discard plan'
+func (p *Printer) DiscardPlan() error {
+	p.logger.Info("discarding plan for workspace")
+	return nil
+}
+
 // Validate logs out 'validate'
 func (p *Printer) Validate(context.Context) error {
 	p.logger.Info("validating workspace")
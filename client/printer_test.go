@@ -100,6 +100,20 @@ func TestPrinterSetEnv(t *testing.T) {
 	assert.Contains(t, buf.String(), "env")
 }
 
+func TestPrinterSetVars(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	p, err := DefaultTestPrinter(&buf)
+	assert.NoError(t, err)
+
+	err = p.SetVars(map[string]interface{}{"vault_secrets": "secret-value"})
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "client.printer")
+	assert.Contains(t, buf.String(), "vault_secrets")
+	assert.NotContains(t, buf.String(), "secret-value")
+}
+
 func TestPrinterSetStdout(t *testing.T) {
 	t.Parallel()
 
@@ -191,6 +205,21 @@ func TestPrinterApply(t *testing.T) {
 	assert.Contains(t, buf.String(), "apply")
 }
 
+func TestPrinterImport(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	p, err := DefaultTestPrinter(&buf)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	err = p.Import(ctx, "module.task.local_file.greeting", "id")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, buf.String())
+	assert.Contains(t, buf.String(), "client.printer")
+	assert.Contains(t, buf.String(), "importing")
+}
+
 func TestPrinterPlan(t *testing.T) {
 	t.Parallel()
 
@@ -207,6 +236,19 @@ func TestPrinterPlan(t *testing.T) {
 	assert.Contains(t, buf.String(), "plan")
 }
 
+func TestPrinterShowPlan(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	p, err := DefaultTestPrinter(&buf)
+	assert.NoError(t, err)
+
+	plan, err := p.ShowPlan(context.Background())
+	assert.NoError(t, err)
+	assert.NotNil(t, plan)
+	assert.Contains(t, buf.String(), "showing plan")
+}
+
 func TestPrinterValidate(t *testing.T) {
 	t.Parallel()
 
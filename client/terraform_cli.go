@@ -2,16 +2,20 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
+	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
 	"github.com/hashicorp/terraform-exec/tfexec"
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 var (
@@ -19,11 +23,10 @@ var (
 
 	wsFailedToSelectRegexp = regexp.MustCompile(`Failed to select workspace`)
 	wsDoesNotExistRegexp   = regexp.MustCompile(`workspace ".*" does not exist`)
+	alreadyManagedRegexp   = regexp.MustCompile(`Resource already managed by Terraform`)
 )
 
-const (
-	tcliSubsystemName = "terraformcli"
-)
+const tcliSubsystemName = "terraformcli"
 
 // TerraformCLI is the client that wraps around terraform-exec
 // to execute Terraform cli commands
@@ -31,7 +34,14 @@ type TerraformCLI struct {
 	tf         terraformExec
 	workingDir string
 	workspace  string
+	vars       map[string]interface{}
 	logger     logging.Logger
+
+	// planFile is the file that the most recent plan is saved to until it is
+	// applied or discarded. The plan contains the values of variables, so it
+	// is saved outside of the working directory and only readable by the
+	// owner.
+	planFile string
 }
 
 // TerraformCLIConfig configures the Terraform client
@@ -41,6 +51,10 @@ type TerraformCLIConfig struct {
 	ExecPath   string
 	WorkingDir string
 	Workspace  string
+
+	// ExecName is the file name of the CLI binary within ExecPath. Defaults
+	// to "terraform", and is "tofu" for OpenTofu.
+	ExecName string
 }
 
 // NewTerraformCLI creates a terraform-exec client and configures and
@@ -50,7 +64,11 @@ func NewTerraformCLI(config *TerraformCLIConfig) (*TerraformCLI, error) {
 		return nil, errors.New("TerraformCLIConfig cannot be nil - no meaningful default values")
 	}
 
-	tfPath := filepath.Join(config.ExecPath, "terraform")
+	execName := config.ExecName
+	if execName == "" {
+		execName = "terraform"
+	}
+	tfPath := filepath.Join(config.ExecPath, execName)
 	tf, err := tfexec.NewTerraform(config.WorkingDir, tfPath)
 	if err != nil {
 		return nil, err
@@ -98,6 +116,18 @@ func (t *TerraformCLI) SetEnv(env map[string]string) error {
 	return t.tf.SetEnv(env)
 }
 
+// SetVars sets the input variables that are passed to Terraform with a
+// temporary variable file for the commands that require them. The file is
+// created outside of the working directory, is only readable by the current
+// user, and is removed once the command completes.
+func (t *TerraformCLI) SetVars(vars map[string]interface{}) error {
+	t.vars = make(map[string]interface{}, len(vars))
+	for k, v := range vars {
+		t.vars[k] = v
+	}
+	return nil
+}
+
 // SetStdout sets the standard out for Terraform
 func (t *TerraformCLI) SetStdout(w io.Writer) {
 	t.tf.SetStdout(w)
@@ -155,14 +185,101 @@ TF_INIT_AGAIN:
 	return nil
 }
 
-// Apply executes the cli command `terraform apply` for a given workspace
+// Apply executes the cli command `terraform apply` for a given workspace. The
+// saved plan of the most recent Plan is applied when there is one, so that the
+// changes applied are the changes that were planned. The saved plan is removed
+// afterwards.
 func (t *TerraformCLI) Apply(ctx context.Context) error {
-	return t.tf.Apply(ctx)
+	if t.planFile != "" {
+		defer t.DiscardPlan()
+		return t.tf.Apply(ctx, tfexec.DirOrPlan(t.planFile))
+	}
+
+	return t.withVarFile(func(varFile string) error {
+		var opts []tfexec.ApplyOption
+		if varFile != "" {
+			opts = append(opts, tfexec.VarFile(varFile))
+		}
+		return t.tf.Apply(ctx, opts...)
+	})
 }
 
-// Plan executes the cli command `terraform plan` for a given workspace
+// Import executes the cli command `terraform import` for a given workspace.
+// ErrAlreadyManaged is returned for resources that are already managed in the
+// state so that imports are safe to repeat.
+func (t *TerraformCLI) Import(ctx context.Context, address, id string) error {
+	err := t.withVarFile(func(varFile string) error {
+		var opts []tfexec.ImportOption
+		if varFile != "" {
+			opts = append(opts, tfexec.VarFile(varFile))
+		}
+		return t.tf.Import(ctx, address, id, opts...)
+	})
+	if err != nil && alreadyManagedRegexp.MatchString(err.Error()) {
+		t.logger.Debug("resource already exists in state, skipping import",
+			"address", address, "id", id)
+		return ErrAlreadyManaged
+	}
+	return err
+}
+
+// Plan executes the cli command `terraform plan` for a given workspace. The
+// plan is saved to a temporary file for ShowPlan and Apply.
 func (t *TerraformCLI) Plan(ctx context.Context) (bool, error) {
-	return t.tf.Plan(ctx)
+	if err := t.DiscardPlan(); err != nil {
+		return false, err
+	}
+
+	// TempFile creates the file with permissions 0600, which Terraform keeps
+	// when it writes the plan to the existing file
+	f, err := ioutil.TempFile("", "cts-*.tfplan")
+	if err != nil {
+		return false, fmt.Errorf("unable to create plan file: %s", err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return false, fmt.Errorf("unable to create plan file: %s", err)
+	}
+	t.planFile = f.Name()
+
+	var changes bool
+	err = t.withVarFile(func(varFile string) error {
+		opts := []tfexec.PlanOption{tfexec.Out(t.planFile)}
+		if varFile != "" {
+			opts = append(opts, tfexec.VarFile(varFile))
+		}
+
+		var err error
+		changes, err = t.tf.Plan(ctx, opts...)
+		return err
+	})
+	if err != nil {
+		t.DiscardPlan()
+	}
+	return changes, err
+}
+
+// ShowPlan executes the cli command `terraform show -json` for the saved plan
+// of the most recent Plan
+func (t *TerraformCLI) ShowPlan(ctx context.Context) (*tfjson.Plan, error) {
+	if t.planFile == "" {
+		return nil, fmt.Errorf("no plan exists for workspace '%s'", t.workspace)
+	}
+	return t.tf.ShowPlanFile(ctx, t.planFile)
+}
+
+// DiscardPlan removes the saved plan of the most recent Plan
+func (t *TerraformCLI) DiscardPlan() error {
+	if t.planFile == "" {
+		return nil
+	}
+
+	err := os.Remove(t.planFile)
+	t.planFile = ""
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
 }
 
 // Validate verifies the generated configuration files
@@ -210,6 +327,31 @@ func (t *TerraformCLI) Validate(ctx context.Context) error {
 	return nil
 }
 
+// withVarFile writes the input variables to a temporary variable file for the
+// duration of fn. The path of the file is empty when there are no variables.
+func (t *TerraformCLI) withVarFile(fn func(varFile string) error) error {
+	if len(t.vars) == 0 {
+		return fn("")
+	}
+
+	// TempFile creates the file with permissions 0600
+	f, err := ioutil.TempFile("", "cts-*.tfvars.json")
+	if err != nil {
+		return fmt.Errorf("unable to create variable file: %s", err)
+	}
+	defer os.Remove(f.Name())
+
+	if err := json.NewEncoder(f).Encode(t.vars); err != nil {
+		f.Close()
+		return fmt.Errorf("unable to write variable file: %s", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("unable to write variable file: %s", err)
+	}
+
+	return fn(f.Name())
+}
+
 // GoString defines the printable version of this struct.
 func (t *TerraformCLI) GoString() string {
 	if t == nil {
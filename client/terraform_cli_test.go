@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
@@ -21,7 +25,7 @@ func NewTestTerraformCLI(config *TerraformCLIConfig, tfMock *mocks.TerraformExec
 		m.On("SetEnv", mock.Anything).Return(nil)
 		m.On("Init", mock.Anything).Return(nil)
 		m.On("Apply", mock.Anything).Return(nil)
-		m.On("Plan", mock.Anything).Return(true, nil)
+		m.On("Plan", mock.Anything, mock.Anything).Return(true, nil)
 		m.On("WorkspaceNew", mock.Anything, mock.Anything).Return(nil)
 		tfMock = m
 	}
@@ -78,6 +82,16 @@ func TestNewTerraformCLI(t *testing.T) {
 				Workspace:  "my-workspace",
 			},
 		},
+		{
+			"happy path: opentofu",
+			false,
+			&TerraformCLIConfig{
+				ExecPath:   "path/to/tf",
+				ExecName:   "tofu",
+				WorkingDir: "./",
+				Workspace:  "my-workspace",
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -244,6 +258,118 @@ func TestTerraformCLIApply(t *testing.T) {
 	}
 }
 
+func TestTerraformCLIApply_SavedPlan(t *testing.T) {
+	t.Parallel()
+
+	var planFile string
+	writePlan := func(args mock.Arguments) {
+		// the plan contains variable values and is only readable by the owner
+		opt := args.Get(1).(*tfexec.OutOption)
+		planFile = reflect.ValueOf(opt).Elem().FieldByName("path").String()
+
+		info, err := os.Stat(planFile)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+		require.NoError(t, ioutil.WriteFile(planFile, []byte("plan"), 0600))
+	}
+	applyPlan := func(args mock.Arguments) {
+		opt := args.Get(1).(*tfexec.DirOrPlanOption)
+		path := reflect.ValueOf(opt).Elem().FieldByName("path").String()
+		assert.Equal(t, planFile, path)
+	}
+
+	wd := t.TempDir()
+	m := new(mocks.TerraformExec)
+	m.On("Plan", mock.Anything, mock.AnythingOfType("*tfexec.OutOption")).
+		Run(writePlan).Return(true, nil).Twice()
+	m.On("Apply", mock.Anything, mock.AnythingOfType("*tfexec.DirOrPlanOption")).
+		Run(applyPlan).Return(nil).Once()
+	m.On("Apply", mock.Anything).Return(nil).Once()
+
+	client := NewTestTerraformCLI(&TerraformCLIConfig{WorkingDir: wd}, m)
+	ctx := context.Background()
+
+	t.Run("apply saved plan", func(t *testing.T) {
+		_, err := client.Plan(ctx)
+		require.NoError(t, err)
+		assert.FileExists(t, planFile)
+		assert.NotEqual(t, wd, filepath.Dir(planFile))
+
+		require.NoError(t, client.Apply(ctx))
+		assert.NoFileExists(t, planFile)
+	})
+
+	t.Run("discarded plan", func(t *testing.T) {
+		_, err := client.Plan(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, client.DiscardPlan())
+		assert.NoFileExists(t, planFile)
+
+		require.NoError(t, client.Apply(ctx))
+	})
+
+	// no plan is left in the working directory
+	files, err := ioutil.ReadDir(wd)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+	m.AssertExpectations(t)
+}
+
+func TestTerraformCLIImport(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		importErr   error
+		expectError bool
+		expectSkip  bool
+	}{
+		{
+			"happy path",
+			nil,
+			false,
+			false,
+		},
+		{
+			"already managed",
+			errors.New(`exit status 1
+
+Error: Resource already managed by Terraform
+
+Terraform is already managing a remote object for
+module.task.local_file.greeting.`),
+			true,
+			true,
+		},
+		{
+			"error",
+			errors.New("Error: Cannot import non-existent remote object"),
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := new(mocks.TerraformExec)
+			m.On("Import", mock.Anything, "module.task.local_file.greeting", "id").
+				Return(tc.importErr).Once()
+
+			client := NewTestTerraformCLI(&TerraformCLIConfig{}, m)
+			err := client.Import(context.Background(), "module.task.local_file.greeting", "id")
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectSkip, errors.Is(err, ErrAlreadyManaged))
+			} else {
+				assert.NoError(t, err)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
 func TestTerraformCLIPlan(t *testing.T) {
 	t.Parallel()
 
@@ -264,6 +390,7 @@ func TestTerraformCLIPlan(t *testing.T) {
 			client := NewTestTerraformCLI(tc.config, nil)
 			ctx := context.Background()
 			_, err := client.Plan(ctx)
+			defer client.DiscardPlan()
 
 			if tc.expectError {
 				assert.Error(t, err)
@@ -275,6 +402,84 @@ func TestTerraformCLIPlan(t *testing.T) {
 	}
 }
 
+func TestTerraformCLISetVars(t *testing.T) {
+	t.Parallel()
+
+	var varFile string
+	var content []byte
+	readVarFile := func(args mock.Arguments) {
+		// the var file only exists for the duration of the command
+		opt := args.Get(len(args) - 1).(*tfexec.VarFileOption)
+		varFile = reflect.ValueOf(opt).Elem().FieldByName("path").String()
+
+		info, err := os.Stat(varFile)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+		content, err = ioutil.ReadFile(varFile)
+		require.NoError(t, err)
+	}
+
+	m := new(mocks.TerraformExec)
+	m.On("Plan", mock.Anything, mock.AnythingOfType("*tfexec.OutOption"),
+		mock.AnythingOfType("*tfexec.VarFileOption")).
+		Run(readVarFile).Return(true, nil).Once()
+	m.On("Apply", mock.Anything, mock.AnythingOfType("*tfexec.VarFileOption")).
+		Run(readVarFile).Return(nil).Once()
+	m.On("Import", mock.Anything, "addr", "id",
+		mock.AnythingOfType("*tfexec.VarFileOption")).
+		Run(readVarFile).Return(nil).Once()
+
+	client := NewTestTerraformCLI(nil, m)
+	require.NoError(t, client.SetVars(map[string]interface{}{
+		"vault_secrets": map[string]map[string]string{
+			"kv/db": {"username": "admin"},
+		},
+	}))
+
+	ctx := context.Background()
+	expected := `{"vault_secrets":{"kv/db":{"username":"admin"}}}`
+	require.NoError(t, client.Apply(ctx))
+	assert.JSONEq(t, expected, string(content))
+	assert.NoFileExists(t, varFile)
+
+	_, err := client.Plan(ctx)
+	require.NoError(t, err)
+	defer client.DiscardPlan()
+	assert.JSONEq(t, expected, string(content))
+	assert.NoFileExists(t, varFile)
+
+	require.NoError(t, client.Import(ctx, "addr", "id"))
+	assert.JSONEq(t, expected, string(content))
+	assert.NoFileExists(t, varFile)
+
+	m.AssertExpectations(t)
+}
+
+func TestTerraformCLIShowPlan(t *testing.T) {
+	t.Parallel()
+
+	expected := &tfjson.Plan{FormatVersion: "0.2"}
+	m := new(mocks.TerraformExec)
+	m.On("Plan", mock.Anything, mock.AnythingOfType("*tfexec.OutOption")).
+		Return(true, nil).Once()
+	m.On("ShowPlanFile", mock.Anything, mock.AnythingOfType("string")).
+		Return(expected, nil).Once()
+
+	client := NewTestTerraformCLI(nil, m)
+	ctx := context.Background()
+	_, err := client.ShowPlan(ctx)
+	assert.Error(t, err, "no plan before Plan")
+
+	_, err = client.Plan(ctx)
+	require.NoError(t, err)
+	defer client.DiscardPlan()
+
+	plan, err := client.ShowPlan(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expected, plan)
+	m.AssertExpectations(t)
+}
+
 func TestTerraformCLIValidate(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,951 @@
+package client
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+var _ Client = (*TerraformCloud)(nil)
+
+const (
+	tfcSubsystemName = "terraformcloud"
+
+	tfcAPIPath          = "/api/v2"
+	tfcContentType      = "application/vnd.api+json"
+	tfcRunMessage       = "Queued by Consul-Terraform-Sync"
+	defaultPollInterval = 2 * time.Second
+
+	// tfcPageSize is the maximum page size of the Terraform Cloud API list
+	// endpoints
+	tfcPageSize = 100
+
+	// Terraform Cloud run statuses
+	runStatusPlanned            = "planned"
+	runStatusPlannedAndFinished = "planned_and_finished"
+	runStatusCostEstimated      = "cost_estimated"
+	runStatusPolicyChecked      = "policy_checked"
+	runStatusPolicySoftFailed   = "policy_soft_failed"
+	runStatusApplied            = "applied"
+	runStatusErrored            = "errored"
+	runStatusDiscarded          = "discarded"
+	runStatusCanceled           = "canceled"
+	runStatusForceCanceled      = "force_canceled"
+
+	cvStatusUploaded = "uploaded"
+	cvStatusErrored  = "errored"
+)
+
+var (
+	// errTFCNotFound is returned when the Terraform Cloud API responds with a
+	// 404 status code
+	errTFCNotFound = errors.New("resource not found")
+
+	// runPlanEndStatuses are the statuses where a run has finished planning
+	// and is either waiting to be confirmed or will not progress further
+	runPlanEndStatuses = map[string]bool{
+		runStatusPlanned:            true,
+		runStatusPlannedAndFinished: true,
+		runStatusCostEstimated:      true,
+		runStatusPolicyChecked:      true,
+		runStatusPolicySoftFailed:   true,
+		runStatusApplied:            true,
+		runStatusErrored:            true,
+		runStatusDiscarded:          true,
+		runStatusCanceled:           true,
+		runStatusForceCanceled:      true,
+	}
+
+	// runConfirmableStatuses are the statuses where a run has finished
+	// planning and waits to be confirmed to apply or to be discarded
+	runConfirmableStatuses = map[string]bool{
+		runStatusPlanned:       true,
+		runStatusCostEstimated: true,
+		runStatusPolicyChecked: true,
+	}
+
+	// runFailedStatuses are the statuses where a run will not be applied
+	runFailedStatuses = map[string]bool{
+		runStatusPolicySoftFailed: true,
+		runStatusErrored:          true,
+		runStatusDiscarded:        true,
+		runStatusCanceled:         true,
+		runStatusForceCanceled:    true,
+	}
+)
+
+// TerraformCloud is the client that executes Terraform as remote runs on
+// Terraform Cloud or Terraform Enterprise using the Terraform Cloud API. Each
+// client manages a single workspace.
+type TerraformCloud struct {
+	mu sync.RWMutex
+
+	httpClient   *http.Client
+	address      string
+	token        string
+	organization string
+	workspace    string
+	workingDir   string
+	pollInterval time.Duration
+
+	tfVersion     string
+	executionMode string
+	agentPoolID   string
+	agentPoolName string
+
+	workspaceID string
+	env         map[string]string
+	vars        map[string]string
+	varsSynced  bool
+	stdout      io.Writer
+	runURL      string
+	planID      string
+
+	// planned is set by Plan until the plan is applied or discarded.
+	// pendingRunID is the run of the plan that waits to be confirmed, which
+	// is empty if the plan has no changes to apply.
+	planned      bool
+	pendingRunID string
+
+	logger logging.Logger
+}
+
+// TerraformCloudConfig configures the Terraform Cloud client
+type TerraformCloudConfig struct {
+	// Address is the base URL of the Terraform Cloud API host
+	Address      string
+	Token        string
+	Organization string
+	Workspace    string
+	WorkingDir   string
+
+	// Workspace attributes
+	TFVersion     string
+	ExecutionMode string
+	AgentPoolID   string
+	AgentPoolName string
+
+	// HTTPClient is optional and defaults to http.DefaultClient
+	HTTPClient *http.Client
+
+	// PollInterval is the interval to check the status of runs and defaults
+	// to 2 seconds
+	PollInterval time.Duration
+}
+
+// NewTerraformCloud creates a new client for a Terraform Cloud workspace
+func NewTerraformCloud(config *TerraformCloudConfig) (*TerraformCloud, error) {
+	if config == nil {
+		return nil, errors.New("TerraformCloudConfig cannot be nil - no meaningful default values")
+	}
+
+	if config.Address == "" || config.Organization == "" || config.Workspace == "" {
+		return nil, errors.New("Terraform Cloud address, organization, and workspace are required")
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	pollInterval := config.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	return &TerraformCloud{
+		httpClient:    httpClient,
+		address:       strings.TrimSuffix(config.Address, "/"),
+		token:         config.Token,
+		organization:  config.Organization,
+		workspace:     config.Workspace,
+		workingDir:    config.WorkingDir,
+		pollInterval:  pollInterval,
+		tfVersion:     config.TFVersion,
+		executionMode: config.ExecutionMode,
+		agentPoolID:   config.AgentPoolID,
+		agentPoolName: config.AgentPoolName,
+		stdout:        ioutil.Discard,
+		logger: logging.Global().Named(loggingSystemName).Named(tfcSubsystemName).With(
+			"workspace", config.Workspace),
+	}, nil
+}
+
+// SetEnv sets the environment variables that are set as sensitive environment
+// variables on the workspace when initialized
+func (t *TerraformCloud) SetEnv(env map[string]string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.env = make(map[string]string, len(env))
+	for k, v := range env {
+		t.env[k] = v
+	}
+	return nil
+}
+
+// SetVars sets the input variables that are set as sensitive Terraform
+// variables on the workspace before the next run. The values are set as HCL
+// expressions encoded in JSON.
+func (t *TerraformCloud) SetVars(vars map[string]interface{}) error {
+	encoded := make(map[string]string, len(vars))
+	for k, v := range vars {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("unable to encode variable '%s': %s", k, err)
+		}
+		encoded[k] = string(b)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.vars = encoded
+	t.varsSynced = false
+	return nil
+}
+
+// SetStdout sets the writer for the logs of remote plans
+func (t *TerraformCloud) SetStdout(w io.Writer) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stdout = w
+}
+
+// Init creates or updates the workspace and its variables
+func (t *TerraformCloud) Init(ctx context.Context) error {
+	wsID, err := t.ensureWorkspace(ctx)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	t.workspaceID = wsID
+	t.mu.Unlock()
+
+	t.mu.RLock()
+	env := make(map[string]string, len(t.env))
+	for k, v := range t.env {
+		env[k] = v
+	}
+	t.mu.RUnlock()
+
+	return t.syncVariables(ctx, "env", env)
+}
+
+// Import is not supported by the Terraform Cloud API
+func (t *TerraformCloud) Import(ctx context.Context, address, id string) error {
+	return fmt.Errorf("importing resources is not supported with Terraform "+
+		"Cloud, import '%s' into the state of workspace '%s' directly",
+		address, t.workspace)
+}
+
+// Apply confirms the run of the most recent Plan when it has not been
+// discarded. Otherwise, it uploads the working directory as a new
+// configuration version and queues a run that is applied once planning is
+// complete.
+func (t *TerraformCloud) Apply(ctx context.Context) error {
+	t.mu.Lock()
+	planned, runID := t.planned, t.pendingRunID
+	t.planned = false
+	t.pendingRunID = ""
+	t.mu.Unlock()
+
+	if planned {
+		if runID == "" {
+			t.logger.Trace("plan has no changes to apply")
+			return nil
+		}
+		return t.applyRun(ctx, runID)
+	}
+
+	run, err := t.queueRun(ctx)
+	if err != nil {
+		return err
+	}
+	if !runConfirmableStatuses[run.stringAttr("status")] {
+		return nil
+	}
+	return t.applyRun(ctx, run.ID)
+}
+
+// Plan uploads the working directory as a new configuration version and
+// queues a run that waits to be confirmed by Apply or discarded by
+// DiscardPlan once planning is complete. The plan log is written to stdout.
+func (t *TerraformCloud) Plan(ctx context.Context) (bool, error) {
+	if err := t.DiscardPlan(); err != nil {
+		return false, err
+	}
+
+	run, err := t.queueRun(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	t.mu.Lock()
+	t.planned = true
+	if runConfirmableStatuses[run.stringAttr("status")] {
+		t.pendingRunID = run.ID
+	}
+	t.mu.Unlock()
+	return run.boolAttr("has-changes"), nil
+}
+
+// ShowPlan reads the JSON output of the plan of the most recent run
+func (t *TerraformCloud) ShowPlan(ctx context.Context) (*tfjson.Plan, error) {
+	t.mu.RLock()
+	planID := t.planID
+	t.mu.RUnlock()
+	if planID == "" {
+		return nil, fmt.Errorf("no plan exists for workspace '%s'", t.workspace)
+	}
+
+	// The endpoint redirects to a temporary URL of the JSON plan, which is not
+	// a JSON:API document
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s%s/plans/%s/json-output", t.address, tfcAPIPath, planID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+t.token)
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error reading JSON plan '%s': %s", planID, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("error reading JSON plan '%s': %s", planID, resp.Status)
+	}
+
+	var plan tfjson.Plan
+	if err := json.NewDecoder(resp.Body).Decode(&plan); err != nil {
+		return nil, fmt.Errorf("error decoding JSON plan '%s': %s", planID, err)
+	}
+	return &plan, nil
+}
+
+// DiscardPlan discards the run of the most recent Plan so that it is not
+// applied by a later Apply, and forgets its plan so that it is not read by
+// ShowPlan
+func (t *TerraformCloud) DiscardPlan() error {
+	t.mu.Lock()
+	runID := t.pendingRunID
+	t.planID = ""
+	t.planned = false
+	t.pendingRunID = ""
+	t.mu.Unlock()
+
+	if runID == "" {
+		return nil
+	}
+	return t.discardRun(context.Background(), runID)
+}
+
+// Validate is a no-op. The configuration is validated remotely as part of each
+// run.
+func (t *TerraformCloud) Validate(ctx context.Context) error {
+	t.logger.Trace("configuration is validated remotely by Terraform Cloud runs")
+	return nil
+}
+
+// RunURL returns the URL of the most recent run queued by the client
+func (t *TerraformCloud) RunURL() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.runURL
+}
+
+// GoString defines the printable version of this struct.
+// Sensitive information is redacted.
+func (t *TerraformCloud) GoString() string {
+	if t == nil {
+		return "(*TerraformCloud)(nil)"
+	}
+
+	return fmt.Sprintf("&TerraformCloud{"+
+		"Address:%s, "+
+		"Organization:%s, "+
+		"Workspace:%s, "+
+		"WorkingDir:%s"+
+		"}",
+		t.address,
+		t.organization,
+		t.workspace,
+		t.workingDir,
+	)
+}
+
+// queueRun uploads the working directory, queues a new run for the
+// workspace, and waits for the run to finish planning. An error is returned if
+// the run will not be applied.
+func (t *TerraformCloud) queueRun(ctx context.Context) (tfcResource, error) {
+	t.mu.RLock()
+	wsID := t.workspaceID
+	t.mu.RUnlock()
+	if wsID == "" {
+		return tfcResource{}, fmt.Errorf("workspace '%s' is not initialized", t.workspace)
+	}
+
+	if err := t.syncTerraformVariables(ctx); err != nil {
+		return tfcResource{}, err
+	}
+
+	cvID, err := t.uploadConfiguration(ctx, wsID)
+	if err != nil {
+		return tfcResource{}, err
+	}
+
+	var run tfcResource
+	err = t.do(ctx, http.MethodPost, "/runs", tfcDocument{Data: tfcResource{
+		Type:       "runs",
+		Attributes: map[string]interface{}{"message": tfcRunMessage},
+		Relationships: map[string]tfcRelationship{
+			"workspace":             {Data: &tfcResource{Type: "workspaces", ID: wsID}},
+			"configuration-version": {Data: &tfcResource{Type: "configuration-versions", ID: cvID}},
+		},
+	}}, &run)
+	if err != nil {
+		return tfcResource{}, fmt.Errorf("error creating run: %s", err)
+	}
+
+	runURL := fmt.Sprintf("%s/app/%s/workspaces/%s/runs/%s",
+		t.address, t.organization, t.workspace, run.ID)
+	t.mu.Lock()
+	t.runURL = runURL
+	t.mu.Unlock()
+	t.logger.Debug("run created", "run_url", runURL)
+
+	run, err = t.waitForRun(ctx, run.ID, runPlanEndStatuses)
+	if err != nil {
+		return run, err
+	}
+
+	if rel, ok := run.Relationships["plan"]; ok && rel.Data != nil {
+		t.mu.Lock()
+		t.planID = rel.Data.ID
+		t.mu.Unlock()
+	}
+
+	if err := t.writePlanLog(ctx, run); err != nil {
+		t.logger.Warn("unable to retrieve plan log", "run_url", runURL, "error", err)
+	}
+
+	status := run.stringAttr("status")
+	if runFailedStatuses[status] {
+		if status == runStatusPolicySoftFailed {
+			// the run waits for an override and would block the runs queued
+			// after it
+			if err := t.discardRun(ctx, run.ID); err != nil {
+				t.logger.Warn("unable to discard run", "run_url", runURL, "error", err)
+			}
+		}
+		return run, fmt.Errorf("run finished with status '%s': %s", status, runURL)
+	}
+	return run, nil
+}
+
+// applyRun confirms a run that finished planning and waits for it to be
+// applied
+func (t *TerraformCloud) applyRun(ctx context.Context, runID string) error {
+	err := t.do(ctx, http.MethodPost, fmt.Sprintf("/runs/%s/actions/apply", runID),
+		map[string]string{"comment": tfcRunMessage}, nil)
+	if err != nil {
+		return fmt.Errorf("error applying run: %s", err)
+	}
+
+	run, err := t.waitForRun(ctx, runID, map[string]bool{
+		runStatusApplied:       true,
+		runStatusErrored:       true,
+		runStatusDiscarded:     true,
+		runStatusCanceled:      true,
+		runStatusForceCanceled: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if status := run.stringAttr("status"); status != runStatusApplied {
+		return fmt.Errorf("run finished with status '%s': %s", status, t.RunURL())
+	}
+	return nil
+}
+
+// discardRun discards a run that finished planning so that it is not applied
+func (t *TerraformCloud) discardRun(ctx context.Context, runID string) error {
+	t.logger.Trace("discarding run", "run_id", runID)
+	err := t.do(ctx, http.MethodPost, fmt.Sprintf("/runs/%s/actions/discard", runID),
+		map[string]string{"comment": tfcRunMessage}, nil)
+	if err != nil {
+		return fmt.Errorf("error discarding run '%s': %s", runID, err)
+	}
+	return nil
+}
+
+// ensureWorkspace reads the workspace for the task and creates it if it does
+// not exist. The workspace attributes are updated to match the configuration.
+func (t *TerraformCloud) ensureWorkspace(ctx context.Context) (string, error) {
+	attrs := map[string]interface{}{
+		"name":       t.workspace,
+		"auto-apply": false,
+	}
+	if t.tfVersion != "" {
+		attrs["terraform-version"] = t.tfVersion
+	}
+	if t.executionMode != "" {
+		attrs["execution-mode"] = t.executionMode
+	}
+	if t.executionMode == "agent" {
+		poolID, err := t.agentPool(ctx)
+		if err != nil {
+			return "", err
+		}
+		attrs["agent-pool-id"] = poolID
+	}
+
+	var ws tfcResource
+	wsPath := fmt.Sprintf("/organizations/%s/workspaces/%s",
+		url.PathEscape(t.organization), url.PathEscape(t.workspace))
+	err := t.do(ctx, http.MethodGet, wsPath, nil, &ws)
+	switch {
+	case errors.Is(err, errTFCNotFound):
+		t.logger.Info("creating workspace")
+		err = t.do(ctx, http.MethodPost,
+			fmt.Sprintf("/organizations/%s/workspaces", url.PathEscape(t.organization)),
+			tfcDocument{Data: tfcResource{Type: "workspaces", Attributes: attrs}}, &ws)
+		if err != nil {
+			return "", fmt.Errorf("error creating workspace '%s': %s", t.workspace, err)
+		}
+		return ws.ID, nil
+	case err != nil:
+		return "", fmt.Errorf("error reading workspace '%s': %s", t.workspace, err)
+	}
+
+	t.logger.Debug("updating workspace", "workspace_id", ws.ID)
+	err = t.do(ctx, http.MethodPatch, fmt.Sprintf("/workspaces/%s", ws.ID),
+		tfcDocument{Data: tfcResource{Type: "workspaces", Attributes: attrs}}, &ws)
+	if err != nil {
+		return "", fmt.Errorf("error updating workspace '%s': %s", t.workspace, err)
+	}
+	return ws.ID, nil
+}
+
+// agentPool returns the agent pool ID configured by ID or by name
+func (t *TerraformCloud) agentPool(ctx context.Context) (string, error) {
+	if t.agentPoolID != "" {
+		return t.agentPoolID, nil
+	}
+
+	pools, err := t.list(ctx, fmt.Sprintf("/organizations/%s/agent-pools?q=%s",
+		url.PathEscape(t.organization), url.QueryEscape(t.agentPoolName)))
+	if err != nil {
+		return "", fmt.Errorf("error listing agent pools: %s", err)
+	}
+	for _, p := range pools {
+		if p.stringAttr("name") == t.agentPoolName {
+			return p.ID, nil
+		}
+	}
+	return "", fmt.Errorf("agent pool '%s' not found", t.agentPoolName)
+}
+
+// syncTerraformVariables creates or updates the Terraform variables of the
+// workspace that were set since the last run
+func (t *TerraformCloud) syncTerraformVariables(ctx context.Context) error {
+	t.mu.RLock()
+	if t.varsSynced {
+		t.mu.RUnlock()
+		return nil
+	}
+	vars := make(map[string]string, len(t.vars))
+	for k, v := range t.vars {
+		vars[k] = v
+	}
+	t.mu.RUnlock()
+
+	if err := t.syncVariables(ctx, "terraform", vars); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	t.varsSynced = true
+	t.mu.Unlock()
+	return nil
+}
+
+// syncVariables creates or updates the variables of the workspace for the
+// category, either "env" or "terraform". Variables are set as sensitive, and
+// Terraform variables are set as HCL.
+func (t *TerraformCloud) syncVariables(ctx context.Context, category string,
+	values map[string]string) error {
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	t.mu.RLock()
+	wsID := t.workspaceID
+	t.mu.RUnlock()
+
+	varsPath := fmt.Sprintf("/workspaces/%s/vars", wsID)
+	vars, err := t.list(ctx, varsPath)
+	if err != nil {
+		return fmt.Errorf("error listing workspace variables: %s", err)
+	}
+
+	existing := make(map[string]string)
+	for _, v := range vars {
+		if v.stringAttr("category") == category {
+			existing[v.stringAttr("key")] = v.ID
+		}
+	}
+
+	for k, v := range values {
+		attrs := map[string]interface{}{
+			"key":       k,
+			"value":     v,
+			"category":  category,
+			"sensitive": true,
+		}
+		if category == "terraform" {
+			attrs["hcl"] = true
+		}
+
+		var err error
+		if id, ok := existing[k]; ok {
+			err = t.do(ctx, http.MethodPatch, fmt.Sprintf("%s/%s", varsPath, id),
+				tfcDocument{Data: tfcResource{Type: "vars", ID: id, Attributes: attrs}}, nil)
+		} else {
+			err = t.do(ctx, http.MethodPost, varsPath,
+				tfcDocument{Data: tfcResource{Type: "vars", Attributes: attrs}}, nil)
+		}
+		if err != nil {
+			return fmt.Errorf("error setting workspace variable '%s': %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+// uploadConfiguration creates a configuration version with the contents of the
+// working directory and waits for the upload to be processed.
+func (t *TerraformCloud) uploadConfiguration(ctx context.Context, wsID string) (string, error) {
+	var cv tfcResource
+	err := t.do(ctx, http.MethodPost, fmt.Sprintf("/workspaces/%s/configuration-versions", wsID),
+		tfcDocument{Data: tfcResource{
+			Type: "configuration-versions",
+			Attributes: map[string]interface{}{
+				"auto-queue-runs": false,
+			},
+		}}, &cv)
+	if err != nil {
+		return "", fmt.Errorf("error creating configuration version: %s", err)
+	}
+
+	archive, err := archiveDir(t.workingDir)
+	if err != nil {
+		return "", fmt.Errorf("error archiving working directory: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut,
+		cv.stringAttr("upload-url"), archive)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error uploading configuration version: %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("error uploading configuration version: %s", resp.Status)
+	}
+
+	for {
+		status := cv.stringAttr("status")
+		switch status {
+		case cvStatusUploaded:
+			return cv.ID, nil
+		case cvStatusErrored:
+			return "", fmt.Errorf("configuration version '%s' errored", cv.ID)
+		}
+
+		if err := t.wait(ctx); err != nil {
+			return "", err
+		}
+		err = t.do(ctx, http.MethodGet, fmt.Sprintf("/configuration-versions/%s", cv.ID), nil, &cv)
+		if err != nil {
+			return "", fmt.Errorf("error reading configuration version: %s", err)
+		}
+	}
+}
+
+// waitForRun polls the run until it reaches one of the statuses
+func (t *TerraformCloud) waitForRun(ctx context.Context, runID string,
+	statuses map[string]bool) (tfcResource, error) {
+
+	var run tfcResource
+	for {
+		err := t.do(ctx, http.MethodGet, fmt.Sprintf("/runs/%s", runID), nil, &run)
+		if err != nil {
+			return run, fmt.Errorf("error reading run '%s': %s", runID, err)
+		}
+
+		status := run.stringAttr("status")
+		t.logger.Trace("run status", "run_id", runID, "status", status)
+		if statuses[status] {
+			return run, nil
+		}
+
+		if err := t.wait(ctx); err != nil {
+			return run, err
+		}
+	}
+}
+
+// writePlanLog writes the log of the run's plan to stdout
+func (t *TerraformCloud) writePlanLog(ctx context.Context, run tfcResource) error {
+	rel, ok := run.Relationships["plan"]
+	if !ok || rel.Data == nil {
+		return nil
+	}
+
+	var plan tfcResource
+	if err := t.do(ctx, http.MethodGet, fmt.Sprintf("/plans/%s", rel.Data.ID), nil, &plan); err != nil {
+		return err
+	}
+
+	logURL := plan.stringAttr("log-read-url")
+	if logURL == "" {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	t.mu.RLock()
+	w := t.stdout
+	t.mu.RUnlock()
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+func (t *TerraformCloud) wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(t.pollInterval):
+		return nil
+	}
+}
+
+// list reads the resources of every page of a Terraform Cloud API list
+// endpoint
+func (t *TerraformCloud) list(ctx context.Context, path string) ([]tfcResource, error) {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+
+	var resources []tfcResource
+	for page := 1; page != 0; {
+		query := url.Values{}
+		query.Set("page[number]", fmt.Sprint(page))
+		query.Set("page[size]", fmt.Sprint(tfcPageSize))
+
+		var r []tfcResource
+		meta, err := t.doWithMeta(ctx, http.MethodGet, path+sep+query.Encode(), nil, &r)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r...)
+		page = meta.Pagination.NextPage
+	}
+	return resources, nil
+}
+
+// do sends a request to the Terraform Cloud API and decodes the data of the
+// response document into out, if provided.
+func (t *TerraformCloud) do(ctx context.Context, method, path string,
+	body interface{}, out interface{}) error {
+
+	_, err := t.doWithMeta(ctx, method, path, body, out)
+	return err
+}
+
+// doWithMeta is do that also returns the meta object of the response document
+func (t *TerraformCloud) doWithMeta(ctx context.Context, method, path string,
+	body interface{}, out interface{}) (tfcMeta, error) {
+
+	var meta tfcMeta
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return meta, err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, t.address+tfcAPIPath+path, reqBody)
+	if err != nil {
+		return meta, err
+	}
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Set("Content-Type", tfcContentType)
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return meta, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return meta, errTFCNotFound
+	}
+
+	if resp.StatusCode >= 300 {
+		var errResp struct {
+			Errors []struct {
+				Title  string `json:"title"`
+				Detail string `json:"detail"`
+			} `json:"errors"`
+		}
+		msgs := []string{resp.Status}
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil {
+			for _, e := range errResp.Errors {
+				msgs = append(msgs, strings.TrimSpace(e.Title+" "+e.Detail))
+			}
+		}
+		return meta, errors.New(strings.Join(msgs, ": "))
+	}
+
+	if out == nil {
+		return meta, nil
+	}
+
+	var doc struct {
+		Data json.RawMessage `json:"data"`
+		Meta tfcMeta         `json:"meta"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return meta, err
+	}
+	return doc.Meta, json.Unmarshal(doc.Data, out)
+}
+
+// tfcDocument is a JSON:API document for Terraform Cloud API requests
+type tfcDocument struct {
+	Data tfcResource `json:"data"`
+}
+
+// tfcResource is a JSON:API resource object
+type tfcResource struct {
+	ID            string                     `json:"id,omitempty"`
+	Type          string                     `json:"type"`
+	Attributes    map[string]interface{}     `json:"attributes,omitempty"`
+	Relationships map[string]tfcRelationship `json:"relationships,omitempty"`
+}
+
+// tfcMeta is the meta object of a JSON:API document. The pagination of list
+// endpoints has no next page when NextPage is 0.
+type tfcMeta struct {
+	Pagination struct {
+		NextPage int `json:"next-page"`
+	} `json:"pagination"`
+}
+
+// tfcRelationship is a JSON:API relationship to a single resource
+type tfcRelationship struct {
+	Data *tfcResource `json:"data"`
+}
+
+func (r tfcResource) stringAttr(key string) string {
+	v, _ := r.Attributes[key].(string)
+	return v
+}
+
+func (r tfcResource) boolAttr(key string) bool {
+	v, _ := r.Attributes[key].(bool)
+	return v
+}
+
+// archiveDir creates a gzipped tarball of the files in a directory, skipping
+// the local .terraform directory and state files.
+func archiveDir(dir string) (io.Reader, error) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		if info.IsDir() && info.Name() == ".terraform" {
+			return filepath.SkipDir
+		}
+		if !info.Mode().IsRegular() || strings.HasPrefix(info.Name(), "terraform.tfstate") {
+			return nil
+		}
+
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return &buf, nil
+}
@@ -0,0 +1,741 @@
+package client
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTerraformCloud(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		config      *TerraformCloudConfig
+		expectError bool
+	}{
+		{
+			"nil config",
+			nil,
+			true,
+		},
+		{
+			"missing workspace",
+			&TerraformCloudConfig{
+				Address:      "https://app.terraform.io",
+				Organization: "org",
+			},
+			true,
+		},
+		{
+			"happy path",
+			&TerraformCloudConfig{
+				Address:      "https://app.terraform.io/",
+				Organization: "org",
+				Workspace:    "task",
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := NewTerraformCloud(tc.config)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "https://app.terraform.io", c.address)
+			assert.Equal(t, defaultPollInterval, c.pollInterval)
+		})
+	}
+}
+
+func TestTerraformCloud_Init(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create workspace", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		c.tfVersion = "1.1.0"
+		c.executionMode = "agent"
+		c.agentPoolName = "pool"
+		require.NoError(t, c.SetEnv(map[string]string{"TOKEN": "secret"}))
+
+		require.NoError(t, c.Init(context.Background()))
+
+		ws, ok := fake.workspaces["task"]
+		require.True(t, ok)
+		assert.Equal(t, ws.ID, c.workspaceID)
+		assert.Equal(t, "1.1.0", ws.stringAttr("terraform-version"))
+		assert.Equal(t, "agent", ws.stringAttr("execution-mode"))
+		assert.Equal(t, "apool-1", ws.stringAttr("agent-pool-id"))
+
+		require.Len(t, fake.vars, 1)
+		for _, v := range fake.vars {
+			assert.Equal(t, "TOKEN", v.stringAttr("key"))
+			assert.Equal(t, "secret", v.stringAttr("value"))
+			assert.Equal(t, "env", v.stringAttr("category"))
+			assert.True(t, v.boolAttr("sensitive"))
+		}
+	})
+
+	t.Run("update workspace and variables", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		fake.workspaces["task"] = tfcResource{ID: "ws-existing", Type: "workspaces",
+			Attributes: map[string]interface{}{"name": "task"}}
+		fake.vars["var-1"] = tfcResource{ID: "var-1", Type: "vars",
+			Attributes: map[string]interface{}{"key": "TOKEN", "value": "old", "category": "env"}}
+
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		require.NoError(t, c.SetEnv(map[string]string{"TOKEN": "new"}))
+		require.NoError(t, c.Init(context.Background()))
+
+		assert.Equal(t, "ws-existing", c.workspaceID)
+		require.Len(t, fake.vars, 1)
+		assert.Equal(t, "new", fake.vars["var-1"].stringAttr("value"))
+	})
+
+	t.Run("paginated variables and agent pools", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		fake.pageSize = 2
+		for i := 1; i <= 5; i++ {
+			id := fmt.Sprintf("var-%d", i)
+			fake.vars[id] = tfcResource{ID: id, Type: "vars", Attributes: map[string]interface{}{
+				"key": fmt.Sprintf("KEY_%d", i), "value": "old", "category": "env"}}
+		}
+		for i := 1; i <= 3; i++ {
+			fake.agentPools = append(fake.agentPools, tfcResource{
+				ID: fmt.Sprintf("apool-%d", i), Type: "agent-pools",
+				Attributes: map[string]interface{}{"name": fmt.Sprintf("pool-%d", i)}})
+		}
+
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		c.executionMode = "agent"
+		c.agentPoolName = "pool-3"
+		require.NoError(t, c.SetEnv(map[string]string{"KEY_5": "new"}))
+		require.NoError(t, c.Init(context.Background()))
+
+		// the variable on the last page is updated instead of duplicated
+		assert.Len(t, fake.vars, 5)
+		assert.Equal(t, "new", fake.vars["var-5"].stringAttr("value"))
+		assert.Equal(t, "apool-3", fake.workspaces["task"].stringAttr("agent-pool-id"))
+	})
+
+	t.Run("agent pool not found", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		fake.agentPools = []tfcResource{{ID: "apool-1", Type: "agent-pools",
+			Attributes: map[string]interface{}{"name": "other"}}}
+
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		c.executionMode = "agent"
+		c.agentPoolName = "pool"
+		err := c.Init(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("api error", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		c.token = "invalid"
+		err := c.Init(context.Background())
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unauthorized")
+	})
+}
+
+func TestTerraformCloud_Apply(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		planStatus  string
+		expectApply bool
+		expectError bool
+	}{
+		{
+			"changes applied",
+			runStatusPlanned,
+			true,
+			false,
+		},
+		{
+			"no changes",
+			runStatusPlannedAndFinished,
+			false,
+			false,
+		},
+		{
+			"errored",
+			runStatusErrored,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fake := newFakeTFC(t)
+			fake.planStatus = tc.planStatus
+			fake.hasChanges = tc.expectApply
+
+			wd := t.TempDir()
+			writeTestFile(t, filepath.Join(wd, "main.tf"), "module \"task\" {}")
+			writeTestFile(t, filepath.Join(wd, ".terraform", "environment"), "task")
+
+			c := newTestTerraformCloud(t, fake, wd)
+			ctx := context.Background()
+			require.NoError(t, c.Init(ctx))
+
+			err := c.Apply(ctx)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			require.Len(t, fake.runs, 1)
+			for _, r := range fake.runs {
+				assert.Equal(t, tc.expectApply, r.applied)
+				assert.Equal(t, fmt.Sprintf("%s/app/org/workspaces/task/runs/%s",
+					fake.server.URL, r.ID), c.RunURL())
+			}
+
+			require.Len(t, fake.uploads, 1)
+			assert.Equal(t, []string{"main.tf"}, fake.uploads[0])
+		})
+	}
+}
+
+func TestTerraformCloud_Plan(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeTFC(t)
+	fake.planStatus = runStatusPlannedAndFinished
+	fake.hasChanges = true
+	fake.planLog = "Plan: 1 to add, 0 to change, 0 to destroy."
+
+	c := newTestTerraformCloud(t, fake, t.TempDir())
+	ctx := context.Background()
+	require.NoError(t, c.Init(ctx))
+
+	var buf bytes.Buffer
+	c.SetStdout(&buf)
+	changes, err := c.Plan(ctx)
+	require.NoError(t, err)
+	assert.True(t, changes)
+	assert.Equal(t, fake.planLog, buf.String())
+	for _, r := range fake.runs {
+		assert.False(t, r.applied)
+	}
+}
+
+func TestTerraformCloud_Plan_Apply(t *testing.T) {
+	t.Parallel()
+
+	t.Run("apply confirms planned run", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		fake.hasChanges = true
+
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		ctx := context.Background()
+		require.NoError(t, c.Init(ctx))
+
+		changes, err := c.Plan(ctx)
+		require.NoError(t, err)
+		assert.True(t, changes)
+		require.NoError(t, c.Apply(ctx))
+
+		// the planned run is applied instead of a new run
+		require.Len(t, fake.runs, 1)
+		for _, r := range fake.runs {
+			assert.True(t, r.applied)
+			assert.False(t, r.discarded)
+		}
+		assert.Len(t, fake.uploads, 1)
+	})
+
+	t.Run("apply plan without changes", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		fake.planStatus = runStatusPlannedAndFinished
+
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		ctx := context.Background()
+		require.NoError(t, c.Init(ctx))
+
+		_, err := c.Plan(ctx)
+		require.NoError(t, err)
+		require.NoError(t, c.Apply(ctx))
+
+		require.Len(t, fake.runs, 1)
+		for _, r := range fake.runs {
+			assert.False(t, r.applied)
+		}
+	})
+
+	t.Run("discarded plan is not applied", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		fake.hasChanges = true
+
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		ctx := context.Background()
+		require.NoError(t, c.Init(ctx))
+
+		_, err := c.Plan(ctx)
+		require.NoError(t, err)
+		require.NoError(t, c.DiscardPlan())
+		_, err = c.ShowPlan(ctx)
+		assert.Error(t, err)
+
+		// apply queues a new run
+		require.NoError(t, c.Apply(ctx))
+		require.Len(t, fake.runs, 2)
+		assert.True(t, fake.runs["run-3"].discarded)
+		assert.False(t, fake.runs["run-3"].applied)
+		assert.True(t, fake.runs["run-5"].applied)
+	})
+
+	t.Run("new plan discards previous plan", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		fake.hasChanges = true
+
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		ctx := context.Background()
+		require.NoError(t, c.Init(ctx))
+
+		_, err := c.Plan(ctx)
+		require.NoError(t, err)
+		_, err = c.Plan(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, fake.runs, 2)
+		assert.True(t, fake.runs["run-3"].discarded)
+		assert.False(t, fake.runs["run-5"].discarded)
+	})
+
+	t.Run("policy soft failed run is discarded", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		fake.planStatus = runStatusPolicySoftFailed
+
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		ctx := context.Background()
+		require.NoError(t, c.Init(ctx))
+
+		_, err := c.Plan(ctx)
+		assert.Error(t, err)
+		require.Len(t, fake.runs, 1)
+		assert.True(t, fake.runs["run-3"].discarded)
+	})
+}
+
+func TestTerraformCloud_SetVars(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeTFC(t)
+	fake.planStatus = runStatusPlannedAndFinished
+
+	c := newTestTerraformCloud(t, fake, t.TempDir())
+	ctx := context.Background()
+	require.NoError(t, c.Init(ctx))
+	require.Empty(t, fake.vars)
+
+	require.NoError(t, c.SetVars(map[string]interface{}{
+		"vault_secrets": map[string]map[string]string{
+			"kv/db": {"username": "admin"},
+		},
+	}))
+	_, err := c.Plan(ctx)
+	require.NoError(t, err)
+
+	require.Len(t, fake.vars, 1)
+	var id string
+	for _, v := range fake.vars {
+		id = v.ID
+		assert.Equal(t, "vault_secrets", v.stringAttr("key"))
+		assert.Equal(t, `{"kv/db":{"username":"admin"}}`, v.stringAttr("value"))
+		assert.Equal(t, "terraform", v.stringAttr("category"))
+		assert.True(t, v.boolAttr("hcl"))
+		assert.True(t, v.boolAttr("sensitive"))
+	}
+
+	// updated variables are synced before the next run
+	require.NoError(t, c.SetVars(map[string]interface{}{
+		"vault_secrets": map[string]map[string]string{
+			"kv/db": {"username": "root"},
+		},
+	}))
+	_, err = c.Plan(ctx)
+	require.NoError(t, err)
+	require.Len(t, fake.vars, 1)
+	assert.Equal(t, `{"kv/db":{"username":"root"}}`, fake.vars[id].stringAttr("value"))
+}
+
+func TestTerraformCloud_Plan_NotInitialized(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeTFC(t)
+	c := newTestTerraformCloud(t, fake, t.TempDir())
+	_, err := c.Plan(context.Background())
+	assert.Error(t, err)
+}
+
+func TestTerraformCloud_Import(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeTFC(t)
+	c := newTestTerraformCloud(t, fake, t.TempDir())
+	err := c.Import(context.Background(), "module.task.x.y", "id")
+	assert.Error(t, err)
+}
+
+func TestTerraformCloud_ShowPlan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no plan", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		_, err := c.ShowPlan(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("plan of most recent run", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		fake.planStatus = runStatusPlannedAndFinished
+		fake.hasChanges = true
+		fake.planJSON = `{"format_version":"0.2","resource_changes":[` +
+			`{"address":"module.task.local_file.a","change":{"actions":["delete"]}}]}`
+
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		ctx := context.Background()
+		require.NoError(t, c.Init(ctx))
+		_, err := c.Plan(ctx)
+		require.NoError(t, err)
+
+		plan, err := c.ShowPlan(ctx)
+		require.NoError(t, err)
+		require.Len(t, plan.ResourceChanges, 1)
+		assert.Equal(t, "module.task.local_file.a", plan.ResourceChanges[0].Address)
+	})
+
+	t.Run("invalid plan", func(t *testing.T) {
+		fake := newFakeTFC(t)
+		fake.planStatus = runStatusPlannedAndFinished
+		fake.planJSON = `not json`
+
+		c := newTestTerraformCloud(t, fake, t.TempDir())
+		ctx := context.Background()
+		require.NoError(t, c.Init(ctx))
+		_, err := c.Plan(ctx)
+		require.NoError(t, err)
+
+		_, err = c.ShowPlan(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestTerraformCloud_GoString(t *testing.T) {
+	t.Parallel()
+
+	var nilClient *TerraformCloud
+	assert.Equal(t, "(*TerraformCloud)(nil)", nilClient.GoString())
+
+	c := &TerraformCloud{address: "https://app.terraform.io", organization: "org",
+		workspace: "task", workingDir: "path", token: "secret"}
+	assert.NotContains(t, c.GoString(), "secret")
+	assert.Contains(t, c.GoString(), "Workspace:task")
+}
+
+func newTestTerraformCloud(t *testing.T, fake *fakeTFC, wd string) *TerraformCloud {
+	c, err := NewTerraformCloud(&TerraformCloudConfig{
+		Address:      fake.server.URL,
+		Token:        fakeTFCToken,
+		Organization: "org",
+		Workspace:    "task",
+		WorkingDir:   wd,
+		HTTPClient:   fake.server.Client(),
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+	c.logger = logging.NewNullLogger()
+	return c
+}
+
+func writeTestFile(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0750))
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0640))
+}
+
+const fakeTFCToken = "test-token"
+
+// fakeTFC is a minimal in-memory fake of the Terraform Cloud API
+type fakeTFC struct {
+	mu     sync.Mutex
+	t      *testing.T
+	server *httptest.Server
+
+	workspaces map[string]tfcResource
+	vars       map[string]tfcResource
+	runs       map[string]*fakeRun
+	uploads    [][]string
+
+	planStatus string
+	hasChanges bool
+	planLog    string
+	planJSON   string
+	nextID     int
+
+	// agentPools are listed by the agent pools endpoint. A pool named by the
+	// query is listed when empty.
+	agentPools []tfcResource
+
+	// pageSize is the maximum number of resources in a page of list endpoints
+	pageSize int
+}
+
+type fakeRun struct {
+	ID        string
+	polls     int
+	applied   bool
+	discarded bool
+}
+
+func newFakeTFC(t *testing.T) *fakeTFC {
+	f := &fakeTFC{
+		t:          t,
+		workspaces: make(map[string]tfcResource),
+		vars:       make(map[string]tfcResource),
+		runs:       make(map[string]*fakeRun),
+		planStatus: runStatusPlanned,
+		pageSize:   tfcPageSize,
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(f.handle))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeTFC) id(prefix string) string {
+	f.nextID++
+	return fmt.Sprintf("%s-%d", prefix, f.nextID)
+}
+
+func (f *fakeTFC) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := r.URL.Path
+	switch {
+	case strings.HasPrefix(path, "/upload/"):
+		f.handleUpload(w, r)
+		return
+	case strings.HasPrefix(path, "/logs/"):
+		io.WriteString(w, f.planLog)
+		return
+	case strings.HasPrefix(path, "/json/"):
+		io.WriteString(w, f.planJSON)
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+fakeTFCToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, `{"errors":[{"status":"401","title":"unauthorized"}]}`)
+		return
+	}
+
+	var doc tfcDocument
+	if r.Body != nil && r.Method != http.MethodGet {
+		json.NewDecoder(r.Body).Decode(&doc)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(path, tfcAPIPath+"/"), "/")
+	switch {
+	case len(parts) == 4 && parts[0] == "organizations" && parts[2] == "workspaces" &&
+		r.Method == http.MethodGet:
+		ws, ok := f.workspaces[parts[3]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		f.write(w, ws)
+
+	case len(parts) == 3 && parts[0] == "organizations" && parts[2] == "workspaces":
+		doc.Data.ID = f.id("ws")
+		f.workspaces[doc.Data.stringAttr("name")] = doc.Data
+		f.write(w, doc.Data)
+
+	case len(parts) == 3 && parts[0] == "organizations" && parts[2] == "agent-pools":
+		pools := f.agentPools
+		if len(pools) == 0 {
+			pools = []tfcResource{{ID: "apool-1", Type: "agent-pools",
+				Attributes: map[string]interface{}{"name": r.URL.Query().Get("q")}}}
+		}
+		f.writePage(w, r, pools)
+
+	case len(parts) == 2 && parts[0] == "workspaces" && r.Method == http.MethodPatch:
+		for name, ws := range f.workspaces {
+			if ws.ID == parts[1] {
+				for k, v := range doc.Data.Attributes {
+					ws.Attributes[k] = v
+				}
+				f.workspaces[name] = ws
+				f.write(w, ws)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+
+	case len(parts) == 3 && parts[0] == "workspaces" && parts[2] == "vars":
+		if r.Method == http.MethodGet {
+			vars := make([]tfcResource, 0, len(f.vars))
+			for _, v := range f.vars {
+				vars = append(vars, v)
+			}
+			sort.Slice(vars, func(i, j int) bool { return vars[i].ID < vars[j].ID })
+			f.writePage(w, r, vars)
+			return
+		}
+		doc.Data.ID = f.id("var")
+		f.vars[doc.Data.ID] = doc.Data
+		f.write(w, doc.Data)
+
+	case len(parts) == 4 && parts[0] == "workspaces" && parts[2] == "vars":
+		f.vars[parts[3]] = doc.Data
+		f.write(w, doc.Data)
+
+	case len(parts) == 3 && parts[0] == "workspaces" && parts[2] == "configuration-versions":
+		doc.Data.ID = f.id("cv")
+		doc.Data.Attributes["status"] = "pending"
+		doc.Data.Attributes["upload-url"] = f.server.URL + "/upload/" + doc.Data.ID
+		f.write(w, doc.Data)
+
+	case len(parts) == 2 && parts[0] == "configuration-versions":
+		f.write(w, tfcResource{ID: parts[1], Type: "configuration-versions",
+			Attributes: map[string]interface{}{"status": cvStatusUploaded}})
+
+	case len(parts) == 1 && parts[0] == "runs":
+		run := &fakeRun{ID: f.id("run")}
+		f.runs[run.ID] = run
+		f.write(w, tfcResource{ID: run.ID, Type: "runs",
+			Attributes: map[string]interface{}{"status": "pending"}})
+
+	case len(parts) == 2 && parts[0] == "runs":
+		run, ok := f.runs[parts[1]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		run.polls++
+		status := "planning"
+		switch {
+		case run.applied:
+			status = runStatusApplied
+		case run.discarded:
+			status = runStatusDiscarded
+		case run.polls > 1:
+			status = f.planStatus
+		}
+		f.write(w, tfcResource{ID: run.ID, Type: "runs",
+			Attributes: map[string]interface{}{"status": status, "has-changes": f.hasChanges},
+			Relationships: map[string]tfcRelationship{
+				"plan": {Data: &tfcResource{ID: "plan-" + run.ID, Type: "plans"}},
+			},
+		})
+
+	case len(parts) == 4 && parts[0] == "runs" && parts[3] == "apply":
+		f.runs[parts[1]].applied = true
+		w.WriteHeader(http.StatusAccepted)
+
+	case len(parts) == 4 && parts[0] == "runs" && parts[3] == "discard":
+		f.runs[parts[1]].discarded = true
+		w.WriteHeader(http.StatusAccepted)
+
+	case len(parts) == 3 && parts[0] == "plans" && parts[2] == "json-output":
+		http.Redirect(w, r, f.server.URL+"/json/"+parts[1], http.StatusTemporaryRedirect)
+
+	case len(parts) == 2 && parts[0] == "plans":
+		f.write(w, tfcResource{ID: parts[1], Type: "plans",
+			Attributes: map[string]interface{}{"log-read-url": f.server.URL + "/logs/" + parts[1]}})
+
+	default:
+		f.t.Errorf("unexpected request to fake Terraform Cloud: %s %s", r.Method, path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeTFC) handleUpload(w http.ResponseWriter, r *http.Request) {
+	gr, err := gzip.NewReader(r.Body)
+	require.NoError(f.t, err)
+	tr := tar.NewReader(gr)
+
+	var files []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(f.t, err)
+		files = append(files, hdr.Name)
+	}
+	f.uploads = append(f.uploads, files)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (f *fakeTFC) write(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", tfcContentType)
+	json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+}
+
+// writePage writes the page of the resources requested by the page query
+// parameters with the pagination meta object
+func (f *fakeTFC) writePage(w http.ResponseWriter, r *http.Request, resources []tfcResource) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+	if page < 1 {
+		page = 1
+	}
+	size := f.pageSize
+	if s, _ := strconv.Atoi(r.URL.Query().Get("page[size]")); s > 0 && s < size {
+		size = s
+	}
+
+	start := (page - 1) * size
+	if start > len(resources) {
+		start = len(resources)
+	}
+	end := start + size
+	var nextPage interface{}
+	if end < len(resources) {
+		nextPage = page + 1
+	} else {
+		end = len(resources)
+	}
+
+	w.Header().Set("Content-Type", tfcContentType)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data": resources[start:end],
+		"meta": map[string]interface{}{
+			"pagination": map[string]interface{}{
+				"current-page": page,
+				"next-page":    nextPage,
+			},
+		},
+	})
+}
@@ -18,8 +18,10 @@ type terraformExec interface {
 	SetEnv(env map[string]string) error
 	SetStdout(w io.Writer)
 	Init(ctx context.Context, opts ...tfexec.InitOption) error
+	Import(ctx context.Context, address, id string, opts ...tfexec.ImportOption) error
 	Apply(ctx context.Context, opts ...tfexec.ApplyOption) error
 	Plan(ctx context.Context, opts ...tfexec.PlanOption) (bool, error)
+	ShowPlanFile(ctx context.Context, planPath string, opts ...tfexec.ShowOption) (*tfjson.Plan, error)
 	WorkspaceNew(ctx context.Context, workspace string, opts ...tfexec.WorkspaceNewCmdOption) error
 	WorkspaceSelect(ctx context.Context, workspace string) error
 	Validate(ctx context.Context) (*tfjson.ValidateOutput, error)
@@ -46,6 +46,12 @@ func Commands() map[string]cli.CommandFactory {
 		cmdTaskCreateName: func() (cli.Command, error) {
 			return newTaskCreateCommand(m), nil
 		},
+		cmdPauseName: func() (cli.Command, error) {
+			return newPauseCommand(m), nil
+		},
+		cmdResumeName: func() (cli.Command, error) {
+			return newResumeCommand(m), nil
+		},
 		cmdStartName: func() (cli.Command, error) {
 			return newStartCommand(m, false), nil
 		},
@@ -16,6 +16,8 @@ func Test_Commands(t *testing.T) {
 		cmdTaskEnableName:  &taskEnableCommand{},
 		cmdTaskDisableName: &taskDisableCommand{},
 		cmdTaskDeleteName:  &taskDeleteCommand{},
+		cmdPauseName:       &pauseCommand{},
+		cmdResumeName:      &resumeCommand{},
 		cmdStartName:       &startCommand{},
 		"":                 &startCommand{},
 	}
@@ -125,6 +125,26 @@ func (m *meta) oneArgCheck(name string, args []string) bool {
 	return false
 }
 
+// noArgCheck checks that no positional arguments were passed to the command.
+// If arguments were passed, an error message and help text are output.
+func (m *meta) noArgCheck(name string, args []string) bool {
+	numArgs := len(args)
+	if numArgs == 0 {
+		return true
+	}
+
+	m.UI.Error("Error: this command does not accept arguments: [options]")
+	m.UI.Output(fmt.Sprintf("%d arguments were passed to the command: '%s'",
+		numArgs, strings.Join(args, ", ")))
+
+	help := fmt.Sprintf("For additional help try 'consul-terraform-sync %s --help'",
+		name)
+	help = wordwrap.WrapString(help, width)
+
+	m.UI.Output(help)
+	return false
+}
+
 // clientConfig is used to initialize and return a new API ClientConfig using
 // the default command line arguments and env vars.
 func (m *meta) clientConfig() (*api.ClientConfig, error) {
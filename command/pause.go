@@ -0,0 +1,119 @@
+package command
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+const cmdPauseName = "pause"
+
+// pauseCommand handles the `pause` command
+type pauseCommand struct {
+	meta
+	flags *flag.FlagSet
+}
+
+func newPauseCommand(m meta) *pauseCommand {
+	logging.DisableLogging()
+	flags := m.defaultFlagSet(cmdPauseName)
+	return &pauseCommand{
+		meta:  m,
+		flags: flags,
+	}
+}
+
+// Name returns the subcommand
+func (c pauseCommand) Name() string {
+	return cmdPauseName
+}
+
+// Help returns the command's usage, list of flags, and examples
+func (c *pauseCommand) Help() string {
+	c.meta.setHelpOptions()
+	helpText := fmt.Sprintf(`
+Usage: consul-terraform-sync pause [-help] [options]
+
+  Pause is used to stop all tasks from executing, for example during an
+  incident. Changes to the tasks' dependencies continue to be watched while
+  paused, and tasks with changes execute with the latest changes once
+  execution is resumed.
+
+Options:
+%s
+
+Example:
+
+  $ consul-terraform-sync pause
+  ==> Pausing task execution...
+
+  ==> Task execution has been paused. Run 'consul-terraform-sync resume' to resume task execution.
+`, strings.Join(c.meta.helpOptions, "\n"))
+	return strings.TrimSpace(helpText)
+}
+
+// Synopsis is a short one-line synopsis of the command
+func (c *pauseCommand) Synopsis() string {
+	return "Pauses the execution of all tasks."
+}
+
+// AutocompleteFlags returns a mapping of supported flags and autocomplete
+// options for this command. The map key for the Flags map should be the
+// complete flag such as "-foo" or "--foo".
+func (c *pauseCommand) AutocompleteFlags() complete.Flags {
+	return c.meta.autoCompleteFlags()
+}
+
+// AutocompleteArgs returns the argument predictor for this command.
+// Since argument completion is not supported, this will return
+// complete.PredictNothing.
+func (c *pauseCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+// Run runs the command
+func (c *pauseCommand) Run(args []string) int {
+	c.meta.setFlagsUsage(c.flags, args, c.Help())
+
+	if err := c.flags.Parse(args); err != nil {
+		return ExitCodeParseFlagsError
+	}
+
+	if ok := c.meta.noArgCheck(c.Name(), c.flags.Args()); !ok {
+		return ExitCodeRequiredFlagsError
+	}
+
+	client, err := c.meta.taskLifecycleClient()
+	if err != nil {
+		c.UI.Error(errCreatingClient)
+		msg := wordwrap.WrapString(err.Error(), uint(78))
+		c.UI.Output(msg)
+
+		return ExitCodeError
+	}
+
+	c.UI.Info("Pausing task execution...\n")
+	resp, err := client.Pause(context.Background())
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		c.UI.Error("Error: unable to pause task execution")
+		err = processEOFError(client.Scheme(), err)
+
+		msg := wordwrap.WrapString(err.Error(), uint(78))
+		c.UI.Output(msg)
+
+		return ExitCodeError
+	}
+
+	c.UI.Info(fmt.Sprintf("Task execution has been paused. Run "+
+		"'consul-terraform-sync %s' to resume task execution.", cmdResumeName))
+
+	return ExitCodeOK
+}
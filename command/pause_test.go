@@ -0,0 +1,43 @@
+package command
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPauseCommand_AutocompleteFlags(t *testing.T) {
+	t.Parallel()
+	cmd := newPauseCommand(meta{UI: cli.NewMockUi()})
+
+	predictor := cmd.AutocompleteFlags()
+
+	// Test that we get the expected number of predictions
+	args := complete.Args{Last: "-"}
+	res := predictor.Predict(args)
+
+	// Grab the list of flags from the Flag object
+	flags := make([]string, 0)
+	cmd.flags.VisitAll(func(flag *flag.Flag) {
+		flags = append(flags, fmt.Sprintf("-%s", flag.Name))
+	})
+
+	// Verify that there is a prediction for each flag associated with the command
+	assert.Equal(t, len(flags), len(res))
+	assert.ElementsMatch(t, flags, res, "flags and predictions didn't match, make sure to add "+
+		"new flags to the command AutoCompleteFlags function")
+}
+
+func TestPauseCommand_Run_Args(t *testing.T) {
+	t.Parallel()
+	ui := cli.NewMockUi()
+	cmd := newPauseCommand(meta{UI: ui})
+
+	code := cmd.Run([]string{"unexpected"})
+	assert.Equal(t, ExitCodeRequiredFlagsError, code)
+	assert.Contains(t, ui.ErrorWriter.String(), "does not accept arguments")
+}
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+const cmdResumeName = "resume"
+
+// resumeCommand handles the `resume` command
+type resumeCommand struct {
+	meta
+	flags *flag.FlagSet
+}
+
+func newResumeCommand(m meta) *resumeCommand {
+	logging.DisableLogging()
+	flags := m.defaultFlagSet(cmdResumeName)
+	return &resumeCommand{
+		meta:  m,
+		flags: flags,
+	}
+}
+
+// Name returns the subcommand
+func (c resumeCommand) Name() string {
+	return cmdResumeName
+}
+
+// Help returns the command's usage, list of flags, and examples
+func (c *resumeCommand) Help() string {
+	c.meta.setHelpOptions()
+	helpText := fmt.Sprintf(`
+Usage: consul-terraform-sync resume [-help] [options]
+
+  Resume is used to resume the execution of tasks after it was paused. Tasks
+  whose dependencies changed while paused execute with the latest changes.
+
+Options:
+%s
+
+Example:
+
+  $ consul-terraform-sync resume
+  ==> Resuming task execution...
+
+  ==> Task execution has been resumed.
+`, strings.Join(c.meta.helpOptions, "\n"))
+	return strings.TrimSpace(helpText)
+}
+
+// Synopsis is a short one-line synopsis of the command
+func (c *resumeCommand) Synopsis() string {
+	return "Resumes the execution of all tasks after a pause."
+}
+
+// AutocompleteFlags returns a mapping of supported flags and autocomplete
+// options for this command. The map key for the Flags map should be the
+// complete flag such as "-foo" or "--foo".
+func (c *resumeCommand) AutocompleteFlags() complete.Flags {
+	return c.meta.autoCompleteFlags()
+}
+
+// AutocompleteArgs returns the argument predictor for this command.
+// Since argument completion is not supported, this will return
+// complete.PredictNothing.
+func (c *resumeCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+// Run runs the command
+func (c *resumeCommand) Run(args []string) int {
+	c.meta.setFlagsUsage(c.flags, args, c.Help())
+
+	if err := c.flags.Parse(args); err != nil {
+		return ExitCodeParseFlagsError
+	}
+
+	if ok := c.meta.noArgCheck(c.Name(), c.flags.Args()); !ok {
+		return ExitCodeRequiredFlagsError
+	}
+
+	client, err := c.meta.taskLifecycleClient()
+	if err != nil {
+		c.UI.Error(errCreatingClient)
+		msg := wordwrap.WrapString(err.Error(), uint(78))
+		c.UI.Output(msg)
+
+		return ExitCodeError
+	}
+
+	c.UI.Info("Resuming task execution...\n")
+	resp, err := client.Resume(context.Background())
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		c.UI.Error("Error: unable to resume task execution")
+		err = processEOFError(client.Scheme(), err)
+
+		msg := wordwrap.WrapString(err.Error(), uint(78))
+		c.UI.Output(msg)
+
+		return ExitCodeError
+	}
+
+	c.UI.Info("Task execution has been resumed.")
+
+	return ExitCodeOK
+}
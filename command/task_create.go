@@ -274,8 +274,8 @@ func handleDeprecations(ui mcli.Ui, tc *config.TaskConfig) error {
 	}
 
 	// Handle deprecated condition source_includes_var usage over use_as_module_input
-	if tc.Condition != nil {
-		switch v := tc.Condition.(type) {
+	for _, cond := range config.FlattenCondition(tc.Condition) {
+		switch v := cond.(type) {
 		case *config.ServicesConditionConfig:
 			if v.DeprecatedSourceIncludesVar != nil {
 				isError = true
@@ -0,0 +1,240 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/cronexpr"
+)
+
+const (
+	// ChangeWindowAllow is a window during which tasks are allowed to apply.
+	// When any allow windows are configured, tasks only apply during one of
+	// them.
+	ChangeWindowAllow = "allow"
+
+	// ChangeWindowDeny is a window during which tasks do not apply, for
+	// example a maintenance freeze.
+	ChangeWindowDeny = "deny"
+)
+
+// ChangeWindowConfig configures a recurring window of time that controls when
+// tasks apply changes. A window opens at each time of its cron schedule and
+// stays open for its duration. Dependency changes that arrive while a task
+// cannot apply are deferred, and the latest rendered state is applied when
+// the task is able to apply again. This block may be specified multiple times
+// globally and within a task.
+type ChangeWindowConfig struct {
+	// Type is either "allow" or "deny".
+	Type *string `mapstructure:"type"`
+
+	// Cron is the schedule of when the window opens.
+	Cron *string `mapstructure:"cron"`
+
+	// Duration is how long the window stays open.
+	Duration *time.Duration `mapstructure:"duration"`
+}
+
+// ChangeWindowConfigs is a collection of ChangeWindowConfig
+type ChangeWindowConfigs []*ChangeWindowConfig
+
+// Copy returns a deep copy of this configuration.
+func (c *ChangeWindowConfig) Copy() *ChangeWindowConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o ChangeWindowConfig
+	o.Type = StringCopy(c.Type)
+	o.Cron = StringCopy(c.Cron)
+	o.Duration = TimeDurationCopy(c.Duration)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+func (c *ChangeWindowConfig) Merge(o *ChangeWindowConfig) *ChangeWindowConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Type != nil {
+		r.Type = StringCopy(o.Type)
+	}
+
+	if o.Cron != nil {
+		r.Cron = StringCopy(o.Cron)
+	}
+
+	if o.Duration != nil {
+		r.Duration = TimeDurationCopy(o.Duration)
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *ChangeWindowConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Type == nil {
+		c.Type = String("")
+	}
+
+	if c.Cron == nil {
+		c.Cron = String("")
+	}
+
+	if c.Duration == nil {
+		c.Duration = TimeDuration(0)
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *ChangeWindowConfig) Validate() error {
+	if c == nil {
+		return errors.New("missing change_window configuration")
+	}
+
+	switch t := StringVal(c.Type); t {
+	case ChangeWindowAllow, ChangeWindowDeny:
+	default:
+		return fmt.Errorf("type must be %q or %q: %q", ChangeWindowAllow,
+			ChangeWindowDeny, t)
+	}
+
+	if StringVal(c.Cron) == "" {
+		return errors.New("cron is required")
+	}
+
+	if _, err := cronexpr.Parse(*c.Cron); err != nil {
+		return fmt.Errorf("unable to parse cron %q: %s. for more information "+
+			"on writing cron expressions, see %s", *c.Cron, err,
+			"https://github.com/hashicorp/cronexpr")
+	}
+
+	if TimeDurationVal(c.Duration) <= 0 {
+		return fmt.Errorf("duration must be greater than 0: %s",
+			TimeDurationVal(c.Duration))
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *ChangeWindowConfig) GoString() string {
+	if c == nil {
+		return "(*ChangeWindowConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&ChangeWindowConfig{"+
+		"Type:%s, "+
+		"Cron:%s, "+
+		"Duration:%s"+
+		"}",
+		StringVal(c.Type),
+		StringVal(c.Cron),
+		TimeDurationVal(c.Duration),
+	)
+}
+
+// DefaultChangeWindowConfigs returns a configuration that is populated with
+// the default values.
+func DefaultChangeWindowConfigs() *ChangeWindowConfigs {
+	return &ChangeWindowConfigs{}
+}
+
+// Len is a helper method to get the length of the underlying config list
+func (c *ChangeWindowConfigs) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	return len(*c)
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *ChangeWindowConfigs) Copy() *ChangeWindowConfigs {
+	if c == nil {
+		return nil
+	}
+
+	o := make(ChangeWindowConfigs, c.Len())
+	for i, w := range *c {
+		o[i] = w.Copy()
+	}
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration. Windows are appended.
+func (c *ChangeWindowConfigs) Merge(o *ChangeWindowConfigs) *ChangeWindowConfigs {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	*r = append(*r, *o.Copy()...)
+	return r
+}
+
+// Finalize ensures the configuration has no nil pointers.
+func (c *ChangeWindowConfigs) Finalize() {
+	if c == nil {
+		return
+	}
+
+	for _, w := range *c {
+		w.Finalize()
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *ChangeWindowConfigs) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	for i, w := range *c {
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("invalid change_window block %d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *ChangeWindowConfigs) GoString() string {
+	if c == nil {
+		return "(*ChangeWindowConfigs)(nil)"
+	}
+
+	s := make([]string, len(*c))
+	for i, w := range *c {
+		s[i] = w.GoString()
+	}
+
+	return "{" + strings.Join(s, ", ") + "}"
+}
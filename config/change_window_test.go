@@ -0,0 +1,209 @@
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeWindowConfig_Copy(t *testing.T) {
+	cases := []struct {
+		name string
+		a    *ChangeWindowConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&ChangeWindowConfig{},
+		},
+		{
+			"fully_configured",
+			&ChangeWindowConfig{
+				Type:     String(ChangeWindowDeny),
+				Cron:     String("0 0 24 12 *"),
+				Duration: TimeDuration(48 * time.Hour),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestChangeWindowConfig_Merge(t *testing.T) {
+	cases := []struct {
+		name string
+		a    *ChangeWindowConfig
+		b    *ChangeWindowConfig
+		r    *ChangeWindowConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&ChangeWindowConfig{},
+			&ChangeWindowConfig{},
+		},
+		{
+			"nil_b",
+			&ChangeWindowConfig{},
+			nil,
+			&ChangeWindowConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"type_overrides",
+			&ChangeWindowConfig{Type: String(ChangeWindowAllow)},
+			&ChangeWindowConfig{Type: String(ChangeWindowDeny)},
+			&ChangeWindowConfig{Type: String(ChangeWindowDeny)},
+		},
+		{
+			"duration_empty_keeps",
+			&ChangeWindowConfig{Duration: TimeDuration(time.Hour)},
+			&ChangeWindowConfig{Cron: String("@daily")},
+			&ChangeWindowConfig{
+				Cron:     String("@daily"),
+				Duration: TimeDuration(time.Hour),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestChangeWindowConfig_Finalize(t *testing.T) {
+	var c ChangeWindowConfig
+	c.Finalize()
+	assert.Equal(t, ChangeWindowConfig{
+		Type:     String(""),
+		Cron:     String(""),
+		Duration: TimeDuration(0),
+	}, c)
+}
+
+func TestChangeWindowConfig_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		i       *ChangeWindowConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			false,
+		},
+		{
+			"valid_allow",
+			&ChangeWindowConfig{
+				Type:     String(ChangeWindowAllow),
+				Cron:     String("0 22 * * 1-5"),
+				Duration: TimeDuration(4 * time.Hour),
+			},
+			true,
+		},
+		{
+			"valid_deny",
+			&ChangeWindowConfig{
+				Type:     String(ChangeWindowDeny),
+				Cron:     String("@daily"),
+				Duration: TimeDuration(time.Hour),
+			},
+			true,
+		},
+		{
+			"invalid_type",
+			&ChangeWindowConfig{
+				Type:     String("freeze"),
+				Cron:     String("@daily"),
+				Duration: TimeDuration(time.Hour),
+			},
+			false,
+		},
+		{
+			"missing_cron",
+			&ChangeWindowConfig{
+				Type:     String(ChangeWindowDeny),
+				Cron:     String(""),
+				Duration: TimeDuration(time.Hour),
+			},
+			false,
+		},
+		{
+			"invalid_cron",
+			&ChangeWindowConfig{
+				Type:     String(ChangeWindowDeny),
+				Cron:     String("invalid"),
+				Duration: TimeDuration(time.Hour),
+			},
+			false,
+		},
+		{
+			"zero_duration",
+			&ChangeWindowConfig{
+				Type:     String(ChangeWindowDeny),
+				Cron:     String("@daily"),
+				Duration: TimeDuration(0),
+			},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestChangeWindowConfigs_Merge(t *testing.T) {
+	a := &ChangeWindowConfigs{{Type: String(ChangeWindowAllow)}}
+	b := &ChangeWindowConfigs{{Type: String(ChangeWindowDeny)}}
+
+	r := a.Merge(b)
+	assert.Equal(t, &ChangeWindowConfigs{
+		{Type: String(ChangeWindowAllow)},
+		{Type: String(ChangeWindowDeny)},
+	}, r)
+
+	assert.Nil(t, (*ChangeWindowConfigs)(nil).Merge(nil))
+	assert.Equal(t, a, a.Merge(nil))
+}
+
+func TestChangeWindowConfigs_Validate(t *testing.T) {
+	valid := &ChangeWindowConfig{
+		Type:     String(ChangeWindowDeny),
+		Cron:     String("@daily"),
+		Duration: TimeDuration(time.Hour),
+	}
+
+	assert.NoError(t, (*ChangeWindowConfigs)(nil).Validate())
+	assert.NoError(t, DefaultChangeWindowConfigs().Validate())
+	assert.NoError(t, (&ChangeWindowConfigs{valid}).Validate())
+
+	err := (&ChangeWindowConfigs{valid, {}}).Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid change_window block 1")
+}
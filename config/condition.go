@@ -51,25 +51,45 @@ func conditionToTypeFunc() mapstructure.DecodeHookFunc {
 			conditions = json
 		}
 
-		if c, ok := conditions[catalogServicesType]; ok {
-			var config CatalogServicesConditionConfig
-			return decodeConditionToType(c, &config)
-		}
-		if c, ok := conditions[servicesType]; ok {
-			var config ServicesConditionConfig
-			return decodeConditionToType(c, &config)
-		}
-		if c, ok := conditions[consulKVType]; ok {
-			var config ConsulKVConditionConfig
-			return decodeConditionToType(c, &config)
-		}
-		if c, ok := conditions[scheduleType]; ok {
-			var config ScheduleConditionConfig
-			return decodeConditionToType(c, &config)
-		}
+		return decodeConditionFromMap(conditions)
+	}
+}
 
-		return nil, fmt.Errorf("unsupported condition type: %v", data)
+// decodeConditionFromMap decodes a condition block, keyed by the condition
+// type, into the condition implementation of that type
+func decodeConditionFromMap(conditions map[string]interface{}) (ConditionConfig, error) {
+	if c, ok := conditions[catalogServicesType]; ok {
+		var config CatalogServicesConditionConfig
+		return decodeConditionToType(c, &config)
+	}
+	if c, ok := conditions[servicesType]; ok {
+		var config ServicesConditionConfig
+		return decodeConditionToType(c, &config)
+	}
+	if c, ok := conditions[consulKVType]; ok {
+		var config ConsulKVConditionConfig
+		return decodeConditionToType(c, &config)
+	}
+	if c, ok := conditions[intentionsType]; ok {
+		var config IntentionsConditionConfig
+		return decodeConditionToType(c, &config)
 	}
+	if c, ok := conditions[nodesType]; ok {
+		var config NodesConditionConfig
+		return decodeConditionToType(c, &config)
+	}
+	if c, ok := conditions[scheduleType]; ok {
+		var config ScheduleConditionConfig
+		return decodeConditionToType(c, &config)
+	}
+	if c, ok := conditions[anyType]; ok {
+		return decodeCompositeCondition(anyType, c)
+	}
+	if c, ok := conditions[allType]; ok {
+		return decodeCompositeCondition(allType, c)
+	}
+
+	return nil, fmt.Errorf("unsupported condition type: %v", conditions)
 }
 
 // decodeConditionToType is used by the overall config mapstructure decode hook
@@ -81,6 +101,7 @@ func decodeConditionToType(data interface{}, monitor MonitorConfig) (MonitorConf
 	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
 			decode.HookWeakDecodeFromSlice,
+			mapstructure.StringToTimeDurationHookFunc(),
 		),
 		WeaklyTypedInput: true,
 		ErrorUnused:      false,
@@ -112,6 +133,19 @@ func isConditionNil(c ConditionConfig) bool {
 	return isMonitorNil(c)
 }
 
+// FlattenCondition returns the conditions that a task's condition block is
+// composed of. For a composite condition, these are its nested conditions.
+// Otherwise, it is the condition itself.
+func FlattenCondition(c ConditionConfig) []ConditionConfig {
+	if isConditionNil(c) {
+		return nil
+	}
+	if composite, ok := c.(*CompositeConditionConfig); ok {
+		return composite.Conditions
+	}
+	return []ConditionConfig{c}
+}
+
 // bothConditionInputConfigLogMsg is the log message to warn when the user
 // configures both `source_includes_var` and `use_as_module_input` fields.
 //
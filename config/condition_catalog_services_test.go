@@ -147,7 +147,10 @@ func TestCatalogServicesConditionConfig_Finalize(t *testing.T) {
 					Regexp:           nil,
 					UseAsModuleInput: Bool(true),
 					Datacenter:       String(""),
+					Datacenters:      []string{},
 					Namespace:        String(""),
+					Partition:        String(""),
+					Peer:             String(""),
 					NodeMeta:         map[string]string{},
 				},
 			},
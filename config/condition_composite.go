@@ -0,0 +1,299 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	anyType = "any"
+	allType = "all"
+)
+
+var _ ConditionConfig = (*CompositeConditionConfig)(nil)
+
+// CompositeConditionConfig configures a condition configuration block of type
+// 'any' or 'all', which composes the condition blocks nested within it. An
+// 'any' condition is triggered when any one of its conditions is triggered. An
+// 'all' condition is triggered once each of its conditions has been triggered
+// since the task last ran.
+type CompositeConditionConfig struct {
+	// Operator is the type of the composite condition, either "any" or "all".
+	// It is set from the label of the condition block when decoding.
+	Operator *string
+
+	Conditions []ConditionConfig
+}
+
+func (c *CompositeConditionConfig) VariableType() string {
+	return ""
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *CompositeConditionConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o CompositeConditionConfig
+	o.Operator = StringCopy(c.Operator)
+
+	if c.Conditions != nil {
+		o.Conditions = make([]ConditionConfig, 0, len(c.Conditions))
+		for _, cond := range c.Conditions {
+			if isConditionNil(cond) {
+				continue
+			}
+			o.Conditions = append(o.Conditions, cond.Copy())
+		}
+	}
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Nested conditions of the same type are merged, other nested conditions are
+// appended.
+func (c *CompositeConditionConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isConditionNil(o) { // o is interface, use isConditionNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isConditionNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*CompositeConditionConfig)
+	if !ok {
+		return r
+	}
+
+	r2 := r.(*CompositeConditionConfig)
+
+	if o2.Operator != nil {
+		r2.Operator = StringCopy(o2.Operator)
+	}
+
+	for _, oCond := range o2.Conditions {
+		if isConditionNil(oCond) {
+			continue
+		}
+
+		merged := false
+		for i, rCond := range r2.Conditions {
+			if reflect.TypeOf(rCond) == reflect.TypeOf(oCond) {
+				r2.Conditions[i] = rCond.Merge(oCond)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			r2.Conditions = append(r2.Conditions, oCond.Copy())
+		}
+	}
+
+	return r2
+}
+
+// Finalize ensures there no nil pointers.
+func (c *CompositeConditionConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.Operator == nil {
+		c.Operator = String("")
+	}
+
+	if c.Conditions == nil {
+		c.Conditions = []ConditionConfig{}
+	}
+	for _, cond := range c.Conditions {
+		if !isConditionNil(cond) {
+			cond.Finalize()
+		}
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *CompositeConditionConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	op := StringVal(c.Operator)
+	if op != anyType && op != allType {
+		return fmt.Errorf("composite condition type must be %q or %q: %q",
+			anyType, allType, op)
+	}
+
+	if len(c.Conditions) < 2 {
+		return fmt.Errorf("%s condition requires at least two nested "+
+			"condition blocks, got %d", op, len(c.Conditions))
+	}
+
+	types := make(map[reflect.Type]bool)
+	for _, cond := range c.Conditions {
+		if isConditionNil(cond) {
+			return fmt.Errorf("%s condition includes an empty condition block", op)
+		}
+
+		switch v := cond.(type) {
+		case *CompositeConditionConfig:
+			return fmt.Errorf("%s condition cannot include nested %q or %q "+
+				"condition blocks", op, anyType, allType)
+		case *NoConditionConfig:
+			return fmt.Errorf("%s condition includes an empty condition block", op)
+		case *ScheduleConditionConfig:
+			if TimeDurationVal(v.OnChangeInterval) > 0 {
+				return fmt.Errorf("%s condition cannot include a schedule "+
+					"condition configured with on_change_interval", op)
+			}
+		}
+
+		t := reflect.TypeOf(cond)
+		if types[t] {
+			return fmt.Errorf("%s condition includes more than one condition "+
+				"block of the same type. condition types must be unique", op)
+		}
+		types[t] = true
+
+		if err := cond.Validate(); err != nil {
+			return fmt.Errorf("invalid condition within %s condition: %s", op, err)
+		}
+	}
+
+	return nil
+}
+
+// IsAll returns true if the composite condition requires all of its conditions
+// to be triggered, i.e. an 'all' condition.
+func (c *CompositeConditionConfig) IsAll() bool {
+	return c != nil && StringVal(c.Operator) == allType
+}
+
+// ScheduleCondition returns the schedule condition nested within the
+// composite condition. Returns nil if there is no schedule condition.
+func (c *CompositeConditionConfig) ScheduleCondition() *ScheduleConditionConfig {
+	if c == nil {
+		return nil
+	}
+
+	for _, cond := range c.Conditions {
+		if s, ok := cond.(*ScheduleConditionConfig); ok {
+			return s
+		}
+	}
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *CompositeConditionConfig) GoString() string {
+	if c == nil {
+		return "(*CompositeConditionConfig)(nil)"
+	}
+
+	conditions := make([]string, 0, len(c.Conditions))
+	for _, cond := range c.Conditions {
+		if isConditionNil(cond) {
+			continue
+		}
+		conditions = append(conditions, cond.GoString())
+	}
+
+	return fmt.Sprintf("&CompositeConditionConfig{"+
+		"Operator:%s, "+
+		"Conditions:[%s]"+
+		"}",
+		StringVal(c.Operator),
+		strings.Join(conditions, ", "),
+	)
+}
+
+// decodeCompositeCondition decodes the nested condition blocks of an 'any' or
+// 'all' condition block into a CompositeConditionConfig
+// data hcl ex: [map[condition:[map[services:[...]] map[consul-kv:[...]]]]]
+// data json ex: map[condition:map[services:map[...] consul-kv:map[...]]]
+func decodeCompositeCondition(operator string, data interface{}) (*CompositeConditionConfig, error) {
+	var block map[string]interface{}
+	switch d := data.(type) {
+	case []map[string]interface{}:
+		if len(d) != 1 {
+			return nil, fmt.Errorf("expected only one item in hcl %s "+
+				"condition but got %d: %v", operator, len(d), data)
+		}
+		block = d[0]
+	case map[string]interface{}:
+		block = d
+	default:
+		return nil, fmt.Errorf("unsupported %s condition format: %v",
+			operator, data)
+	}
+
+	var unused []string
+	for k := range block {
+		if k != "condition" {
+			unused = append(unused, k)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		return nil, fmt.Errorf("invalid keys: %s", strings.Join(unused, ", "))
+	}
+
+	// normalize nested conditions into a list of single condition blocks
+	var nested []map[string]interface{}
+	switch d := block["condition"].(type) {
+	case nil:
+	case []map[string]interface{}:
+		nested = d
+	case []interface{}:
+		for _, item := range d {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("unsupported condition format within "+
+					"%s condition: %v", operator, item)
+			}
+			nested = append(nested, m)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(d))
+		for k := range d {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			nested = append(nested, map[string]interface{}{k: d[k]})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported condition format within %s "+
+			"condition: %v", operator, d)
+	}
+
+	config := &CompositeConditionConfig{
+		Operator:   String(operator),
+		Conditions: make([]ConditionConfig, 0, len(nested)),
+	}
+	for _, n := range nested {
+		if len(n) != 1 {
+			return nil, fmt.Errorf("expected only one condition type per "+
+				"condition block within %s condition but got %d: %v",
+				operator, len(n), n)
+		}
+		cond, err := decodeConditionFromMap(n)
+		if err != nil {
+			return nil, err
+		}
+		config.Conditions = append(config.Conditions, cond)
+	}
+
+	return config, nil
+}
@@ -0,0 +1,339 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompositeConditionConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *CompositeConditionConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&CompositeConditionConfig{},
+		},
+		{
+			"fully_configured",
+			&CompositeConditionConfig{
+				Operator: String("any"),
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{Cron: String("* * * * * * *")},
+					&ConsulKVConditionConfig{
+						ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+							Path: String("key-path"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestCompositeConditionConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *CompositeConditionConfig
+		b    *CompositeConditionConfig
+		r    *CompositeConditionConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&CompositeConditionConfig{},
+			&CompositeConditionConfig{},
+		},
+		{
+			"nil_b",
+			&CompositeConditionConfig{},
+			nil,
+			&CompositeConditionConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&CompositeConditionConfig{},
+			&CompositeConditionConfig{},
+			&CompositeConditionConfig{},
+		},
+		{
+			"operator_overrides",
+			&CompositeConditionConfig{Operator: String("any")},
+			&CompositeConditionConfig{Operator: String("all")},
+			&CompositeConditionConfig{Operator: String("all")},
+		},
+		{
+			"operator_empty_one",
+			&CompositeConditionConfig{Operator: String("any")},
+			&CompositeConditionConfig{},
+			&CompositeConditionConfig{Operator: String("any")},
+		},
+		{
+			"conditions_same_type_merge",
+			&CompositeConditionConfig{
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{Cron: String("same")},
+				},
+			},
+			&CompositeConditionConfig{
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{Cron: String("different")},
+				},
+			},
+			&CompositeConditionConfig{
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{Cron: String("different")},
+				},
+			},
+		},
+		{
+			"conditions_different_type_append",
+			&CompositeConditionConfig{
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{Cron: String("same")},
+				},
+			},
+			&CompositeConditionConfig{
+				Conditions: []ConditionConfig{
+					&ConsulKVConditionConfig{
+						ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+							Path: String("key-path"),
+						},
+					},
+				},
+			},
+			&CompositeConditionConfig{
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{Cron: String("same")},
+					&ConsulKVConditionConfig{
+						ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+							Path: String("key-path"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestCompositeConditionConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *CompositeConditionConfig
+		r    *CompositeConditionConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&CompositeConditionConfig{},
+			&CompositeConditionConfig{
+				Operator:   String(""),
+				Conditions: []ConditionConfig{},
+			},
+		},
+		{
+			"conditions_finalized",
+			&CompositeConditionConfig{
+				Operator: String("all"),
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{},
+				},
+			},
+			&CompositeConditionConfig{
+				Operator: String("all"),
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{
+						Cron:             String(""),
+						OnChangeInterval: TimeDuration(0),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestCompositeConditionConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	kv := func() *ConsulKVConditionConfig {
+		c := &ConsulKVConditionConfig{
+			ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+				Path: String("key-path"),
+			},
+		}
+		c.Finalize()
+		return c
+	}
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *CompositeConditionConfig
+	}{
+		{
+			"nil",
+			false,
+			nil,
+		},
+		{
+			"valid_any",
+			false,
+			&CompositeConditionConfig{
+				Operator: String("any"),
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{Cron: String("* * * * * * *")},
+					kv(),
+				},
+			},
+		},
+		{
+			"valid_all",
+			false,
+			&CompositeConditionConfig{
+				Operator: String("all"),
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{Cron: String("* * * * * * *")},
+					kv(),
+				},
+			},
+		},
+		{
+			"invalid_operator",
+			true,
+			&CompositeConditionConfig{
+				Operator: String("none"),
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{Cron: String("* * * * * * *")},
+					kv(),
+				},
+			},
+		},
+		{
+			"one_condition",
+			true,
+			&CompositeConditionConfig{
+				Operator: String("any"),
+				Conditions: []ConditionConfig{
+					kv(),
+				},
+			},
+		},
+		{
+			"duplicate_condition_types",
+			true,
+			&CompositeConditionConfig{
+				Operator: String("any"),
+				Conditions: []ConditionConfig{
+					kv(),
+					kv(),
+				},
+			},
+		},
+		{
+			"nested_composite",
+			true,
+			&CompositeConditionConfig{
+				Operator: String("any"),
+				Conditions: []ConditionConfig{
+					kv(),
+					&CompositeConditionConfig{
+						Operator: String("all"),
+						Conditions: []ConditionConfig{
+							&ScheduleConditionConfig{Cron: String("* * * * * * *")},
+							kv(),
+						},
+					},
+				},
+			},
+		},
+		{
+			"schedule_on_change_interval",
+			true,
+			&CompositeConditionConfig{
+				Operator: String("any"),
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{
+						Cron:             String("* * * * * * *"),
+						OnChangeInterval: TimeDuration(time.Minute),
+					},
+					kv(),
+				},
+			},
+		},
+		{
+			"invalid_nested_condition",
+			true,
+			&CompositeConditionConfig{
+				Operator: String("any"),
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{Cron: String("invalid")},
+					kv(),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -29,6 +29,8 @@ func TestConsulKVConditionConfig_Copy(t *testing.T) {
 					Recurse:    Bool(true),
 					Datacenter: String("dc2"),
 					Namespace:  String("ns2"),
+					Partition:  String(""),
+					Decode:     String("json"),
 				},
 				UseAsModuleInput:            Bool(true),
 				DeprecatedSourceIncludesVar: Bool(true),
@@ -226,6 +228,18 @@ func TestConsulKVConditionConfig_Merge(t *testing.T) {
 			&ConsulKVConditionConfig{ConsulKVMonitorConfig: ConsulKVMonitorConfig{Namespace: String("same")}},
 			&ConsulKVConditionConfig{ConsulKVMonitorConfig: ConsulKVMonitorConfig{Namespace: String("same")}},
 		},
+		{
+			"decode_overrides",
+			&ConsulKVConditionConfig{ConsulKVMonitorConfig: ConsulKVMonitorConfig{Decode: String("json")}},
+			&ConsulKVConditionConfig{ConsulKVMonitorConfig: ConsulKVMonitorConfig{Decode: String("yaml")}},
+			&ConsulKVConditionConfig{ConsulKVMonitorConfig: ConsulKVMonitorConfig{Decode: String("yaml")}},
+		},
+		{
+			"decode_empty_one",
+			&ConsulKVConditionConfig{ConsulKVMonitorConfig: ConsulKVMonitorConfig{Decode: String("json")}},
+			&ConsulKVConditionConfig{},
+			&ConsulKVConditionConfig{ConsulKVMonitorConfig: ConsulKVMonitorConfig{Decode: String("json")}},
+		},
 	}
 
 	for _, tc := range cases {
@@ -258,6 +272,8 @@ func TestConsulKVConditionConfig_Finalize(t *testing.T) {
 					Recurse:    Bool(false),
 					Datacenter: String(""),
 					Namespace:  String(""),
+					Partition:  String(""),
+					Decode:     String(""),
 				},
 				UseAsModuleInput: Bool(true),
 			},
@@ -333,6 +349,8 @@ func TestConsulKVConditionConfig_Validate(t *testing.T) {
 					Recurse:    Bool(true),
 					Datacenter: String("dc2"),
 					Namespace:  String("ns2"),
+					Partition:  String(""),
+					Decode:     String(""),
 				},
 				UseAsModuleInput: Bool(true),
 			},
@@ -342,6 +360,26 @@ func TestConsulKVConditionConfig_Validate(t *testing.T) {
 			true,
 			&ConsulKVConditionConfig{},
 		},
+		{
+			"decode_yaml",
+			false,
+			&ConsulKVConditionConfig{
+				ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+					Path:   String("key-path"),
+					Decode: String("yaml"),
+				},
+			},
+		},
+		{
+			"invalid_decode",
+			true,
+			&ConsulKVConditionConfig{
+				ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+					Path:   String("key-path"),
+					Decode: String("xml"),
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
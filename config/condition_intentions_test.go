@@ -0,0 +1,133 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntentionsConditionConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *IntentionsConditionConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&IntentionsConditionConfig{},
+		},
+		{
+			"fully_configured",
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					Datacenter: String("dc2"),
+					Namespace:  String("ns2"),
+					SourceServices: &IntentionsServicesConfig{
+						Regexp: String("^web.*"),
+					},
+				},
+				UseAsModuleInput: Bool(false),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestIntentionsConditionConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	a := &IntentionsConditionConfig{
+		IntentionsMonitorConfig: IntentionsMonitorConfig{
+			Datacenter: String("dc1"),
+		},
+		UseAsModuleInput: Bool(true),
+	}
+	b := &IntentionsConditionConfig{
+		IntentionsMonitorConfig: IntentionsMonitorConfig{
+			Datacenter:     String("dc2"),
+			SourceServices: &IntentionsServicesConfig{Regexp: String(".*")},
+		},
+		UseAsModuleInput: Bool(false),
+	}
+	expected := &IntentionsConditionConfig{
+		IntentionsMonitorConfig: IntentionsMonitorConfig{
+			Datacenter:     String("dc2"),
+			SourceServices: &IntentionsServicesConfig{Regexp: String(".*")},
+		},
+		UseAsModuleInput: Bool(false),
+	}
+	assert.Equal(t, expected, a.Merge(b))
+
+	var nilCond *IntentionsConditionConfig
+	assert.Equal(t, a, a.Merge(nilCond))
+	assert.Equal(t, a, nilCond.Merge(a))
+}
+
+func TestIntentionsConditionConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	c := &IntentionsConditionConfig{}
+	c.Finalize()
+	assert.Equal(t, &IntentionsConditionConfig{
+		IntentionsMonitorConfig: IntentionsMonitorConfig{
+			Datacenter: String(""),
+			Namespace:  String(""),
+		},
+		UseAsModuleInput: Bool(true),
+	}, c)
+}
+
+func TestIntentionsConditionConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *IntentionsConditionConfig
+	}{
+		{
+			"happy_path",
+			false,
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					DestinationServices: &IntentionsServicesConfig{
+						Names: []string{"api"},
+					},
+				},
+				UseAsModuleInput: Bool(true),
+			},
+		},
+		{
+			"no_services",
+			true,
+			&IntentionsConditionConfig{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
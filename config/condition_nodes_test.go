@@ -0,0 +1,110 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodesConditionConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *NodesConditionConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&NodesConditionConfig{},
+		},
+		{
+			"fully_configured",
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Datacenter: String("dc2"),
+					Filter:     String("Meta.env == production"),
+				},
+				UseAsModuleInput: Bool(false),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestNodesConditionConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	a := &NodesConditionConfig{
+		NodesMonitorConfig: NodesMonitorConfig{
+			Datacenter: String("dc1"),
+		},
+		UseAsModuleInput: Bool(true),
+	}
+	b := &NodesConditionConfig{
+		NodesMonitorConfig: NodesMonitorConfig{
+			Filter: String("Node == web"),
+		},
+		UseAsModuleInput: Bool(false),
+	}
+	expected := &NodesConditionConfig{
+		NodesMonitorConfig: NodesMonitorConfig{
+			Datacenter: String("dc1"),
+			Filter:     String("Node == web"),
+		},
+		UseAsModuleInput: Bool(false),
+	}
+	assert.Equal(t, expected, a.Merge(b))
+
+	var nilCond *NodesConditionConfig
+	assert.Equal(t, a, a.Merge(nilCond))
+	assert.Equal(t, a, nilCond.Merge(a))
+}
+
+func TestNodesConditionConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	c := &NodesConditionConfig{}
+	c.Finalize()
+	assert.Equal(t, &NodesConditionConfig{
+		NodesMonitorConfig: NodesMonitorConfig{
+			Datacenter: String(""),
+			Filter:     String(""),
+		},
+		UseAsModuleInput: Bool(true),
+	}, c)
+	assert.NoError(t, c.Validate())
+}
+
+func TestNodesConditionConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	c := &NodesConditionConfig{
+		NodesMonitorConfig: NodesMonitorConfig{
+			Datacenter: String("dc2"),
+			Filter:     String("Node == web"),
+		},
+		UseAsModuleInput: Bool(false),
+	}
+	assert.Equal(t, "&NodesConditionConfig{"+
+		"&NodesMonitorConfig{Datacenter:dc2, Filter:Node == web}, "+
+		"UseAsModuleInput:false"+
+		"}", c.GoString())
+
+	var nilCond *NodesConditionConfig
+	assert.Equal(t, "(*NodesConditionConfig)(nil)", nilCond.GoString())
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/cronexpr"
 )
@@ -14,6 +15,12 @@ var _ ConditionConfig = (*ScheduleConditionConfig)(nil)
 // 'schedule'. A schedule condition is triggered by a configured cron schedule
 type ScheduleConditionConfig struct {
 	Cron *string `mapstructure:"cron"`
+
+	// OnChangeInterval optionally configures the task to also be triggered by
+	// changes to its module inputs in between scheduled runs. Triggers are
+	// rate-limited to at most one run per interval. The scheduled runs continue
+	// regardless to periodically reconcile the task.
+	OnChangeInterval *time.Duration `mapstructure:"on_change_interval"`
 }
 
 func (c *ScheduleConditionConfig) VariableType() string {
@@ -28,6 +35,7 @@ func (c *ScheduleConditionConfig) Copy() MonitorConfig {
 
 	var o ScheduleConditionConfig
 	o.Cron = StringCopy(c.Cron)
+	o.OnChangeInterval = TimeDurationCopy(c.OnChangeInterval)
 
 	return &o
 }
@@ -58,6 +66,10 @@ func (c *ScheduleConditionConfig) Merge(o MonitorConfig) MonitorConfig {
 		r2.Cron = StringCopy(o2.Cron)
 	}
 
+	if o2.OnChangeInterval != nil {
+		r2.OnChangeInterval = TimeDurationCopy(o2.OnChangeInterval)
+	}
+
 	return r2
 }
 
@@ -70,6 +82,10 @@ func (c *ScheduleConditionConfig) Finalize() {
 	if c.Cron == nil {
 		c.Cron = String("")
 	}
+
+	if c.OnChangeInterval == nil {
+		c.OnChangeInterval = TimeDuration(0)
+	}
 }
 
 // Validate validates the values and required options. This method is recommended
@@ -89,6 +105,11 @@ func (c *ScheduleConditionConfig) Validate() error {
 			StringVal(c.Cron), err, "https://github.com/hashicorp/cronexpr")
 	}
 
+	if TimeDurationVal(c.OnChangeInterval) < 0 {
+		return fmt.Errorf("on_change_interval for schedule condition cannot "+
+			"be negative: %s", TimeDurationVal(c.OnChangeInterval))
+	}
+
 	return nil
 }
 
@@ -100,7 +121,9 @@ func (c *ScheduleConditionConfig) GoString() string {
 
 	return fmt.Sprintf("&ScheduleConditionConfig{"+
 		"Cron:%s, "+
+		"OnChangeInterval:%s, "+
 		"}",
 		StringVal(c.Cron),
+		TimeDurationVal(c.OnChangeInterval),
 	)
 }
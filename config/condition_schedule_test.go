@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -24,7 +25,8 @@ func TestScheduleConditionConfig_Copy(t *testing.T) {
 		{
 			"fully_configured",
 			&ScheduleConditionConfig{
-				Cron: String("* * * * * * *"),
+				Cron:             String("* * * * * * *"),
+				OnChangeInterval: TimeDuration(5 * time.Minute),
 			},
 		},
 	}
@@ -99,6 +101,18 @@ func TestScheduleConditionConfig_Merge(t *testing.T) {
 			&ScheduleConditionConfig{Cron: String("same")},
 			&ScheduleConditionConfig{Cron: String("same")},
 		},
+		{
+			"on_change_interval_overrides",
+			&ScheduleConditionConfig{OnChangeInterval: TimeDuration(time.Minute)},
+			&ScheduleConditionConfig{OnChangeInterval: TimeDuration(5 * time.Minute)},
+			&ScheduleConditionConfig{OnChangeInterval: TimeDuration(5 * time.Minute)},
+		},
+		{
+			"on_change_interval_empty_one",
+			&ScheduleConditionConfig{OnChangeInterval: TimeDuration(time.Minute)},
+			&ScheduleConditionConfig{},
+			&ScheduleConditionConfig{OnChangeInterval: TimeDuration(time.Minute)},
+		},
 	}
 
 	for _, tc := range cases {
@@ -131,7 +145,8 @@ func TestScheduleConditionConfig_Finalize(t *testing.T) {
 			"empty",
 			&ScheduleConditionConfig{},
 			&ScheduleConditionConfig{
-				Cron: String(""),
+				Cron:             String(""),
+				OnChangeInterval: TimeDuration(0),
 			},
 		},
 		{
@@ -140,7 +155,19 @@ func TestScheduleConditionConfig_Finalize(t *testing.T) {
 				Cron: String("* * * * *"),
 			},
 			&ScheduleConditionConfig{
-				Cron: String("* * * * *"),
+				Cron:             String("* * * * *"),
+				OnChangeInterval: TimeDuration(0),
+			},
+		},
+		{
+			"on_change_interval_configured",
+			&ScheduleConditionConfig{
+				Cron:             String("* * * * *"),
+				OnChangeInterval: TimeDuration(time.Minute),
+			},
+			&ScheduleConditionConfig{
+				Cron:             String("* * * * *"),
+				OnChangeInterval: TimeDuration(time.Minute),
 			},
 		},
 	}
@@ -178,6 +205,22 @@ func TestScheduleConditionConfig_Validate(t *testing.T) {
 			true,
 			&ScheduleConditionConfig{},
 		},
+		{
+			"valid_on_change_interval",
+			false,
+			&ScheduleConditionConfig{
+				Cron:             String("* * * * * * *"),
+				OnChangeInterval: TimeDuration(time.Minute),
+			},
+		},
+		{
+			"negative_on_change_interval",
+			true,
+			&ScheduleConditionConfig{
+				Cron:             String("* * * * * * *"),
+				OnChangeInterval: TimeDuration(-time.Minute),
+			},
+		},
 		{
 			"invalid_cron",
 			true,
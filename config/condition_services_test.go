@@ -205,8 +205,13 @@ func TestServicesConditionConfig_Finalize(t *testing.T) {
 					Regexp:             nil,
 					Names:              []string{},
 					Datacenter:         String(""),
+					Datacenters:        []string{},
 					Namespace:          String(""),
+					Partition:          String(""),
+					Peer:               String(""),
 					Filter:             String(""),
+					TriggerOn:          String("any"),
+					IncludeNonPassing:  Bool(false),
 					CTSUserDefinedMeta: map[string]string{},
 				},
 				UseAsModuleInput: Bool(true),
@@ -331,16 +336,22 @@ func TestServicesCondition_GoString(t *testing.T) {
 					Regexp:     String("^api$"),
 					Datacenter: String("dc"),
 					Namespace:  String("namespace"),
+					Partition:  String("partition"),
+					Peer:       String("peer"),
 					Filter:     String("filter"),
 					CTSUserDefinedMeta: map[string]string{
 						"key": "value",
 					},
+					TriggerOn:         String("health_transition"),
+					IncludeNonPassing: Bool(true),
 				},
 				UseAsModuleInput: Bool(false),
 			},
 			"&ServicesConditionConfig{&ServicesMonitorConfig{Regexp:^api$, Names:[], " +
-				"Datacenter:dc, Namespace:namespace, Filter:filter, " +
-				"CTSUserDefinedMeta:map[key:value]}, UseAsModuleInput:false}",
+				"Datacenter:dc, Datacenters:[], Namespace:namespace, " +
+				"Partition:partition, Peer:peer, Filter:filter, " +
+				"CTSUserDefinedMeta:map[key:value], TriggerOn:health_transition, " +
+				"IncludeNonPassing:true}, UseAsModuleInput:false}",
 		},
 	}
 
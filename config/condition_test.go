@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -23,7 +24,10 @@ func TestCondition_DecodeConfig(t *testing.T) {
 					Regexp:           String(".*"),
 					UseAsModuleInput: Bool(true),
 					Datacenter:       String("dc2"),
+					Datacenters:      []string{},
 					Namespace:        String("ns2"),
+					Partition:        String("ap1"),
+					Peer:             String("cluster-02"),
 					NodeMeta: map[string]string{
 						"key1": "value1",
 						"key2": "value2",
@@ -39,6 +43,8 @@ task {
 		regexp = ".*"
 		use_as_module_input = true
 		namespace = "ns2"
+		partition = "ap1"
+		peer = "cluster-02"
 		datacenter = "dc2"
 		node_meta {
 		  "key1" = "value1"
@@ -64,14 +70,19 @@ task {
 			false,
 			&ServicesConditionConfig{
 				ServicesMonitorConfig: ServicesMonitorConfig{
-					Regexp:     String(".*"),
-					Names:      []string{},
-					Datacenter: String("dc"),
-					Namespace:  String("namespace"),
-					Filter:     String("filter"),
+					Regexp:      String(".*"),
+					Names:       []string{},
+					Datacenter:  String(""),
+					Datacenters: []string{"dc1", "dc2"},
+					Namespace:   String("namespace"),
+					Partition:   String(""),
+					Peer:        String(""),
+					Filter:      String("filter"),
 					CTSUserDefinedMeta: map[string]string{
 						"key": "value",
 					},
+					TriggerOn:         String("membership"),
+					IncludeNonPassing: Bool(true),
 				},
 				UseAsModuleInput: Bool(true),
 			},
@@ -82,9 +93,11 @@ task {
 	module = "..."
 	condition "services" {
 		regexp = ".*"
-		datacenter = "dc"
+		datacenters = ["dc1", "dc2"]
 		namespace = "namespace"
 		filter = "filter"
+		trigger_on = "membership"
+		include_non_passing = true
 		cts_user_defined_meta {
 			key = "value"
 		}
@@ -109,7 +122,8 @@ task {
 			"schedule: happy path",
 			false,
 			&ScheduleConditionConfig{
-				Cron: String("* * * * * * *"),
+				Cron:             String("* * * * * * *"),
+				OnChangeInterval: TimeDuration(5 * time.Minute),
 			},
 			"config.hcl",
 			`
@@ -118,6 +132,7 @@ task {
 	module = "..."
 	condition "schedule" {
 		cron = "* * * * * * *"
+		on_change_interval = "5m"
 	}
 }`,
 		},
@@ -160,7 +175,9 @@ task {
 					Path:       String("key-path"),
 					Datacenter: String("dc2"),
 					Namespace:  String("ns2"),
+					Partition:  String("ap1"),
 					Recurse:    Bool(true),
+					Decode:     String("json"),
 				},
 				UseAsModuleInput: Bool(true),
 			},
@@ -173,8 +190,183 @@ task {
 		path = "key-path"
 		use_as_module_input = true
 		namespace = "ns2"
+		partition = "ap1"
 		datacenter = "dc2"
 		recurse = true
+		decode = "json"
+	}
+}`,
+		},
+		{
+			"intentions: happy path",
+			false,
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					Datacenter: String("dc2"),
+					Namespace:  String("ns2"),
+					SourceServices: &IntentionsServicesConfig{
+						Regexp: String("^web.*"),
+						Names:  []string{},
+					},
+					DestinationServices: &IntentionsServicesConfig{
+						Names: []string{"api", "db"},
+					},
+				},
+				UseAsModuleInput: Bool(true),
+			},
+			"config.hcl",
+			`
+task {
+	name = "condition_task"
+	module = "..."
+	condition "intentions" {
+		datacenter = "dc2"
+		namespace = "ns2"
+		source_services {
+			regexp = "^web.*"
+		}
+		destination_services {
+			names = ["api", "db"]
+		}
+	}
+}`,
+		},
+		{
+			"nodes: happy path",
+			false,
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Datacenter: String("dc2"),
+					Filter:     String("Meta.env == production"),
+				},
+				UseAsModuleInput: Bool(false),
+			},
+			"config.hcl",
+			`
+task {
+	name = "condition_task"
+	module = "..."
+	condition "nodes" {
+		datacenter = "dc2"
+		filter = "Meta.env == production"
+		use_as_module_input = false
+	}
+}`,
+		},
+		{
+			"any: happy path",
+			false,
+			&CompositeConditionConfig{
+				Operator: String("any"),
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{
+						Cron:             String("* * * * * * *"),
+						OnChangeInterval: TimeDuration(0),
+					},
+					&ConsulKVConditionConfig{
+						ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+							Path:       String("key-path"),
+							Datacenter: String(""),
+							Namespace:  String(""),
+							Partition:  String(""),
+							Decode:     String(""),
+							Recurse:    Bool(false),
+						},
+						UseAsModuleInput: Bool(true),
+					},
+				},
+			},
+			"config.hcl",
+			`
+task {
+	name = "condition_task"
+	module = "..."
+	condition "any" {
+		condition "schedule" {
+			cron = "* * * * * * *"
+		}
+		condition "consul-kv" {
+			path = "key-path"
+		}
+	}
+}`,
+		},
+		{
+			"all: happy path",
+			false,
+			&CompositeConditionConfig{
+				Operator: String("all"),
+				Conditions: []ConditionConfig{
+					&ConsulKVConditionConfig{
+						ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+							Path:       String("key-path"),
+							Datacenter: String(""),
+							Namespace:  String(""),
+							Partition:  String(""),
+							Decode:     String(""),
+							Recurse:    Bool(false),
+						},
+						UseAsModuleInput: Bool(true),
+					},
+					&NodesConditionConfig{
+						NodesMonitorConfig: NodesMonitorConfig{
+							Datacenter: String(""),
+							Filter:     String(""),
+						},
+						UseAsModuleInput: Bool(true),
+					},
+				},
+			},
+			"config.hcl",
+			`
+task {
+	name = "condition_task"
+	module = "..."
+	condition "all" {
+		condition "consul-kv" {
+			path = "key-path"
+		}
+		condition "nodes" {
+		}
+	}
+}`,
+		},
+		{
+			"any: unsupported field",
+			true,
+			nil,
+			"config.hcl",
+			`
+task {
+	name = "condition_task"
+	module = "..."
+	condition "any" {
+		nonexistent_field = true
+		condition "schedule" {
+			cron = "* * * * * * *"
+		}
+		condition "consul-kv" {
+			path = "key-path"
+		}
+	}
+}`,
+		},
+		{
+			"any: unsupported nested condition field",
+			true,
+			nil,
+			"config.hcl",
+			`
+task {
+	name = "condition_task"
+	module = "..."
+	condition "any" {
+		condition "schedule" {
+			cron = "* * * * * * *"
+		}
+		condition "consul-kv" {
+			nonexistent_field = true
+		}
 	}
 }`,
 		},
@@ -199,7 +391,10 @@ task {
 					Regexp:           String(".*"),
 					UseAsModuleInput: Bool(true),
 					Datacenter:       String("dc2"),
+					Datacenters:      []string{},
 					Namespace:        String("ns2"),
+					Partition:        String(""),
+					Peer:             String(""),
 					NodeMeta: map[string]string{
 						"key1": "value1",
 						"key2": "value2",
@@ -229,6 +424,51 @@ task {
 			}
 		  }
 		}
+]}`,
+		},
+		{
+			"json any happy path",
+			false,
+			&CompositeConditionConfig{
+				Operator: String("any"),
+				Conditions: []ConditionConfig{
+					&ConsulKVConditionConfig{
+						ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+							Path:       String("key-path"),
+							Datacenter: String(""),
+							Namespace:  String(""),
+							Partition:  String(""),
+							Decode:     String(""),
+							Recurse:    Bool(false),
+						},
+						UseAsModuleInput: Bool(true),
+					},
+					&ScheduleConditionConfig{
+						Cron:             String("* * * * * * *"),
+						OnChangeInterval: TimeDuration(0),
+					},
+				},
+			},
+			"config.json",
+			`
+{
+	"task": [
+		{
+		  "name": "task",
+		  "module": "Y",
+		  "condition": {
+			"any": {
+			  "condition": {
+				"schedule": {
+				  "cron": "* * * * * * *"
+				},
+				"consul-kv": {
+				  "path": "key-path"
+				}
+			  }
+			}
+		  }
+		}
 ]}`,
 		},
 	}
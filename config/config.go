@@ -46,6 +46,7 @@ type Config struct {
 	DeprecatedServices *ServiceConfigs           `mapstructure:"service"`
 	TerraformProviders *TerraformProviderConfigs `mapstructure:"terraform_provider"`
 	BufferPeriod       *BufferPeriodConfig       `mapstructure:"buffer_period"`
+	ChangeWindows      *ChangeWindowConfigs      `mapstructure:"change_window"`
 	TLS                *CTSTLSConfig             `mapstructure:"tls"`
 }
 
@@ -86,6 +87,7 @@ func DefaultConfig() *Config {
 		DeprecatedServices: DefaultServiceConfigs(),
 		TerraformProviders: DefaultTerraformProviderConfigs(),
 		BufferPeriod:       DefaultBufferPeriodConfig(),
+		ChangeWindows:      DefaultChangeWindowConfigs(),
 		TLS:                DefaultCTSTLSConfig(),
 	}
 }
@@ -109,6 +111,7 @@ func (c *Config) Copy() *Config {
 		DeprecatedServices: c.DeprecatedServices.Copy(),
 		TerraformProviders: c.TerraformProviders.Copy(),
 		BufferPeriod:       c.BufferPeriod.Copy(),
+		ChangeWindows:      c.ChangeWindows.Copy(),
 		TLS:                c.TLS.Copy(),
 	}
 }
@@ -175,6 +178,10 @@ func (c *Config) Merge(o *Config) *Config {
 		r.BufferPeriod = r.BufferPeriod.Merge(o.BufferPeriod)
 	}
 
+	if o.ChangeWindows != nil {
+		r.ChangeWindows = r.ChangeWindows.Merge(o.ChangeWindows)
+	}
+
 	if o.TLS != nil {
 		r.TLS = r.TLS.Merge(o.TLS)
 	}
@@ -231,6 +238,11 @@ func (c *Config) Finalize() {
 	}
 	c.BufferPeriod.Finalize(DefaultBufferPeriodConfig())
 
+	if c.ChangeWindows == nil {
+		c.ChangeWindows = DefaultChangeWindowConfigs()
+	}
+	c.ChangeWindows.Finalize()
+
 	if c.Tasks == nil {
 		c.Tasks = DefaultTaskConfigs()
 	}
@@ -270,6 +282,12 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	for _, t := range *c.Tasks {
+		if err := c.Driver.ValidateTask(t); err != nil {
+			return err
+		}
+	}
+
 	if err := c.DeprecatedServices.Validate(); err != nil {
 		return err
 	}
@@ -282,6 +300,10 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.ChangeWindows.Validate(); err != nil {
+		return err
+	}
+
 	if err := c.validateTaskProvider(); err != nil {
 		return err
 	}
@@ -347,6 +369,7 @@ func (c *Config) GoString() string {
 		"Services (deprecated):%s, "+
 		"TerraformProviders:%s, "+
 		"BufferPeriod:%s,"+
+		"ChangeWindows:%s, "+
 		"TLS:%s"+
 		"}",
 		StringVal(c.LogLevel),
@@ -360,19 +383,29 @@ func (c *Config) GoString() string {
 		c.DeprecatedServices.GoString(),
 		c.TerraformProviders.GoString(),
 		c.BufferPeriod.GoString(),
+		c.ChangeWindows.GoString(),
 		c.TLS.GoString(),
 	)
 }
 
 func (c *Config) validateDynamicConfigs() error {
-	// If dynamic provider configs contain Vault dependency, verify that Vault is
-	// configured.
+	// If dynamic provider configs or module inputs contain Vault dependency,
+	// verify that Vault is configured.
 	if c.Vault != nil && !*c.Vault.Enabled {
 		for _, p := range *c.TerraformProviders {
 			if hcltmpl.ContainsVaultSecret(fmt.Sprint(*p)) {
 				return fmt.Errorf("detected dynamic configuration using Vault: missing Vault configuration")
 			}
 		}
+
+		for _, t := range *c.Tasks {
+			for _, input := range *t.ModuleInputs {
+				if _, ok := input.(*VaultSecretsModuleInputConfig); ok {
+					return fmt.Errorf("task %q has a vault_secrets module_input: "+
+						"missing Vault configuration", StringVal(t.Name))
+				}
+			}
+		}
 	}
 
 	// Dynamic configuration is only supported for terraform_provider blocks.
@@ -100,6 +100,42 @@ var (
 				DeprecatedServices: []string{"serviceA", "serviceB", "serviceC"},
 				Providers:          []string{"X"},
 				Module:             String("Y"),
+				Imports: map[string]string{
+					"module.task.x_resource.a": "id-a",
+				},
+				PostApply: &PostApplyConfigs{
+					{
+						Command: []string{"./reload.sh", "--all"},
+						Timeout: TimeDuration(10 * time.Second),
+						Retries: Int(2),
+					},
+				},
+				PreApply: &PreApplyConfigs{
+					{MaxDestroy: Int(0)},
+					{
+						URL:     String("https://lock.example.com/acquire"),
+						Headers: map[string]string{"X-Token": "token"},
+					},
+				},
+				Policies: &PolicyConfigs{
+					{
+						Name:             String("no-deletes"),
+						Expression:       String("plan.resource_changes.all(rc, !('delete' in rc.change.actions))"),
+						EnforcementLevel: String(PolicyEnforcementAdvisory),
+						Message:          String("resources must not be deleted"),
+					},
+				},
+				Guardrails: &GuardrailsConfig{
+					MaxDestroy:       Int(2),
+					MaxChangePercent: Int(50),
+				},
+				ChangeWindows: &ChangeWindowConfigs{
+					{
+						Type:     String(ChangeWindowAllow),
+						Cron:     String("0 22 * * 1-5"),
+						Duration: TimeDuration(4 * time.Hour),
+					},
+				},
 				Condition: &CatalogServicesConditionConfig{
 					CatalogServicesMonitorConfig{
 						Regexp:           String(".*"),
@@ -131,6 +167,13 @@ var (
 			Min: TimeDuration(20 * time.Second),
 			Max: TimeDuration(60 * time.Second),
 		},
+		ChangeWindows: &ChangeWindowConfigs{
+			{
+				Type:     String(ChangeWindowDeny),
+				Cron:     String("0 0 24 12 *"),
+				Duration: TimeDuration(48 * time.Hour),
+			},
+		},
 	}
 )
 
@@ -321,6 +364,11 @@ func TestConfig_Finalize(t *testing.T) {
 	expected.TLS.Finalize()
 	expected.Driver.consul = expected.Consul
 	expected.Driver.Terraform.Version = String("")
+	expected.Driver.Terraform.Flavor = String(TerraformFlavorTerraform)
+	expected.Driver.Terraform.MirrorURL = String("")
+	expected.Driver.Terraform.Archive = String("")
+	expected.Driver.Terraform.SHA256 = String("")
+	expected.Driver.Terraform.SignatureKey = String("")
 	expected.Driver.Terraform.PersistLog = Bool(false)
 	backend := expected.Driver.Terraform.Backend["consul"].(map[string]interface{})
 	backend["scheme"] = "https"
@@ -328,6 +376,25 @@ func TestConfig_Finalize(t *testing.T) {
 	backend["key_file"] = "key"
 	(*expected.Tasks)[0].Enabled = Bool(true)
 	(*expected.Tasks)[0].TFVersion = String("")
+	(*expected.Tasks)[0].PreApply = &PreApplyConfigs{
+		{
+			Command:    []string{},
+			URL:        String(""),
+			Method:     String(""),
+			Headers:    map[string]string{},
+			MaxDestroy: Int(0),
+			Timeout:    TimeDuration(DefaultPreApplyTimeout),
+			Retries:    Int(0),
+		}, {
+			Command: []string{},
+			URL:     String("https://lock.example.com/acquire"),
+			Method:  String("POST"),
+			Headers: map[string]string{"X-Token": "token"},
+			Timeout: TimeDuration(DefaultPreApplyTimeout),
+			Retries: Int(0),
+		},
+	}
+	(*(*expected.Tasks)[0].Policies)[0].File = String("")
 	(*expected.Tasks)[0].TFCWorkspace = DefaultTerraformCloudWorkspaceConfig()
 	(*expected.Tasks)[0].VarFiles = []string{}
 	(*expected.Tasks)[0].Version = String("")
@@ -337,6 +404,11 @@ func TestConfig_Finalize(t *testing.T) {
 	(*expected.Tasks)[0].BufferPeriod.Max = TimeDuration(60 * time.Second)
 	(*expected.Tasks)[0].Variables = map[string]string{}
 	(*expected.Tasks)[0].WorkingDir = String("working/task")
+	(*expected.Tasks)[0].Condition.(*CatalogServicesConditionConfig).Datacenters = []string{}
+	(*expected.Tasks)[0].Condition.(*CatalogServicesConditionConfig).Partition = String("")
+	(*expected.Tasks)[0].Condition.(*CatalogServicesConditionConfig).Peer = String("")
+	(*(*expected.Tasks)[0].ModuleInputs)[0].(*ConsulKVModuleInputConfig).Partition = String("")
+	(*(*expected.Tasks)[0].ModuleInputs)[0].(*ConsulKVModuleInputConfig).Decode = String("")
 	(*expected.DeprecatedServices)[0].ID = String("serviceA")
 	(*expected.DeprecatedServices)[0].Namespace = String("")
 	(*expected.DeprecatedServices)[0].Datacenter = String("")
@@ -491,6 +563,35 @@ func TestConfig_validateDynamicConfig(t *testing.T) {
 				},
 			},
 			false,
+		}, {
+			"vault_secrets module_input with vault",
+			Config{
+				Tasks: &TaskConfigs{{
+					Name: String("task"),
+					ModuleInputs: &ModuleInputConfigs{
+						&VaultSecretsModuleInputConfig{
+							VaultSecretsMonitorConfig{Paths: []string{"secret/data/app"}},
+						},
+					},
+				}},
+				Vault: &VaultConfig{
+					Address: String("vault.example.com"),
+				},
+			},
+			true,
+		}, {
+			"vault_secrets module_input missing vault",
+			Config{
+				Tasks: &TaskConfigs{{
+					Name: String("task"),
+					ModuleInputs: &ModuleInputConfigs{
+						&VaultSecretsModuleInputConfig{
+							VaultSecretsMonitorConfig{Paths: []string{"secret/data/app"}},
+						},
+					},
+				}},
+			},
+			false,
 		}, {
 			"dynamic configs unsupported outside of providers",
 			Config{
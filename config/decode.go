@@ -29,11 +29,6 @@ try 'terraform_provider'. The terraform_provider configuration blocks are
 similar to provider blocks in Terraform but have additional features
 supported only by CTS.`, err)
 
-		case "driver.terraform-cloud":
-			return fmt.Errorf(`%s
-
-Terraform Cloud is a Consul-Terraform-Sync (CTS) Enterprise feature.
-Upgrade to Consul Enterprise to enable CTS Enterprise features.`, err)
 		}
 	}
 	return err
@@ -1,13 +1,23 @@
 package config
 
-import "fmt"
+import (
+	"fmt"
+
+	goVersion "github.com/hashicorp/go-version"
+)
+
+// sensitiveVarsConstraint is the version constraint of Terraform for tasks
+// with a vault_secrets module input, which is passed to the module as a
+// sensitive variable
+const sensitiveVarsConstraint = ">= 0.14"
 
 // DriverConfig is the configuration for the Sync driver used to execute
 // infrastructure updates.
 type DriverConfig struct {
 	consul *ConsulConfig
 
-	Terraform *TerraformConfig `mapstructure:"terraform"`
+	Terraform      *TerraformConfig      `mapstructure:"terraform"`
+	TerraformCloud *TerraformCloudConfig `mapstructure:"terraform-cloud"`
 }
 
 // DefaultDriverConfig returns the default configuration struct.
@@ -31,6 +41,10 @@ func (c *DriverConfig) Copy() *DriverConfig {
 		o.Terraform = c.Terraform.Copy()
 	}
 
+	if c.TerraformCloud != nil {
+		o.TerraformCloud = c.TerraformCloud.Copy()
+	}
+
 	return &o
 }
 
@@ -60,6 +74,10 @@ func (c *DriverConfig) Merge(o *DriverConfig) *DriverConfig {
 		r.Terraform = r.Terraform.Merge(o.Terraform)
 	}
 
+	if o.TerraformCloud != nil {
+		r.TerraformCloud = r.TerraformCloud.Merge(o.TerraformCloud)
+	}
+
 	return r
 }
 
@@ -69,6 +87,13 @@ func (c *DriverConfig) Finalize() {
 		return
 	}
 
+	// The Terraform driver is the default unless the Terraform Cloud driver
+	// is configured
+	if c.TerraformCloud != nil {
+		c.TerraformCloud.Finalize()
+		return
+	}
+
 	if c.Terraform == nil {
 		c.Terraform = DefaultTerraformConfig()
 	}
@@ -81,9 +106,64 @@ func (c *DriverConfig) Validate() error {
 		return fmt.Errorf("missing driver configuration")
 	}
 
+	if c.TerraformCloud != nil {
+		if c.Terraform != nil {
+			return fmt.Errorf("only one driver can be configured, found both " +
+				"the 'terraform' and 'terraform-cloud' drivers")
+		}
+		return c.TerraformCloud.Validate()
+	}
+
 	return c.Terraform.Validate()
 }
 
+// ValidateTask validates the task configuration options that are only
+// supported by specific drivers.
+func (c *DriverConfig) ValidateTask(t *TaskConfig) error {
+	if c == nil || t == nil {
+		return nil
+	}
+
+	if c.TerraformCloud != nil {
+		// The Terraform Cloud API does not support importing resources into
+		// the state of a workspace
+		if len(t.Imports) > 0 {
+			return fmt.Errorf("unsupported configuration 'import' for task "+
+				"%q. This option is only available when using the Terraform "+
+				"driver", StringVal(t.Name))
+		}
+		return nil
+	}
+
+	if v := StringVal(t.TFVersion); v != "" {
+		// Task versions are installed by the Terraform driver and have the
+		// same requirements as the driver's version
+		tfConf := &TerraformConfig{
+			Version: String(v),
+			Backend: map[string]interface{}{},
+		}
+		if c.Terraform != nil {
+			tfConf.Flavor = StringCopy(c.Terraform.Flavor)
+		}
+		if err := tfConf.Validate(); err != nil {
+			return fmt.Errorf("invalid 'terraform_version' for task %q: %s",
+				StringVal(t.Name), err)
+		}
+	}
+
+	if err := c.validateSensitiveVars(t); err != nil {
+		return err
+	}
+
+	if t.TFCWorkspace != nil && !t.TFCWorkspace.isEmpty() {
+		return fmt.Errorf("unsupported configuration 'terraform_cloud_workspace' "+
+			"for task %q. This option is only available when using the Terraform "+
+			"Cloud driver", StringVal(t.Name))
+	}
+
+	return nil
+}
+
 // GoString defines the printable version of this struct.
 func (c *DriverConfig) GoString() string {
 	if c == nil {
@@ -91,8 +171,43 @@ func (c *DriverConfig) GoString() string {
 	}
 
 	return fmt.Sprintf("&DriverConfig{"+
-		"Terraform:%s"+
+		"Terraform:%s, "+
+		"TerraformCloud:%s"+
 		"}",
 		c.Terraform.GoString(),
+		c.TerraformCloud.GoString(),
 	)
 }
+
+// validateSensitiveVars checks that the Terraform version configured for the
+// task supports sensitive variables when the task has a vault_secrets module
+// input. The installed version is checked by the driver when it is not
+// configured.
+func (c *DriverConfig) validateSensitiveVars(t *TaskConfig) error {
+	if !t.ModuleInputs.hasVaultSecrets() {
+		return nil
+	}
+
+	v := StringVal(t.TFVersion)
+	if v == "" && c.Terraform != nil {
+		v = StringVal(c.Terraform.Version)
+	}
+	if v == "" {
+		return nil
+	}
+
+	version, err := goVersion.NewVersion(v)
+	if err != nil {
+		return err
+	}
+	constraint, err := goVersion.NewConstraint(sensitiveVarsConstraint)
+	if err != nil {
+		return err
+	}
+	if !constraint.Check(version) {
+		return fmt.Errorf("module_input 'vault_secrets' for task %q requires "+
+			"Terraform %s for sensitive variables: %s", StringVal(t.Name),
+			sensitiveVarsConstraint, v)
+	}
+	return nil
+}
@@ -151,6 +151,11 @@ func TestDriverConfig_Finalize(t *testing.T) {
 			&DriverConfig{
 				Terraform: &TerraformConfig{
 					Version:           String(""),
+					Flavor:            String(TerraformFlavorTerraform),
+					MirrorURL:         String(""),
+					Archive:           String(""),
+					SHA256:            String(""),
+					SignatureKey:      String(""),
 					Log:               Bool(false),
 					PersistLog:        Bool(false),
 					Path:              String(wd),
@@ -159,6 +164,23 @@ func TestDriverConfig_Finalize(t *testing.T) {
 				},
 			},
 		},
+		{
+			"with_terraform_cloud",
+			&DriverConfig{
+				TerraformCloud: &TerraformCloudConfig{
+					Organization: String("org"),
+				},
+			},
+			&DriverConfig{
+				TerraformCloud: &TerraformCloudConfig{
+					Hostname:          String(DefaultTFCHostname),
+					Organization:      String("org"),
+					Token:             String(""),
+					WorkspacePrefix:   String(""),
+					RequiredProviders: map[string]interface{}{},
+				},
+			},
+		},
 		{
 			"with_terraform",
 			&DriverConfig{
@@ -169,6 +191,11 @@ func TestDriverConfig_Finalize(t *testing.T) {
 			&DriverConfig{
 				Terraform: &TerraformConfig{
 					Version:           String(""),
+					Flavor:            String(TerraformFlavorTerraform),
+					MirrorURL:         String(""),
+					Archive:           String(""),
+					SHA256:            String(""),
+					SignatureKey:      String(""),
 					Log:               Bool(true),
 					PersistLog:        Bool(false),
 					Path:              String(wd),
@@ -211,6 +238,27 @@ func TestDriverConfig_Validate(t *testing.T) {
 			"terraform_invalid",
 			&DriverConfig{Terraform: &TerraformConfig{}},
 			false,
+		}, {
+			"terraform_cloud",
+			&DriverConfig{TerraformCloud: &TerraformCloudConfig{
+				Organization: String("org"),
+				Token:        String("token"),
+			}},
+			true,
+		}, {
+			"terraform_cloud_invalid",
+			&DriverConfig{TerraformCloud: &TerraformCloudConfig{}},
+			false,
+		}, {
+			"multiple_drivers",
+			&DriverConfig{
+				Terraform: &TerraformConfig{Backend: map[string]interface{}{"consul": nil}},
+				TerraformCloud: &TerraformCloudConfig{
+					Organization: String("org"),
+					Token:        String("token"),
+				},
+			},
+			false,
 		},
 	}
 
@@ -225,3 +273,144 @@ func TestDriverConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestDriverConfig_ValidateTask(t *testing.T) {
+	t.Parallel()
+
+	tfDriver := &DriverConfig{Terraform: DefaultTerraformConfig()}
+	tfcDriver := &DriverConfig{TerraformCloud: DefaultTerraformCloudConfig()}
+
+	cases := []struct {
+		name    string
+		driver  *DriverConfig
+		task    *TaskConfig
+		isValid bool
+	}{
+		{
+			"terraform: basic task",
+			tfDriver,
+			&TaskConfig{Name: String("task")},
+			true,
+		}, {
+			"terraform: terraform_version",
+			tfDriver,
+			&TaskConfig{Name: String("task"), TFVersion: String("1.0.0")},
+			true,
+		}, {
+			"terraform: terraform_version not exact",
+			tfDriver,
+			&TaskConfig{Name: String("task"), TFVersion: String("1.0")},
+			false,
+		}, {
+			"terraform: terraform_version unsupported",
+			tfDriver,
+			&TaskConfig{Name: String("task"), TFVersion: String("0.12.31")},
+			false,
+		}, {
+			"terraform: opentofu terraform_version",
+			&DriverConfig{Terraform: &TerraformConfig{
+				Flavor: String(TerraformFlavorOpenTofu),
+			}},
+			&TaskConfig{Name: String("task"), TFVersion: String("1.6.2")},
+			true,
+		}, {
+			"terraform: terraform_cloud_workspace unsupported",
+			tfDriver,
+			&TaskConfig{Name: String("task"), TFCWorkspace: &TerraformCloudWorkspaceConfig{
+				ExecutionMode: String("remote"),
+			}},
+			false,
+		}, {
+			"terraform: empty terraform_cloud_workspace",
+			tfDriver,
+			&TaskConfig{Name: String("task"), TFCWorkspace: DefaultTerraformCloudWorkspaceConfig()},
+			true,
+		}, {
+			"terraform: vault_secrets",
+			tfDriver,
+			&TaskConfig{
+				Name:         String("task"),
+				TFVersion:    String("1.0.0"),
+				ModuleInputs: &ModuleInputConfigs{&VaultSecretsModuleInputConfig{}},
+			},
+			true,
+		}, {
+			"terraform: vault_secrets terraform_version unsupported",
+			tfDriver,
+			&TaskConfig{
+				Name:         String("task"),
+				TFVersion:    String("0.13.7"),
+				ModuleInputs: &ModuleInputConfigs{&VaultSecretsModuleInputConfig{}},
+			},
+			false,
+		}, {
+			"terraform: vault_secrets driver version unsupported",
+			&DriverConfig{Terraform: &TerraformConfig{Version: String("0.13.7")}},
+			&TaskConfig{
+				Name:         String("task"),
+				ModuleInputs: &ModuleInputConfigs{&VaultSecretsModuleInputConfig{}},
+			},
+			false,
+		}, {
+			"terraform-cloud: workspace options",
+			tfcDriver,
+			&TaskConfig{
+				Name:      String("task"),
+				TFVersion: String("1.0.0"),
+				TFCWorkspace: &TerraformCloudWorkspaceConfig{
+					ExecutionMode: String("remote"),
+				},
+			},
+			true,
+		}, {
+			"terraform: guardrails",
+			tfDriver,
+			&TaskConfig{Name: String("task"), Guardrails: &GuardrailsConfig{
+				MaxDestroy: Int(0),
+			}},
+			true,
+		}, {
+			"terraform-cloud: guardrails",
+			tfcDriver,
+			&TaskConfig{Name: String("task"), Guardrails: &GuardrailsConfig{
+				MaxDestroy: Int(0),
+			}},
+			true,
+		}, {
+			"terraform-cloud: empty guardrails",
+			tfcDriver,
+			&TaskConfig{Name: String("task"), Guardrails: DefaultGuardrailsConfig()},
+			true,
+		}, {
+			"terraform: import",
+			tfDriver,
+			&TaskConfig{Name: String("task"), Imports: map[string]string{
+				"module.task.local_file.a": "a",
+			}},
+			true,
+		}, {
+			"terraform-cloud: import unsupported",
+			tfcDriver,
+			&TaskConfig{Name: String("task"), Imports: map[string]string{
+				"module.task.local_file.a": "a",
+			}},
+			false,
+		}, {
+			"terraform-cloud: empty import",
+			tfcDriver,
+			&TaskConfig{Name: String("task"), Imports: map[string]string{}},
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.driver.ValidateTask(tc.task)
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
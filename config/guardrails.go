@@ -0,0 +1,132 @@
+package config
+
+import (
+	"fmt"
+)
+
+// GuardrailsConfig configures limits on the blast radius of a task's apply.
+// The limits are checked against the task's plan before it applies. When the
+// plan exceeds any limit, the task does not apply and is blocked from applying
+// until an operator approves or overrides the apply through the API.
+type GuardrailsConfig struct {
+	// MaxDestroy is the maximum number of resources the plan can destroy,
+	// including resources that are replaced.
+	MaxDestroy *int `mapstructure:"max_destroy"`
+
+	// MaxChanges is the maximum number of resources the plan can create,
+	// update, or destroy.
+	MaxChanges *int `mapstructure:"max_changes"`
+
+	// MaxChangePercent is the maximum percentage of the resources managed by
+	// the task that the plan can update or destroy. It is not checked when
+	// the task does not manage any resources yet.
+	MaxChangePercent *int `mapstructure:"max_change_percent"`
+}
+
+// DefaultGuardrailsConfig returns a configuration without any limits.
+func DefaultGuardrailsConfig() *GuardrailsConfig {
+	return &GuardrailsConfig{}
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *GuardrailsConfig) Copy() *GuardrailsConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o GuardrailsConfig
+	o.MaxDestroy = IntCopy(c.MaxDestroy)
+	o.MaxChanges = IntCopy(c.MaxChanges)
+	o.MaxChangePercent = IntCopy(c.MaxChangePercent)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+func (c *GuardrailsConfig) Merge(o *GuardrailsConfig) *GuardrailsConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.MaxDestroy != nil {
+		r.MaxDestroy = IntCopy(o.MaxDestroy)
+	}
+
+	if o.MaxChanges != nil {
+		r.MaxChanges = IntCopy(o.MaxChanges)
+	}
+
+	if o.MaxChangePercent != nil {
+		r.MaxChangePercent = IntCopy(o.MaxChangePercent)
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers. Limits are left nil when they are
+// not configured since there is no default limit.
+func (c *GuardrailsConfig) Finalize() {}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *GuardrailsConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.MaxDestroy != nil && *c.MaxDestroy < 0 {
+		return fmt.Errorf("max_destroy cannot be negative: %d", *c.MaxDestroy)
+	}
+
+	if c.MaxChanges != nil && *c.MaxChanges < 0 {
+		return fmt.Errorf("max_changes cannot be negative: %d", *c.MaxChanges)
+	}
+
+	if c.MaxChangePercent != nil {
+		if p := *c.MaxChangePercent; p < 0 || p > 100 {
+			return fmt.Errorf("max_change_percent must be between 0 and 100: %d", p)
+		}
+	}
+
+	return nil
+}
+
+// IsEmpty returns true when no limits are configured.
+func (c *GuardrailsConfig) IsEmpty() bool {
+	return c == nil ||
+		(c.MaxDestroy == nil && c.MaxChanges == nil && c.MaxChangePercent == nil)
+}
+
+// GoString defines the printable version of this struct.
+func (c *GuardrailsConfig) GoString() string {
+	if c == nil {
+		return "(*GuardrailsConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&GuardrailsConfig{"+
+		"MaxDestroy:%s, "+
+		"MaxChanges:%s, "+
+		"MaxChangePercent:%s"+
+		"}",
+		intGoString(c.MaxDestroy),
+		intGoString(c.MaxChanges),
+		intGoString(c.MaxChangePercent),
+	)
+}
+
+// intGoString returns the printable version of an optional int
+func intGoString(i *int) string {
+	if i == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%d", *i)
+}
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuardrailsConfig_Copy(t *testing.T) {
+	cases := []struct {
+		name string
+		a    *GuardrailsConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&GuardrailsConfig{},
+		},
+		{
+			"fully_configured",
+			&GuardrailsConfig{
+				MaxDestroy:       Int(1),
+				MaxChanges:       Int(10),
+				MaxChangePercent: Int(25),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestGuardrailsConfig_Merge(t *testing.T) {
+	cases := []struct {
+		name string
+		a    *GuardrailsConfig
+		b    *GuardrailsConfig
+		r    *GuardrailsConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&GuardrailsConfig{},
+			&GuardrailsConfig{},
+		},
+		{
+			"nil_b",
+			&GuardrailsConfig{},
+			nil,
+			&GuardrailsConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"max_destroy_overrides",
+			&GuardrailsConfig{MaxDestroy: Int(1)},
+			&GuardrailsConfig{MaxDestroy: Int(0)},
+			&GuardrailsConfig{MaxDestroy: Int(0)},
+		},
+		{
+			"max_changes_empty_keeps",
+			&GuardrailsConfig{MaxChanges: Int(5)},
+			&GuardrailsConfig{MaxChangePercent: Int(10)},
+			&GuardrailsConfig{MaxChanges: Int(5), MaxChangePercent: Int(10)},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestGuardrailsConfig_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		i       *GuardrailsConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			true,
+		},
+		{
+			"empty",
+			&GuardrailsConfig{},
+			true,
+		},
+		{
+			"zero_limits",
+			&GuardrailsConfig{
+				MaxDestroy:       Int(0),
+				MaxChanges:       Int(0),
+				MaxChangePercent: Int(0),
+			},
+			true,
+		},
+		{
+			"negative_max_destroy",
+			&GuardrailsConfig{MaxDestroy: Int(-1)},
+			false,
+		},
+		{
+			"negative_max_changes",
+			&GuardrailsConfig{MaxChanges: Int(-1)},
+			false,
+		},
+		{
+			"max_change_percent_over_100",
+			&GuardrailsConfig{MaxChangePercent: Int(101)},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGuardrailsConfig_IsEmpty(t *testing.T) {
+	assert.True(t, (*GuardrailsConfig)(nil).IsEmpty())
+	assert.True(t, DefaultGuardrailsConfig().IsEmpty())
+	assert.False(t, (&GuardrailsConfig{MaxChanges: Int(0)}).IsEmpty())
+}
@@ -64,6 +64,26 @@ func moduleInputToTypeFunc() mapstructure.DecodeHookFunc {
 			return decodeModuleInputToType(c, &config)
 		}
 
+		if c, ok := moduleInputs[intentionsType]; ok {
+			var config IntentionsModuleInputConfig
+			return decodeModuleInputToType(c, &config)
+		}
+
+		if c, ok := moduleInputs[nodesType]; ok {
+			var config NodesModuleInputConfig
+			return decodeModuleInputToType(c, &config)
+		}
+
+		if c, ok := moduleInputs[configEntriesType]; ok {
+			var config ConfigEntriesModuleInputConfig
+			return decodeModuleInputToType(c, &config)
+		}
+
+		if c, ok := moduleInputs[vaultSecretsType]; ok {
+			var config VaultSecretsModuleInputConfig
+			return decodeModuleInputToType(c, &config)
+		}
+
 		return nil, fmt.Errorf("unsupported module_input type: %v", data)
 	}
 }
@@ -117,6 +137,21 @@ func (c *ModuleInputConfigs) Len() int {
 	return len(*c)
 }
 
+// hasVaultSecrets returns whether any of the module inputs is of type
+// 'vault_secrets'
+func (c *ModuleInputConfigs) hasVaultSecrets() bool {
+	if c == nil {
+		return false
+	}
+
+	for _, input := range *c {
+		if _, ok := input.(*VaultSecretsModuleInputConfig); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // Copy returns a deep copy of this configuration.
 func (c *ModuleInputConfigs) Copy() *ModuleInputConfigs {
 	if c == nil {
@@ -210,15 +245,17 @@ func (c *ModuleInputConfigs) Validate(services []string, condition ConditionConf
 	if condition == nil {
 		return nil
 	}
-	if ok := varTypes[condition.VariableType()]; ok {
-		err := fmt.Errorf("task's condition block and module_input block "+
-			"both monitor %q variable type. condition and module_input "+
-			"variable type must be unique", condition.VariableType())
-		logger.Error("condition and module_input block cannot monitor same "+
-			"variable type. If both are needed, consider combining the "+
-			"module_input with the condition block or creating separate tasks",
-			"error", err)
-		return err
+	for _, cond := range FlattenCondition(condition) {
+		if ok := varTypes[cond.VariableType()]; ok {
+			err := fmt.Errorf("task's condition block and module_input block "+
+				"both monitor %q variable type. condition and module_input "+
+				"variable type must be unique", cond.VariableType())
+			logger.Error("condition and module_input block cannot monitor same "+
+				"variable type. If both are needed, consider combining the "+
+				"module_input with the condition block or creating separate tasks",
+				"error", err)
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+)
+
+var _ ModuleInputConfig = (*ConfigEntriesModuleInputConfig)(nil)
+
+// ConfigEntriesModuleInputConfig configures a module_input configuration block of
+// type 'config_entries'. The Consul config entries will be used as input for the
+// module variables.
+type ConfigEntriesModuleInputConfig struct {
+	ConfigEntriesMonitorConfig `mapstructure:",squash"`
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *ConfigEntriesModuleInputConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	m, ok := c.ConfigEntriesMonitorConfig.Copy().(*ConfigEntriesMonitorConfig)
+	if !ok {
+		return nil
+	}
+	return &ConfigEntriesModuleInputConfig{
+		ConfigEntriesMonitorConfig: *m,
+	}
+}
+
+// Merge combines all values in this configuration `c` with the values in the other
+// configuration `o`, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *ConfigEntriesModuleInputConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isModuleInputNil(o) { // o is interface, use isModuleInputNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isModuleInputNil(o) {
+		return c.Copy()
+	}
+
+	imc, ok := o.(*ConfigEntriesModuleInputConfig)
+	if !ok {
+		return nil
+	}
+
+	merged, ok := c.ConfigEntriesMonitorConfig.Merge(&imc.ConfigEntriesMonitorConfig).(*ConfigEntriesMonitorConfig)
+	if !ok {
+		return nil
+	}
+
+	return &ConfigEntriesModuleInputConfig{
+		ConfigEntriesMonitorConfig: *merged,
+	}
+}
+
+// Finalize ensures there are no nil pointers.
+func (c *ConfigEntriesModuleInputConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+	c.ConfigEntriesMonitorConfig.Finalize()
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *ConfigEntriesModuleInputConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+	return c.ConfigEntriesMonitorConfig.Validate()
+}
+
+// GoString defines the printable version of this struct.
+func (c *ConfigEntriesModuleInputConfig) GoString() string {
+	if c == nil {
+		return "(*ConfigEntriesModuleInputConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&ConfigEntriesModuleInputConfig{"+
+		"%s"+
+		"}",
+		c.ConfigEntriesMonitorConfig.GoString(),
+	)
+}
@@ -0,0 +1,284 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigEntriesModuleInputConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *ConfigEntriesModuleInputConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&ConfigEntriesModuleInputConfig{},
+		},
+		{
+			"fully_configured",
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{
+					Kind:       String("ingress-gateway"),
+					Names:      []string{"ingress", "edge"},
+					Datacenter: String("dc2"),
+					Namespace:  String("ns2"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestConfigEntriesModuleInputConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *ConfigEntriesModuleInputConfig
+		b    *ConfigEntriesModuleInputConfig
+		r    *ConfigEntriesModuleInputConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&ConfigEntriesModuleInputConfig{},
+			&ConfigEntriesModuleInputConfig{},
+		},
+		{
+			"nil_b",
+			&ConfigEntriesModuleInputConfig{},
+			nil,
+			&ConfigEntriesModuleInputConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"kind_overrides",
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{Kind: String("service-defaults")},
+			},
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{Kind: String("ingress-gateway")},
+			},
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{Kind: String("ingress-gateway")},
+			},
+		},
+		{
+			"names_merge",
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{Names: []string{"a"}},
+			},
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{Names: []string{"b"}},
+			},
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{Names: []string{"a", "b"}},
+			},
+		},
+		{
+			"datacenter_and_namespace",
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{
+					Datacenter: String("dc1"),
+					Namespace:  String("ns1"),
+				},
+			},
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{Datacenter: String("dc2")},
+			},
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{
+					Datacenter: String("dc2"),
+					Namespace:  String("ns1"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestConfigEntriesModuleInputConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *ConfigEntriesModuleInputConfig
+		r    *ConfigEntriesModuleInputConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&ConfigEntriesModuleInputConfig{},
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{
+					Kind:       String(""),
+					Names:      []string{},
+					Datacenter: String(""),
+					Namespace:  String(""),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestConfigEntriesModuleInputConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *ConfigEntriesModuleInputConfig
+	}{
+		{
+			"nil",
+			false,
+			nil,
+		},
+		{
+			"happy_path",
+			false,
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{
+					Kind:  String("terminating-gateway"),
+					Names: []string{"egress"},
+				},
+			},
+		},
+		{
+			"all_entries_of_kind",
+			false,
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{
+					Kind: String("service-defaults"),
+				},
+			},
+		},
+		{
+			"missing_kind",
+			true,
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{
+					Names: []string{"egress"},
+				},
+			},
+		},
+		{
+			"unsupported_kind",
+			true,
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{
+					Kind: String("proxy-defaults"),
+				},
+			},
+		},
+		{
+			"empty_name",
+			true,
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{
+					Kind:  String("ingress-gateway"),
+					Names: []string{""},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.c.Finalize()
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConfigEntriesModuleInputConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		i        *ConfigEntriesModuleInputConfig
+		expected string
+	}{
+		{
+			"configured",
+			&ConfigEntriesModuleInputConfig{
+				ConfigEntriesMonitorConfig{
+					Kind:       String("ingress-gateway"),
+					Names:      []string{"ingress"},
+					Datacenter: String("dc2"),
+					Namespace:  String("ns2"),
+				},
+			},
+			"&ConfigEntriesModuleInputConfig{" +
+				"&ConfigEntriesMonitorConfig{" +
+				"Kind:ingress-gateway, " +
+				"Names:[ingress], " +
+				"Datacenter:dc2, " +
+				"Namespace:ns2" +
+				"}" +
+				"}",
+		},
+		{
+			"nil",
+			nil,
+			"(*ConfigEntriesModuleInputConfig)(nil)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := tc.i.GoString()
+			assert.Equal(t, tc.expected, a)
+		})
+	}
+}
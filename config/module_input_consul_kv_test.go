@@ -31,6 +31,8 @@ func TestConsulKVModuleInputConfig_Copy(t *testing.T) {
 					Recurse:    Bool(true),
 					Datacenter: String("dc2"),
 					Namespace:  String("ns2"),
+					Partition:  String(""),
+					Decode:     String(""),
 				},
 			},
 		},
@@ -210,6 +212,8 @@ func TestConsulKVModuleInputConfig_Finalize(t *testing.T) {
 					Recurse:    Bool(false),
 					Datacenter: String(""),
 					Namespace:  String(""),
+					Partition:  String(""),
+					Decode:     String(""),
 				},
 			},
 		},
@@ -240,6 +244,8 @@ func TestConsulKVModuleInputConfig_Validate(t *testing.T) {
 					Recurse:    Bool(true),
 					Datacenter: String("dc2"),
 					Namespace:  String("ns2"),
+					Partition:  String(""),
+					Decode:     String(""),
 				},
 			},
 		},
@@ -278,6 +284,8 @@ func TestConsulKVModuleInputConfig_GoString(t *testing.T) {
 					Recurse:    Bool(true),
 					Datacenter: String("dc"),
 					Namespace:  String("ns"),
+					Partition:  String("partition"),
+					Decode:     String("yaml"),
 				},
 			},
 			"&ConsulKVModuleInputConfig{" +
@@ -286,6 +294,8 @@ func TestConsulKVModuleInputConfig_GoString(t *testing.T) {
 				"Recurse:true, " +
 				"Datacenter:dc, " +
 				"Namespace:ns, " +
+				"Partition:partition, " +
+				"Decode:yaml, " +
 				"}" +
 				"}",
 		},
@@ -76,6 +76,10 @@ func (c *ServicesModuleInputConfig) Validate() error {
 	if err := c.ServicesMonitorConfig.Validate(); err != nil {
 		return fmt.Errorf("error validating `module_input \"services\"`: %s", err)
 	}
+	if trigger := StringVal(c.TriggerOn); trigger != "" && trigger != ServicesTriggerAny {
+		return fmt.Errorf("error validating `module_input \"services\"`: " +
+			"trigger_on is only supported for `condition \"services\"`")
+	}
 	return nil
 }
 
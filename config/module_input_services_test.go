@@ -150,9 +150,14 @@ func TestServicesModuleInputConfig_Finalize(t *testing.T) {
 					Regexp:             nil,
 					Names:              []string{},
 					Datacenter:         String(""),
+					Datacenters:        []string{},
 					Namespace:          String(""),
+					Partition:          String(""),
+					Peer:               String(""),
 					Filter:             String(""),
 					CTSUserDefinedMeta: map[string]string{},
+					TriggerOn:          String("any"),
+					IncludeNonPassing:  Bool(false),
 				},
 			},
 		},
@@ -197,6 +202,27 @@ func TestServicesModuleInputConfig_Validate(t *testing.T) {
 				},
 			},
 		},
+		{
+			"valid_include_non_passing",
+			false,
+			&ServicesModuleInputConfig{
+				ServicesMonitorConfig{
+					Regexp:            String(".*"),
+					TriggerOn:         String(ServicesTriggerAny),
+					IncludeNonPassing: Bool(true),
+				},
+			},
+		},
+		{
+			"invalid_trigger_on",
+			true,
+			&ServicesModuleInputConfig{
+				ServicesMonitorConfig{
+					Regexp:    String(".*"),
+					TriggerOn: String(ServicesTriggerMembership),
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -237,9 +263,14 @@ func TestGoString(t *testing.T) {
 				"Regexp:^api$, " +
 				"Names:[], " +
 				"Datacenter:dc2, " +
+				"Datacenters:[], " +
 				"Namespace:ns2, " +
+				"Partition:, " +
+				"Peer:, " +
 				"Filter:some-filter, " +
-				"CTSUserDefinedMeta:map[key:value]" +
+				"CTSUserDefinedMeta:map[key:value], " +
+				"TriggerOn:, " +
+				"IncludeNonPassing:false" +
 				"}" +
 				"}",
 		},
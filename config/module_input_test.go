@@ -41,6 +41,56 @@ task {
 		datacenter = "dc2"
 		recurse = true
 	}
+}`
+	testModuleInputIntentionsSuccess = `
+task {
+	name = "module_input_task"
+	module = "..."
+	condition "schedule" {
+		cron = "* * * * * * *"
+	}
+	module_input "intentions" {
+		destination_services {
+			names = ["api"]
+		}
+	}
+}`
+	testModuleInputNodesSuccess = `
+task {
+	name = "module_input_task"
+	module = "..."
+	condition "schedule" {
+		cron = "* * * * * * *"
+	}
+	module_input "nodes" {
+		filter = "Meta.env == production"
+	}
+}`
+	testModuleInputConfigEntriesSuccess = `
+task {
+	name = "module_input_task"
+	module = "..."
+	condition "schedule" {
+		cron = "* * * * * * *"
+	}
+	module_input "config_entries" {
+		kind = "terminating-gateway"
+		names = ["egress"]
+	}
+}`
+	testModuleInputVaultSecretsSuccess = `
+vault {
+	address = "vault.example.com"
+}
+task {
+	name = "module_input_task"
+	module = "..."
+	condition "schedule" {
+		cron = "* * * * * * *"
+	}
+	module_input "vault_secrets" {
+		paths = ["secret/data/app", "kv/db"]
+	}
 }`
 	testModuleInputsSuccess = `
 task {
@@ -103,9 +153,14 @@ func TestModuleInput_DecodeConfig_Success(t *testing.T) {
 						Regexp:             String(".*"),
 						Names:              []string{},
 						Datacenter:         String("dc2"),
+						Datacenters:        []string{},
 						Namespace:          String("ns2"),
+						Partition:          String(""),
+						Peer:               String(""),
 						Filter:             String("some-filter"),
 						CTSUserDefinedMeta: map[string]string{"key": "value"},
+						TriggerOn:          String("any"),
+						IncludeNonPassing:  Bool(false),
 					},
 				},
 			},
@@ -119,12 +174,66 @@ func TestModuleInput_DecodeConfig_Success(t *testing.T) {
 						Path:       String("key-path"),
 						Datacenter: String("dc2"),
 						Namespace:  String("ns2"),
+						Partition:  String(""),
+						Decode:     String(""),
 						Recurse:    Bool(true),
 					},
 				},
 			},
 			config: testModuleInputConsulKVSuccess,
 		},
+		{
+			name: "intentions",
+			expected: &ModuleInputConfigs{
+				&IntentionsModuleInputConfig{
+					IntentionsMonitorConfig{
+						Datacenter: String(""),
+						Namespace:  String(""),
+						DestinationServices: &IntentionsServicesConfig{
+							Names: []string{"api"},
+						},
+					},
+				},
+			},
+			config: testModuleInputIntentionsSuccess,
+		},
+		{
+			name: "nodes",
+			expected: &ModuleInputConfigs{
+				&NodesModuleInputConfig{
+					NodesMonitorConfig{
+						Datacenter: String(""),
+						Filter:     String("Meta.env == production"),
+					},
+				},
+			},
+			config: testModuleInputNodesSuccess,
+		},
+		{
+			name: "config_entries",
+			expected: &ModuleInputConfigs{
+				&ConfigEntriesModuleInputConfig{
+					ConfigEntriesMonitorConfig{
+						Kind:       String("terminating-gateway"),
+						Names:      []string{"egress"},
+						Datacenter: String(""),
+						Namespace:  String(""),
+					},
+				},
+			},
+			config: testModuleInputConfigEntriesSuccess,
+		},
+		{
+			name: "vault_secrets",
+			expected: &ModuleInputConfigs{
+				&VaultSecretsModuleInputConfig{
+					VaultSecretsMonitorConfig{
+						Paths: []string{"secret/data/app", "kv/db"},
+					},
+				},
+			},
+			config: testModuleInputVaultSecretsSuccess,
+		},
 		{
 			name: "multiple unique module_inputs",
 			expected: &ModuleInputConfigs{
@@ -132,9 +241,14 @@ func TestModuleInput_DecodeConfig_Success(t *testing.T) {
 					ServicesMonitorConfig{
 						Names:              []string{"api"},
 						Datacenter:         String(""),
+						Datacenters:        []string{},
 						Namespace:          String(""),
+						Partition:          String(""),
+						Peer:               String(""),
 						Filter:             String(""),
 						CTSUserDefinedMeta: map[string]string{},
+						TriggerOn:          String("any"),
+						IncludeNonPassing:  Bool(false),
 					},
 				},
 				&ConsulKVModuleInputConfig{
@@ -143,6 +257,8 @@ func TestModuleInput_DecodeConfig_Success(t *testing.T) {
 						Recurse:    Bool(false),
 						Datacenter: String(""),
 						Namespace:  String(""),
+						Partition:  String(""),
+						Decode:     String(""),
 					},
 				},
 			},
@@ -264,6 +380,8 @@ func TestModuleInputConfigs_Copy(t *testing.T) {
 						Recurse:    Bool(true),
 						Datacenter: String("dc2"),
 						Namespace:  String("ns2"),
+						Partition:  String(""),
+						Decode:     String(""),
 					},
 				},
 			},
@@ -285,6 +403,8 @@ func TestModuleInputConfigs_Copy(t *testing.T) {
 						Recurse:    Bool(true),
 						Datacenter: String("dc2"),
 						Namespace:  String("ns2"),
+						Partition:  String(""),
+						Decode:     String(""),
 					},
 				},
 			},
@@ -390,9 +510,14 @@ func TestModuleInputConfigs_Finalize(t *testing.T) {
 						Regexp:             nil,
 						Names:              []string{},
 						Datacenter:         String(""),
+						Datacenters:        []string{},
 						Namespace:          String(""),
+						Partition:          String(""),
+						Peer:               String(""),
 						Filter:             String(""),
 						CTSUserDefinedMeta: map[string]string{},
+						TriggerOn:          String("any"),
+						IncludeNonPassing:  Bool(false),
 					},
 				},
 			},
@@ -477,6 +602,20 @@ func TestModuleInputConfigs_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "invalid: composite cond & module_input same type",
+			condition: &CompositeConditionConfig{
+				Operator: String("any"),
+				Conditions: []ConditionConfig{
+					&ScheduleConditionConfig{},
+					&ConsulKVConditionConfig{},
+				},
+			},
+			moduleInputs: &ModuleInputConfigs{
+				&ConsulKVModuleInputConfig{},
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -519,9 +658,10 @@ func TestModuleInputConfigs_GoString(t *testing.T) {
 				},
 			},
 			"{&ServicesModuleInputConfig{&ServicesMonitorConfig{Regexp:^api$, Names:[], " +
-				"Datacenter:, Namespace:, Filter:, CTSUserDefinedMeta:map[]}}, " +
+				"Datacenter:, Datacenters:[], Namespace:, Partition:, Peer:, Filter:, " +
+				"CTSUserDefinedMeta:map[], TriggerOn:, IncludeNonPassing:false}}, " +
 				"&ConsulKVModuleInputConfig{&ConsulKVMonitorConfig{Path:my/path, " +
-				"Recurse:false, Datacenter:, Namespace:, }}}",
+				"Recurse:false, Datacenter:, Namespace:, Partition:, Decode:, }}}",
 		},
 	}
 
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"fmt"
+)
+
+var _ ModuleInputConfig = (*VaultSecretsModuleInputConfig)(nil)
+
+// VaultSecretsModuleInputConfig configures a module_input configuration block of
+// type 'vault_secrets'. The Vault secrets will be used as input for the module
+// variables. The secret values are passed to Terraform as a sensitive variable
+// and are not rendered to the terraform.tfvars file.
+type VaultSecretsModuleInputConfig struct {
+	VaultSecretsMonitorConfig `mapstructure:",squash"`
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *VaultSecretsModuleInputConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	m, ok := c.VaultSecretsMonitorConfig.Copy().(*VaultSecretsMonitorConfig)
+	if !ok {
+		return nil
+	}
+	return &VaultSecretsModuleInputConfig{
+		VaultSecretsMonitorConfig: *m,
+	}
+}
+
+// Merge combines all values in this configuration `c` with the values in the other
+// configuration `o`, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *VaultSecretsModuleInputConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isModuleInputNil(o) { // o is interface, use isModuleInputNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isModuleInputNil(o) {
+		return c.Copy()
+	}
+
+	imc, ok := o.(*VaultSecretsModuleInputConfig)
+	if !ok {
+		return nil
+	}
+
+	merged, ok := c.VaultSecretsMonitorConfig.Merge(&imc.VaultSecretsMonitorConfig).(*VaultSecretsMonitorConfig)
+	if !ok {
+		return nil
+	}
+
+	return &VaultSecretsModuleInputConfig{
+		VaultSecretsMonitorConfig: *merged,
+	}
+}
+
+// Finalize ensures there are no nil pointers.
+func (c *VaultSecretsModuleInputConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+	c.VaultSecretsMonitorConfig.Finalize()
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *VaultSecretsModuleInputConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+	return c.VaultSecretsMonitorConfig.Validate()
+}
+
+// GoString defines the printable version of this struct.
+func (c *VaultSecretsModuleInputConfig) GoString() string {
+	if c == nil {
+		return "(*VaultSecretsModuleInputConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&VaultSecretsModuleInputConfig{"+
+		"%s"+
+		"}",
+		c.VaultSecretsMonitorConfig.GoString(),
+	)
+}
@@ -0,0 +1,227 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVaultSecretsModuleInputConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *VaultSecretsModuleInputConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&VaultSecretsModuleInputConfig{},
+		},
+		{
+			"fully_configured",
+			&VaultSecretsModuleInputConfig{
+				VaultSecretsMonitorConfig{
+					Paths: []string{"secret/data/app", "kv/db"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestVaultSecretsModuleInputConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *VaultSecretsModuleInputConfig
+		b    *VaultSecretsModuleInputConfig
+		r    *VaultSecretsModuleInputConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&VaultSecretsModuleInputConfig{},
+			&VaultSecretsModuleInputConfig{},
+		},
+		{
+			"nil_b",
+			&VaultSecretsModuleInputConfig{},
+			nil,
+			&VaultSecretsModuleInputConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"paths_merge",
+			&VaultSecretsModuleInputConfig{
+				VaultSecretsMonitorConfig{Paths: []string{"secret/data/app"}},
+			},
+			&VaultSecretsModuleInputConfig{
+				VaultSecretsMonitorConfig{Paths: []string{"kv/db"}},
+			},
+			&VaultSecretsModuleInputConfig{
+				VaultSecretsMonitorConfig{Paths: []string{"secret/data/app", "kv/db"}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestVaultSecretsModuleInputConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *VaultSecretsModuleInputConfig
+		r    *VaultSecretsModuleInputConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&VaultSecretsModuleInputConfig{},
+			&VaultSecretsModuleInputConfig{
+				VaultSecretsMonitorConfig{
+					Paths: []string{},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestVaultSecretsModuleInputConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *VaultSecretsModuleInputConfig
+	}{
+		{
+			"nil",
+			false,
+			nil,
+		},
+		{
+			"happy_path",
+			false,
+			&VaultSecretsModuleInputConfig{
+				VaultSecretsMonitorConfig{
+					Paths: []string{"secret/data/app", "kv/db"},
+				},
+			},
+		},
+		{
+			"missing_paths",
+			true,
+			&VaultSecretsModuleInputConfig{},
+		},
+		{
+			"empty_path",
+			true,
+			&VaultSecretsModuleInputConfig{
+				VaultSecretsMonitorConfig{
+					Paths: []string{"secret/data/app", ""},
+				},
+			},
+		},
+		{
+			"duplicate_path",
+			true,
+			&VaultSecretsModuleInputConfig{
+				VaultSecretsMonitorConfig{
+					Paths: []string{"secret/data/app", "secret/data/app"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.c.Finalize()
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVaultSecretsModuleInputConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		i        *VaultSecretsModuleInputConfig
+		expected string
+	}{
+		{
+			"configured",
+			&VaultSecretsModuleInputConfig{
+				VaultSecretsMonitorConfig{
+					Paths: []string{"secret/data/app", "kv/db"},
+				},
+			},
+			"&VaultSecretsModuleInputConfig{" +
+				"&VaultSecretsMonitorConfig{" +
+				"Paths:[secret/data/app kv/db]" +
+				"}" +
+				"}",
+		},
+		{
+			"nil",
+			nil,
+			"(*VaultSecretsModuleInputConfig)(nil)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := tc.i.GoString()
+			assert.Equal(t, tc.expected, a)
+		})
+	}
+}
@@ -1,9 +1,14 @@
 package config
 
 import (
+	"fmt"
 	"reflect"
 )
 
+// DatacentersAll can be configured as the only value of a monitor's
+// datacenters field to monitor all datacenters known to the Consul agent
+const DatacentersAll = "all"
+
 // MonitorConfig represents the base object for objects like monitor_input and
 // condition, both of which "monitor" an object in order to perform some action
 type MonitorConfig interface {
@@ -40,14 +45,64 @@ func isMonitorNil(c MonitorConfig) bool {
 		result = v == nil
 	case *ScheduleConditionConfig:
 		result = v == nil
+	case *IntentionsConditionConfig:
+		result = v == nil
+	case *CompositeConditionConfig:
+		result = v == nil
 
 	// Module Inputs
 	case *ServicesModuleInputConfig:
 		result = v == nil
 	case *ConsulKVModuleInputConfig:
 		result = v == nil
+	case *IntentionsModuleInputConfig:
+		result = v == nil
+	case *NodesConditionConfig:
+		result = v == nil
+	case *NodesModuleInputConfig:
+		result = v == nil
+	case *ConfigEntriesModuleInputConfig:
+		result = v == nil
+	case *VaultSecretsModuleInputConfig:
+		result = v == nil
 	default:
 		return c == nil || reflect.ValueOf(c).IsNil()
 	}
 	return c == nil || result
 }
+
+// validateDatacenters validates that a monitor is configured with either a
+// single datacenter or a list of datacenters, but not both
+func validateDatacenters(datacenter *string, datacenters []string) error {
+	if len(datacenters) == 0 {
+		return nil
+	}
+
+	if StringVal(datacenter) != "" {
+		return fmt.Errorf("datacenter and datacenters fields cannot both be " +
+			"configured")
+	}
+
+	for _, dc := range datacenters {
+		if dc == "" {
+			return fmt.Errorf("datacenters field includes empty string(s). " +
+				"datacenter names cannot be empty")
+		}
+		if dc == DatacentersAll && len(datacenters) > 1 {
+			return fmt.Errorf("datacenters field cannot include %q with other "+
+				"datacenters", DatacentersAll)
+		}
+	}
+	return nil
+}
+
+// validatePeer validates that a monitor of services imported from a cluster
+// peer is not configured to monitor multiple datacenters. Imported services
+// are only queried from the local datacenter.
+func validatePeer(peer *string, datacenters []string) error {
+	if StringVal(peer) != "" && len(datacenters) > 0 {
+		return fmt.Errorf("peer and datacenters fields cannot both be " +
+			"configured")
+	}
+	return nil
+}
@@ -20,6 +20,20 @@ type CatalogServicesMonitorConfig struct {
 	Namespace  *string           `mapstructure:"namespace"`
 	NodeMeta   map[string]string `mapstructure:"node_meta"`
 
+	// Partition is the admin partition to monitor (Consul Enterprise only). If
+	// not provided, the partition will be inferred from the CTS ACL token, or
+	// default to the `default` partition.
+	Partition *string `mapstructure:"partition"`
+
+	// Peer is the name of the cluster peer to monitor the services imported
+	// from. If not provided, services of the local cluster are monitored.
+	Peer *string `mapstructure:"peer"`
+
+	// Datacenters configures multiple datacenters to monitor. The tags of
+	// services registered across the datacenters are merged together. Set to
+	// ["all"] to monitor all datacenters. Cannot be configured with Datacenter.
+	Datacenters []string `mapstructure:"datacenters"`
+
 	// UseAsModuleInput was previously named SourceIncludesVar - deprecated v0.5
 	UseAsModuleInput            *bool `mapstructure:"use_as_module_input"`
 	DeprecatedSourceIncludesVar *bool `mapstructure:"source_includes_var"`
@@ -39,7 +53,10 @@ func (c *CatalogServicesMonitorConfig) Copy() MonitorConfig {
 
 	o.Regexp = StringCopy(c.Regexp)
 	o.Datacenter = StringCopy(c.Datacenter)
+	o.Datacenters = append(o.Datacenters, c.Datacenters...)
 	o.Namespace = StringCopy(c.Namespace)
+	o.Partition = StringCopy(c.Partition)
+	o.Peer = StringCopy(c.Peer)
 
 	o.UseAsModuleInput = BoolCopy(c.UseAsModuleInput)
 	o.DeprecatedSourceIncludesVar = BoolCopy(c.DeprecatedSourceIncludesVar)
@@ -93,10 +110,20 @@ func (c *CatalogServicesMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
 		r2.Datacenter = StringCopy(o2.Datacenter)
 	}
 
+	r2.Datacenters = append(r2.Datacenters, o2.Datacenters...)
+
 	if o2.Namespace != nil {
 		r2.Namespace = StringCopy(o2.Namespace)
 	}
 
+	if o2.Partition != nil {
+		r2.Partition = StringCopy(o2.Partition)
+	}
+
+	if o2.Peer != nil {
+		r2.Peer = StringCopy(o2.Peer)
+	}
+
 	if o2.NodeMeta != nil {
 		if r2.NodeMeta == nil {
 			r2.NodeMeta = make(map[string]string)
@@ -137,10 +164,22 @@ func (c *CatalogServicesMonitorConfig) Finalize() {
 		c.Datacenter = String("")
 	}
 
+	if c.Datacenters == nil {
+		c.Datacenters = []string{}
+	}
+
 	if c.Namespace == nil {
 		c.Namespace = String("")
 	}
 
+	if c.Partition == nil {
+		c.Partition = String("")
+	}
+
+	if c.Peer == nil {
+		c.Peer = String("")
+	}
+
 	if c.NodeMeta == nil {
 		c.NodeMeta = make(map[string]string)
 	}
@@ -161,6 +200,14 @@ func (c *CatalogServicesMonitorConfig) Validate() error {
 	if _, err := regexp.Compile(StringVal(c.Regexp)); err != nil {
 		return fmt.Errorf("unable to compile catalog-services 'regexp': %s", err)
 	}
+
+	if err := validateDatacenters(c.Datacenter, c.Datacenters); err != nil {
+		return fmt.Errorf("invalid catalog-services datacenters: %s", err)
+	}
+
+	if err := validatePeer(c.Peer, c.Datacenters); err != nil {
+		return fmt.Errorf("invalid catalog-services peer: %s", err)
+	}
 	return nil
 }
 
@@ -173,13 +220,19 @@ func (c *CatalogServicesMonitorConfig) GoString() string {
 	return fmt.Sprintf("&CatalogServicesMonitorConfig{"+
 		"Regexp:%s, "+
 		"Datacenter:%v, "+
+		"Datacenters:%s, "+
 		"Namespace:%v, "+
+		"Partition:%v, "+
+		"Peer:%v, "+
 		"NodeMeta:%s, "+
 		"UseAsModuleInput:%v"+
 		"}",
 		StringVal(c.Regexp),
 		StringVal(c.Datacenter),
+		c.Datacenters,
 		StringVal(c.Namespace),
+		StringVal(c.Partition),
+		StringVal(c.Peer),
 		c.NodeMeta,
 		BoolVal(c.UseAsModuleInput),
 	)
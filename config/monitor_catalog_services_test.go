@@ -264,7 +264,10 @@ func TestCatalogServicesMonitorConfig_Finalize(t *testing.T) {
 					Regexp:           nil,
 					UseAsModuleInput: Bool(true),
 					Datacenter:       String(""),
+					Datacenters:      []string{},
 					Namespace:        String(""),
+					Partition:        String(""),
+					Peer:             String(""),
 					NodeMeta:         map[string]string{},
 				},
 			},
@@ -365,6 +368,38 @@ func TestCatalogServicesMonitorConfig_Validate(t *testing.T) {
 				},
 			},
 		},
+		{
+			"valid_datacenters_all",
+			false,
+			&CatalogServicesConditionConfig{
+				CatalogServicesMonitorConfig{
+					Regexp:      String(".*"),
+					Datacenters: []string{"all"},
+				},
+			},
+		},
+		{
+			"invalid_datacenter_and_datacenters",
+			true,
+			&CatalogServicesConditionConfig{
+				CatalogServicesMonitorConfig{
+					Regexp:      String(".*"),
+					Datacenter:  String("dc1"),
+					Datacenters: []string{"dc2"},
+				},
+			},
+		},
+		{
+			"invalid_peer_and_datacenters",
+			true,
+			&CatalogServicesConditionConfig{
+				CatalogServicesMonitorConfig{
+					Regexp:      String(".*"),
+					Peer:        String("cluster-02"),
+					Datacenters: []string{"all"},
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
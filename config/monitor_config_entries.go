@@ -0,0 +1,175 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+const configEntriesType = "config_entries"
+
+// supportedConfigEntryKinds are the kinds of Consul config entries that can be
+// monitored
+var supportedConfigEntryKinds = []string{
+	"service-defaults",
+	"ingress-gateway",
+	"terminating-gateway",
+}
+
+var _ MonitorConfig = (*ConfigEntriesMonitorConfig)(nil)
+
+// ConfigEntriesMonitorConfig configures a configuration block adhering to the
+// monitor interface of type 'config_entries'. A config entries monitor watches
+// for changes that occur to the Consul config entries of a kind.
+type ConfigEntriesMonitorConfig struct {
+	// Kind is the kind of config entries to monitor. Supported kinds are
+	// service-defaults, ingress-gateway, and terminating-gateway.
+	Kind *string `mapstructure:"kind"`
+
+	// Names selects the config entries of the kind by name. All config entries
+	// of the kind are monitored when no names are configured.
+	Names []string `mapstructure:"names"`
+
+	// Datacenter is the datacenter to query the config entries of.
+	Datacenter *string `mapstructure:"datacenter"`
+
+	// Namespace is the namespace to query the config entries of (Consul
+	// Enterprise only).
+	Namespace *string `mapstructure:"namespace"`
+}
+
+func (c *ConfigEntriesMonitorConfig) VariableType() string {
+	return "config_entries"
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *ConfigEntriesMonitorConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o ConfigEntriesMonitorConfig
+	o.Kind = StringCopy(c.Kind)
+	if c.Names != nil {
+		o.Names = make([]string, 0, len(c.Names))
+		o.Names = append(o.Names, c.Names...)
+	}
+	o.Datacenter = StringCopy(c.Datacenter)
+	o.Namespace = StringCopy(c.Namespace)
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+func (c *ConfigEntriesMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isConditionNil(o) { // o is interface, use isConditionNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isConditionNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*ConfigEntriesMonitorConfig)
+	if !ok {
+		return r
+	}
+
+	r2 := r.(*ConfigEntriesMonitorConfig)
+
+	if o2.Kind != nil {
+		r2.Kind = StringCopy(o2.Kind)
+	}
+
+	r2.Names = append(r2.Names, o2.Names...)
+
+	if o2.Datacenter != nil {
+		r2.Datacenter = StringCopy(o2.Datacenter)
+	}
+
+	if o2.Namespace != nil {
+		r2.Namespace = StringCopy(o2.Namespace)
+	}
+
+	return r2
+}
+
+// Finalize ensures there no nil pointers.
+func (c *ConfigEntriesMonitorConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.Kind == nil {
+		c.Kind = String("")
+	}
+
+	if c.Names == nil {
+		c.Names = []string{}
+	}
+
+	if c.Datacenter == nil {
+		c.Datacenter = String("")
+	}
+
+	if c.Namespace == nil {
+		c.Namespace = String("")
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *ConfigEntriesMonitorConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	kind := StringVal(c.Kind)
+	if kind == "" {
+		return fmt.Errorf("kind is a required field for config_entries")
+	}
+
+	supported := false
+	for _, k := range supportedConfigEntryKinds {
+		if kind == k {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return fmt.Errorf("unsupported config entry kind %q, kind must be "+
+			"one of: %s", kind, strings.Join(supportedConfigEntryKinds, ", "))
+	}
+
+	for _, name := range c.Names {
+		if name == "" {
+			return fmt.Errorf("names field includes empty string(s). " +
+				"config entry names cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *ConfigEntriesMonitorConfig) GoString() string {
+	if c == nil {
+		return "(*ConfigEntriesMonitorConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&ConfigEntriesMonitorConfig{"+
+		"Kind:%s, "+
+		"Names:%s, "+
+		"Datacenter:%v, "+
+		"Namespace:%v"+
+		"}",
+		StringVal(c.Kind),
+		c.Names,
+		StringVal(c.Datacenter),
+		StringVal(c.Namespace),
+	)
+}
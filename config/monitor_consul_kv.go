@@ -4,7 +4,13 @@ import (
 	"fmt"
 )
 
-const consulKVType = "consul-kv"
+const (
+	consulKVType = "consul-kv"
+
+	// Formats for decoding the values of Consul KV pairs
+	kvDecodeJSON = "json"
+	kvDecodeYAML = "yaml"
+)
 
 var _ MonitorConfig = (*ConsulKVMonitorConfig)(nil)
 
@@ -16,6 +22,17 @@ type ConsulKVMonitorConfig struct {
 	Recurse    *bool   `mapstructure:"recurse"`
 	Datacenter *string `mapstructure:"datacenter"`
 	Namespace  *string `mapstructure:"namespace"`
+
+	// Partition is the admin partition of the KV path (Consul Enterprise
+	// only). If not provided, the partition will be inferred from the CTS ACL
+	// token, or default to the `default` partition.
+	Partition *string `mapstructure:"partition"`
+
+	// Decode is the format of the KV values, either "json" or "yaml". When
+	// configured, values are decoded and rendered as typed values of the
+	// consul_kv variable instead of strings. Values that cannot be decoded
+	// cause the task to error. If not provided, values are not decoded.
+	Decode *string `mapstructure:"decode"`
 }
 
 func (c *ConsulKVMonitorConfig) VariableType() string {
@@ -33,6 +50,8 @@ func (c *ConsulKVMonitorConfig) Copy() MonitorConfig {
 	o.Recurse = BoolCopy(c.Recurse)
 	o.Datacenter = StringCopy(c.Datacenter)
 	o.Namespace = StringCopy(c.Namespace)
+	o.Partition = StringCopy(c.Partition)
+	o.Decode = StringCopy(c.Decode)
 
 	return &o
 }
@@ -75,6 +94,14 @@ func (c *ConsulKVMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
 		r2.Namespace = StringCopy(o2.Namespace)
 	}
 
+	if o2.Partition != nil {
+		r2.Partition = StringCopy(o2.Partition)
+	}
+
+	if o2.Decode != nil {
+		r2.Decode = StringCopy(o2.Decode)
+	}
+
 	return r2
 }
 
@@ -100,6 +127,13 @@ func (c *ConsulKVMonitorConfig) Finalize() {
 		c.Namespace = String("")
 	}
 
+	if c.Partition == nil {
+		c.Partition = String("")
+	}
+
+	if c.Decode == nil {
+		c.Decode = String("")
+	}
 }
 
 // Validate validates the values and required options. This method is recommended
@@ -113,6 +147,13 @@ func (c *ConsulKVMonitorConfig) Validate() error {
 		return fmt.Errorf("path is required for consul-kv condition")
 	}
 
+	switch StringVal(c.Decode) {
+	case "", kvDecodeJSON, kvDecodeYAML:
+	default:
+		return fmt.Errorf("decode for consul-kv must be %q or %q: %q",
+			kvDecodeJSON, kvDecodeYAML, StringVal(c.Decode))
+	}
+
 	return nil
 }
 
@@ -127,10 +168,14 @@ func (c *ConsulKVMonitorConfig) GoString() string {
 		"Recurse:%v, "+
 		"Datacenter:%v, "+
 		"Namespace:%v, "+
+		"Partition:%v, "+
+		"Decode:%v, "+
 		"}",
 		StringVal(c.Path),
 		BoolVal(c.Recurse),
 		StringVal(c.Datacenter),
 		StringVal(c.Namespace),
+		StringVal(c.Partition),
+		StringVal(c.Decode),
 	)
 }
@@ -0,0 +1,256 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+)
+
+const intentionsType = "intentions"
+
+var _ MonitorConfig = (*IntentionsMonitorConfig)(nil)
+
+// IntentionsMonitorConfig configures a configuration block adhering to the
+// monitor interface of type 'intentions'. An intentions monitor watches for
+// changes that occur to the Consul intentions between the source and
+// destination services.
+type IntentionsMonitorConfig struct {
+	// Datacenter is the datacenter to query the intentions of.
+	Datacenter *string `mapstructure:"datacenter"`
+
+	// Namespace is the namespace to query the intentions of (Consul
+	// Enterprise only).
+	Namespace *string `mapstructure:"namespace"`
+
+	// SourceServices filters the intentions by the source service name. At
+	// least one of SourceServices or DestinationServices must be configured.
+	SourceServices *IntentionsServicesConfig `mapstructure:"source_services"`
+
+	// DestinationServices filters the intentions by the destination service
+	// name.
+	DestinationServices *IntentionsServicesConfig `mapstructure:"destination_services"`
+}
+
+// IntentionsServicesConfig configures the service names that an intentions
+// monitor matches for either side of an intention.
+type IntentionsServicesConfig struct {
+	// Regexp matches the service name. Either Regexp or Names must be
+	// configured, not both.
+	Regexp *string `mapstructure:"regexp"`
+
+	// Names lists the service names. Either Regexp or Names must be
+	// configured, not both.
+	Names []string `mapstructure:"names"`
+}
+
+func (c *IntentionsMonitorConfig) VariableType() string {
+	return "intentions"
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *IntentionsMonitorConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o IntentionsMonitorConfig
+	o.Datacenter = StringCopy(c.Datacenter)
+	o.Namespace = StringCopy(c.Namespace)
+	o.SourceServices = c.SourceServices.Copy()
+	o.DestinationServices = c.DestinationServices.Copy()
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+func (c *IntentionsMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isConditionNil(o) { // o is interface, use isConditionNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isConditionNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*IntentionsMonitorConfig)
+	if !ok {
+		return r
+	}
+
+	r2 := r.(*IntentionsMonitorConfig)
+
+	if o2.Datacenter != nil {
+		r2.Datacenter = StringCopy(o2.Datacenter)
+	}
+
+	if o2.Namespace != nil {
+		r2.Namespace = StringCopy(o2.Namespace)
+	}
+
+	r2.SourceServices = r2.SourceServices.Merge(o2.SourceServices)
+	r2.DestinationServices = r2.DestinationServices.Merge(o2.DestinationServices)
+
+	return r2
+}
+
+// Finalize ensures there no nil pointers. The source and destination services
+// remain nil when unconfigured.
+func (c *IntentionsMonitorConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.Datacenter == nil {
+		c.Datacenter = String("")
+	}
+
+	if c.Namespace == nil {
+		c.Namespace = String("")
+	}
+
+	c.SourceServices.Finalize()
+	c.DestinationServices.Finalize()
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *IntentionsMonitorConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	if c.SourceServices == nil && c.DestinationServices == nil {
+		return fmt.Errorf("at least one of source_services or " +
+			"destination_services is required for intentions")
+	}
+
+	if err := c.SourceServices.Validate(); err != nil {
+		return fmt.Errorf("invalid source_services: %s", err)
+	}
+
+	if err := c.DestinationServices.Validate(); err != nil {
+		return fmt.Errorf("invalid destination_services: %s", err)
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *IntentionsMonitorConfig) GoString() string {
+	if c == nil {
+		return "(*IntentionsMonitorConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&IntentionsMonitorConfig{"+
+		"Datacenter:%v, "+
+		"Namespace:%v, "+
+		"SourceServices:%s, "+
+		"DestinationServices:%s"+
+		"}",
+		StringVal(c.Datacenter),
+		StringVal(c.Namespace),
+		c.SourceServices.GoString(),
+		c.DestinationServices.GoString(),
+	)
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *IntentionsServicesConfig) Copy() *IntentionsServicesConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o IntentionsServicesConfig
+	o.Regexp = StringCopy(c.Regexp)
+	o.Names = append(o.Names, c.Names...)
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+func (c *IntentionsServicesConfig) Merge(o *IntentionsServicesConfig) *IntentionsServicesConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Regexp != nil {
+		r.Regexp = StringCopy(o.Regexp)
+	}
+
+	r.Names = append(r.Names, o.Names...)
+
+	return r
+}
+
+// Finalize ensures there no nil pointers. Regexp retains a nil value when
+// unset to be able to distinguish it from matching all services.
+func (c *IntentionsServicesConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Names == nil {
+		c.Names = []string{}
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *IntentionsServicesConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	namesConfigured := len(c.Names) > 0
+	regexConfigured := c.Regexp != nil
+	if namesConfigured && regexConfigured {
+		return fmt.Errorf("regexp and names fields cannot both be configured")
+	}
+	if !namesConfigured && !regexConfigured {
+		return fmt.Errorf("either the regexp or names field must be configured")
+	}
+
+	if regexConfigured {
+		if _, err := regexp.Compile(StringVal(c.Regexp)); err != nil {
+			return fmt.Errorf("unable to compile regexp: %s", err)
+		}
+	}
+
+	for _, name := range c.Names {
+		if name == "" {
+			return fmt.Errorf("names field includes empty string(s). " +
+				"service names cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *IntentionsServicesConfig) GoString() string {
+	if c == nil {
+		return "(*IntentionsServicesConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&IntentionsServicesConfig{"+
+		"Regexp:%s, "+
+		"Names:%s"+
+		"}",
+		StringVal(c.Regexp),
+		c.Names,
+	)
+}
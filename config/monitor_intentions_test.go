@@ -0,0 +1,278 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntentionsMonitorConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *IntentionsMonitorConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&IntentionsMonitorConfig{},
+		},
+		{
+			"fully_configured",
+			&IntentionsMonitorConfig{
+				Datacenter: String("dc2"),
+				Namespace:  String("ns2"),
+				SourceServices: &IntentionsServicesConfig{
+					Regexp: String("^web.*"),
+				},
+				DestinationServices: &IntentionsServicesConfig{
+					Names: []string{"api"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestIntentionsMonitorConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *IntentionsMonitorConfig
+		b    *IntentionsMonitorConfig
+		r    *IntentionsMonitorConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&IntentionsMonitorConfig{},
+			&IntentionsMonitorConfig{},
+		},
+		{
+			"nil_b",
+			&IntentionsMonitorConfig{},
+			nil,
+			&IntentionsMonitorConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"datacenter_overrides",
+			&IntentionsMonitorConfig{Datacenter: String("dc1")},
+			&IntentionsMonitorConfig{Datacenter: String("dc2")},
+			&IntentionsMonitorConfig{Datacenter: String("dc2")},
+		},
+		{
+			"namespace_empty_one",
+			&IntentionsMonitorConfig{Namespace: String("ns")},
+			&IntentionsMonitorConfig{},
+			&IntentionsMonitorConfig{Namespace: String("ns")},
+		},
+		{
+			"source_services_merges",
+			&IntentionsMonitorConfig{
+				SourceServices: &IntentionsServicesConfig{Names: []string{"web"}},
+			},
+			&IntentionsMonitorConfig{
+				SourceServices: &IntentionsServicesConfig{Names: []string{"api"}},
+			},
+			&IntentionsMonitorConfig{
+				SourceServices: &IntentionsServicesConfig{Names: []string{"web", "api"}},
+			},
+		},
+		{
+			"destination_services_empty_one",
+			&IntentionsMonitorConfig{},
+			&IntentionsMonitorConfig{
+				DestinationServices: &IntentionsServicesConfig{Regexp: String(".*")},
+			},
+			&IntentionsMonitorConfig{
+				DestinationServices: &IntentionsServicesConfig{Regexp: String(".*")},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestIntentionsMonitorConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *IntentionsMonitorConfig
+		r    *IntentionsMonitorConfig
+	}{
+		{
+			"empty",
+			&IntentionsMonitorConfig{},
+			&IntentionsMonitorConfig{
+				Datacenter: String(""),
+				Namespace:  String(""),
+			},
+		},
+		{
+			"source_services",
+			&IntentionsMonitorConfig{
+				SourceServices: &IntentionsServicesConfig{Regexp: String(".*")},
+			},
+			&IntentionsMonitorConfig{
+				Datacenter: String(""),
+				Namespace:  String(""),
+				SourceServices: &IntentionsServicesConfig{
+					Regexp: String(".*"),
+					Names:  []string{},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestIntentionsMonitorConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *IntentionsMonitorConfig
+	}{
+		{
+			"nil",
+			false,
+			nil,
+		},
+		{
+			"source_services",
+			false,
+			&IntentionsMonitorConfig{
+				SourceServices: &IntentionsServicesConfig{Regexp: String("^web.*")},
+			},
+		},
+		{
+			"source_and_destination_services",
+			false,
+			&IntentionsMonitorConfig{
+				SourceServices:      &IntentionsServicesConfig{Names: []string{"web"}},
+				DestinationServices: &IntentionsServicesConfig{Names: []string{"api"}},
+			},
+		},
+		{
+			"no_services",
+			true,
+			&IntentionsMonitorConfig{},
+		},
+		{
+			"regexp_and_names",
+			true,
+			&IntentionsMonitorConfig{
+				SourceServices: &IntentionsServicesConfig{
+					Regexp: String(".*"),
+					Names:  []string{"web"},
+				},
+			},
+		},
+		{
+			"neither_regexp_nor_names",
+			true,
+			&IntentionsMonitorConfig{
+				DestinationServices: &IntentionsServicesConfig{},
+			},
+		},
+		{
+			"invalid_regexp",
+			true,
+			&IntentionsMonitorConfig{
+				DestinationServices: &IntentionsServicesConfig{Regexp: String("*")},
+			},
+		},
+		{
+			"empty_name",
+			true,
+			&IntentionsMonitorConfig{
+				DestinationServices: &IntentionsServicesConfig{Names: []string{""}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIntentionsMonitorConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		i        *IntentionsMonitorConfig
+		expected string
+	}{
+		{
+			"nil",
+			nil,
+			`(*IntentionsMonitorConfig)(nil)`,
+		},
+		{
+			"fully_configured",
+			&IntentionsMonitorConfig{
+				Datacenter:          String("dc2"),
+				Namespace:           String("ns2"),
+				SourceServices:      &IntentionsServicesConfig{Regexp: String("^web")},
+				DestinationServices: &IntentionsServicesConfig{Names: []string{"api"}},
+			},
+			"&IntentionsMonitorConfig{Datacenter:dc2, Namespace:ns2, " +
+				"SourceServices:&IntentionsServicesConfig{Regexp:^web, Names:[]}, " +
+				"DestinationServices:&IntentionsServicesConfig{Regexp:, Names:[api]}}",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.i.GoString())
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"fmt"
+)
+
+const nodesType = "nodes"
+
+var _ MonitorConfig = (*NodesMonitorConfig)(nil)
+
+// NodesMonitorConfig configures a configuration block adhering to the monitor
+// interface of type 'nodes'. A nodes monitor watches for changes that occur
+// to the nodes registered in the Consul catalog.
+type NodesMonitorConfig struct {
+	// Datacenter is the datacenter to query the nodes of.
+	Datacenter *string `mapstructure:"datacenter"`
+
+	// Filter is an expression used to filter the nodes returned by the
+	// catalog nodes API.
+	// https://www.consul.io/api-docs/catalog#filtering
+	Filter *string `mapstructure:"filter"`
+}
+
+func (c *NodesMonitorConfig) VariableType() string {
+	return "nodes"
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *NodesMonitorConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o NodesMonitorConfig
+	o.Datacenter = StringCopy(c.Datacenter)
+	o.Filter = StringCopy(c.Filter)
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+func (c *NodesMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isConditionNil(o) { // o is interface, use isConditionNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isConditionNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*NodesMonitorConfig)
+	if !ok {
+		return r
+	}
+
+	r2 := r.(*NodesMonitorConfig)
+
+	if o2.Datacenter != nil {
+		r2.Datacenter = StringCopy(o2.Datacenter)
+	}
+
+	if o2.Filter != nil {
+		r2.Filter = StringCopy(o2.Filter)
+	}
+
+	return r2
+}
+
+// Finalize ensures there no nil pointers.
+func (c *NodesMonitorConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.Datacenter == nil {
+		c.Datacenter = String("")
+	}
+
+	if c.Filter == nil {
+		c.Filter = String("")
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+// The filter expression is validated by Consul when the nodes are queried.
+func (c *NodesMonitorConfig) Validate() error {
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *NodesMonitorConfig) GoString() string {
+	if c == nil {
+		return "(*NodesMonitorConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&NodesMonitorConfig{"+
+		"Datacenter:%v, "+
+		"Filter:%v"+
+		"}",
+		StringVal(c.Datacenter),
+		StringVal(c.Filter),
+	)
+}
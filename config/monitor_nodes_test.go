@@ -0,0 +1,192 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodesMonitorConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *NodesMonitorConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&NodesMonitorConfig{},
+		},
+		{
+			"fully_configured",
+			&NodesMonitorConfig{
+				Datacenter: String("dc2"),
+				Filter:     String("Meta.env == production"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestNodesMonitorConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *NodesMonitorConfig
+		b    *NodesMonitorConfig
+		r    *NodesMonitorConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&NodesMonitorConfig{},
+			&NodesMonitorConfig{},
+		},
+		{
+			"nil_b",
+			&NodesMonitorConfig{},
+			nil,
+			&NodesMonitorConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&NodesMonitorConfig{},
+			&NodesMonitorConfig{},
+			&NodesMonitorConfig{},
+		},
+		{
+			"datacenter_overrides",
+			&NodesMonitorConfig{Datacenter: String("dc1")},
+			&NodesMonitorConfig{Datacenter: String("dc2")},
+			&NodesMonitorConfig{Datacenter: String("dc2")},
+		},
+		{
+			"datacenter_empty_one",
+			&NodesMonitorConfig{Datacenter: String("dc1")},
+			&NodesMonitorConfig{},
+			&NodesMonitorConfig{Datacenter: String("dc1")},
+		},
+		{
+			"filter_overrides",
+			&NodesMonitorConfig{Filter: String("Node == a")},
+			&NodesMonitorConfig{Filter: String("Node == b")},
+			&NodesMonitorConfig{Filter: String("Node == b")},
+		},
+		{
+			"filter_empty_two",
+			&NodesMonitorConfig{},
+			&NodesMonitorConfig{Filter: String("Node == b")},
+			&NodesMonitorConfig{Filter: String("Node == b")},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestNodesMonitorConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *NodesMonitorConfig
+		r    *NodesMonitorConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&NodesMonitorConfig{},
+			&NodesMonitorConfig{
+				Datacenter: String(""),
+				Filter:     String(""),
+			},
+		},
+		{
+			"configured",
+			&NodesMonitorConfig{
+				Datacenter: String("dc2"),
+				Filter:     String("Meta.env == production"),
+			},
+			&NodesMonitorConfig{
+				Datacenter: String("dc2"),
+				Filter:     String("Meta.env == production"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestNodesMonitorConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		i        *NodesMonitorConfig
+		expected string
+	}{
+		{
+			"configured",
+			&NodesMonitorConfig{
+				Datacenter: String("dc2"),
+				Filter:     String("Meta.env == production"),
+			},
+			"&NodesMonitorConfig{" +
+				"Datacenter:dc2, " +
+				"Filter:Meta.env == production" +
+				"}",
+		},
+		{
+			"nil",
+			nil,
+			"(*NodesMonitorConfig)(nil)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := tc.i.GoString()
+			assert.Equal(t, tc.expected, a)
+		})
+	}
+}
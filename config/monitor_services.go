@@ -7,6 +7,19 @@ import (
 
 const servicesType = "services"
 
+const (
+	// ServicesTriggerAny triggers on any change to the service instances
+	ServicesTriggerAny = "any"
+
+	// ServicesTriggerMembership triggers only when service instances are
+	// added or removed
+	ServicesTriggerMembership = "membership"
+
+	// ServicesTriggerHealthTransition triggers only when service instances
+	// are added or removed, or transition between passing and critical
+	ServicesTriggerHealthTransition = "health_transition"
+)
+
 var _ MonitorConfig = (*ServicesMonitorConfig)(nil)
 
 // ServicesMonitorConfig configures a configuration block adhering to the
@@ -26,11 +39,25 @@ type ServicesMonitorConfig struct {
 	// Datacenter is the datacenter the service is deployed in.
 	Datacenter *string `mapstricture:"datacenter"`
 
+	// Datacenters configures multiple datacenters to monitor the services in.
+	// The service instances across the datacenters are merged together. Set to
+	// ["all"] to monitor all datacenters. Cannot be configured with Datacenter.
+	Datacenters []string `mapstructure:"datacenters"`
+
 	// Namespace is the namespace of the service (Consul Enterprise only). If
 	// not provided, the namespace will be inferred from the CTS ACL token, or
 	// default to the `default` namespace.
 	Namespace *string `mapstructure:"namespace"`
 
+	// Partition is the admin partition of the service (Consul Enterprise only).
+	// If not provided, the partition will be inferred from the CTS ACL token,
+	// or default to the `default` partition.
+	Partition *string `mapstructure:"partition"`
+
+	// Peer is the name of the cluster peer to monitor the services imported
+	// from. If not provided, services of the local cluster are monitored.
+	Peer *string `mapstructure:"peer"`
+
 	// Filter is used to filter nodes based on a Consul compatible filter
 	// expression.
 	Filter *string `mapstructure:"filter"`
@@ -38,6 +65,15 @@ type ServicesMonitorConfig struct {
 	// CTSUserDefinedMeta is metadata added to a service automated by CTS for
 	// network infrastructure automation.
 	CTSUserDefinedMeta map[string]string `mapstructure:"cts_user_defined_meta"`
+
+	// TriggerOn configures which changes to the service instances trigger a
+	// services condition: any change, membership changes only, or health
+	// transitions only. Only supported for the condition block.
+	TriggerOn *string `mapstructure:"trigger_on"`
+
+	// IncludeNonPassing includes the service instances that do not have a
+	// passing health status. By default, only passing instances are monitored.
+	IncludeNonPassing *bool `mapstructure:"include_non_passing"`
 }
 
 func (c *ServicesMonitorConfig) VariableType() string {
@@ -53,8 +89,11 @@ func (c *ServicesMonitorConfig) Copy() MonitorConfig {
 	var o ServicesMonitorConfig
 	o.Regexp = StringCopy(c.Regexp)
 	o.Names = append(o.Names, c.Names...)
+	o.Datacenters = append(o.Datacenters, c.Datacenters...)
 	o.Datacenter = StringCopy(c.Datacenter)
 	o.Namespace = StringCopy(c.Namespace)
+	o.Partition = StringCopy(c.Partition)
+	o.Peer = StringCopy(c.Peer)
 	o.Filter = StringCopy(c.Filter)
 	if c.CTSUserDefinedMeta != nil {
 		o.CTSUserDefinedMeta = make(map[string]string)
@@ -62,6 +101,8 @@ func (c *ServicesMonitorConfig) Copy() MonitorConfig {
 			o.CTSUserDefinedMeta[k] = v
 		}
 	}
+	o.TriggerOn = StringCopy(c.TriggerOn)
+	o.IncludeNonPassing = BoolCopy(c.IncludeNonPassing)
 
 	return &o
 }
@@ -95,6 +136,7 @@ func (c *ServicesMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
 	}
 
 	r2.Names = append(r2.Names, o2.Names...)
+	r2.Datacenters = append(r2.Datacenters, o2.Datacenters...)
 
 	if o2.Datacenter != nil {
 		r2.Datacenter = StringCopy(o2.Datacenter)
@@ -102,6 +144,14 @@ func (c *ServicesMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
 	if o2.Namespace != nil {
 		r2.Namespace = StringCopy(o2.Namespace)
 	}
+
+	if o2.Partition != nil {
+		r2.Partition = StringCopy(o2.Partition)
+	}
+
+	if o2.Peer != nil {
+		r2.Peer = StringCopy(o2.Peer)
+	}
 	if o2.Filter != nil {
 		r2.Filter = StringCopy(o2.Filter)
 	}
@@ -113,6 +163,12 @@ func (c *ServicesMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
 			r2.CTSUserDefinedMeta[k] = v
 		}
 	}
+	if o2.TriggerOn != nil {
+		r2.TriggerOn = StringCopy(o2.TriggerOn)
+	}
+	if o2.IncludeNonPassing != nil {
+		r2.IncludeNonPassing = BoolCopy(o2.IncludeNonPassing)
+	}
 
 	return r2
 }
@@ -132,18 +188,36 @@ func (c *ServicesMonitorConfig) Finalize() {
 	if c.Names == nil {
 		c.Names = []string{}
 	}
+
+	if c.Datacenters == nil {
+		c.Datacenters = []string{}
+	}
 	if c.Datacenter == nil {
 		c.Datacenter = String("")
 	}
 	if c.Namespace == nil {
 		c.Namespace = String("")
 	}
+
+	if c.Partition == nil {
+		c.Partition = String("")
+	}
+
+	if c.Peer == nil {
+		c.Peer = String("")
+	}
 	if c.Filter == nil {
 		c.Filter = String("")
 	}
 	if c.CTSUserDefinedMeta == nil {
 		c.CTSUserDefinedMeta = make(map[string]string)
 	}
+	if c.TriggerOn == nil {
+		c.TriggerOn = String(ServicesTriggerAny)
+	}
+	if c.IncludeNonPassing == nil {
+		c.IncludeNonPassing = Bool(false)
+	}
 }
 
 // Validate validates the values and required options. This method is recommended
@@ -183,9 +257,38 @@ func (c *ServicesMonitorConfig) Validate() error {
 		}
 	}
 
+	if err := validateDatacenters(c.Datacenter, c.Datacenters); err != nil {
+		return err
+	}
+
+	if err := validatePeer(c.Peer, c.Datacenters); err != nil {
+		return err
+	}
+
+	switch StringVal(c.TriggerOn) {
+	case "", ServicesTriggerAny, ServicesTriggerMembership, ServicesTriggerHealthTransition:
+	default:
+		return fmt.Errorf("invalid trigger_on %q, must be one of: %s, %s, %s",
+			StringVal(c.TriggerOn), ServicesTriggerAny, ServicesTriggerMembership,
+			ServicesTriggerHealthTransition)
+	}
+
 	return nil
 }
 
+// IsHealthAware returns whether the health-aware options are configured to
+// values other than their defaults. The services of a health-aware monitor are
+// queried together with a single template function.
+func (c *ServicesMonitorConfig) IsHealthAware() bool {
+	if c == nil {
+		return false
+	}
+
+	trigger := StringVal(c.TriggerOn)
+	return (trigger != "" && trigger != ServicesTriggerAny) ||
+		BoolVal(c.IncludeNonPassing)
+}
+
 // GoString defines the printable version of this struct.
 func (c *ServicesMonitorConfig) GoString() string {
 	if c == nil {
@@ -196,15 +299,25 @@ func (c *ServicesMonitorConfig) GoString() string {
 		"Regexp:%s, "+
 		"Names:%s, "+
 		"Datacenter:%s, "+
+		"Datacenters:%s, "+
 		"Namespace:%s, "+
+		"Partition:%s, "+
+		"Peer:%s, "+
 		"Filter:%s, "+
-		"CTSUserDefinedMeta:%s"+
+		"CTSUserDefinedMeta:%s, "+
+		"TriggerOn:%s, "+
+		"IncludeNonPassing:%v"+
 		"}",
 		StringVal(c.Regexp),
 		c.Names,
 		StringVal(c.Datacenter),
+		c.Datacenters,
 		StringVal(c.Namespace),
+		StringVal(c.Partition),
+		StringVal(c.Peer),
 		StringVal(c.Filter),
 		c.CTSUserDefinedMeta,
+		StringVal(c.TriggerOn),
+		BoolVal(c.IncludeNonPassing),
 	)
 }
@@ -267,9 +267,14 @@ func TestServicesMonitorConfig_Finalize(t *testing.T) {
 				Regexp:             nil,
 				Names:              []string{},
 				Datacenter:         String(""),
+				Datacenters:        []string{},
 				Namespace:          String(""),
+				Partition:          String(""),
+				Peer:               String(""),
 				Filter:             String(""),
 				CTSUserDefinedMeta: map[string]string{},
+				TriggerOn:          String("any"),
+				IncludeNonPassing:  Bool(false),
 			},
 		},
 		{
@@ -284,14 +289,19 @@ func TestServicesMonitorConfig_Finalize(t *testing.T) {
 				},
 			},
 			&ServicesMonitorConfig{
-				Regexp:     String("^web.*"),
-				Names:      []string{},
-				Datacenter: String("dc"),
-				Namespace:  String("namespace"),
-				Filter:     String("filter"),
+				Regexp:      String("^web.*"),
+				Names:       []string{},
+				Datacenter:  String("dc"),
+				Datacenters: []string{},
+				Namespace:   String("namespace"),
+				Partition:   String(""),
+				Peer:        String(""),
+				Filter:      String("filter"),
 				CTSUserDefinedMeta: map[string]string{
 					"key": "value",
 				},
+				TriggerOn:         String("any"),
+				IncludeNonPassing: Bool(false),
 			},
 		},
 		{
@@ -306,14 +316,19 @@ func TestServicesMonitorConfig_Finalize(t *testing.T) {
 				},
 			},
 			&ServicesMonitorConfig{
-				Names:      []string{"api"},
-				Regexp:     nil,
-				Datacenter: String("dc"),
-				Namespace:  String("namespace"),
-				Filter:     String("filter"),
+				Names:       []string{"api"},
+				Regexp:      nil,
+				Datacenter:  String("dc"),
+				Datacenters: []string{},
+				Namespace:   String("namespace"),
+				Partition:   String(""),
+				Peer:        String(""),
+				Filter:      String("filter"),
 				CTSUserDefinedMeta: map[string]string{
 					"key": "value",
 				},
+				TriggerOn:         String("any"),
+				IncludeNonPassing: Bool(false),
 			},
 		},
 	}
@@ -360,6 +375,15 @@ func TestServicesMonitorConfig_Validate(t *testing.T) {
 				Regexp: String(""),
 			},
 		},
+		{
+			"valid_health_aware",
+			false,
+			&ServicesMonitorConfig{
+				Names:             []string{"api"},
+				TriggerOn:         String(ServicesTriggerHealthTransition),
+				IncludeNonPassing: Bool(true),
+			},
+		},
 		{
 			"invalid_regexp",
 			true,
@@ -367,6 +391,65 @@ func TestServicesMonitorConfig_Validate(t *testing.T) {
 				Regexp: String("*"),
 			},
 		},
+		{
+			"valid_datacenters",
+			false,
+			&ServicesMonitorConfig{
+				Regexp:      String(".*"),
+				Datacenter:  String(""),
+				Datacenters: []string{"dc1", "dc2"},
+			},
+		},
+		{
+			"invalid_datacenters_all_with_others",
+			true,
+			&ServicesMonitorConfig{
+				Regexp:      String(".*"),
+				Datacenters: []string{"all", "dc2"},
+			},
+		},
+		{
+			"invalid_datacenters_empty_string",
+			true,
+			&ServicesMonitorConfig{
+				Regexp:      String(".*"),
+				Datacenters: []string{"dc1", ""},
+			},
+		},
+		{
+			"invalid_datacenter_and_datacenters",
+			true,
+			&ServicesMonitorConfig{
+				Regexp:      String(".*"),
+				Datacenter:  String("dc1"),
+				Datacenters: []string{"dc2"},
+			},
+		},
+		{
+			"valid_peer",
+			false,
+			&ServicesMonitorConfig{
+				Names: []string{"api"},
+				Peer:  String("cluster-02"),
+			},
+		},
+		{
+			"invalid_peer_and_datacenters",
+			true,
+			&ServicesMonitorConfig{
+				Regexp:      String(".*"),
+				Peer:        String("cluster-02"),
+				Datacenters: []string{"dc1", "dc2"},
+			},
+		},
+		{
+			"invalid_trigger_on",
+			true,
+			&ServicesMonitorConfig{
+				Regexp:    String(".*"),
+				TriggerOn: String("critical"),
+			},
+		},
 		{
 			"invalid_empty_string_names",
 			true,
@@ -420,29 +503,37 @@ func TestServicesMonitorConfig_GoString(t *testing.T) {
 				Regexp:     String("^api$"),
 				Datacenter: String("dc"),
 				Namespace:  String("namespace"),
+				Partition:  String("partition"),
+				Peer:       String("peer"),
 				Filter:     String("filter"),
 				CTSUserDefinedMeta: map[string]string{
 					"key": "value",
 				},
+				TriggerOn:         String("membership"),
+				IncludeNonPassing: Bool(true),
 			},
 			"&ServicesMonitorConfig{Regexp:^api$, Names:[], Datacenter:dc, " +
-				"Namespace:namespace, Filter:filter, " +
-				"CTSUserDefinedMeta:map[key:value]}",
+				"Datacenters:[], Namespace:namespace, " +
+				"Partition:partition, Peer:peer, Filter:filter, " +
+				"CTSUserDefinedMeta:map[key:value], TriggerOn:membership, " +
+				"IncludeNonPassing:true}",
 		},
 		{
 			"names_fully_configured",
 			&ServicesMonitorConfig{
-				Names:      []string{"api", "web"},
-				Datacenter: String("dc"),
-				Namespace:  String("namespace"),
-				Filter:     String("filter"),
+				Names:       []string{"api", "web"},
+				Datacenters: []string{"dc1", "dc2"},
+				Namespace:   String("namespace"),
+				Filter:      String("filter"),
 				CTSUserDefinedMeta: map[string]string{
 					"key": "value",
 				},
 			},
-			"&ServicesMonitorConfig{Regexp:, Names:[api web], Datacenter:dc, " +
-				"Namespace:namespace, Filter:filter, " +
-				"CTSUserDefinedMeta:map[key:value]}",
+			"&ServicesMonitorConfig{Regexp:, Names:[api web], Datacenter:, " +
+				"Datacenters:[dc1 dc2], Namespace:namespace, " +
+				"Partition:, Peer:, Filter:filter, " +
+				"CTSUserDefinedMeta:map[key:value], TriggerOn:, " +
+				"IncludeNonPassing:false}",
 		},
 	}
 
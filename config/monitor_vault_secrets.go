@@ -0,0 +1,115 @@
+package config
+
+import (
+	"fmt"
+)
+
+const vaultSecretsType = "vault_secrets"
+
+var _ MonitorConfig = (*VaultSecretsMonitorConfig)(nil)
+
+// VaultSecretsMonitorConfig configures a configuration block adhering to the
+// monitor interface of type 'vault_secrets'. A Vault secrets monitor watches
+// for changes that occur to the secrets at Vault KV paths.
+type VaultSecretsMonitorConfig struct {
+	// Paths are the API paths of the Vault KV secrets to monitor, e.g.
+	// "secret/data/app" for a secret of a KV version 2 engine mounted at
+	// "secret".
+	Paths []string `mapstructure:"paths"`
+}
+
+func (c *VaultSecretsMonitorConfig) VariableType() string {
+	return "vault_secrets"
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *VaultSecretsMonitorConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o VaultSecretsMonitorConfig
+	if c.Paths != nil {
+		o.Paths = make([]string, 0, len(c.Paths))
+		o.Paths = append(o.Paths, c.Paths...)
+	}
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+func (c *VaultSecretsMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isConditionNil(o) { // o is interface, use isConditionNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isConditionNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*VaultSecretsMonitorConfig)
+	if !ok {
+		return r
+	}
+
+	r2 := r.(*VaultSecretsMonitorConfig)
+
+	r2.Paths = append(r2.Paths, o2.Paths...)
+
+	return r2
+}
+
+// Finalize ensures there no nil pointers.
+func (c *VaultSecretsMonitorConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.Paths == nil {
+		c.Paths = []string{}
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *VaultSecretsMonitorConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	if len(c.Paths) == 0 {
+		return fmt.Errorf("paths is a required field for vault_secrets")
+	}
+
+	unique := make(map[string]bool, len(c.Paths))
+	for _, path := range c.Paths {
+		if path == "" {
+			return fmt.Errorf("paths field includes empty string(s). " +
+				"Vault secret paths cannot be empty")
+		}
+		if unique[path] {
+			return fmt.Errorf("paths field includes duplicate path %q", path)
+		}
+		unique[path] = true
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *VaultSecretsMonitorConfig) GoString() string {
+	if c == nil {
+		return "(*VaultSecretsMonitorConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&VaultSecretsMonitorConfig{"+
+		"Paths:%s"+
+		"}",
+		c.Paths,
+	)
+}
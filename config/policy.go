@@ -0,0 +1,274 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// PolicyEnforcementAdvisory reports a failed policy without stopping the
+	// task from applying.
+	PolicyEnforcementAdvisory = "advisory"
+
+	// PolicyEnforcementMandatory stops the task from applying when the policy
+	// fails.
+	PolicyEnforcementMandatory = "mandatory"
+
+	// DefaultPolicyEnforcementLevel is the enforcement level of a policy that
+	// does not configure one.
+	DefaultPolicyEnforcementLevel = PolicyEnforcementMandatory
+)
+
+// PolicyConfig configures a policy-as-code rule that a task's plan is checked
+// against before the task applies its changes. The rule is a CEL expression
+// that is evaluated locally with the structured plan as the `plan` variable,
+// and passes when it evaluates to true. This block may be specified multiple
+// times within a task to check multiple policies.
+type PolicyConfig struct {
+	// Name identifies the policy in the policy results.
+	Name *string `mapstructure:"name"`
+
+	// Expression is the CEL expression of the rule.
+	Expression *string `mapstructure:"expression"`
+
+	// File is the path to a file that contains the CEL expression of the
+	// rule. Only one of Expression and File may be configured.
+	File *string `mapstructure:"file"`
+
+	// EnforcementLevel is either "advisory" or "mandatory". A failed mandatory
+	// policy stops the task from applying, while a failed advisory policy is
+	// only reported.
+	EnforcementLevel *string `mapstructure:"enforcement_level"`
+
+	// Message is reported when the policy fails.
+	Message *string `mapstructure:"message"`
+}
+
+// PolicyConfigs is a collection of PolicyConfig
+type PolicyConfigs []*PolicyConfig
+
+// Copy returns a deep copy of this configuration.
+func (c *PolicyConfig) Copy() *PolicyConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o PolicyConfig
+	o.Name = StringCopy(c.Name)
+	o.Expression = StringCopy(c.Expression)
+	o.File = StringCopy(c.File)
+	o.EnforcementLevel = StringCopy(c.EnforcementLevel)
+	o.Message = StringCopy(c.Message)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+func (c *PolicyConfig) Merge(o *PolicyConfig) *PolicyConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Name != nil {
+		r.Name = StringCopy(o.Name)
+	}
+
+	if o.Expression != nil {
+		r.Expression = StringCopy(o.Expression)
+	}
+
+	if o.File != nil {
+		r.File = StringCopy(o.File)
+	}
+
+	if o.EnforcementLevel != nil {
+		r.EnforcementLevel = StringCopy(o.EnforcementLevel)
+	}
+
+	if o.Message != nil {
+		r.Message = StringCopy(o.Message)
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *PolicyConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Name == nil {
+		c.Name = String("")
+	}
+
+	if c.Expression == nil {
+		c.Expression = String("")
+	}
+
+	if c.File == nil {
+		c.File = String("")
+	}
+
+	if c.EnforcementLevel == nil || *c.EnforcementLevel == "" {
+		c.EnforcementLevel = String(DefaultPolicyEnforcementLevel)
+	}
+
+	if c.Message == nil {
+		c.Message = String("")
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed. The
+// expression itself is compiled when the task is created.
+func (c *PolicyConfig) Validate() error {
+	if c == nil {
+		return errors.New("missing policy configuration")
+	}
+
+	if StringVal(c.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	hasExpr := strings.TrimSpace(StringVal(c.Expression)) != ""
+	hasFile := StringVal(c.File) != ""
+	if hasExpr == hasFile {
+		return fmt.Errorf("policy %q: exactly one of expression or file is "+
+			"required", *c.Name)
+	}
+
+	switch level := StringVal(c.EnforcementLevel); level {
+	case "", PolicyEnforcementAdvisory, PolicyEnforcementMandatory:
+	default:
+		return fmt.Errorf("policy %q: enforcement_level must be %q or %q: %s",
+			*c.Name, PolicyEnforcementAdvisory, PolicyEnforcementMandatory, level)
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *PolicyConfig) GoString() string {
+	if c == nil {
+		return "(*PolicyConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&PolicyConfig{"+
+		"Name:%s, "+
+		"Expression:%s, "+
+		"File:%s, "+
+		"EnforcementLevel:%s, "+
+		"Message:%s"+
+		"}",
+		StringVal(c.Name),
+		StringVal(c.Expression),
+		StringVal(c.File),
+		StringVal(c.EnforcementLevel),
+		StringVal(c.Message),
+	)
+}
+
+// DefaultPolicyConfigs returns a configuration that is populated with the
+// default values.
+func DefaultPolicyConfigs() *PolicyConfigs {
+	return &PolicyConfigs{}
+}
+
+// Len is a helper method to get the length of the underlying config list
+func (c *PolicyConfigs) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	return len(*c)
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *PolicyConfigs) Copy() *PolicyConfigs {
+	if c == nil {
+		return nil
+	}
+
+	o := make(PolicyConfigs, c.Len())
+	for i, p := range *c {
+		o[i] = p.Copy()
+	}
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration. Policies are appended.
+func (c *PolicyConfigs) Merge(o *PolicyConfigs) *PolicyConfigs {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	*r = append(*r, *o.Copy()...)
+	return r
+}
+
+// Finalize ensures the configuration has no nil pointers and sets default
+// values.
+func (c *PolicyConfigs) Finalize() {
+	if c == nil {
+		return
+	}
+
+	for _, p := range *c {
+		p.Finalize()
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+// Policy names must be unique.
+func (c *PolicyConfigs) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	for i, p := range *c {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid policy block %d: %s", i, err)
+		}
+		if names[*p.Name] {
+			return fmt.Errorf("duplicate policy name: %s", *p.Name)
+		}
+		names[*p.Name] = true
+	}
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *PolicyConfigs) GoString() string {
+	if c == nil {
+		return "(*PolicyConfigs)(nil)"
+	}
+
+	s := make([]string, len(*c))
+	for i, p := range *c {
+		s[i] = p.GoString()
+	}
+
+	return "{" + strings.Join(s, ", ") + "}"
+}
@@ -0,0 +1,228 @@
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyConfig_Copy(t *testing.T) {
+	cases := []struct {
+		name string
+		a    *PolicyConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&PolicyConfig{},
+		},
+		{
+			"fully_configured",
+			&PolicyConfig{
+				Name:             String("no-deletes"),
+				Expression:       String("true"),
+				File:             String("policy.cel"),
+				EnforcementLevel: String(PolicyEnforcementAdvisory),
+				Message:          String("message"),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestPolicyConfig_Merge(t *testing.T) {
+	cases := []struct {
+		name string
+		a    *PolicyConfig
+		b    *PolicyConfig
+		r    *PolicyConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&PolicyConfig{},
+			&PolicyConfig{},
+		},
+		{
+			"nil_b",
+			&PolicyConfig{},
+			nil,
+			&PolicyConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"expression_overrides",
+			&PolicyConfig{Expression: String("true")},
+			&PolicyConfig{Expression: String("false")},
+			&PolicyConfig{Expression: String("false")},
+		},
+		{
+			"enforcement_level_empty_keeps",
+			&PolicyConfig{EnforcementLevel: String(PolicyEnforcementAdvisory)},
+			&PolicyConfig{},
+			&PolicyConfig{EnforcementLevel: String(PolicyEnforcementAdvisory)},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestPolicyConfigs_Merge(t *testing.T) {
+	a := &PolicyConfigs{{Name: String("a")}}
+	b := &PolicyConfigs{{Name: String("b")}}
+
+	r := a.Merge(b)
+	assert.Equal(t, &PolicyConfigs{{Name: String("a")}, {Name: String("b")}}, r)
+	assert.Equal(t, 1, a.Len(), "merge should not modify the original")
+}
+
+func TestPolicyConfig_Finalize(t *testing.T) {
+	cases := []struct {
+		name string
+		i    *PolicyConfig
+		r    *PolicyConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&PolicyConfig{},
+			&PolicyConfig{
+				Name:             String(""),
+				Expression:       String(""),
+				File:             String(""),
+				EnforcementLevel: String(PolicyEnforcementMandatory),
+				Message:          String(""),
+			},
+		},
+		{
+			"advisory",
+			&PolicyConfig{
+				Name:             String("no-deletes"),
+				File:             String("policy.cel"),
+				EnforcementLevel: String(PolicyEnforcementAdvisory),
+			},
+			&PolicyConfig{
+				Name:             String("no-deletes"),
+				Expression:       String(""),
+				File:             String("policy.cel"),
+				EnforcementLevel: String(PolicyEnforcementAdvisory),
+				Message:          String(""),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestPolicyConfig_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		i       *PolicyConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			false,
+		},
+		{
+			"expression",
+			&PolicyConfig{
+				Name:       String("no-deletes"),
+				Expression: String("true"),
+			},
+			true,
+		},
+		{
+			"file",
+			&PolicyConfig{
+				Name:             String("no-deletes"),
+				File:             String("policy.cel"),
+				EnforcementLevel: String(PolicyEnforcementAdvisory),
+			},
+			true,
+		},
+		{
+			"missing_name",
+			&PolicyConfig{Expression: String("true")},
+			false,
+		},
+		{
+			"missing_rule",
+			&PolicyConfig{Name: String("no-deletes")},
+			false,
+		},
+		{
+			"expression_and_file",
+			&PolicyConfig{
+				Name:       String("no-deletes"),
+				Expression: String("true"),
+				File:       String("policy.cel"),
+			},
+			false,
+		},
+		{
+			"invalid_enforcement_level",
+			&PolicyConfig{
+				Name:             String("no-deletes"),
+				Expression:       String("true"),
+				EnforcementLevel: String("soft-mandatory"),
+			},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigs_Validate(t *testing.T) {
+	valid := &PolicyConfigs{
+		{Name: String("a"), Expression: String("true")},
+		{Name: String("b"), Expression: String("true")},
+	}
+	assert.NoError(t, valid.Validate())
+
+	duplicate := &PolicyConfigs{
+		{Name: String("a"), Expression: String("true")},
+		{Name: String("a"), Expression: String("false")},
+	}
+	assert.Error(t, duplicate.Validate())
+}
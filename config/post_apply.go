@@ -0,0 +1,224 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultPostApplyTimeout is the default time a post-apply command is
+	// allowed to run before it is stopped
+	DefaultPostApplyTimeout = 30 * time.Second
+)
+
+// PostApplyConfig configures a command that is executed after a task applies.
+// The command receives the task name, event ID and outcome of the apply
+// through environment variables and as JSON on stdin. This block may be
+// specified multiple times within a task to run multiple commands in order.
+type PostApplyConfig struct {
+	// Command is the executable and its arguments to run. The command runs in
+	// the task's working directory.
+	Command []string `mapstructure:"command"`
+
+	// Timeout is the maximum duration for a single run of the command.
+	Timeout *time.Duration `mapstructure:"timeout"`
+
+	// Retries is the number of times the command is retried when it fails.
+	Retries *int `mapstructure:"retries"`
+}
+
+// PostApplyConfigs is a collection of PostApplyConfig
+type PostApplyConfigs []*PostApplyConfig
+
+// Copy returns a deep copy of this configuration.
+func (c *PostApplyConfig) Copy() *PostApplyConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o PostApplyConfig
+	o.Command = append(o.Command, c.Command...)
+	o.Timeout = TimeDurationCopy(c.Timeout)
+	o.Retries = IntCopy(c.Retries)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+func (c *PostApplyConfig) Merge(o *PostApplyConfig) *PostApplyConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Command != nil {
+		r.Command = append([]string{}, o.Command...)
+	}
+
+	if o.Timeout != nil {
+		r.Timeout = TimeDurationCopy(o.Timeout)
+	}
+
+	if o.Retries != nil {
+		r.Retries = IntCopy(o.Retries)
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *PostApplyConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Command == nil {
+		c.Command = []string{}
+	}
+
+	if c.Timeout == nil {
+		c.Timeout = TimeDuration(DefaultPostApplyTimeout)
+	}
+
+	if c.Retries == nil {
+		c.Retries = Int(0)
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *PostApplyConfig) Validate() error {
+	if c == nil {
+		return errors.New("missing post_apply configuration")
+	}
+
+	if len(c.Command) == 0 || strings.TrimSpace(c.Command[0]) == "" {
+		return errors.New("command is required")
+	}
+
+	if c.Timeout != nil && *c.Timeout <= 0 {
+		return fmt.Errorf("timeout must be greater than 0: %s", *c.Timeout)
+	}
+
+	if c.Retries != nil && *c.Retries < 0 {
+		return fmt.Errorf("retries cannot be negative: %d", *c.Retries)
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *PostApplyConfig) GoString() string {
+	if c == nil {
+		return "(*PostApplyConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&PostApplyConfig{"+
+		"Command:%s, "+
+		"Timeout:%s, "+
+		"Retries:%d"+
+		"}",
+		c.Command,
+		TimeDurationVal(c.Timeout),
+		IntVal(c.Retries),
+	)
+}
+
+// DefaultPostApplyConfigs returns a configuration that is populated with the
+// default values.
+func DefaultPostApplyConfigs() *PostApplyConfigs {
+	return &PostApplyConfigs{}
+}
+
+// Len is a helper method to get the length of the underlying config list
+func (c *PostApplyConfigs) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	return len(*c)
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *PostApplyConfigs) Copy() *PostApplyConfigs {
+	if c == nil {
+		return nil
+	}
+
+	o := make(PostApplyConfigs, c.Len())
+	for i, p := range *c {
+		o[i] = p.Copy()
+	}
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration. Commands are appended so that they run after the commands
+// of this configuration.
+func (c *PostApplyConfigs) Merge(o *PostApplyConfigs) *PostApplyConfigs {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	*r = append(*r, *o.Copy()...)
+	return r
+}
+
+// Finalize ensures the configuration has no nil pointers and sets default
+// values.
+func (c *PostApplyConfigs) Finalize() {
+	if c == nil {
+		return
+	}
+
+	for _, p := range *c {
+		p.Finalize()
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *PostApplyConfigs) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	for i, p := range *c {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid post_apply block %d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *PostApplyConfigs) GoString() string {
+	if c == nil {
+		return "(*PostApplyConfigs)(nil)"
+	}
+
+	s := make([]string, len(*c))
+	for i, p := range *c {
+		s[i] = p.GoString()
+	}
+
+	return "{" + strings.Join(s, ", ") + "}"
+}
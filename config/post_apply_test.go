@@ -0,0 +1,207 @@
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostApplyConfig_Copy(t *testing.T) {
+	cases := []struct {
+		name string
+		a    *PostApplyConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&PostApplyConfig{},
+		},
+		{
+			"fully_configured",
+			&PostApplyConfig{
+				Command: []string{"./reload.sh", "--all"},
+				Timeout: TimeDuration(10 * time.Second),
+				Retries: Int(2),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestPostApplyConfig_Merge(t *testing.T) {
+	cases := []struct {
+		name string
+		a    *PostApplyConfig
+		b    *PostApplyConfig
+		r    *PostApplyConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&PostApplyConfig{},
+			&PostApplyConfig{},
+		},
+		{
+			"nil_b",
+			&PostApplyConfig{},
+			nil,
+			&PostApplyConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"command_overrides",
+			&PostApplyConfig{Command: []string{"a", "b"}},
+			&PostApplyConfig{Command: []string{"c"}},
+			&PostApplyConfig{Command: []string{"c"}},
+		},
+		{
+			"timeout_overrides",
+			&PostApplyConfig{Timeout: TimeDuration(10 * time.Second)},
+			&PostApplyConfig{Timeout: TimeDuration(20 * time.Second)},
+			&PostApplyConfig{Timeout: TimeDuration(20 * time.Second)},
+		},
+		{
+			"retries_empty_one",
+			&PostApplyConfig{Retries: Int(2)},
+			&PostApplyConfig{},
+			&PostApplyConfig{Retries: Int(2)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestPostApplyConfigs_Merge(t *testing.T) {
+	a := &PostApplyConfigs{{Command: []string{"a"}}}
+	b := &PostApplyConfigs{{Command: []string{"b"}}}
+
+	r := a.Merge(b)
+	assert.Equal(t, &PostApplyConfigs{
+		{Command: []string{"a"}},
+		{Command: []string{"b"}},
+	}, r)
+}
+
+func TestPostApplyConfig_Finalize(t *testing.T) {
+	cases := []struct {
+		name string
+		i    *PostApplyConfig
+		r    *PostApplyConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&PostApplyConfig{},
+			&PostApplyConfig{
+				Command: []string{},
+				Timeout: TimeDuration(DefaultPostApplyTimeout),
+				Retries: Int(0),
+			},
+		},
+		{
+			"configured",
+			&PostApplyConfig{
+				Command: []string{"./reload.sh"},
+				Timeout: TimeDuration(5 * time.Second),
+				Retries: Int(1),
+			},
+			&PostApplyConfig{
+				Command: []string{"./reload.sh"},
+				Timeout: TimeDuration(5 * time.Second),
+				Retries: Int(1),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestPostApplyConfig_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		i       *PostApplyConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			false,
+		},
+		{
+			"valid",
+			&PostApplyConfig{
+				Command: []string{"./reload.sh"},
+				Timeout: TimeDuration(5 * time.Second),
+				Retries: Int(1),
+			},
+			true,
+		},
+		{
+			"missing_command",
+			&PostApplyConfig{Command: []string{}},
+			false,
+		},
+		{
+			"blank_command",
+			&PostApplyConfig{Command: []string{" "}},
+			false,
+		},
+		{
+			"zero_timeout",
+			&PostApplyConfig{
+				Command: []string{"./reload.sh"},
+				Timeout: TimeDuration(0),
+			},
+			false,
+		},
+		{
+			"negative_retries",
+			&PostApplyConfig{
+				Command: []string{"./reload.sh"},
+				Retries: Int(-1),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,337 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultPreApplyTimeout is the default time a pre-apply command or HTTP
+	// request is allowed to run before it is stopped
+	DefaultPreApplyTimeout = 30 * time.Second
+)
+
+// PreApplyConfig configures a check that runs after a task plans its changes
+// and before it applies them. The check receives the plan and can veto the
+// apply. Exactly one of a command, an HTTP URL or a plan policy is configured
+// per block. This block may be specified multiple times within a task to run
+// multiple checks in order, and the first check to veto stops the apply.
+type PreApplyConfig struct {
+	// Command is the executable and its arguments to run. The command runs in
+	// the task's working directory, receives the plan as JSON on stdin and
+	// vetoes the apply by exiting with a non-zero status.
+	Command []string `mapstructure:"command"`
+
+	// URL is an HTTP(S) endpoint that the plan is sent to as JSON. The apply
+	// is vetoed when the endpoint responds with a non-2xx status.
+	URL *string `mapstructure:"url"`
+
+	// Method is the HTTP method for the request to URL. Defaults to POST.
+	Method *string `mapstructure:"method"`
+
+	// Headers are additional HTTP headers for the request to URL.
+	Headers map[string]string `mapstructure:"headers"`
+
+	// MaxDestroy is a plan policy that vetoes the apply when the plan destroys
+	// more than this number of resources.
+	MaxDestroy *int `mapstructure:"max_destroy"`
+
+	// Timeout is the maximum duration for a single run of the command or HTTP
+	// request.
+	Timeout *time.Duration `mapstructure:"timeout"`
+
+	// Retries is the number of times the command or HTTP request is retried
+	// when it fails. An HTTP response with a 4xx status vetoes the apply
+	// without being retried.
+	Retries *int `mapstructure:"retries"`
+}
+
+// PreApplyConfigs is a collection of PreApplyConfig
+type PreApplyConfigs []*PreApplyConfig
+
+// Copy returns a deep copy of this configuration.
+func (c *PreApplyConfig) Copy() *PreApplyConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o PreApplyConfig
+	o.Command = append(o.Command, c.Command...)
+	o.URL = StringCopy(c.URL)
+	o.Method = StringCopy(c.Method)
+
+	if c.Headers != nil {
+		o.Headers = make(map[string]string)
+		for k, v := range c.Headers {
+			o.Headers[k] = v
+		}
+	}
+
+	o.MaxDestroy = IntCopy(c.MaxDestroy)
+	o.Timeout = TimeDurationCopy(c.Timeout)
+	o.Retries = IntCopy(c.Retries)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps are merged, other values are overwritten.
+func (c *PreApplyConfig) Merge(o *PreApplyConfig) *PreApplyConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Command != nil {
+		r.Command = append([]string{}, o.Command...)
+	}
+
+	if o.URL != nil {
+		r.URL = StringCopy(o.URL)
+	}
+
+	if o.Method != nil {
+		r.Method = StringCopy(o.Method)
+	}
+
+	for k, v := range o.Headers {
+		if r.Headers == nil {
+			r.Headers = make(map[string]string)
+		}
+		r.Headers[k] = v
+	}
+
+	if o.MaxDestroy != nil {
+		r.MaxDestroy = IntCopy(o.MaxDestroy)
+	}
+
+	if o.Timeout != nil {
+		r.Timeout = TimeDurationCopy(o.Timeout)
+	}
+
+	if o.Retries != nil {
+		r.Retries = IntCopy(o.Retries)
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers. MaxDestroy is left nil when it is
+// not configured since a value of 0 is a valid policy.
+func (c *PreApplyConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Command == nil {
+		c.Command = []string{}
+	}
+
+	if c.URL == nil {
+		c.URL = String("")
+	}
+
+	if c.Method == nil {
+		c.Method = String("")
+	}
+	if *c.URL != "" && *c.Method == "" {
+		c.Method = String(http.MethodPost)
+	}
+
+	if c.Headers == nil {
+		c.Headers = make(map[string]string)
+	}
+
+	if c.Timeout == nil {
+		c.Timeout = TimeDuration(DefaultPreApplyTimeout)
+	}
+
+	if c.Retries == nil {
+		c.Retries = Int(0)
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *PreApplyConfig) Validate() error {
+	if c == nil {
+		return errors.New("missing pre_apply configuration")
+	}
+
+	var count int
+	if len(c.Command) > 0 {
+		count++
+		if strings.TrimSpace(c.Command[0]) == "" {
+			return errors.New("command cannot be empty")
+		}
+	}
+	if StringVal(c.URL) != "" {
+		count++
+		u, err := url.Parse(*c.URL)
+		if err != nil {
+			return fmt.Errorf("invalid url: %s", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("url must use http or https: %s", *c.URL)
+		}
+	}
+	if c.MaxDestroy != nil {
+		count++
+		if *c.MaxDestroy < 0 {
+			return fmt.Errorf("max_destroy cannot be negative: %d", *c.MaxDestroy)
+		}
+	}
+
+	if count != 1 {
+		return errors.New("exactly one of command, url or max_destroy is required")
+	}
+
+	if c.Timeout != nil && *c.Timeout <= 0 {
+		return fmt.Errorf("timeout must be greater than 0: %s", *c.Timeout)
+	}
+
+	if c.Retries != nil && *c.Retries < 0 {
+		return fmt.Errorf("retries cannot be negative: %d", *c.Retries)
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+// Header values are redacted since they may contain credentials.
+func (c *PreApplyConfig) GoString() string {
+	if c == nil {
+		return "(*PreApplyConfig)(nil)"
+	}
+
+	headers := make([]string, 0, len(c.Headers))
+	for k := range c.Headers {
+		headers = append(headers, k)
+	}
+	sort.Strings(headers)
+
+	maxDestroy := "<nil>"
+	if c.MaxDestroy != nil {
+		maxDestroy = fmt.Sprintf("%d", *c.MaxDestroy)
+	}
+
+	return fmt.Sprintf("&PreApplyConfig{"+
+		"Command:%s, "+
+		"URL:%s, "+
+		"Method:%s, "+
+		"Headers:%s, "+
+		"MaxDestroy:%s, "+
+		"Timeout:%s, "+
+		"Retries:%d"+
+		"}",
+		c.Command,
+		StringVal(c.URL),
+		StringVal(c.Method),
+		headers,
+		maxDestroy,
+		TimeDurationVal(c.Timeout),
+		IntVal(c.Retries),
+	)
+}
+
+// DefaultPreApplyConfigs returns a configuration that is populated with the
+// default values.
+func DefaultPreApplyConfigs() *PreApplyConfigs {
+	return &PreApplyConfigs{}
+}
+
+// Len is a helper method to get the length of the underlying config list
+func (c *PreApplyConfigs) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	return len(*c)
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *PreApplyConfigs) Copy() *PreApplyConfigs {
+	if c == nil {
+		return nil
+	}
+
+	o := make(PreApplyConfigs, c.Len())
+	for i, p := range *c {
+		o[i] = p.Copy()
+	}
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration. Checks are appended so that they run after the checks of
+// this configuration.
+func (c *PreApplyConfigs) Merge(o *PreApplyConfigs) *PreApplyConfigs {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	*r = append(*r, *o.Copy()...)
+	return r
+}
+
+// Finalize ensures the configuration has no nil pointers and sets default
+// values.
+func (c *PreApplyConfigs) Finalize() {
+	if c == nil {
+		return
+	}
+
+	for _, p := range *c {
+		p.Finalize()
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *PreApplyConfigs) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	for i, p := range *c {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid pre_apply block %d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *PreApplyConfigs) GoString() string {
+	if c == nil {
+		return "(*PreApplyConfigs)(nil)"
+	}
+
+	s := make([]string, len(*c))
+	for i, p := range *c {
+		s[i] = p.GoString()
+	}
+
+	return "{" + strings.Join(s, ", ") + "}"
+}
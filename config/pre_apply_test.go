@@ -0,0 +1,237 @@
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreApplyConfig_Copy(t *testing.T) {
+	cases := []struct {
+		name string
+		a    *PreApplyConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&PreApplyConfig{},
+		},
+		{
+			"fully_configured",
+			&PreApplyConfig{
+				Command:    []string{"./lock.sh"},
+				URL:        String("https://lock.example.com"),
+				Method:     String("PUT"),
+				Headers:    map[string]string{"X-Token": "token"},
+				MaxDestroy: Int(1),
+				Timeout:    TimeDuration(10 * time.Second),
+				Retries:    Int(2),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestPreApplyConfig_Merge(t *testing.T) {
+	cases := []struct {
+		name string
+		a    *PreApplyConfig
+		b    *PreApplyConfig
+		r    *PreApplyConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&PreApplyConfig{},
+			&PreApplyConfig{},
+		},
+		{
+			"nil_b",
+			&PreApplyConfig{},
+			nil,
+			&PreApplyConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"url_overrides",
+			&PreApplyConfig{URL: String("http://a")},
+			&PreApplyConfig{URL: String("http://b")},
+			&PreApplyConfig{URL: String("http://b")},
+		},
+		{
+			"headers_merge",
+			&PreApplyConfig{Headers: map[string]string{"a": "1", "b": "2"}},
+			&PreApplyConfig{Headers: map[string]string{"b": "3"}},
+			&PreApplyConfig{Headers: map[string]string{"a": "1", "b": "3"}},
+		},
+		{
+			"max_destroy_empty_one",
+			&PreApplyConfig{MaxDestroy: Int(0)},
+			&PreApplyConfig{},
+			&PreApplyConfig{MaxDestroy: Int(0)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestPreApplyConfigs_Merge(t *testing.T) {
+	a := &PreApplyConfigs{{MaxDestroy: Int(0)}}
+	b := &PreApplyConfigs{{Command: []string{"b"}}}
+
+	r := a.Merge(b)
+	assert.Equal(t, &PreApplyConfigs{
+		{MaxDestroy: Int(0)},
+		{Command: []string{"b"}},
+	}, r)
+}
+
+func TestPreApplyConfig_Finalize(t *testing.T) {
+	cases := []struct {
+		name string
+		i    *PreApplyConfig
+		r    *PreApplyConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&PreApplyConfig{},
+			&PreApplyConfig{
+				Command: []string{},
+				URL:     String(""),
+				Method:  String(""),
+				Headers: map[string]string{},
+				Timeout: TimeDuration(DefaultPreApplyTimeout),
+				Retries: Int(0),
+			},
+		},
+		{
+			"url_default_method",
+			&PreApplyConfig{URL: String("https://lock.example.com")},
+			&PreApplyConfig{
+				Command: []string{},
+				URL:     String("https://lock.example.com"),
+				Method:  String("POST"),
+				Headers: map[string]string{},
+				Timeout: TimeDuration(DefaultPreApplyTimeout),
+				Retries: Int(0),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestPreApplyConfig_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		i       *PreApplyConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			false,
+		},
+		{
+			"command",
+			&PreApplyConfig{Command: []string{"./lock.sh"}},
+			true,
+		},
+		{
+			"url",
+			&PreApplyConfig{URL: String("https://lock.example.com")},
+			true,
+		},
+		{
+			"max_destroy",
+			&PreApplyConfig{MaxDestroy: Int(0)},
+			true,
+		},
+		{
+			"none_configured",
+			&PreApplyConfig{Command: []string{}, URL: String("")},
+			false,
+		},
+		{
+			"multiple_configured",
+			&PreApplyConfig{
+				Command:    []string{"./lock.sh"},
+				MaxDestroy: Int(1),
+			},
+			false,
+		},
+		{
+			"blank_command",
+			&PreApplyConfig{Command: []string{" "}},
+			false,
+		},
+		{
+			"invalid_url_scheme",
+			&PreApplyConfig{URL: String("ftp://lock.example.com")},
+			false,
+		},
+		{
+			"negative_max_destroy",
+			&PreApplyConfig{MaxDestroy: Int(-1)},
+			false,
+		},
+		{
+			"zero_timeout",
+			&PreApplyConfig{
+				Command: []string{"./lock.sh"},
+				Timeout: TimeDuration(0),
+			},
+			false,
+		},
+		{
+			"negative_retries",
+			&PreApplyConfig{
+				Command: []string{"./lock.sh"},
+				Retries: Int(-1),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
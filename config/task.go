@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	goVersion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 )
 
@@ -62,14 +63,41 @@ type TaskConfig struct {
 	// will be used as the default if omitted.
 	Version *string `mapstructure:"version"`
 
-	// The Terraform client version to use for the task when configured with CTS
-	// enterprise and the Terraform Cloud driver. This option is not supported
-	// when using CTS OSS or the Terraform driver.
+	// Imports maps resource addresses within the generated root module to the
+	// IDs of existing infrastructure, e.g. "module.<task>.<type>.<name>" = "<id>".
+	// The resources are imported into state before the task first applies so
+	// that pre-existing infrastructure is adopted instead of recreated.
+	Imports map[string]string `mapstructure:"import"`
+
+	// PostApply configures commands to execute after the task applies, after
+	// any out-of-band actions for the task's providers.
+	PostApply *PostApplyConfigs `mapstructure:"post_apply"`
+
+	// PreApply configures checks that receive the task's plan before the task
+	// applies, any of which can veto the apply.
+	PreApply *PreApplyConfigs `mapstructure:"pre_apply"`
+
+	// Policies configures policy-as-code rules that the task's plan is
+	// checked against before the task applies.
+	Policies *PolicyConfigs `mapstructure:"policy"`
+
+	// Guardrails configures limits on the changes of the task's plan. A plan
+	// that exceeds the limits blocks the task until it is approved.
+	Guardrails *GuardrailsConfig `mapstructure:"guardrails"`
+
+	// ChangeWindows configures when the task is allowed to apply changes.
+	// The task applies only when both its own change windows and the global
+	// change windows allow it.
+	ChangeWindows *ChangeWindowConfigs `mapstructure:"change_window"`
+
+	// The Terraform client version to use for the task. The Terraform driver
+	// installs the version alongside its default version, and the Terraform
+	// Cloud driver sets the version for the task's workspace.
 	TFVersion *string `mapstructure:"terraform_version"`
 
-	// The workspace configurations to use for the task when configured with CTS
-	// enterprise and the Terraform Cloud driver. This option is not supported
-	// when using CTS OSS or the Terraform driver.
+	// The workspace configurations to use for the task when configured with
+	// the Terraform Cloud driver. This option is not supported when using the
+	// Terraform driver.
 	TFCWorkspace *TerraformCloudWorkspaceConfig `mapstructure:"terraform_cloud_workspace"`
 
 	// BufferPeriod configures per-task buffer timers.
@@ -124,6 +152,23 @@ func (c *TaskConfig) Copy() *TaskConfig {
 
 	o.Version = StringCopy(c.Version)
 
+	if c.Imports != nil {
+		o.Imports = make(map[string]string)
+		for k, v := range c.Imports {
+			o.Imports[k] = v
+		}
+	}
+
+	o.PostApply = c.PostApply.Copy()
+
+	o.PreApply = c.PreApply.Copy()
+
+	o.Policies = c.Policies.Copy()
+
+	o.Guardrails = c.Guardrails.Copy()
+
+	o.ChangeWindows = c.ChangeWindows.Copy()
+
 	o.TFVersion = StringCopy(c.TFVersion)
 
 	if c.TFCWorkspace != nil {
@@ -199,6 +244,33 @@ func (c *TaskConfig) Merge(o *TaskConfig) *TaskConfig {
 		r.Version = StringCopy(o.Version)
 	}
 
+	for k, v := range o.Imports {
+		if r.Imports == nil {
+			r.Imports = make(map[string]string)
+		}
+		r.Imports[k] = v
+	}
+
+	if o.PostApply != nil {
+		r.PostApply = r.PostApply.Merge(o.PostApply)
+	}
+
+	if o.PreApply != nil {
+		r.PreApply = r.PreApply.Merge(o.PreApply)
+	}
+
+	if o.Policies != nil {
+		r.Policies = r.Policies.Merge(o.Policies)
+	}
+
+	if o.Guardrails != nil {
+		r.Guardrails = r.Guardrails.Merge(o.Guardrails)
+	}
+
+	if o.ChangeWindows != nil {
+		r.ChangeWindows = r.ChangeWindows.Merge(o.ChangeWindows)
+	}
+
 	if o.TFVersion != nil {
 		r.TFVersion = StringCopy(o.TFVersion)
 	}
@@ -281,6 +353,35 @@ func (c *TaskConfig) Finalize(globalBp *BufferPeriodConfig, wd string) {
 		c.Version = String("")
 	}
 
+	if c.Imports == nil {
+		c.Imports = make(map[string]string)
+	}
+
+	if c.PostApply == nil {
+		c.PostApply = DefaultPostApplyConfigs()
+	}
+	c.PostApply.Finalize()
+
+	if c.PreApply == nil {
+		c.PreApply = DefaultPreApplyConfigs()
+	}
+	c.PreApply.Finalize()
+
+	if c.Policies == nil {
+		c.Policies = DefaultPolicyConfigs()
+	}
+	c.Policies.Finalize()
+
+	if c.Guardrails == nil {
+		c.Guardrails = DefaultGuardrailsConfig()
+	}
+	c.Guardrails.Finalize()
+
+	if c.ChangeWindows == nil {
+		c.ChangeWindows = DefaultChangeWindowConfigs()
+	}
+	c.ChangeWindows.Finalize()
+
 	if c.TFCWorkspace == nil {
 		c.TFCWorkspace = &TerraformCloudWorkspaceConfig{}
 	}
@@ -291,7 +392,7 @@ func (c *TaskConfig) Finalize(globalBp *BufferPeriodConfig, wd string) {
 	}
 
 	bp := globalBp
-	if _, ok := c.Condition.(*ScheduleConditionConfig); ok {
+	if isScheduleOnlyCondition(c.Condition) {
 		// disable buffer_period for schedule condition
 		if c.BufferPeriod != nil {
 			logger.Warn("disabling buffer_period for schedule condition. "+
@@ -365,16 +466,15 @@ func (c *TaskConfig) Validate() error {
 	}
 
 	if c.TFVersion != nil && *c.TFVersion != "" {
-		return fmt.Errorf("unsupported configuration 'terraform_version' for "+
-			"task %q. This option is available for Consul-Terraform-Sync Enterprise "+
-			"when using the Terraform Cloud driver, or configure the Terraform client "+
-			"version within the Terraform driver block", *c.Name)
+		if _, err := goVersion.NewSemver(*c.TFVersion); err != nil {
+			return fmt.Errorf("invalid 'terraform_version' for task %q: %s",
+				*c.Name, err)
+		}
 	}
 
-	if c.TFCWorkspace != nil && !c.TFCWorkspace.isEmpty() {
-		return fmt.Errorf("unsupported configuration 'terraform_cloud_workspace' for "+
-			"task %q. This option is available for Consul-Terraform-Sync Enterprise "+
-			"when using the Terraform Cloud driver", *c.Name)
+	if err := c.TFCWorkspace.Validate(); err != nil {
+		return fmt.Errorf("invalid 'terraform_cloud_workspace' for task %q: %s",
+			*c.Name, err)
 	}
 
 	// Restrict only one provider instance per task
@@ -389,6 +489,36 @@ func (c *TaskConfig) Validate() error {
 
 	// TODO validate c.Variables
 
+	for addr, id := range c.Imports {
+		if strings.TrimSpace(addr) == "" {
+			return fmt.Errorf("import for task %q has an empty resource address", *c.Name)
+		}
+		if strings.TrimSpace(id) == "" {
+			return fmt.Errorf("import for task %q is missing an ID for resource "+
+				"address %q", *c.Name, addr)
+		}
+	}
+
+	if err := c.PostApply.Validate(); err != nil {
+		return fmt.Errorf("task %q: %s", *c.Name, err)
+	}
+
+	if err := c.PreApply.Validate(); err != nil {
+		return fmt.Errorf("task %q: %s", *c.Name, err)
+	}
+
+	if err := c.Policies.Validate(); err != nil {
+		return fmt.Errorf("task %q: %s", *c.Name, err)
+	}
+
+	if err := c.Guardrails.Validate(); err != nil {
+		return fmt.Errorf("task %q: invalid guardrails: %s", *c.Name, err)
+	}
+
+	if err := c.ChangeWindows.Validate(); err != nil {
+		return fmt.Errorf("task %q: %s", *c.Name, err)
+	}
+
 	if err := c.BufferPeriod.Validate(); err != nil {
 		return err
 	}
@@ -421,6 +551,12 @@ func (c *TaskConfig) GoString() string {
 		"Module:%s, "+
 		"VarFiles:%s, "+
 		"Version:%s, "+
+		"Imports:%v, "+
+		"PostApply:%s, "+
+		"PreApply:%s, "+
+		"Policies:%s, "+
+		"Guardrails:%s, "+
+		"ChangeWindows:%s, "+
 		"TFVersion: %s, "+
 		"BufferPeriod:%s, "+
 		"Enabled:%t, "+
@@ -434,6 +570,12 @@ func (c *TaskConfig) GoString() string {
 		StringVal(c.Module),
 		c.VarFiles,
 		StringVal(c.Version),
+		c.Imports,
+		c.PostApply.GoString(),
+		c.PreApply.GoString(),
+		c.Policies.GoString(),
+		c.Guardrails.GoString(),
+		c.ChangeWindows.GoString(),
 		StringVal(c.TFVersion),
 		c.BufferPeriod.GoString(),
 		BoolVal(c.Enabled),
@@ -582,7 +724,10 @@ func (c *TaskConfig) validateCondition() error {
 
 	// Confirm that condition's variable type is not services since task.services
 	// is configured
-	if _, ok := c.Condition.(*ServicesConditionConfig); ok {
+	for _, cond := range FlattenCondition(c.Condition) {
+		if _, ok := cond.(*ServicesConditionConfig); !ok {
+			continue
+		}
 		err := fmt.Errorf("task's `services` field and `condition " +
 			"'services'` block both monitor \"services\" variable type. only " +
 			"one of these can be configured per task")
@@ -596,6 +741,21 @@ func (c *TaskConfig) validateCondition() error {
 	return nil
 }
 
+// isScheduleOnlyCondition returns true if the task is only triggered by a
+// schedule: either a schedule condition or an 'all' condition that includes
+// a schedule condition, which defers to the schedule to run the task. Runs of a
+// schedule condition configured with on_change_interval are rate-limited by
+// the controller rather than a buffer period.
+func isScheduleOnlyCondition(c ConditionConfig) bool {
+	switch v := c.(type) {
+	case *ScheduleConditionConfig:
+		return true
+	case *CompositeConditionConfig:
+		return v.IsAll() && v.ScheduleCondition() != nil
+	}
+	return false
+}
+
 // sourceFieldLogMsg is the log message for deprecating the `source` field.
 const sourceFieldLogMsg = `the 'source' field in the task block is deprecated ` +
 	`in v0.5.0 and will be removed in a future major version after v0.8.0.
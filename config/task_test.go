@@ -30,6 +30,7 @@ func TestTaskConfig_Copy(t *testing.T) {
 				DeprecatedServices: []string{"service"},
 				Module:             String("path"),
 				Version:            String("0.0.0"),
+				Imports:            map[string]string{"module.name.x.y": "id"},
 				Enabled:            Bool(true),
 				Condition: &CatalogServicesConditionConfig{
 					CatalogServicesMonitorConfig{
@@ -262,6 +263,18 @@ func TestTaskConfig_Merge(t *testing.T) {
 			&TaskConfig{Version: String("0.0.0")},
 			&TaskConfig{Version: String("0.0.0")},
 		},
+		{
+			"imports_merge",
+			&TaskConfig{Imports: map[string]string{"a": "1", "b": "2"}},
+			&TaskConfig{Imports: map[string]string{"b": "3", "c": "4"}},
+			&TaskConfig{Imports: map[string]string{"a": "1", "b": "3", "c": "4"}},
+		},
+		{
+			"imports_empty_one",
+			&TaskConfig{},
+			&TaskConfig{Imports: map[string]string{"a": "1"}},
+			&TaskConfig{Imports: map[string]string{"a": "1"}},
+		},
 		{
 			"tf_version_merges",
 			&TaskConfig{TFVersion: String("0.14.0")},
@@ -473,6 +486,12 @@ func TestTaskConfig_Finalize(t *testing.T) {
 				Module:             String(""),
 				VarFiles:           []string{},
 				Variables:          map[string]string{},
+				Imports:            map[string]string{},
+				PostApply:          DefaultPostApplyConfigs(),
+				PreApply:           DefaultPreApplyConfigs(),
+				Policies:           DefaultPolicyConfigs(),
+				Guardrails:         DefaultGuardrailsConfig(),
+				ChangeWindows:      DefaultChangeWindowConfigs(),
 				Version:            String(""),
 				TFVersion:          String(""),
 				TFCWorkspace:       DefaultTerraformCloudWorkspaceConfig(),
@@ -496,6 +515,12 @@ func TestTaskConfig_Finalize(t *testing.T) {
 				Module:             String(""),
 				VarFiles:           []string{},
 				Variables:          map[string]string{},
+				Imports:            map[string]string{},
+				PostApply:          DefaultPostApplyConfigs(),
+				PreApply:           DefaultPreApplyConfigs(),
+				Policies:           DefaultPolicyConfigs(),
+				Guardrails:         DefaultGuardrailsConfig(),
+				ChangeWindows:      DefaultChangeWindowConfigs(),
 				Version:            String(""),
 				TFVersion:          String(""),
 				TFCWorkspace:       DefaultTerraformCloudWorkspaceConfig(),
@@ -520,6 +545,12 @@ func TestTaskConfig_Finalize(t *testing.T) {
 				Module:             String(""),
 				VarFiles:           []string{},
 				Variables:          map[string]string{},
+				Imports:            map[string]string{},
+				PostApply:          DefaultPostApplyConfigs(),
+				PreApply:           DefaultPreApplyConfigs(),
+				Policies:           DefaultPolicyConfigs(),
+				Guardrails:         DefaultGuardrailsConfig(),
+				ChangeWindows:      DefaultChangeWindowConfigs(),
 				Version:            String(""),
 				TFVersion:          String(""),
 				TFCWorkspace:       DefaultTerraformCloudWorkspaceConfig(),
@@ -529,7 +560,51 @@ func TestTaskConfig_Finalize(t *testing.T) {
 					Max:     TimeDuration(0 * time.Second),
 				},
 				Enabled:      Bool(true),
-				Condition:    &ScheduleConditionConfig{String("")},
+				Condition:    &ScheduleConditionConfig{Cron: String(""), OnChangeInterval: TimeDuration(0)},
+				WorkingDir:   String("sync-tasks/task"),
+				ModuleInputs: DefaultModuleInputConfigs(),
+			},
+		},
+		{
+			"with_all_condition_including_schedule",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &CompositeConditionConfig{
+					Operator: String("all"),
+					Conditions: []ConditionConfig{
+						&ScheduleConditionConfig{},
+					},
+				},
+			},
+			&TaskConfig{
+				Description:        String(""),
+				Name:               String("task"),
+				Providers:          []string{},
+				DeprecatedServices: []string{},
+				Module:             String(""),
+				VarFiles:           []string{},
+				Variables:          map[string]string{},
+				Imports:            map[string]string{},
+				PostApply:          DefaultPostApplyConfigs(),
+				PreApply:           DefaultPreApplyConfigs(),
+				Policies:           DefaultPolicyConfigs(),
+				Guardrails:         DefaultGuardrailsConfig(),
+				ChangeWindows:      DefaultChangeWindowConfigs(),
+				Version:            String(""),
+				TFVersion:          String(""),
+				TFCWorkspace:       DefaultTerraformCloudWorkspaceConfig(),
+				BufferPeriod: &BufferPeriodConfig{
+					Enabled: Bool(false),
+					Min:     TimeDuration(0 * time.Second),
+					Max:     TimeDuration(0 * time.Second),
+				},
+				Enabled: Bool(true),
+				Condition: &CompositeConditionConfig{
+					Operator: String("all"),
+					Conditions: []ConditionConfig{
+						&ScheduleConditionConfig{Cron: String(""), OnChangeInterval: TimeDuration(0)},
+					},
+				},
 				WorkingDir:   String("sync-tasks/task"),
 				ModuleInputs: DefaultModuleInputConfigs(),
 			},
@@ -552,6 +627,12 @@ func TestTaskConfig_Finalize(t *testing.T) {
 				Module:             String(""),
 				VarFiles:           []string{},
 				Variables:          map[string]string{},
+				Imports:            map[string]string{},
+				PostApply:          DefaultPostApplyConfigs(),
+				PreApply:           DefaultPreApplyConfigs(),
+				Policies:           DefaultPolicyConfigs(),
+				Guardrails:         DefaultGuardrailsConfig(),
+				ChangeWindows:      DefaultChangeWindowConfigs(),
 				Version:            String(""),
 				TFVersion:          String(""),
 				TFCWorkspace:       DefaultTerraformCloudWorkspaceConfig(),
@@ -561,16 +642,21 @@ func TestTaskConfig_Finalize(t *testing.T) {
 					Max:     TimeDuration(0 * time.Second),
 				},
 				Enabled:    Bool(true),
-				Condition:  &ScheduleConditionConfig{String("")},
+				Condition:  &ScheduleConditionConfig{Cron: String(""), OnChangeInterval: TimeDuration(0)},
 				WorkingDir: String("sync-tasks/task"),
 				ModuleInputs: &ModuleInputConfigs{&ServicesModuleInputConfig{
 					ServicesMonitorConfig{
 						Regexp:             String("^api$"),
 						Names:              []string{},
 						Datacenter:         String(""),
+						Datacenters:        []string{},
 						Namespace:          String(""),
+						Partition:          String(""),
+						Peer:               String(""),
 						Filter:             String(""),
 						CTSUserDefinedMeta: map[string]string{},
+						TriggerOn:          String("any"),
+						IncludeNonPassing:  Bool(false),
 					}}},
 			},
 		},
@@ -653,6 +739,8 @@ func TestTaskConfig_Finalize_DeprecatedSourceInputs(t *testing.T) {
 						Recurse:    Bool(false),
 						Datacenter: String(""),
 						Namespace:  String(""),
+						Partition:  String(""),
+						Decode:     String(""),
 					},
 				},
 			},
@@ -674,9 +762,14 @@ func TestTaskConfig_Finalize_DeprecatedSourceInputs(t *testing.T) {
 						Regexp:             String(".*"),
 						Names:              []string{},
 						Datacenter:         String(""),
+						Datacenters:        []string{},
 						Namespace:          String(""),
+						Partition:          String(""),
+						Peer:               String(""),
 						Filter:             String(""),
 						CTSUserDefinedMeta: map[string]string{},
+						TriggerOn:          String("any"),
+						IncludeNonPassing:  Bool(false),
 					},
 				},
 			},
@@ -706,6 +799,8 @@ func TestTaskConfig_Finalize_DeprecatedSourceInputs(t *testing.T) {
 						Recurse:    Bool(false),
 						Datacenter: String(""),
 						Namespace:  String(""),
+						Partition:  String(""),
+						Decode:     String(""),
 					},
 				},
 				&ServicesModuleInputConfig{
@@ -713,9 +808,14 @@ func TestTaskConfig_Finalize_DeprecatedSourceInputs(t *testing.T) {
 						Regexp:             String(".*"),
 						Names:              []string{},
 						Datacenter:         String(""),
+						Datacenters:        []string{},
 						Namespace:          String(""),
+						Partition:          String(""),
+						Peer:               String(""),
 						Filter:             String(""),
 						CTSUserDefinedMeta: map[string]string{},
+						TriggerOn:          String("any"),
+						IncludeNonPassing:  Bool(false),
 					},
 				},
 			},
@@ -789,7 +889,55 @@ func TestTaskConfig_Validate(t *testing.T) {
 			false,
 		},
 		{
-			"invalid: TF version: unsupported version",
+			"valid: import",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &ServicesConditionConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"api"},
+					},
+				},
+				Module: String("path"),
+				Imports: map[string]string{
+					"module.task.local_file.greeting": "greeting.txt",
+				},
+			},
+			true,
+		},
+		{
+			"invalid: import: missing id",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &ServicesConditionConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"api"},
+					},
+				},
+				Module: String("path"),
+				Imports: map[string]string{
+					"module.task.local_file.greeting": "",
+				},
+			},
+			false,
+		},
+		{
+			"invalid: import: empty address",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &ServicesConditionConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"api"},
+					},
+				},
+				Module: String("path"),
+				Imports: map[string]string{
+					" ": "greeting.txt",
+				},
+			},
+			false,
+		},
+		{
+			"valid: TF version",
 			&TaskConfig{
 				Name: String("task"),
 				Condition: &ServicesConditionConfig{
@@ -800,10 +948,24 @@ func TestTaskConfig_Validate(t *testing.T) {
 				Module:    String("path"),
 				TFVersion: String("0.15.0"),
 			},
+			true,
+		},
+		{
+			"invalid: TF version: malformed",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &ServicesConditionConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"api"},
+					},
+				},
+				Module:    String("path"),
+				TFVersion: String("latest"),
+			},
 			false,
 		},
 		{
-			"invalid: TFC workspace unsupported",
+			"valid: TFC workspace",
 			&TaskConfig{
 				Name: String("task"),
 				Condition: &ServicesConditionConfig{
@@ -816,6 +978,22 @@ func TestTaskConfig_Validate(t *testing.T) {
 					ExecutionMode: String("remote"),
 				},
 			},
+			true,
+		},
+		{
+			"invalid: TFC workspace: execution mode",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &ServicesConditionConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"api"},
+					},
+				},
+				Module: String("path"),
+				TFCWorkspace: &TerraformCloudWorkspaceConfig{
+					ExecutionMode: String("local"),
+				},
+			},
 			false,
 		},
 		{
@@ -975,7 +1153,7 @@ func TestTasksConfig_Validate(t *testing.T) {
 			},
 			isValid: false,
 		}, {
-			name: "unsupported TF version per task",
+			name: "invalid TF version per task",
 			i: []*TaskConfig{
 				{
 					Name: String("task"),
@@ -985,7 +1163,7 @@ func TestTasksConfig_Validate(t *testing.T) {
 						},
 					},
 					Module:    String("path"),
-					TFVersion: String("0.15.0"),
+					TFVersion: String("latest"),
 				},
 			},
 			isValid: false,
@@ -1047,6 +1225,20 @@ func TestTaskConfig_validateCondition(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid: services & composite cond-block with services configured",
+			&TaskConfig{
+				DeprecatedServices: []string{"api"},
+				Condition: &CompositeConditionConfig{
+					Operator: String("any"),
+					Conditions: []ConditionConfig{
+						&ConsulKVConditionConfig{},
+						&ServicesConditionConfig{},
+					},
+				},
+			},
+			false,
+		},
 	}
 
 	for i, tc := range cases {
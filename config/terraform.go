@@ -1,7 +1,9 @@
 package config
 
 import (
+	"encoding/hex"
 	"fmt"
+	"net/url"
 	"os"
 	"path"
 	"strings"
@@ -18,9 +20,20 @@ const (
 	logSystemName          = "config"
 )
 
+// Supported flavors of the Terraform CLI for the Terraform driver
+const (
+	TerraformFlavorTerraform = "terraform"
+	TerraformFlavorOpenTofu  = "opentofu"
+)
+
 // TerraformConfig is the configuration for the Terraform driver.
 type TerraformConfig struct {
 	Version           *string                `mapstructure:"version"`
+	Flavor            *string                `mapstructure:"flavor"`
+	MirrorURL         *string                `mapstructure:"mirror_url"`
+	Archive           *string                `mapstructure:"archive"`
+	SHA256            *string                `mapstructure:"sha256"`
+	SignatureKey      *string                `mapstructure:"signature_key"`
 	Log               *bool                  `mapstructure:"log"`
 	PersistLog        *bool                  `mapstructure:"persist_log"`
 	Path              *string                `mapstructure:"path"`
@@ -101,6 +114,26 @@ func (c *TerraformConfig) Copy() *TerraformConfig {
 		o.Version = StringCopy(c.Version)
 	}
 
+	if c.Flavor != nil {
+		o.Flavor = StringCopy(c.Flavor)
+	}
+
+	if c.MirrorURL != nil {
+		o.MirrorURL = StringCopy(c.MirrorURL)
+	}
+
+	if c.Archive != nil {
+		o.Archive = StringCopy(c.Archive)
+	}
+
+	if c.SHA256 != nil {
+		o.SHA256 = StringCopy(c.SHA256)
+	}
+
+	if c.SignatureKey != nil {
+		o.SignatureKey = StringCopy(c.SignatureKey)
+	}
+
 	if c.Log != nil {
 		o.Log = BoolCopy(c.Log)
 	}
@@ -152,6 +185,26 @@ func (c *TerraformConfig) Merge(o *TerraformConfig) *TerraformConfig {
 		r.Version = StringCopy(o.Version)
 	}
 
+	if o.Flavor != nil {
+		r.Flavor = StringCopy(o.Flavor)
+	}
+
+	if o.MirrorURL != nil {
+		r.MirrorURL = StringCopy(o.MirrorURL)
+	}
+
+	if o.Archive != nil {
+		r.Archive = StringCopy(o.Archive)
+	}
+
+	if o.SHA256 != nil {
+		r.SHA256 = StringCopy(o.SHA256)
+	}
+
+	if o.SignatureKey != nil {
+		r.SignatureKey = StringCopy(o.SignatureKey)
+	}
+
 	if o.Log != nil {
 		r.Log = BoolCopy(o.Log)
 	}
@@ -206,6 +259,26 @@ func (c *TerraformConfig) Finalize(consul *ConsulConfig) {
 		c.Version = String("")
 	}
 
+	if c.Flavor == nil || *c.Flavor == "" {
+		c.Flavor = String(TerraformFlavorTerraform)
+	}
+
+	if c.MirrorURL == nil {
+		c.MirrorURL = String("")
+	}
+
+	if c.Archive == nil {
+		c.Archive = String("")
+	}
+
+	if c.SHA256 == nil {
+		c.SHA256 = String("")
+	}
+
+	if c.SignatureKey == nil {
+		c.SignatureKey = String("")
+	}
+
 	if c.Log == nil {
 		c.Log = Bool(false)
 	}
@@ -242,6 +315,14 @@ func (c *TerraformConfig) Validate() error {
 		return fmt.Errorf("missing Terraform driver configuration")
 	}
 
+	switch StringVal(c.Flavor) {
+	case "", TerraformFlavorTerraform, TerraformFlavorOpenTofu:
+	default:
+		return fmt.Errorf("unsupported Terraform driver flavor %q, must be "+
+			"either %q or %q", *c.Flavor, TerraformFlavorTerraform,
+			TerraformFlavorOpenTofu)
+	}
+
 	if c.Version != nil && *c.Version != "" {
 		v, err := goVersion.NewSemver(*c.Version)
 		if err != nil {
@@ -249,13 +330,43 @@ func (c *TerraformConfig) Validate() error {
 		}
 
 		if len(strings.Split(*c.Version, ".")) < 3 {
-			return fmt.Errorf("provide the exact Terraform version to install: %s", *c.Version)
+			return fmt.Errorf("provide the exact %s version to install: %s",
+				c.ProductName(), *c.Version)
 		}
 
-		if !ctsVersion.TerraformConstraint.Check(v) {
-			return fmt.Errorf("Terraform version is not supported by Consul "+
+		if !c.VersionConstraint().Check(v) {
+			return fmt.Errorf("%s version is not supported by Consul "+
 				"Terraform Sync, try updating to a different version (%s): %s",
-				ctsVersion.CompatibleTerraformVersionConstraint, *c.Version)
+				c.ProductName(), c.CompatibleVersionConstraint(), *c.Version)
+		}
+	}
+
+	if StringVal(c.MirrorURL) != "" && StringVal(c.Archive) != "" {
+		return fmt.Errorf("only one of 'mirror_url' or 'archive' can be " +
+			"configured to install the Terraform driver binary")
+	}
+
+	if StringVal(c.Archive) != "" && StringVal(c.SHA256) == "" &&
+		StringVal(c.SignatureKey) == "" {
+		return fmt.Errorf("'sha256' or 'signature_key' must be configured " +
+			"to verify the Terraform driver 'archive'")
+	}
+
+	if sum := StringVal(c.SHA256); sum != "" {
+		if b, err := hex.DecodeString(sum); err != nil || len(b) != 32 {
+			return fmt.Errorf("invalid Terraform driver 'sha256' %q, expected "+
+				"a hex encoded SHA256 checksum", sum)
+		}
+	}
+
+	if mirror := StringVal(c.MirrorURL); mirror != "" {
+		u, err := url.Parse(mirror)
+		if err != nil {
+			return fmt.Errorf("invalid Terraform driver 'mirror_url': %s", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid Terraform driver 'mirror_url' %q, "+
+				"expected an http or https URL", mirror)
 		}
 	}
 
@@ -293,6 +404,11 @@ func (c *TerraformConfig) GoString() string {
 
 	return fmt.Sprintf("&TerraformConfig{"+
 		"Version:%s, "+
+		"Flavor:%s, "+
+		"MirrorURL:%s, "+
+		"Archive:%s, "+
+		"SHA256:%s, "+
+		"SignatureKey:%s, "+
 		"Log:%v, "+
 		"PersistLog:%v, "+
 		"Path:%s, "+
@@ -300,6 +416,11 @@ func (c *TerraformConfig) GoString() string {
 		"RequiredProviders:%+v"+
 		"}",
 		StringVal(c.Version),
+		StringVal(c.Flavor),
+		StringVal(c.MirrorURL),
+		StringVal(c.Archive),
+		StringVal(c.SHA256),
+		StringVal(c.SignatureKey),
 		BoolVal(c.Log),
 		BoolVal(c.PersistLog),
 		StringVal(c.Path),
@@ -311,7 +432,7 @@ func (c *TerraformConfig) GoString() string {
 // IsConsulBackend returns if the Terraform backend is using Consul KV for
 // remote state store.
 func (c *TerraformConfig) IsConsulBackend() bool {
-	if c.Backend == nil {
+	if c == nil || c.Backend == nil {
 		return false
 	}
 
@@ -319,6 +440,47 @@ func (c *TerraformConfig) IsConsulBackend() bool {
 	return ok
 }
 
+// IsOpenTofu returns if the Terraform driver is configured to use OpenTofu
+// instead of the HashiCorp Terraform CLI.
+func (c *TerraformConfig) IsOpenTofu() bool {
+	return c != nil && StringVal(c.Flavor) == TerraformFlavorOpenTofu
+}
+
+// ProductName returns the human readable name of the configured flavor.
+func (c *TerraformConfig) ProductName() string {
+	if c.IsOpenTofu() {
+		return "OpenTofu"
+	}
+	return "Terraform"
+}
+
+// BinaryName returns the file name of the CLI binary for the configured
+// flavor.
+func (c *TerraformConfig) BinaryName() string {
+	if c.IsOpenTofu() {
+		return "tofu"
+	}
+	return "terraform"
+}
+
+// CompatibleVersionConstraint returns the version constraint supported by
+// CTS for the configured flavor.
+func (c *TerraformConfig) CompatibleVersionConstraint() string {
+	if c.IsOpenTofu() {
+		return ctsVersion.CompatibleOpenTofuVersionConstraint
+	}
+	return ctsVersion.CompatibleTerraformVersionConstraint
+}
+
+// VersionConstraint returns the go-version constraint for
+// CompatibleVersionConstraint.
+func (c *TerraformConfig) VersionConstraint() goVersion.Constraints {
+	if c.IsOpenTofu() {
+		return ctsVersion.OpenTofuConstraint
+	}
+	return ctsVersion.TerraformConstraint
+}
+
 func mergeMaps(c, o map[string]interface{}) map[string]interface{} {
 	r := make(map[string]interface{})
 	for k, v := range c {
@@ -0,0 +1,193 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// DefaultTFCHostname is the default hostname for Terraform Cloud.
+const DefaultTFCHostname = "app.terraform.io"
+
+// TerraformCloudConfig is the configuration for the Terraform Cloud driver.
+// Tasks are executed as remote runs within a Terraform Cloud or Terraform
+// Enterprise workspace per task.
+type TerraformCloudConfig struct {
+	// Hostname is the Terraform Cloud or Terraform Enterprise host. A scheme
+	// may be included, otherwise HTTPS is used.
+	Hostname *string `mapstructure:"hostname"`
+
+	// Organization is the name of the organization the task workspaces
+	// belong to.
+	Organization *string `mapstructure:"organization"`
+
+	// Token is the API token used to authenticate with Terraform Cloud.
+	Token *string `mapstructure:"token"`
+
+	// WorkspacePrefix is prepended to the task name to determine the name of
+	// the workspace for a task.
+	WorkspacePrefix *string `mapstructure:"workspace_prefix"`
+
+	// RequiredProviders is the map of provider names to source and version
+	// constraints for the generated root module of each task.
+	RequiredProviders map[string]interface{} `mapstructure:"required_providers"`
+}
+
+// DefaultTerraformCloudConfig returns the default configuration struct.
+func DefaultTerraformCloudConfig() *TerraformCloudConfig {
+	return &TerraformCloudConfig{
+		Hostname:          String(DefaultTFCHostname),
+		Organization:      String(""),
+		Token:             String(""),
+		WorkspacePrefix:   String(""),
+		RequiredProviders: make(map[string]interface{}),
+	}
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *TerraformCloudConfig) Copy() *TerraformCloudConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o TerraformCloudConfig
+	o.Hostname = StringCopy(c.Hostname)
+	o.Organization = StringCopy(c.Organization)
+	o.Token = StringCopy(c.Token)
+	o.WorkspacePrefix = StringCopy(c.WorkspacePrefix)
+
+	if c.RequiredProviders != nil {
+		o.RequiredProviders = make(map[string]interface{})
+		for k, v := range c.RequiredProviders {
+			o.RequiredProviders[k] = v
+		}
+	}
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten.
+func (c *TerraformCloudConfig) Merge(o *TerraformCloudConfig) *TerraformCloudConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Hostname != nil {
+		r.Hostname = StringCopy(o.Hostname)
+	}
+
+	if o.Organization != nil {
+		r.Organization = StringCopy(o.Organization)
+	}
+
+	if o.Token != nil {
+		r.Token = StringCopy(o.Token)
+	}
+
+	if o.WorkspacePrefix != nil {
+		r.WorkspacePrefix = StringCopy(o.WorkspacePrefix)
+	}
+
+	for k, v := range o.RequiredProviders {
+		if r.RequiredProviders == nil {
+			r.RequiredProviders = make(map[string]interface{})
+		}
+		r.RequiredProviders[k] = v
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *TerraformCloudConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Hostname == nil || *c.Hostname == "" {
+		c.Hostname = String(DefaultTFCHostname)
+	}
+
+	if c.Organization == nil {
+		c.Organization = String("")
+	}
+
+	if c.Token == nil {
+		c.Token = String("")
+	}
+
+	if c.WorkspacePrefix == nil {
+		c.WorkspacePrefix = String("")
+	}
+
+	if c.RequiredProviders == nil {
+		c.RequiredProviders = make(map[string]interface{})
+	}
+}
+
+// Validate validates the values and nested values of the configuration struct
+func (c *TerraformCloudConfig) Validate() error {
+	if c == nil {
+		return fmt.Errorf("missing Terraform Cloud driver configuration")
+	}
+
+	if StringVal(c.Organization) == "" {
+		return fmt.Errorf("organization is required for the Terraform Cloud driver")
+	}
+
+	if StringVal(c.Token) == "" {
+		return fmt.Errorf("token is required for the Terraform Cloud driver")
+	}
+
+	if _, err := url.Parse(c.Address()); err != nil {
+		return fmt.Errorf("invalid hostname for the Terraform Cloud driver: %s", err)
+	}
+
+	return nil
+}
+
+// Address returns the base URL of the Terraform Cloud API host. HTTPS is used
+// when the hostname is not configured with a scheme.
+func (c *TerraformCloudConfig) Address() string {
+	hostname := StringVal(c.Hostname)
+	if hostname == "" {
+		hostname = DefaultTFCHostname
+	}
+	if !strings.Contains(hostname, "://") {
+		hostname = "https://" + hostname
+	}
+	return strings.TrimSuffix(hostname, "/")
+}
+
+// GoString defines the printable version of this struct.
+// Sensitive information is redacted.
+func (c *TerraformCloudConfig) GoString() string {
+	if c == nil {
+		return "(*TerraformCloudConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&TerraformCloudConfig{"+
+		"Hostname:%s, "+
+		"Organization:%s, "+
+		"Token:%s, "+
+		"WorkspacePrefix:%s, "+
+		"RequiredProviders:%+v"+
+		"}",
+		StringVal(c.Hostname),
+		StringVal(c.Organization),
+		sensitiveGoString(c.Token),
+		StringVal(c.WorkspacePrefix),
+		c.RequiredProviders,
+	)
+}
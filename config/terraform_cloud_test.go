@@ -0,0 +1,188 @@
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerraformCloudConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *TerraformCloudConfig
+	}{
+		{
+			"nil",
+			nil,
+		}, {
+			"empty",
+			&TerraformCloudConfig{},
+		}, {
+			"same_enabled",
+			&TerraformCloudConfig{
+				Hostname:        String("tfe.example.com"),
+				Organization:    String("org"),
+				Token:           String("token"),
+				WorkspacePrefix: String("cts-"),
+				RequiredProviders: map[string]interface{}{
+					"pName1": map[string]string{
+						"version": "v0.0.0",
+						"source":  "namespace/pName1",
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestTerraformCloudConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *TerraformCloudConfig
+		b    *TerraformCloudConfig
+		r    *TerraformCloudConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&TerraformCloudConfig{},
+			&TerraformCloudConfig{},
+		},
+		{
+			"nil_b",
+			&TerraformCloudConfig{},
+			nil,
+			&TerraformCloudConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"hostname_overrides",
+			&TerraformCloudConfig{Hostname: String("tfe.example.com")},
+			&TerraformCloudConfig{Hostname: String("app.terraform.io")},
+			&TerraformCloudConfig{Hostname: String("app.terraform.io")},
+		},
+		{
+			"organization_empty_one",
+			&TerraformCloudConfig{Organization: String("org")},
+			&TerraformCloudConfig{},
+			&TerraformCloudConfig{Organization: String("org")},
+		},
+		{
+			"token_empty_two",
+			&TerraformCloudConfig{},
+			&TerraformCloudConfig{Token: String("token")},
+			&TerraformCloudConfig{Token: String("token")},
+		},
+		{
+			"workspace_prefix_overrides",
+			&TerraformCloudConfig{WorkspacePrefix: String("a-")},
+			&TerraformCloudConfig{WorkspacePrefix: String("b-")},
+			&TerraformCloudConfig{WorkspacePrefix: String("b-")},
+		},
+		{
+			"required_providers_merge",
+			&TerraformCloudConfig{RequiredProviders: map[string]interface{}{"a": "1"}},
+			&TerraformCloudConfig{RequiredProviders: map[string]interface{}{"b": "2"}},
+			&TerraformCloudConfig{RequiredProviders: map[string]interface{}{"a": "1", "b": "2"}},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestTerraformCloudConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	c := &TerraformCloudConfig{}
+	c.Finalize()
+	assert.Equal(t, DefaultTerraformCloudConfig(), c)
+}
+
+func TestTerraformCloudConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		i       *TerraformCloudConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			false,
+		}, {
+			"valid",
+			&TerraformCloudConfig{
+				Organization: String("org"),
+				Token:        String("token"),
+			},
+			true,
+		}, {
+			"missing organization",
+			&TerraformCloudConfig{
+				Token: String("token"),
+			},
+			false,
+		}, {
+			"missing token",
+			&TerraformCloudConfig{
+				Organization: String("org"),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTerraformCloudConfig_Address(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		hostname *string
+		expected string
+	}{
+		{"default", nil, "https://app.terraform.io"},
+		{"hostname", String("tfe.example.com"), "https://tfe.example.com"},
+		{"scheme", String("http://127.0.0.1:8080/"), "http://127.0.0.1:8080"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &TerraformCloudConfig{Hostname: tc.hostname}
+			assert.Equal(t, tc.expected, c.Address())
+		})
+	}
+}
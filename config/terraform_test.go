@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	ctsVersion "github.com/hashicorp/consul-terraform-sync/version"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -25,8 +26,10 @@ func TestTerraformConfig_Copy(t *testing.T) {
 		}, {
 			"same_enabled",
 			&TerraformConfig{
-				Log:  Bool(true),
-				Path: String("path"),
+				Flavor:    String(TerraformFlavorOpenTofu),
+				MirrorURL: String("https://mirror.example.com"),
+				Log:       Bool(true),
+				Path:      String("path"),
 				Backend: map[string]interface{}{"consul": map[string]interface{}{
 					"path": "consul-terraform-sync/terraform",
 				}},
@@ -393,6 +396,11 @@ func TestTerraformConfig_Finalize(t *testing.T) {
 			nil,
 			&TerraformConfig{
 				Version:           String(""),
+				Flavor:            String(TerraformFlavorTerraform),
+				MirrorURL:         String(""),
+				Archive:           String(""),
+				SHA256:            String(""),
+				SignatureKey:      String(""),
 				Log:               Bool(false),
 				PersistLog:        Bool(false),
 				Path:              String(wd),
@@ -405,10 +413,15 @@ func TestTerraformConfig_Finalize(t *testing.T) {
 			&TerraformConfig{},
 			consul,
 			&TerraformConfig{
-				Version:    String(""),
-				Log:        Bool(false),
-				PersistLog: Bool(false),
-				Path:       String(wd),
+				Version:      String(""),
+				Flavor:       String(TerraformFlavorTerraform),
+				MirrorURL:    String(""),
+				Archive:      String(""),
+				SHA256:       String(""),
+				SignatureKey: String(""),
+				Log:          Bool(false),
+				PersistLog:   Bool(false),
+				Path:         String(wd),
 				Backend: map[string]interface{}{
 					"consul": map[string]interface{}{
 						"address": *consul.Address,
@@ -430,10 +443,15 @@ func TestTerraformConfig_Finalize(t *testing.T) {
 				},
 			},
 			&TerraformConfig{
-				Version:    String(""),
-				Log:        Bool(false),
-				PersistLog: Bool(false),
-				Path:       String(wd),
+				Version:      String(""),
+				Flavor:       String(TerraformFlavorTerraform),
+				MirrorURL:    String(""),
+				Archive:      String(""),
+				SHA256:       String(""),
+				SignatureKey: String(""),
+				Log:          Bool(false),
+				PersistLog:   Bool(false),
+				Path:         String(wd),
 				Backend: map[string]interface{}{
 					"consul": map[string]interface{}{
 						"address":   *consul.Address,
@@ -456,10 +474,15 @@ func TestTerraformConfig_Finalize(t *testing.T) {
 				KVPath:  String("custom-path"),
 			},
 			&TerraformConfig{
-				Version:    String(""),
-				Log:        Bool(false),
-				PersistLog: Bool(false),
-				Path:       String(wd),
+				Version:      String(""),
+				Flavor:       String(TerraformFlavorTerraform),
+				MirrorURL:    String(""),
+				Archive:      String(""),
+				SHA256:       String(""),
+				SignatureKey: String(""),
+				Log:          Bool(false),
+				PersistLog:   Bool(false),
+				Path:         String(wd),
 				Backend: map[string]interface{}{
 					"consul": map[string]interface{}{
 						"address": "127.0.0.1:8080",
@@ -473,14 +496,24 @@ func TestTerraformConfig_Finalize(t *testing.T) {
 		{
 			"terraform path empty string",
 			&TerraformConfig{
-				Version:    String(""),
-				Log:        Bool(false),
-				PersistLog: Bool(false),
-				Path:       String(""),
+				Version:      String(""),
+				Flavor:       String(TerraformFlavorTerraform),
+				MirrorURL:    String(""),
+				Archive:      String(""),
+				SHA256:       String(""),
+				SignatureKey: String(""),
+				Log:          Bool(false),
+				PersistLog:   Bool(false),
+				Path:         String(""),
 			},
 			nil,
 			&TerraformConfig{
 				Version:           String(""),
+				Flavor:            String(TerraformFlavorTerraform),
+				MirrorURL:         String(""),
+				Archive:           String(""),
+				SHA256:            String(""),
+				SignatureKey:      String(""),
 				Log:               Bool(false),
 				PersistLog:        Bool(false),
 				Path:              String(wd),
@@ -534,6 +567,102 @@ func TestTerraformConfig_Validate(t *testing.T) {
 			"backend_invalid",
 			&TerraformConfig{Backend: map[string]interface{}{"unsupported": nil}},
 			false,
+		}, {
+			"valid terraform version",
+			&TerraformConfig{
+				Version: String("1.1.0"),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			true,
+		}, {
+			"unsupported terraform version",
+			&TerraformConfig{
+				Version: String("1.6.0"),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			false,
+		}, {
+			"valid opentofu version",
+			&TerraformConfig{
+				Version: String("1.6.0"),
+				Flavor:  String(TerraformFlavorOpenTofu),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			true,
+		}, {
+			"unsupported opentofu version",
+			&TerraformConfig{
+				Version: String("1.1.0"),
+				Flavor:  String(TerraformFlavorOpenTofu),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			false,
+		}, {
+			"unsupported flavor",
+			&TerraformConfig{
+				Flavor:  String("terragrunt"),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			false,
+		}, {
+			"valid mirror_url",
+			&TerraformConfig{
+				MirrorURL: String("https://mirror.example.com/opentofu"),
+				Backend:   map[string]interface{}{"local": nil},
+			},
+			true,
+		}, {
+			"invalid mirror_url scheme",
+			&TerraformConfig{
+				MirrorURL: String("ftp://mirror.example.com"),
+				Backend:   map[string]interface{}{"local": nil},
+			},
+			false,
+		}, {
+			"valid sha256",
+			&TerraformConfig{
+				SHA256:  String("9f2d8d4d1fd1a5f8c0c8e1d1b8d1e8e9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5"),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			true,
+		}, {
+			"invalid sha256",
+			&TerraformConfig{
+				SHA256:  String("abc"),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			false,
+		}, {
+			"mirror_url and archive",
+			&TerraformConfig{
+				MirrorURL: String("https://mirror.example.com"),
+				Archive:   String("/tmp/tofu.zip"),
+				Backend:   map[string]interface{}{"local": nil},
+			},
+			false,
+		}, {
+			"archive with sha256",
+			&TerraformConfig{
+				Archive: String("/tmp/tofu.zip"),
+				SHA256:  String("9f2d8d4d1fd1a5f8c0c8e1d1b8d1e8e9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5"),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			true,
+		}, {
+			"archive with signature_key",
+			&TerraformConfig{
+				Archive:      String("/tmp/tofu.zip"),
+				SignatureKey: String("/tmp/key.asc"),
+				Backend:      map[string]interface{}{"local": nil},
+			},
+			true,
+		}, {
+			"archive without verification",
+			&TerraformConfig{
+				Archive: String("/tmp/tofu.zip"),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			false,
 		},
 	}
 
@@ -640,3 +769,38 @@ func TestDefaultTerraformBackend(t *testing.T) {
 		})
 	}
 }
+
+func TestTerraformConfig_Flavor(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		c          *TerraformConfig
+		binary     string
+		constraint string
+	}{
+		{
+			"nil",
+			nil,
+			"terraform",
+			ctsVersion.CompatibleTerraformVersionConstraint,
+		}, {
+			"terraform",
+			&TerraformConfig{Flavor: String(TerraformFlavorTerraform)},
+			"terraform",
+			ctsVersion.CompatibleTerraformVersionConstraint,
+		}, {
+			"opentofu",
+			&TerraformConfig{Flavor: String(TerraformFlavorOpenTofu)},
+			"tofu",
+			ctsVersion.CompatibleOpenTofuVersionConstraint,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.binary, tc.c.BinaryName())
+			assert.Equal(t, tc.constraint, tc.c.CompatibleVersionConstraint())
+		})
+	}
+}
@@ -12,6 +12,12 @@ buffer_period {
   max = "60s"
 }
 
+change_window {
+  type = "deny"
+  cron = "0 0 24 12 *"
+  duration = "48h"
+}
+
 tls {
   enabled = true
   cert = "../testutils/certs/consul_cert.pem"
@@ -83,6 +89,38 @@ task {
   services = ["serviceA", "serviceB", "serviceC"]
   providers = ["X"]
   module = "Y"
+  import {
+    "module.task.x_resource.a" = "id-a"
+  }
+  post_apply {
+    command = ["./reload.sh", "--all"]
+    timeout = "10s"
+    retries = 2
+  }
+  pre_apply {
+    max_destroy = 0
+  }
+  pre_apply {
+    url = "https://lock.example.com/acquire"
+    headers = {
+      "X-Token" = "token"
+    }
+  }
+  policy {
+    name = "no-deletes"
+    expression = "plan.resource_changes.all(rc, !('delete' in rc.change.actions))"
+    enforcement_level = "advisory"
+    message = "resources must not be deleted"
+  }
+  guardrails {
+    max_destroy = 2
+    max_change_percent = 50
+  }
+  change_window {
+    type = "allow"
+    cron = "0 22 * * 1-5"
+    duration = "4h"
+  }
   condition "catalog-services" {
     regexp = ".*"
     use_as_module_input = true
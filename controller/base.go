@@ -96,6 +96,7 @@ func (ctrl *baseController) init(ctx context.Context) error {
 			ctrl.logger.Error("error initializing task", taskNameLogKey, taskName)
 			return err
 		}
+		ctrl.restoreTaskBlock(d.Task())
 
 		err = ctrl.drivers.Add(taskName, d)
 		if err != nil {
@@ -109,6 +110,51 @@ func (ctrl *baseController) init(ctx context.Context) error {
 	return nil
 }
 
+// storeTaskBlock persists the apply that the task's guardrails blocked to the
+// state store so that the task stays blocked when its driver is recreated. The
+// stored block is removed once the task is no longer blocked.
+func (ctrl *baseController) storeTaskBlock(task *driver.Task) {
+	b, ok := task.Blocked()
+	if !ok {
+		ctrl.state.DeleteTaskBlock(task.Name())
+		return
+	}
+
+	err := ctrl.state.SetTaskBlock(state.Block{
+		TaskName: task.Name(),
+		Reason:   b.Reason,
+		Changes:  b.Changes.Changes,
+		Destroy:  b.Changes.Destroy,
+		Existing: b.Changes.Existing,
+		Managed:  b.Changes.Managed,
+	})
+	if err != nil {
+		ctrl.logger.Error("error storing blocked apply", taskNameLogKey,
+			task.Name(), "error", err)
+	}
+}
+
+// restoreTaskBlock blocks a newly created task if the state store has a
+// blocked apply for it
+func (ctrl *baseController) restoreTaskBlock(task *driver.Task) {
+	b, ok := ctrl.state.GetTaskBlock(task.Name())
+	if !ok {
+		return
+	}
+
+	task.Block(driver.Blocked{
+		Reason: b.Reason,
+		Changes: driver.PlanChanges{
+			Changes:  b.Changes,
+			Destroy:  b.Destroy,
+			Existing: b.Existing,
+			Managed:  b.Managed,
+		},
+	})
+	ctrl.logger.Info("task is blocked by its guardrails", taskNameLogKey,
+		task.Name(), "reason", b.Reason)
+}
+
 func (ctrl *baseController) createNewTaskDriver(taskConfig config.TaskConfig) (driver.Driver, error) {
 	logger := ctrl.logger.With("task_name", *taskConfig.Name)
 	logger.Trace("creating new task driver")
@@ -175,7 +221,10 @@ func (ctrl *baseController) logDepSize(n uint, i int64) {
 // newDriverFunc is a constructor abstraction for all of supported drivers
 func newDriverFunc(conf *config.Config) (
 	func(conf *config.Config, task *driver.Task, w templates.Watcher) (driver.Driver, error), error) {
-	if conf.Driver.Terraform != nil {
+	switch {
+	case conf.Driver.TerraformCloud != nil:
+		return newTerraformCloudDriver, nil
+	case conf.Driver.Terraform != nil:
 		return newTerraformDriver, nil
 	}
 	return nil, errors.New("unsupported driver")
@@ -194,6 +243,24 @@ func newTerraformDriver(conf *config.Config, task *driver.Task, w templates.Watc
 		Backend:           tfConf.Backend,
 		RequiredProviders: tfConf.RequiredProviders,
 		ClientType:        *conf.ClientType,
+		ExecName:          tfConf.BinaryName(),
+		RequiredVersion:   tfConf.CompatibleVersionConstraint(),
+	})
+}
+
+// newTerraformCloudDriver maps user configuration to initialize a Terraform
+// Cloud driver for a task
+func newTerraformCloudDriver(conf *config.Config, task *driver.Task, w templates.Watcher) (driver.Driver, error) {
+	tfcConf := *conf.Driver.TerraformCloud
+	return driver.NewTerraformCloud(&driver.TerraformCloudConfig{
+		Task:              task,
+		Watcher:           w,
+		Address:           tfcConf.Address(),
+		Organization:      *tfcConf.Organization,
+		Token:             *tfcConf.Token,
+		WorkspacePrefix:   *tfcConf.WorkspacePrefix,
+		RequiredProviders: tfcConf.RequiredProviders,
+		ClientType:        *conf.ClientType,
 	})
 }
 
@@ -210,7 +277,13 @@ func newDriverTask(conf *config.Config, taskConfig *config.TaskConfig,
 		services[si] = getService(conf.DeprecatedServices, service, meta)
 	}
 
-	tfConf := conf.Driver.Terraform
+	var requiredProviders map[string]interface{}
+	switch {
+	case conf.Driver.TerraformCloud != nil:
+		requiredProviders = conf.Driver.TerraformCloud.RequiredProviders
+	case conf.Driver.Terraform != nil:
+		requiredProviders = conf.Driver.Terraform.RequiredProviders
+	}
 
 	providers := make(driver.TerraformProviderBlocks, len(taskConfig.Providers))
 	providerInfo := make(map[string]interface{})
@@ -220,10 +293,8 @@ func newDriverTask(conf *config.Config, taskConfig *config.TaskConfig,
 		// This is Terraform specific to pass version and source info for
 		// providers from the required_provider block
 		name, _ := splitProviderID(providerID)
-		if tfConf != nil {
-			if pInfo, ok := tfConf.RequiredProviders[name]; ok {
-				providerInfo[name] = pInfo
-			}
+		if pInfo, ok := requiredProviders[name]; ok {
+			providerInfo[name] = pInfo
 		}
 	}
 
@@ -235,6 +306,11 @@ func newDriverTask(conf *config.Config, taskConfig *config.TaskConfig,
 		}
 	}
 
+	var globalWindows config.ChangeWindowConfigs
+	if conf.ChangeWindows != nil {
+		globalWindows = *conf.ChangeWindows
+	}
+
 	task, err := driver.NewTask(driver.TaskConfig{
 		Description:  *taskConfig.Description,
 		Name:         *taskConfig.Name,
@@ -247,11 +323,19 @@ func newDriverTask(conf *config.Config, taskConfig *config.TaskConfig,
 		VarFiles:     taskConfig.VarFiles,
 		Version:      *taskConfig.Version,
 		Variables:    taskConfig.Variables,
+		Imports:      taskConfig.Imports,
+		PostApply:    *taskConfig.PostApply,
+		PreApply:     *taskConfig.PreApply,
+		Policies:     *taskConfig.Policies,
+		Guardrails:   *taskConfig.Guardrails,
 		BufferPeriod: bp,
 		Condition:    taskConfig.Condition,
 		ModuleInputs: *taskConfig.ModuleInputs,
 		WorkingDir:   *taskConfig.WorkingDir,
 
+		ChangeWindows:       *taskConfig.ChangeWindows,
+		GlobalChangeWindows: globalWindows,
+
 		// Enterprise
 		TFVersion:    *taskConfig.TFVersion,
 		TFCWorkspace: *taskConfig.TFCWorkspace,
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/consul-terraform-sync/driver"
 	"github.com/hashicorp/consul-terraform-sync/logging"
 	mocksD "github.com/hashicorp/consul-terraform-sync/mocks/driver"
+	"github.com/hashicorp/consul-terraform-sync/state"
 	"github.com/hashicorp/consul-terraform-sync/templates"
 	"github.com/hashicorp/consul-terraform-sync/templates/hcltmpl"
 	"github.com/stretchr/testify/assert"
@@ -48,8 +49,10 @@ func TestBaseControllerInit(t *testing.T) {
 			d := new(mocksD.Driver)
 			d.On("TemplateIDs").Return(nil)
 			d.On("InitTask", mock.Anything).Return(tc.initTaskErr).Once()
+			d.On("Task").Return(enabledTestTask(t, "task"))
 
 			baseCtrl := baseController{
+				state: state.NewInMemoryStore(tc.config),
 				newDriver: func(*config.Config, *driver.Task, templates.Watcher) (driver.Driver, error) {
 					return d, nil
 				},
@@ -72,6 +75,89 @@ func TestBaseControllerInit(t *testing.T) {
 	}
 }
 
+func TestBaseController_TaskBlock(t *testing.T) {
+	t.Parallel()
+
+	conf := singleTaskConfig()
+	blocked := driver.Blocked{
+		Reason: "plan destroys 2 resources, exceeding max_destroy 1",
+		Changes: driver.PlanChanges{
+			Changes:  3,
+			Destroy:  2,
+			Existing: 2,
+			Managed:  4,
+		},
+	}
+
+	t.Run("store and restore", func(t *testing.T) {
+		ctrl := baseController{
+			state:  state.NewInMemoryStore(conf),
+			logger: logging.NewNullLogger(),
+		}
+
+		// Blocked task is stored
+		task := enabledTestTask(t, "task")
+		task.Block(blocked)
+		ctrl.storeTaskBlock(task)
+		b, ok := ctrl.state.GetTaskBlock("task")
+		require.True(t, ok)
+		assert.Equal(t, state.Block{
+			TaskName: "task",
+			Reason:   blocked.Reason,
+			Changes:  3,
+			Destroy:  2,
+			Existing: 2,
+			Managed:  4,
+		}, b)
+
+		// Recreated task is blocked again
+		recreated := enabledTestTask(t, "task")
+		ctrl.restoreTaskBlock(recreated)
+		actual, ok := recreated.Blocked()
+		require.True(t, ok)
+		assert.Equal(t, blocked, actual)
+
+		// Unblocked task is removed from the store
+		recreated.Unblock()
+		ctrl.storeTaskBlock(recreated)
+		_, ok = ctrl.state.GetTaskBlock("task")
+		assert.False(t, ok)
+	})
+
+	t.Run("init restores block", func(t *testing.T) {
+		task := enabledTestTask(t, "task")
+		d := new(mocksD.Driver)
+		d.On("TemplateIDs").Return(nil)
+		d.On("InitTask", mock.Anything).Return(nil).Once()
+		d.On("Task").Return(task)
+
+		ctrl := baseController{
+			state: state.NewInMemoryStore(conf),
+			newDriver: func(*config.Config, *driver.Task, templates.Watcher) (driver.Driver, error) {
+				return d, nil
+			},
+			drivers:  driver.NewDrivers(),
+			initConf: conf,
+			logger:   logging.NewNullLogger(),
+		}
+		err := ctrl.state.SetTaskBlock(state.Block{
+			TaskName: "task",
+			Reason:   blocked.Reason,
+			Changes:  3,
+			Destroy:  2,
+			Existing: 2,
+			Managed:  4,
+		})
+		require.NoError(t, err)
+
+		err = ctrl.init(context.Background())
+		require.NoError(t, err)
+		actual, ok := task.Blocked()
+		require.True(t, ok)
+		assert.Equal(t, blocked, actual)
+	})
+}
+
 func TestNewDriverTask(t *testing.T) {
 	// newDriverTask function reorganizes various user-defined configuration
 	// blocks into a task object with all the information for the driver to
@@ -98,10 +184,14 @@ func TestNewDriverTask(t *testing.T) {
 					Enabled:      config.Bool(true),
 					Module:       config.String("path"),
 					Version:      config.String("version"),
+					Imports:      map[string]string{"module.name.x.y": "id"},
 					BufferPeriod: config.DefaultBufferPeriodConfig(),
 					Condition:    config.EmptyConditionConfig(),
 					ModuleInputs: config.DefaultModuleInputConfigs(),
 					WorkingDir:   config.String("working-dir/name"),
+					PostApply: &config.PostApplyConfigs{
+						{Command: []string{"./reload.sh"}},
+					},
 
 					// Enterprise
 					TFVersion:    config.String("1.0.0"),
@@ -120,7 +210,19 @@ func TestNewDriverTask(t *testing.T) {
 				},
 				Condition:    config.EmptyConditionConfig(),
 				ModuleInputs: *config.DefaultModuleInputConfigs(),
+				Imports:      map[string]string{"module.name.x.y": "id"},
 				WorkingDir:   "working-dir/name",
+				PostApply: config.PostApplyConfigs{{
+					Command: []string{"./reload.sh"},
+					Timeout: config.TimeDuration(config.DefaultPostApplyTimeout),
+					Retries: config.Int(0),
+				}},
+				PreApply:   config.PreApplyConfigs{},
+				Policies:   config.PolicyConfigs{},
+				Guardrails: config.GuardrailsConfig{},
+
+				ChangeWindows:       config.ChangeWindowConfigs{},
+				GlobalChangeWindows: config.ChangeWindowConfigs{},
 
 				// Enterprise
 				TFVersion:    "1.0.0",
@@ -182,6 +284,15 @@ func TestNewDriverTask(t *testing.T) {
 				VarFiles:     []string{},
 				Condition:    config.EmptyConditionConfig(),
 				ModuleInputs: *config.DefaultModuleInputConfigs(),
+				Imports:      map[string]string{},
+				PostApply:    config.PostApplyConfigs{},
+				PreApply:     config.PreApplyConfigs{},
+				Policies:     config.PolicyConfigs{},
+				Guardrails:   config.GuardrailsConfig{},
+
+				ChangeWindows:       config.ChangeWindowConfigs{},
+				GlobalChangeWindows: config.ChangeWindowConfigs{},
+
 				BufferPeriod: &driver.BufferPeriod{
 					Min: 5 * time.Second,
 					Max: 20 * time.Second,
@@ -252,6 +363,15 @@ func TestNewDriverTask(t *testing.T) {
 				VarFiles:     []string{},
 				Condition:    config.EmptyConditionConfig(),
 				ModuleInputs: *config.DefaultModuleInputConfigs(),
+				Imports:      map[string]string{},
+				PostApply:    config.PostApplyConfigs{},
+				PreApply:     config.PreApplyConfigs{},
+				Policies:     config.PolicyConfigs{},
+				Guardrails:   config.GuardrailsConfig{},
+
+				ChangeWindows:       config.ChangeWindowConfigs{},
+				GlobalChangeWindows: config.ChangeWindowConfigs{},
+
 				BufferPeriod: &driver.BufferPeriod{
 					Min: 5 * time.Second,
 					Max: 20 * time.Second,
@@ -307,6 +427,15 @@ func TestNewDriverTask(t *testing.T) {
 				VarFiles:     []string{},
 				Condition:    config.EmptyConditionConfig(),
 				ModuleInputs: *config.DefaultModuleInputConfigs(),
+				Imports:      map[string]string{},
+				PostApply:    config.PostApplyConfigs{},
+				PreApply:     config.PreApplyConfigs{},
+				Policies:     config.PolicyConfigs{},
+				Guardrails:   config.GuardrailsConfig{},
+
+				ChangeWindows:       config.ChangeWindowConfigs{},
+				GlobalChangeWindows: config.ChangeWindowConfigs{},
+
 				BufferPeriod: &driver.BufferPeriod{
 					Min: 5 * time.Second,
 					Max: 20 * time.Second,
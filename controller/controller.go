@@ -34,8 +34,33 @@ type Oncer interface {
 
 // InstallDriver installs necessary drivers based on user configuration.
 func InstallDriver(ctx context.Context, conf *config.Config) error {
-	if conf.Driver.Terraform != nil {
-		return driver.InstallTerraform(ctx, conf.Driver.Terraform)
+	switch {
+	case conf.Driver.TerraformCloud != nil:
+		// Terraform is executed remotely by Terraform Cloud
+		return nil
+	case conf.Driver.Terraform != nil:
+		if err := driver.InstallTerraform(ctx, conf.Driver.Terraform); err != nil {
+			return err
+		}
+		if conf.Tasks == nil {
+			return nil
+		}
+		for _, t := range *conf.Tasks {
+			if err := InstallTaskDriver(ctx, conf, t); err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 	return errors.New("unsupported driver")
 }
+
+// InstallTaskDriver installs driver dependencies specific to a task, such as
+// a Terraform version pinned by the task.
+func InstallTaskDriver(ctx context.Context, conf *config.Config, task *config.TaskConfig) error {
+	v := config.StringVal(task.TFVersion)
+	if conf.Driver.Terraform == nil || v == "" {
+		return nil
+	}
+	return driver.InstallTerraformVersion(ctx, conf.Driver.Terraform, v)
+}
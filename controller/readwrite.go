@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/config"
@@ -34,6 +35,21 @@ type ReadWrite struct {
 	// deleteCh is used to coordinate task deletion via the API
 	deleteCh chan string
 
+	// resumeCh is used to coordinate resuming task execution via the API
+	resumeCh chan struct{}
+
+	// rolloutCh is used to coordinate starting rollouts via the API.
+	// rolloutID is the ID of the most recent rollout.
+	rolloutCh chan string
+	rolloutMu sync.Mutex
+	rolloutID string
+
+	// paused is set while task execution is paused via the API. pending
+	// holds the names of tasks with changes that rendered while paused.
+	pauseMu sync.RWMutex
+	paused  bool
+	pending map[string]bool
+
 	// taskNotify is only initialized if EnableTestMode() is used. It provides
 	// tests insight into which tasks were triggered and had completed
 	taskNotify chan string
@@ -51,6 +67,8 @@ func NewReadWrite(conf *config.Config) (*ReadWrite, error) {
 		retry:           retry.NewRetry(defaultRetry, time.Now().UnixNano()),
 		scheduleStartCh: make(chan driver.Driver, 10), // arbitrarily chosen size
 		deleteCh:        make(chan string, 10),        // arbitrarily chosen size
+		resumeCh:        make(chan struct{}, 1),
+		rolloutCh:       make(chan string, 10), // arbitrarily chosen size
 		scheduleStopChs: make(map[string](chan struct{})),
 	}, nil
 }
@@ -79,6 +97,11 @@ func (rw *ReadWrite) Run(ctx context.Context) error {
 			rw.scheduleStopChs[d.Task().Name()] = stopCh
 			go rw.runScheduledTask(ctx, d, stopCh)
 		}
+
+		if d.Task().IsDeferred() {
+			// Changes deferred during once-mode
+			go rw.runDeferredTask(ctx, d)
+		}
 	}
 
 	errCh := make(chan error)
@@ -96,6 +119,13 @@ func (rw *ReadWrite) Run(ctx context.Context) error {
 		// Size of channel is an arbitrarily chosen value.
 		rw.deleteCh = make(chan string, 10)
 	}
+	if rw.resumeCh == nil {
+		rw.resumeCh = make(chan struct{}, 1)
+	}
+	if rw.rolloutCh == nil {
+		// Size of channel is an arbitrarily chosen value.
+		rw.rolloutCh = make(chan string, 10)
+	}
 	if rw.scheduleStopChs == nil {
 		rw.scheduleStopChs = make(map[string](chan struct{}))
 	}
@@ -131,6 +161,16 @@ func (rw *ReadWrite) Run(ctx context.Context) error {
 		case n := <-rw.deleteCh:
 			go rw.deleteTask(ctx, n)
 
+		case id := <-rw.rolloutCh:
+			go rw.runRollout(ctx, id)
+
+		case <-rw.resumeCh:
+			for _, n := range rw.pendingTasks() {
+				if d, ok := rw.drivers.Get(n); ok {
+					go rw.runPendingTask(ctx, d)
+				}
+			}
+
 		case err := <-errCh:
 			return err
 
@@ -147,7 +187,12 @@ func (rw *ReadWrite) Run(ctx context.Context) error {
 func (rw *ReadWrite) runDynamicTask(ctx context.Context, d driver.Driver) error {
 	task := d.Task()
 	taskName := task.Name()
-	if task.IsScheduled() {
+	if interval := task.OnChangeInterval(); interval > 0 {
+		// Scheduled task that is also triggered by changes to its module
+		// inputs, at most once per interval
+		return rw.runThrottledTask(ctx, d, interval)
+	}
+	if !task.IsDynamic() {
 		// Schedule tasks are not dynamic and run in a different process
 		return nil
 	}
@@ -171,6 +216,67 @@ func (rw *ReadWrite) runDynamicTask(ctx context.Context, d driver.Driver) error
 	return nil
 }
 
+// runThrottledTask runs a scheduled task that was triggered by dependency
+// changes in between its scheduled runs. The task runs at most once per
+// interval. Changes that occur before the interval has elapsed since the last
+// run are coalesced into a single run at the end of the interval.
+func (rw *ReadWrite) runThrottledTask(ctx context.Context, d driver.Driver,
+	interval time.Duration) error {
+
+	task := d.Task()
+	taskName := task.Name()
+
+	wait, gen, ok := task.QueueTrigger(time.Now(), interval)
+	if !ok {
+		rw.logger.Trace("task already queued to run, skipping",
+			taskNameLogKey, taskName)
+		return nil
+	}
+	if wait > 0 {
+		rw.logger.Debug("delaying task triggered by dependency changes",
+			taskNameLogKey, taskName, "wait_time", wait)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if _, ok := rw.drivers.Get(taskName); !ok {
+		rw.logger.Debug("task no longer exists", taskNameLogKey, taskName)
+		return nil
+	}
+	if rw.drivers.IsMarkedForDeletion(taskName) {
+		rw.logger.Trace("task is marked for deletion, skipping", taskNameLogKey, taskName)
+		return nil
+	}
+
+	err := rw.waitForTaskInactive(ctx, taskName)
+	if err != nil {
+		return err
+	}
+
+	if !task.RunQueuedTrigger(gen, time.Now()) {
+		// the task ran on its schedule while waiting, which included the
+		// dependency changes
+		rw.logger.Debug("task already ran since triggered by dependency "+
+			"changes, skipping", taskNameLogKey, taskName)
+		return nil
+	}
+
+	rw.logger.Info("running scheduled task triggered by dependency changes",
+		taskNameLogKey, taskName)
+	complete, err := rw.checkApply(ctx, d, true, false)
+	if err != nil {
+		return err
+	}
+
+	if rw.taskNotify != nil && complete {
+		rw.taskNotify <- taskName
+	}
+	return nil
+}
+
 // runScheduledTask starts up a go-routine for a given scheduled task/driver.
 // The go-routine will manage the task's schedule and trigger the task on time.
 // If there are dependency changes since the task's last run time, then the task
@@ -179,8 +285,8 @@ func (rw *ReadWrite) runScheduledTask(ctx context.Context, d driver.Driver, stop
 	task := d.Task()
 	taskName := task.Name()
 
-	cond, ok := task.Condition().(*config.ScheduleConditionConfig)
-	if !ok {
+	cond := task.Schedule()
+	if cond == nil {
 		rw.logger.Error("unexpected condition while running a scheduled "+
 			"condition", taskNameLogKey, taskName, "condition_type",
 			fmt.Sprintf("%T", task.Condition()))
@@ -223,6 +329,7 @@ func (rw *ReadWrite) runScheduledTask(ctx context.Context, d driver.Driver, stop
 				continue
 			}
 
+			task.Triggered(time.Now())
 			complete, err := rw.checkApply(ctx, d, true, false)
 			if err != nil {
 				// print error but continue
@@ -309,7 +416,7 @@ func (rw *ReadWrite) checkApply(ctx context.Context, d driver.Driver, retry, onc
 	task := d.Task()
 	taskName := task.Name()
 	if !task.IsEnabled() {
-		if task.IsScheduled() {
+		if !task.IsDynamic() {
 			// Schedule tasks are specifically triggered and logged at INFO.
 			// Accompanying disabled log should be at same level
 			rw.logger.Info("skipping disabled scheduled task", taskNameLogKey, taskName)
@@ -349,8 +456,14 @@ func (rw *ReadWrite) checkApply(ctx context.Context, d driver.Driver, retry, onc
 			taskName, storedErr)
 	}
 
+	if task.IsDeferred() || rw.isPending(taskName) {
+		// Changes that rendered outside of the change windows or while paused
+		// have not been applied yet, even if the template has no new changes
+		rendered = true
+	}
+
 	if !rendered && !once {
-		if task.IsScheduled() {
+		if !task.IsDynamic() {
 			// We sometimes want to store an event when a scheduled task did not
 			// render i.e. the task ran on schedule but there were no
 			// dependency changes so the template did not re-render
@@ -368,17 +481,54 @@ func (rw *ReadWrite) checkApply(ctx context.Context, d driver.Driver, retry, onc
 	// rendering a template may take several cycles in order to completely fetch
 	// new data
 	if rendered {
+		if blocked, ok := task.Blocked(); ok {
+			// The latest rendered changes are applied once the blocked apply
+			// is approved
+			rw.logger.Info("skipping task blocked by guardrails until the "+
+				"apply is approved", taskNameLogKey, taskName,
+				"reason", blocked.Reason)
+			return rendered, nil
+		}
+
+		if rw.pauseTask(taskName) {
+			rw.logger.Info("task execution is paused, skipping task until "+
+				"execution is resumed", taskNameLogKey, taskName)
+			return rendered, nil
+		}
+
+		if open, next := task.InChangeWindow(time.Now()); !open {
+			rw.deferTask(ctx, d, next, once)
+			return rendered, nil
+		}
+		task.ClearDeferred()
+
 		rw.logger.Info("executing task", taskNameLogKey, taskName)
 		rw.drivers.SetActive(taskName)
 		defer rw.drivers.SetInactive(taskName)
 		defer storeEvent()
 
+		ctx = event.WithEvent(ctx, ev)
 		if retry {
+			// blockedErr is set when the guardrails block the apply. It is not
+			// an error for the retry so that the apply is not retried.
+			var blockedErr error
+			apply := func(ctx context.Context) error {
+				err := d.ApplyTask(ctx)
+				if err != nil && task.IsBlocked() {
+					blockedErr = err
+					return nil
+				}
+				return err
+			}
 			desc := fmt.Sprintf("ApplyTask %s", taskName)
-			storedErr = rw.retry.Do(ctx, d.ApplyTask, desc)
+			storedErr = rw.retry.Do(ctx, apply, desc)
+			if blockedErr != nil {
+				storedErr = blockedErr
+			}
 		} else {
 			storedErr = d.ApplyTask(ctx)
 		}
+		rw.storeTaskBlock(task)
 		if storedErr != nil {
 			return false, fmt.Errorf("could not apply changes for task %s: %s",
 				taskName, storedErr)
@@ -390,6 +540,142 @@ func (rw *ReadWrite) checkApply(ctx context.Context, d driver.Driver, retry, onc
 	return rendered, nil
 }
 
+// deferTask defers applying the task's rendered changes until the change
+// windows allow the task to apply. The changes are applied by a go-routine
+// that checks the change windows again at the given time. In once-mode, the
+// go-routine is not started until the controller runs.
+func (rw *ReadWrite) deferTask(ctx context.Context, d driver.Driver, next time.Time, once bool) {
+	task := d.Task()
+	taskName := task.Name()
+	if next.IsZero() {
+		rw.logger.Warn("deferring task changes but the change windows do not "+
+			"open again", taskNameLogKey, taskName)
+	} else {
+		rw.logger.Info("deferring task changes until the change windows open",
+			taskNameLogKey, taskName, "next_check", next)
+	}
+
+	deferred := task.IsDeferred()
+	task.Defer(next)
+	if !deferred && !once {
+		go rw.runDeferredTask(ctx, d)
+	}
+}
+
+// runDeferredTask waits until the change windows of a task with deferred
+// changes could open, and then attempts to apply the task's latest rendered
+// changes. It returns once the task no longer has deferred changes.
+func (rw *ReadWrite) runDeferredTask(ctx context.Context, d driver.Driver) {
+	task := d.Task()
+	taskName := task.Name()
+	for {
+		next, ok := task.Deferred()
+		if !ok || next.IsZero() {
+			return
+		}
+
+		select {
+		case <-time.After(time.Until(next)):
+		case <-ctx.Done():
+			return
+		}
+
+		if _, ok := rw.drivers.Get(taskName); !ok {
+			rw.logger.Debug("task with deferred changes no longer exists",
+				taskNameLogKey, taskName)
+			return
+		}
+		if rw.drivers.IsMarkedForDeletion(taskName) {
+			rw.logger.Trace("task is marked for deletion, skipping", taskNameLogKey, taskName)
+			return
+		}
+
+		if err := rw.waitForTaskInactive(ctx, taskName); err != nil {
+			return
+		}
+		complete, err := rw.checkApply(ctx, d, true, false)
+		if err != nil {
+			// print error but continue
+			rw.logger.Error("error applying deferred task changes",
+				taskNameLogKey, taskName, "error", err)
+		}
+
+		if rw.taskNotify != nil && complete {
+			rw.taskNotify <- taskName
+		}
+
+		if until, ok := task.Deferred(); ok && !until.After(time.Now()) {
+			// The task did not check its change windows, for example when it
+			// is disabled or blocked. Its latest changes are applied the
+			// next time the task runs.
+			task.ClearDeferred()
+		}
+	}
+}
+
+// pauseTask returns whether task execution is paused. While paused, the task
+// is marked as having pending changes to apply once execution is resumed.
+func (rw *ReadWrite) pauseTask(taskName string) bool {
+	rw.pauseMu.Lock()
+	defer rw.pauseMu.Unlock()
+
+	if !rw.paused {
+		delete(rw.pending, taskName)
+		return false
+	}
+
+	if rw.pending == nil {
+		rw.pending = make(map[string]bool)
+	}
+	rw.pending[taskName] = true
+	return true
+}
+
+// isPending returns whether the task has changes that rendered while task
+// execution was paused
+func (rw *ReadWrite) isPending(taskName string) bool {
+	rw.pauseMu.RLock()
+	defer rw.pauseMu.RUnlock()
+	return rw.pending[taskName]
+}
+
+// pendingTasks returns the names of the tasks that have changes that rendered
+// while task execution was paused
+func (rw *ReadWrite) pendingTasks() []string {
+	rw.pauseMu.RLock()
+	defer rw.pauseMu.RUnlock()
+
+	names := make([]string, 0, len(rw.pending))
+	for n := range rw.pending {
+		names = append(names, n)
+	}
+	return names
+}
+
+// runPendingTask applies the latest changes of a task that had changes while
+// task execution was paused
+func (rw *ReadWrite) runPendingTask(ctx context.Context, d driver.Driver) {
+	taskName := d.Task().Name()
+	if rw.drivers.IsMarkedForDeletion(taskName) {
+		rw.logger.Trace("task is marked for deletion, skipping", taskNameLogKey, taskName)
+		return
+	}
+
+	if err := rw.waitForTaskInactive(ctx, taskName); err != nil {
+		return
+	}
+	complete, err := rw.checkApply(ctx, d, true, false)
+	if err != nil {
+		// print error but continue
+		rw.logger.Error("error applying changes pending since the pause",
+			taskNameLogKey, taskName, "error", err)
+	}
+
+	if rw.taskNotify != nil && complete {
+		rw.taskNotify <- taskName
+	}
+}
+
 // createTask creates and initializes a singular task from configuration
 func (rw *ReadWrite) createTask(ctx context.Context, taskConfig config.TaskConfig) (driver.Driver, error) {
 	conf := rw.state.GetConfig()
@@ -398,6 +684,10 @@ func (rw *ReadWrite) createTask(ctx context.Context, taskConfig config.TaskConfi
 		rw.logger.Trace("invalid config to create task", "error", err)
 		return nil, err
 	}
+	if err := conf.Driver.ValidateTask(&taskConfig); err != nil {
+		rw.logger.Trace("invalid config to create task for driver", "error", err)
+		return nil, err
+	}
 
 	taskName := *taskConfig.Name
 	logger := rw.logger.With(taskNameLogKey, taskName)
@@ -408,6 +698,11 @@ func (rw *ReadWrite) createTask(ctx context.Context, taskConfig config.TaskConfi
 		return nil, fmt.Errorf("task with name %s already exists", taskName)
 	}
 
+	if err := InstallTaskDriver(ctx, &conf, &taskConfig); err != nil {
+		logger.Error("error installing driver for task", "error", err)
+		return nil, err
+	}
+
 	d, err := rw.createNewTaskDriver(taskConfig)
 	if err != nil {
 		logger.Error("error creating new task driver", "error", err)
@@ -423,6 +718,7 @@ func (rw *ReadWrite) createTask(ctx context.Context, taskConfig config.TaskConfi
 		logger.Debug("task destroyed", "task_name", *taskConfig.Name)
 		return nil, err
 	}
+	rw.restoreTaskBlock(d.Task())
 
 	csTimeout := time.After(30 * time.Second)
 	timeout := time.After(1 * time.Minute)
@@ -502,7 +798,8 @@ func (rw *ReadWrite) runTask(ctx context.Context, d driver.Driver) error {
 	ev.Start()
 
 	// Apply task
-	err = d.ApplyTask(ctx)
+	err = d.ApplyTask(event.WithEvent(ctx, ev))
+	rw.storeTaskBlock(task)
 	if err != nil {
 		logger.Error("error applying task", "error", err)
 		return err
@@ -557,6 +854,7 @@ func (rw *ReadWrite) deleteTask(ctx context.Context, name string) error {
 		return err
 	}
 	rw.state.DeleteTaskEvents(name)
+	rw.state.DeleteTaskBlock(name)
 	logger.Debug("task deleted")
 	return nil
 }
@@ -162,6 +162,114 @@ func TestReadWrite_CheckApply(t *testing.T) {
 	})
 }
 
+func TestReadWrite_CheckApply_Blocked(t *testing.T) {
+	// Test that a task blocked by its guardrails renders but does not apply
+	// until the apply is approved
+	controller := newTestController()
+
+	taskName := "blocked_task"
+	task := enabledTestTask(t, taskName)
+	task.Block(driver.Blocked{Reason: "plan destroys 1 resources"})
+
+	d := new(mocksD.Driver)
+	d.On("Task").Return(task)
+	d.On("TemplateIDs").Return(nil)
+	d.On("RenderTemplate", mock.Anything).Return(true, nil)
+	controller.drivers.Add(taskName, d)
+
+	ctx := context.Background()
+	rendered, err := controller.checkApply(ctx, d, false, false)
+	assert.NoError(t, err)
+	assert.True(t, rendered)
+	d.AssertNotCalled(t, "ApplyTask", mock.Anything)
+
+	data := controller.state.GetTaskEvents(taskName)
+	assert.Empty(t, data[taskName])
+}
+
+func TestReadWrite_CheckApply_ChangeWindows(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deferred-outside-window", func(t *testing.T) {
+		// A deny window that is always active
+		controller := newTestController()
+		taskName := "deferred_task"
+		task := changeWindowTestTask(t, taskName, config.ChangeWindowDeny)
+
+		d := new(mocksD.Driver)
+		d.On("Task").Return(task)
+		d.On("TemplateIDs").Return(nil)
+		d.On("RenderTemplate", mock.Anything).Return(true, nil)
+		controller.drivers.Add(taskName, d)
+
+		rendered, err := controller.checkApply(ctx, d, false, true)
+		assert.NoError(t, err)
+		assert.True(t, rendered)
+		assert.True(t, task.IsDeferred())
+		d.AssertNotCalled(t, "ApplyTask", mock.Anything)
+
+		data := controller.state.GetTaskEvents(taskName)
+		assert.Empty(t, data[taskName])
+	})
+
+	t.Run("deferred-applies-in-window", func(t *testing.T) {
+		// An allow window that is always active
+		controller := newTestController()
+		taskName := "window_task"
+		task := changeWindowTestTask(t, taskName, config.ChangeWindowAllow)
+		task.Defer(time.Now())
+
+		d := new(mocksD.Driver)
+		d.On("Task").Return(task)
+		d.On("TemplateIDs").Return(nil)
+		d.On("RenderTemplate", mock.Anything).Return(false, nil)
+		d.On("ApplyTask", mock.Anything).Return(nil).Once()
+		controller.drivers.Add(taskName, d)
+
+		controller.runDeferredTask(ctx, d)
+		assert.False(t, task.IsDeferred())
+		d.AssertExpectations(t)
+
+		data := controller.state.GetTaskEvents(taskName)
+		assert.Len(t, data[taskName], 1)
+	})
+}
+
+func TestReadWrite_CheckApply_Paused(t *testing.T) {
+	ctx := context.Background()
+	controller := newTestController()
+	controller.resumeCh = make(chan struct{}, 1)
+	taskName := "paused_task"
+	task := enabledTestTask(t, taskName)
+
+	d := new(mocksD.Driver)
+	d.On("Task").Return(task)
+	d.On("TemplateIDs").Return(nil)
+	d.On("RenderTemplate", mock.Anything).Return(true, nil).Once()
+	controller.drivers.Add(taskName, d)
+
+	controller.Pause(ctx)
+	rendered, err := controller.checkApply(ctx, d, false, false)
+	assert.NoError(t, err)
+	assert.True(t, rendered)
+	assert.Equal(t, []string{taskName}, controller.pendingTasks())
+	d.AssertNotCalled(t, "ApplyTask", mock.Anything)
+
+	// The pending changes apply after resuming even though the template has
+	// no new changes
+	controller.Resume(ctx)
+	assert.Len(t, controller.resumeCh, 1)
+
+	d.On("RenderTemplate", mock.Anything).Return(false, nil)
+	d.On("ApplyTask", mock.Anything).Return(nil).Once()
+	controller.runPendingTask(ctx, d)
+	assert.Empty(t, controller.pendingTasks())
+	d.AssertExpectations(t)
+
+	data := controller.state.GetTaskEvents(taskName)
+	assert.Len(t, data[taskName], 1)
+}
+
 func TestReadWrite_CheckApply_Store(t *testing.T) {
 	t.Run("mult-checkapply-store", func(t *testing.T) {
 		d := new(mocksD.Driver)
@@ -231,7 +339,7 @@ func Test_once(t *testing.T) {
 				newDriver: func(c *config.Config, task *driver.Task, w templates.Watcher) (driver.Driver, error) {
 					taskName := task.Name()
 					d := new(mocksD.Driver)
-					d.On("Task").Return(enabledTestTask(t, taskName)).Twice()
+					d.On("Task").Return(enabledTestTask(t, taskName)).Times(3)
 					d.On("TemplateIDs").Return(nil)
 					d.On("RenderTemplate", mock.Anything).Return(false, nil).Once()
 					d.On("RenderTemplate", mock.Anything).Return(true, nil).Once()
@@ -379,6 +487,119 @@ func TestReadWrite_runDynamicTask(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("skip-all-condition-scheduled-tasks", func(t *testing.T) {
+		controller := newTestController()
+
+		taskName := "scheduled_task"
+		d := new(mocksD.Driver)
+		d.On("Task").Return(compositeScheduledTestTask(t, taskName, "all"))
+		d.On("TemplateIDs").Return(nil)
+		// no other methods should be called (or mocked)
+		controller.drivers.Add(taskName, d)
+
+		err := controller.runDynamicTask(context.Background(), d)
+		assert.NoError(t, err)
+	})
+
+	t.Run("throttled-scheduled-task", func(t *testing.T) {
+		controller := newTestController()
+
+		ctx := context.Background()
+		d := new(mocksD.Driver)
+		taskName := "scheduled_task"
+		mockDriver(ctx, d, throttledScheduledTestTask(t, taskName, time.Second))
+		controller.drivers.Add(taskName, d)
+		controller.EnableTestMode()
+
+		// First trigger runs immediately
+		err := controller.runDynamicTask(ctx, d)
+		assert.NoError(t, err)
+		select {
+		case <-controller.taskNotify:
+		case <-time.After(250 * time.Millisecond):
+			t.Fatal("task did not run on first trigger")
+		}
+
+		// Second trigger waits for the interval and third trigger is
+		// coalesced into the queued run
+		start := time.Now()
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- controller.runDynamicTask(ctx, d)
+		}()
+		time.Sleep(100 * time.Millisecond)
+		err = controller.runDynamicTask(ctx, d)
+		assert.NoError(t, err)
+
+		select {
+		case <-controller.taskNotify:
+			assert.GreaterOrEqual(t, int64(time.Since(start)),
+				int64(900*time.Millisecond), "task ran before interval elapsed")
+		case <-time.After(2 * time.Second):
+			t.Fatal("queued task did not run after the interval")
+		}
+		assert.NoError(t, <-errCh)
+
+		select {
+		case <-controller.taskNotify:
+			t.Fatal("coalesced trigger should not have run the task")
+		case <-time.After(250 * time.Millisecond):
+		}
+		d.AssertNumberOfCalls(t, "ApplyTask", 2)
+	})
+
+	t.Run("throttled-scheduled-task-superseded", func(t *testing.T) {
+		// Tests that a scheduled run while a triggered run waits for the
+		// interval supersedes the waiting run
+		controller := newTestController()
+
+		ctx := context.Background()
+		d := new(mocksD.Driver)
+		taskName := "scheduled_task"
+		task := throttledScheduledTestTask(t, taskName, time.Second)
+		mockDriver(ctx, d, task)
+		controller.drivers.Add(taskName, d)
+		controller.EnableTestMode()
+
+		// First trigger runs immediately
+		err := controller.runDynamicTask(ctx, d)
+		assert.NoError(t, err)
+		<-controller.taskNotify
+
+		// Second trigger waits for the interval
+		errCh := make(chan error, 2)
+		go func() {
+			errCh <- controller.runDynamicTask(ctx, d)
+		}()
+		time.Sleep(100 * time.Millisecond)
+
+		// Cron tick runs the task while the trigger waits
+		task.Triggered(time.Now())
+		_, err = controller.checkApply(ctx, d, true, false)
+		assert.NoError(t, err)
+
+		// Third trigger after the scheduled run queues a new run
+		time.Sleep(100 * time.Millisecond)
+		go func() {
+			errCh <- controller.runDynamicTask(ctx, d)
+		}()
+
+		select {
+		case <-controller.taskNotify:
+		case <-time.After(2 * time.Second):
+			t.Fatal("queued task did not run after the interval")
+		}
+		assert.NoError(t, <-errCh)
+		assert.NoError(t, <-errCh)
+
+		select {
+		case <-controller.taskNotify:
+			t.Fatal("superseded trigger should not have run the task")
+		case <-time.After(250 * time.Millisecond):
+		}
+		d.AssertNumberOfCalls(t, "ApplyTask", 3)
+	})
+
 	t.Run("active-task", func(t *testing.T) {
 		controller := newTestController()
 		controller.EnableTestMode()
@@ -508,6 +729,34 @@ func TestReadWrite_runScheduledTask(t *testing.T) {
 		}
 	})
 
+	t.Run("stop-composite-scheduled-task", func(t *testing.T) {
+		// Tests that a task with a schedule condition composed in an 'any'
+		// condition runs on schedule until stopped
+		ctrl := newTestController()
+
+		taskName := "scheduled_task"
+		d := new(mocksD.Driver)
+		d.On("Task").Return(compositeScheduledTestTask(t, taskName, "any")).Once()
+		d.On("TemplateIDs").Return(nil)
+		ctrl.drivers.Add(taskName, d)
+
+		ctx := context.Background()
+		errCh := make(chan error)
+		stopCh := make(chan struct{}, 1)
+		go func() {
+			err := ctrl.runScheduledTask(ctx, d, stopCh)
+			errCh <- err
+		}()
+		stopCh <- struct{}{}
+
+		select {
+		case err := <-errCh:
+			assert.NoError(t, err)
+		case <-time.After(time.Second * 5):
+			t.Fatal("runScheduledTask did not exit as expected")
+		}
+	})
+
 	t.Run("deleted-scheduled-task", func(t *testing.T) {
 		// Tests that a scheduled task stops if it no longer is in the
 		// list of drivers
@@ -1081,6 +1330,57 @@ func scheduledTestTask(tb testing.TB, name string) *driver.Task {
 	return task
 }
 
+func compositeScheduledTestTask(tb testing.TB, name, operator string) *driver.Task {
+	task, err := driver.NewTask(driver.TaskConfig{
+		Name:        name,
+		Description: "runs every 3 seconds and on consul-kv changes",
+		Enabled:     true,
+		Condition: &config.CompositeConditionConfig{
+			Operator: config.String(operator),
+			Conditions: []config.ConditionConfig{
+				&config.ScheduleConditionConfig{
+					Cron: config.String("*/3 * * * * * *"),
+				},
+				&config.ConsulKVConditionConfig{
+					ConsulKVMonitorConfig: config.ConsulKVMonitorConfig{
+						Path: config.String("key"),
+					},
+				},
+			},
+		},
+	})
+	require.NoError(tb, err)
+	return task
+}
+
+func throttledScheduledTestTask(tb testing.TB, name string, interval time.Duration) *driver.Task {
+	task, err := driver.NewTask(driver.TaskConfig{
+		Name:        name,
+		Description: "runs yearly and on module input changes",
+		Enabled:     true,
+		Condition: &config.ScheduleConditionConfig{
+			Cron:             config.String("@yearly"),
+			OnChangeInterval: config.TimeDuration(interval),
+		},
+	})
+	require.NoError(tb, err)
+	return task
+}
+
+func changeWindowTestTask(tb testing.TB, name, windowType string) *driver.Task {
+	task, err := driver.NewTask(driver.TaskConfig{
+		Name:    name,
+		Enabled: true,
+		ChangeWindows: config.ChangeWindowConfigs{{
+			Type:     config.String(windowType),
+			Cron:     config.String("* * * * *"),
+			Duration: config.TimeDuration(time.Hour),
+		}},
+	})
+	require.NoError(tb, err)
+	return task
+}
+
 func newTestController() ReadWrite {
 	return ReadWrite{
 		baseController: &baseController{
@@ -0,0 +1,290 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/driver"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/hashicorp/consul-terraform-sync/state/rollout"
+	"github.com/pkg/errors"
+)
+
+// RolloutCreate starts a rollout of a module version to the enabled tasks
+// that use the module. The tasks are upgraded in batches of the batch size,
+// and the rollout stops if any task of a batch fails to upgrade or if task
+// execution is paused. Only one rollout can run at a time.
+func (rw *ReadWrite) RolloutCreate(ctx context.Context, module, version string, batchSize int) (rollout.Rollout, error) {
+	rw.rolloutMu.Lock()
+	defer rw.rolloutMu.Unlock()
+
+	if rw.Paused(ctx) {
+		return rollout.Rollout{}, fmt.Errorf("task execution is paused, " +
+			"a rollout cannot start until execution is resumed")
+	}
+
+	if r, ok := rw.state.GetRollout(rw.rolloutID); ok && r.Status == rollout.StatusRunning {
+		return rollout.Rollout{}, fmt.Errorf("rollout '%s' of module '%s' is "+
+			"still running", r.ID, r.Module)
+	}
+
+	// local modules are converted to absolute paths when the task is created
+	source := module
+	if strings.HasPrefix(module, "./") || strings.HasPrefix(module, "../") {
+		wd, err := os.Getwd()
+		if err != nil {
+			return rollout.Rollout{}, err
+		}
+		source = filepath.Join(wd, module)
+	}
+
+	var tasks []rollout.Task
+	for _, d := range rw.drivers.Map() {
+		task := d.Task()
+		if !task.IsEnabled() || task.Module() != source || task.Version() == version {
+			continue
+		}
+		tasks = append(tasks, rollout.Task{
+			Name:            task.Name(),
+			PreviousVersion: task.Version(),
+		})
+	}
+	if len(tasks) == 0 {
+		return rollout.Rollout{}, fmt.Errorf("no enabled tasks use module '%s' "+
+			"at a version other than '%s'", module, version)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].Name < tasks[j].Name
+	})
+
+	r, err := rollout.NewRollout(module, version, batchSize, tasks)
+	if err != nil {
+		return rollout.Rollout{}, err
+	}
+	if err := rw.state.SetRollout(*r); err != nil {
+		return rollout.Rollout{}, err
+	}
+	rw.rolloutID = r.ID
+
+	rw.logger.Info("created rollout", "rollout_id", r.ID, "module", module,
+		"version", version, "batch_size", batchSize, "tasks", len(tasks))
+	rw.rolloutCh <- r.ID
+	return r.Copy(), nil
+}
+
+// Rollout returns the progress of a rollout
+func (rw *ReadWrite) Rollout(ctx context.Context, id string) (rollout.Rollout, error) {
+	r, ok := rw.state.GetRollout(id)
+	if !ok {
+		return rollout.Rollout{}, fmt.Errorf("rollout %s does not exist", id)
+	}
+	return r, nil
+}
+
+// runRollout upgrades the tasks of a rollout one batch at a time. The plans
+// of the tasks of a batch are inspected at the new version before any task
+// of the batch is upgraded, and the batch is not upgraded if any plan is
+// unexpected. The tasks of a batch are upgraded concurrently, and the next
+// batch is only upgraded once all of the tasks of the batch succeeded.
+func (rw *ReadWrite) runRollout(ctx context.Context, id string) {
+	r, ok := rw.state.GetRollout(id)
+	if !ok {
+		return
+	}
+	logger := rw.logger.With("rollout_id", id)
+
+	var rolloutErr error
+	batches := r.Batches()
+	for i, batch := range batches {
+		if rw.Paused(ctx) {
+			rolloutErr = fmt.Errorf("task execution was paused during the rollout")
+			break
+		}
+
+		for _, idx := range batch {
+			r.Tasks[idx].Status = rollout.StatusUpgrading
+		}
+		rw.storeRollout(r)
+
+		logger.Info("inspecting rollout batch", "batch", i+1,
+			"batches", len(batches), "version", r.Version)
+		rw.runRolloutBatch(r, batch, func(t *rollout.Task) error {
+			return rw.inspectUpgrade(ctx, t.Name, r.Version)
+		})
+		if rolloutErr = batchError(r, batch); rolloutErr != nil {
+			// none of the tasks of the batch are upgraded
+			for _, idx := range batch {
+				if t := &r.Tasks[idx]; t.Status != rollout.StatusFailed {
+					t.Status = rollout.StatusPending
+				}
+			}
+			rw.storeRollout(r)
+			break
+		}
+
+		logger.Info("upgrading rollout batch", "batch", i+1,
+			"batches", len(batches), "version", r.Version)
+		rw.runRolloutBatch(r, batch, func(t *rollout.Task) error {
+			if err := rw.upgradeTask(ctx, t.Name, r.Version); err != nil {
+				return err
+			}
+			t.Status = rollout.StatusSucceeded
+			return nil
+		})
+		rolloutErr = batchError(r, batch)
+		rw.storeRollout(r)
+		if rolloutErr != nil {
+			break
+		}
+	}
+
+	r.End(rolloutErr)
+	rw.storeRollout(r)
+	if rolloutErr != nil {
+		logger.Error("stopped rollout", "error", rolloutErr)
+		return
+	}
+	logger.Info("rollout completed", "module", r.Module, "version", r.Version)
+}
+
+// runRolloutBatch runs the function for the tasks of a batch concurrently.
+// Tasks that the function returns an error for are marked as failed.
+func (rw *ReadWrite) runRolloutBatch(r rollout.Rollout, batch []int, f func(*rollout.Task) error) {
+	var wg sync.WaitGroup
+	for _, idx := range batch {
+		wg.Add(1)
+		go func(t *rollout.Task) {
+			defer wg.Done()
+			if err := f(t); err != nil {
+				rw.logger.Error("error upgrading task", "rollout_id", r.ID,
+					taskNameLogKey, t.Name, "error", err)
+				t.Status = rollout.StatusFailed
+				t.Error = err.Error()
+			}
+		}(&r.Tasks[idx])
+	}
+	wg.Wait()
+}
+
+// batchError returns an error for the first failed task of a batch
+func batchError(r rollout.Rollout, batch []int) error {
+	for _, idx := range batch {
+		if t := r.Tasks[idx]; t.Status == rollout.StatusFailed {
+			return fmt.Errorf("task '%s' failed to upgrade", t.Name)
+		}
+	}
+	return nil
+}
+
+// rolloutDriver returns the driver of a task of a rollout once the task is
+// inactive, and marks the task as active. The caller marks the task as
+// inactive when it is done with the task.
+func (rw *ReadWrite) rolloutDriver(ctx context.Context, taskName string) (driver.Driver, error) {
+	d, ok := rw.drivers.Get(taskName)
+	if !ok || rw.drivers.IsMarkedForDeletion(taskName) {
+		return nil, fmt.Errorf("task %s does not exist to upgrade", taskName)
+	}
+
+	if err := rw.waitForTaskInactive(ctx, taskName); err != nil {
+		return nil, err
+	}
+	rw.drivers.SetActive(taskName)
+
+	if err := rw.checkPaused(ctx, taskName, "upgraded"); err != nil {
+		rw.drivers.SetInactive(taskName)
+		return nil, err
+	}
+	if !d.Task().IsEnabled() {
+		rw.drivers.SetInactive(taskName)
+		return nil, fmt.Errorf("task %s was disabled during the rollout", taskName)
+	}
+	return d, nil
+}
+
+// inspectUpgrade inspects the plan of a task at the module version of a
+// rollout without upgrading the task. An error is returned if the plan fails
+// the task's mandatory policies or exceeds its guardrails.
+func (rw *ReadWrite) inspectUpgrade(ctx context.Context, taskName, version string) error {
+	d, err := rw.rolloutDriver(ctx, taskName)
+	if err != nil {
+		return err
+	}
+	defer rw.drivers.SetInactive(taskName)
+
+	plan, err := d.UpdateTask(ctx, driver.PatchTask{
+		RunOption: driver.RunOptionInspect,
+		Enabled:   true,
+		Version:   version,
+	})
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("error inspecting upgrade of "+
+			"task %q", taskName))
+	}
+
+	if failed := plan.Policies.Failed(config.PolicyEnforcementMandatory); len(failed) > 0 {
+		return fmt.Errorf("plan for version %s fails mandatory policies: %s",
+			version, failed.Error())
+	}
+	if len(plan.Guardrails) > 0 {
+		return fmt.Errorf("plan for version %s exceeds guardrails: %s",
+			version, strings.Join(plan.Guardrails, "; "))
+	}
+	return nil
+}
+
+// upgradeTask updates the module version of a task and applies the task, and
+// stores an event for the run. The version is stored in the task's config
+// once the task is upgraded. The driver keeps the previous version if the
+// upgrade fails to apply.
+func (rw *ReadWrite) upgradeTask(ctx context.Context, taskName, version string) error {
+	d, err := rw.rolloutDriver(ctx, taskName)
+	if err != nil {
+		return err
+	}
+	defer rw.drivers.SetInactive(taskName)
+
+	task := d.Task()
+	ev, err := event.NewEvent(taskName, &event.Config{
+		Providers: task.ProviderNames(),
+		Services:  task.ServiceNames(),
+		Source:    task.Module(),
+	})
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("error creating task upgrade "+
+			"event for %q", taskName))
+	}
+	ev.Start()
+
+	_, err = d.UpdateTask(event.WithEvent(ctx, ev), driver.PatchTask{
+		RunOption: driver.RunOptionNow,
+		Enabled:   true,
+		Version:   version,
+	})
+	rw.storeTaskBlock(d.Task())
+	ev.End(err)
+	rw.logger.Trace("adding event", "event", ev.GoString())
+	if storeErr := rw.state.AddTaskEvent(*ev); storeErr != nil {
+		rw.logger.Error("error storing event", "event", ev.GoString(),
+			"error", storeErr)
+	}
+	if err != nil {
+		return err
+	}
+
+	rw.state.SetTaskVersion(taskName, version)
+	return nil
+}
+
+// storeRollout stores the progress of a rollout
+func (rw *ReadWrite) storeRollout(r rollout.Rollout) {
+	if err := rw.state.SetRollout(r); err != nil {
+		rw.logger.Error("error storing rollout", "rollout", r.GoString(),
+			"error", err)
+	}
+}
@@ -0,0 +1,301 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/driver"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	mocksD "github.com/hashicorp/consul-terraform-sync/mocks/driver"
+	"github.com/hashicorp/consul-terraform-sync/policy"
+	"github.com/hashicorp/consul-terraform-sync/state"
+	"github.com/hashicorp/consul-terraform-sync/state/rollout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadWrite_RolloutCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("matching tasks", func(t *testing.T) {
+		ctrl := newTestRolloutController()
+		addRolloutTestDriver(t, ctrl, "task_b", "org/module", "1.0.0", true)
+		addRolloutTestDriver(t, ctrl, "task_a", "org/module", "1.0.0", true)
+		addRolloutTestDriver(t, ctrl, "task_c", "org/module", "1.1.0", true)
+		addRolloutTestDriver(t, ctrl, "task_d", "org/other", "1.0.0", true)
+		addRolloutTestDriver(t, ctrl, "task_e", "org/module", "1.0.0", false)
+
+		r, err := ctrl.RolloutCreate(ctx, "org/module", "1.1.0", 1)
+		require.NoError(t, err)
+		assert.Equal(t, rollout.StatusRunning, r.Status)
+		assert.Equal(t, []rollout.Task{
+			{Name: "task_a", PreviousVersion: "1.0.0", Status: rollout.StatusPending},
+			{Name: "task_b", PreviousVersion: "1.0.0", Status: rollout.StatusPending},
+		}, r.Tasks)
+		assert.Equal(t, r.ID, <-ctrl.rolloutCh)
+
+		stored, err := ctrl.Rollout(ctx, r.ID)
+		require.NoError(t, err)
+		assert.Equal(t, r, stored)
+
+		// another rollout cannot be created while the first is running
+		_, err = ctrl.RolloutCreate(ctx, "org/module", "1.2.0", 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("no matching tasks", func(t *testing.T) {
+		ctrl := newTestRolloutController()
+		addRolloutTestDriver(t, ctrl, "task_a", "org/module", "1.1.0", true)
+
+		_, err := ctrl.RolloutCreate(ctx, "org/module", "1.1.0", 1)
+		assert.Error(t, err)
+		assert.Empty(t, ctrl.rolloutCh)
+	})
+
+	t.Run("invalid batch size", func(t *testing.T) {
+		ctrl := newTestRolloutController()
+		addRolloutTestDriver(t, ctrl, "task_a", "org/module", "1.0.0", true)
+
+		_, err := ctrl.RolloutCreate(ctx, "org/module", "1.1.0", 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("paused", func(t *testing.T) {
+		ctrl := newTestRolloutController()
+		addRolloutTestDriver(t, ctrl, "task_a", "org/module", "1.0.0", true)
+		ctrl.Pause(ctx)
+
+		_, err := ctrl.RolloutCreate(ctx, "org/module", "1.1.0", 1)
+		assert.Error(t, err)
+		assert.Empty(t, ctrl.rolloutCh)
+	})
+
+	t.Run("rollout not found", func(t *testing.T) {
+		ctrl := newTestRolloutController()
+		_, err := ctrl.Rollout(ctx, "non-existent-rollout")
+		assert.Error(t, err)
+	})
+}
+
+func TestReadWrite_runRollout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	patch := driver.PatchTask{
+		RunOption: driver.RunOptionNow,
+		Enabled:   true,
+		Version:   "1.1.0",
+	}
+	inspect := driver.PatchTask{
+		RunOption: driver.RunOptionInspect,
+		Enabled:   true,
+		Version:   "1.1.0",
+	}
+
+	t.Run("all batches succeed", func(t *testing.T) {
+		ctrl := newTestRolloutController()
+		conf := &config.Config{Tasks: &config.TaskConfigs{}}
+		var drivers []*mocksD.Driver
+		for _, name := range []string{"task_a", "task_b", "task_c"} {
+			*conf.Tasks = append(*conf.Tasks, &config.TaskConfig{
+				Name:    config.String(name),
+				Module:  config.String("org/module"),
+				Version: config.String("1.0.0"),
+			})
+			d := addRolloutTestDriver(t, ctrl, name, "org/module", "1.0.0", true)
+			d.On("UpdateTask", mock.Anything, inspect).
+				Return(driver.InspectPlan{}, nil).Once()
+			d.On("UpdateTask", mock.Anything, patch).
+				Return(driver.InspectPlan{}, nil).Once()
+			drivers = append(drivers, d)
+		}
+
+		ctrl.state = state.NewInMemoryStore(conf)
+
+		r, err := ctrl.RolloutCreate(ctx, "org/module", "1.1.0", 2)
+		require.NoError(t, err)
+		ctrl.runRollout(ctx, <-ctrl.rolloutCh)
+
+		for _, d := range drivers {
+			d.AssertExpectations(t)
+		}
+		r, err = ctrl.Rollout(ctx, r.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rollout.StatusSucceeded, r.Status)
+		assert.False(t, r.EndTime.IsZero())
+		for _, task := range r.Tasks {
+			assert.Equal(t, rollout.StatusSucceeded, task.Status)
+			events := ctrl.state.GetTaskEvents(task.Name)
+			assert.Len(t, events[task.Name], 1)
+		}
+		for _, tc := range *ctrl.state.GetConfig().Tasks {
+			assert.Equal(t, "1.1.0", *tc.Version,
+				"upgraded version is stored in the task config")
+		}
+	})
+
+	t.Run("failed batch stops rollout", func(t *testing.T) {
+		ctrl := newTestRolloutController()
+		dA := addRolloutTestDriver(t, ctrl, "task_a", "org/module", "1.0.0", true)
+		dA.On("UpdateTask", mock.Anything, inspect).
+			Return(driver.InspectPlan{}, nil).Once()
+		dA.On("UpdateTask", mock.Anything, patch).
+			Return(driver.InspectPlan{}, nil).Once()
+		dB := addRolloutTestDriver(t, ctrl, "task_b", "org/module", "1.0.0", true)
+		dB.On("UpdateTask", mock.Anything, inspect).
+			Return(driver.InspectPlan{}, nil).Once()
+		dB.On("UpdateTask", mock.Anything, patch).
+			Return(driver.InspectPlan{}, fmt.Errorf("apply error")).Once()
+		dC := addRolloutTestDriver(t, ctrl, "task_c", "org/module", "1.0.0", true)
+
+		conf := &config.Config{Tasks: &config.TaskConfigs{}}
+		for _, name := range []string{"task_a", "task_b", "task_c"} {
+			*conf.Tasks = append(*conf.Tasks, &config.TaskConfig{
+				Name:    config.String(name),
+				Module:  config.String("org/module"),
+				Version: config.String("1.0.0"),
+			})
+		}
+		ctrl.state = state.NewInMemoryStore(conf)
+
+		r, err := ctrl.RolloutCreate(ctx, "org/module", "1.1.0", 2)
+		require.NoError(t, err)
+		ctrl.runRollout(ctx, <-ctrl.rolloutCh)
+
+		dA.AssertExpectations(t)
+		dB.AssertExpectations(t)
+		dC.AssertNotCalled(t, "UpdateTask", mock.Anything, mock.Anything)
+
+		// only the upgraded task stores the new version
+		versions := make(map[string]string)
+		for _, tc := range *ctrl.state.GetConfig().Tasks {
+			versions[*tc.Name] = *tc.Version
+		}
+		assert.Equal(t, map[string]string{
+			"task_a": "1.1.0",
+			"task_b": "1.0.0",
+			"task_c": "1.0.0",
+		}, versions)
+
+		r, err = ctrl.Rollout(ctx, r.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rollout.StatusFailed, r.Status)
+		assert.Equal(t, rollout.StatusSucceeded, r.Tasks[0].Status)
+		assert.Equal(t, rollout.StatusFailed, r.Tasks[1].Status)
+		assert.Contains(t, r.Tasks[1].Error, "apply error")
+		assert.Equal(t, rollout.StatusPending, r.Tasks[2].Status)
+
+		// a new rollout can be created once the previous one stopped
+		_, err = ctrl.RolloutCreate(ctx, "org/module", "1.1.0", 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("unexpected plan stops batch", func(t *testing.T) {
+		ctrl := newTestRolloutController()
+		dA := addRolloutTestDriver(t, ctrl, "task_a", "org/module", "1.0.0", true)
+		dA.On("UpdateTask", mock.Anything, inspect).
+			Return(driver.InspectPlan{}, nil).Once()
+		dB := addRolloutTestDriver(t, ctrl, "task_b", "org/module", "1.0.0", true)
+		dB.On("UpdateTask", mock.Anything, inspect).
+			Return(driver.InspectPlan{
+				ChangesPresent: true,
+				Guardrails:     []string{"plan destroys 2 resources"},
+			}, nil).Once()
+		dC := addRolloutTestDriver(t, ctrl, "task_c", "org/module", "1.0.0", true)
+		dC.On("UpdateTask", mock.Anything, inspect).
+			Return(driver.InspectPlan{
+				ChangesPresent: true,
+				Policies: policy.Results{{
+					Name:             "no-deletes",
+					EnforcementLevel: config.PolicyEnforcementMandatory,
+				}},
+			}, nil).Once()
+
+		r, err := ctrl.RolloutCreate(ctx, "org/module", "1.1.0", 3)
+		require.NoError(t, err)
+		ctrl.runRollout(ctx, <-ctrl.rolloutCh)
+
+		for _, d := range []*mocksD.Driver{dA, dB, dC} {
+			d.AssertExpectations(t)
+			d.AssertNotCalled(t, "UpdateTask", mock.Anything, patch)
+		}
+
+		r, err = ctrl.Rollout(ctx, r.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rollout.StatusFailed, r.Status)
+		assert.Equal(t, rollout.StatusPending, r.Tasks[0].Status)
+		assert.Equal(t, rollout.StatusFailed, r.Tasks[1].Status)
+		assert.Contains(t, r.Tasks[1].Error, "exceeds guardrails")
+		assert.Equal(t, rollout.StatusFailed, r.Tasks[2].Status)
+		assert.Contains(t, r.Tasks[2].Error, "mandatory policies")
+		assert.Empty(t, ctrl.state.GetTaskEvents(""), "no task is applied")
+	})
+
+	t.Run("pause stops rollout", func(t *testing.T) {
+		ctrl := newTestRolloutController()
+		dA := addRolloutTestDriver(t, ctrl, "task_a", "org/module", "1.0.0", true)
+		dB := addRolloutTestDriver(t, ctrl, "task_b", "org/module", "1.0.0", true)
+
+		r, err := ctrl.RolloutCreate(ctx, "org/module", "1.1.0", 1)
+		require.NoError(t, err)
+		ctrl.Pause(ctx)
+		ctrl.runRollout(ctx, <-ctrl.rolloutCh)
+
+		dA.AssertNotCalled(t, "UpdateTask", mock.Anything, mock.Anything)
+		dB.AssertNotCalled(t, "UpdateTask", mock.Anything, mock.Anything)
+
+		r, err = ctrl.Rollout(ctx, r.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rollout.StatusFailed, r.Status)
+		for _, task := range r.Tasks {
+			assert.Equal(t, rollout.StatusPending, task.Status)
+		}
+	})
+
+	t.Run("pause during upgrade", func(t *testing.T) {
+		ctrl := newTestRolloutController()
+		d := addRolloutTestDriver(t, ctrl, "task_a", "org/module", "1.0.0", true)
+		ctrl.Pause(ctx)
+
+		err := ctrl.upgradeTask(ctx, "task_a", "1.1.0")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "paused")
+		d.AssertNotCalled(t, "UpdateTask", mock.Anything, mock.Anything)
+	})
+}
+
+func newTestRolloutController() *ReadWrite {
+	conf := &config.Config{}
+	conf.Finalize()
+	return &ReadWrite{
+		baseController: &baseController{
+			state:   state.NewInMemoryStore(conf),
+			drivers: driver.NewDrivers(),
+			logger:  logging.NewNullLogger(),
+		},
+		rolloutCh: make(chan string, 1),
+	}
+}
+
+func addRolloutTestDriver(tb testing.TB, ctrl *ReadWrite, name, module,
+	version string, enabled bool) *mocksD.Driver {
+
+	task, err := driver.NewTask(driver.TaskConfig{
+		Name:    name,
+		Enabled: enabled,
+		Module:  module,
+		Version: version,
+	})
+	require.NoError(tb, err)
+
+	d := new(mocksD.Driver)
+	d.On("Task").Return(task)
+	d.On("TemplateIDs").Return(nil)
+	require.NoError(tb, ctrl.drivers.Add(name, d))
+	return d
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/driver"
@@ -19,6 +20,54 @@ func (rw *ReadWrite) Events(ctx context.Context, taskName string) (map[string][]
 	return rw.state.GetTaskEvents(taskName), nil
 }
 
+// Pause stops tasks from applying until task execution is resumed. Tasks
+// continue to render changes to their dependencies while paused.
+func (rw *ReadWrite) Pause(ctx context.Context) {
+	rw.pauseMu.Lock()
+	defer rw.pauseMu.Unlock()
+	if !rw.paused {
+		rw.logger.Info("pausing task execution")
+	}
+	rw.paused = true
+}
+
+// Paused returns whether task execution is paused
+func (rw *ReadWrite) Paused(ctx context.Context) bool {
+	rw.pauseMu.RLock()
+	defer rw.pauseMu.RUnlock()
+	return rw.paused
+}
+
+// Resume allows tasks to apply again after task execution was paused. Tasks
+// with changes that rendered while paused apply their latest changes.
+func (rw *ReadWrite) Resume(ctx context.Context) {
+	rw.pauseMu.Lock()
+	wasPaused := rw.paused
+	rw.paused = false
+	rw.pauseMu.Unlock()
+
+	if !wasPaused {
+		return
+	}
+	rw.logger.Info("resuming task execution")
+
+	select {
+	case rw.resumeCh <- struct{}{}:
+	default:
+		// pending tasks are already going to be applied
+	}
+}
+
+// checkPaused returns an error if task execution is paused. Requests that
+// apply a task immediately are rejected while paused.
+func (rw *ReadWrite) checkPaused(ctx context.Context, taskName, action string) error {
+	if rw.Paused(ctx) {
+		return fmt.Errorf("task execution is paused, task '%s' cannot be %s "+
+			"until execution is resumed", taskName, action)
+	}
+	return nil
+}
+
 func (rw *ReadWrite) Task(ctx context.Context, taskName string) (config.TaskConfig, error) {
 	// TODO handle ctx while waiting for driver lock if it is currently active
 	d, ok := rw.drivers.Get(taskName)
@@ -65,6 +114,10 @@ func (rw *ReadWrite) TaskCreate(ctx context.Context, taskConfig config.TaskConfi
 }
 
 func (rw *ReadWrite) TaskCreateAndRun(ctx context.Context, taskConfig config.TaskConfig) (config.TaskConfig, error) {
+	if err := rw.checkPaused(ctx, config.StringVal(taskConfig.Name), "run"); err != nil {
+		return config.TaskConfig{}, err
+	}
+
 	d, err := rw.createTask(ctx, taskConfig)
 	if err != nil {
 		return config.TaskConfig{}, err
@@ -132,6 +185,11 @@ func (rw *ReadWrite) TaskUpdate(ctx context.Context, updateConf config.TaskConfi
 	taskName := *updateConf.Name
 	logger := rw.logger.With(taskNameLogKey, taskName)
 	logger.Trace("updating task")
+	if runOp == driver.RunOptionNow {
+		if err := rw.checkPaused(ctx, taskName, "run"); err != nil {
+			return false, "", "", err
+		}
+	}
 	if rw.drivers.IsActive(taskName) {
 		return false, "", "", fmt.Errorf("task '%s' is active and cannot be updated at this time", taskName)
 	}
@@ -166,6 +224,7 @@ func (rw *ReadWrite) TaskUpdate(ctx context.Context, updateConf config.TaskConfi
 			}
 		}()
 		ev.Start()
+		ctx = event.WithEvent(ctx, ev)
 	}
 
 	patch := driver.PatchTask{
@@ -174,6 +233,7 @@ func (rw *ReadWrite) TaskUpdate(ctx context.Context, updateConf config.TaskConfi
 	}
 	var plan driver.InspectPlan
 	plan, storedErr = d.UpdateTask(ctx, patch)
+	rw.storeTaskBlock(d.Task())
 	if storedErr != nil {
 		logger.Trace("error while updating task", "error", storedErr)
 		return false, "", "", storedErr
@@ -182,6 +242,145 @@ func (rw *ReadWrite) TaskUpdate(ctx context.Context, updateConf config.TaskConfi
 	return plan.ChangesPresent, plan.Plan, "", nil
 }
 
+// TaskApprove applies a task that is blocked by its guardrails, and stores an
+// event for the run
+func (rw *ReadWrite) TaskApprove(ctx context.Context, taskName string, override bool) error {
+	logger := rw.logger.With(taskNameLogKey, taskName)
+	logger.Trace("approving task")
+	if err := rw.checkPaused(ctx, taskName, "approved"); err != nil {
+		return err
+	}
+	if rw.drivers.IsActive(taskName) {
+		return fmt.Errorf("task '%s' is active and cannot be approved at this time", taskName)
+	}
+	rw.drivers.SetActive(taskName)
+	defer rw.drivers.SetInactive(taskName)
+
+	d, ok := rw.drivers.Get(taskName)
+	if !ok {
+		return fmt.Errorf("task %s does not exist to run", taskName)
+	}
+
+	task := d.Task()
+	if !task.IsBlocked() {
+		return fmt.Errorf("task '%s' is not blocked by its guardrails", taskName)
+	}
+
+	ev, err := event.NewEvent(taskName, &event.Config{
+		Providers: task.ProviderNames(),
+		Services:  task.ServiceNames(),
+		Source:    task.Module(),
+	})
+	if err != nil {
+		err = errors.Wrap(err, fmt.Sprintf("error creating task approve "+
+			"event for %q", taskName))
+		logger.Error("error creating new event", "error", err)
+		return err
+	}
+	ev.Start()
+
+	err = d.ApproveTask(event.WithEvent(ctx, ev), override)
+	rw.storeTaskBlock(d.Task())
+	ev.End(err)
+	logger.Trace("adding event", "event", ev.GoString())
+	if err := rw.state.AddTaskEvent(*ev); err != nil {
+		logger.Error("error storing event", "event", ev.GoString(), "error", err)
+	}
+	if err != nil {
+		logger.Trace("error while approving task", "error", err)
+		return err
+	}
+
+	logger.Info("task completed", "override", override)
+	return nil
+}
+
+// TaskBlocked returns the reason the task is blocked by its guardrails. The
+// second parameter returns false if the task is not blocked.
+func (rw *ReadWrite) TaskBlocked(ctx context.Context, taskName string) (string, bool) {
+	d, ok := rw.drivers.Get(taskName)
+	if !ok {
+		return "", false
+	}
+
+	blocked, ok := d.Task().Blocked()
+	return blocked.Reason, ok
+}
+
+// TaskDeferred returns the time the deferred changes of the task are next
+// checked against the task's change windows. The second parameter returns
+// false if the task does not have deferred changes.
+func (rw *ReadWrite) TaskDeferred(ctx context.Context, taskName string) (time.Time, bool) {
+	d, ok := rw.drivers.Get(taskName)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return d.Task().Deferred()
+}
+
+// TaskRollback applies the snapshot of the task's run for a prior event, and
+// stores an event for the run. The task is pinned to the snapshot until the
+// task is re-enabled.
+func (rw *ReadWrite) TaskRollback(ctx context.Context, taskName, eventID string) error {
+	logger := rw.logger.With(taskNameLogKey, taskName, "event_id", eventID)
+	logger.Trace("rolling back task")
+	if err := rw.checkPaused(ctx, taskName, "rolled back"); err != nil {
+		return err
+	}
+	if rw.drivers.IsActive(taskName) {
+		return fmt.Errorf("task '%s' is active and cannot be rolled back at this time", taskName)
+	}
+	rw.drivers.SetActive(taskName)
+	defer rw.drivers.SetInactive(taskName)
+
+	d, ok := rw.drivers.Get(taskName)
+	if !ok {
+		return fmt.Errorf("task %s does not exist to run", taskName)
+	}
+
+	task := d.Task()
+	ev, err := event.NewEvent(taskName, &event.Config{
+		Providers: task.ProviderNames(),
+		Services:  task.ServiceNames(),
+		Source:    task.Module(),
+	})
+	if err != nil {
+		err = errors.Wrap(err, fmt.Sprintf("error creating task rollback "+
+			"event for %q", taskName))
+		logger.Error("error creating new event", "error", err)
+		return err
+	}
+	ev.Start()
+
+	err = d.RollbackTask(event.WithEvent(ctx, ev), eventID)
+	rw.storeTaskBlock(d.Task())
+	ev.End(err)
+	logger.Trace("adding event", "event", ev.GoString())
+	if err := rw.state.AddTaskEvent(*ev); err != nil {
+		logger.Error("error storing event", "event", ev.GoString(), "error", err)
+	}
+	if err != nil {
+		logger.Trace("error while rolling back task", "error", err)
+		return err
+	}
+
+	logger.Info("task rolled back and pinned until the task is enabled")
+	return nil
+}
+
+// TaskSnapshot returns whether the task has a snapshot of its run for the
+// event that the task can be rolled back to
+func (rw *ReadWrite) TaskSnapshot(ctx context.Context, taskName, eventID string) bool {
+	d, ok := rw.drivers.Get(taskName)
+	if !ok {
+		return false
+	}
+
+	_, ok = d.Task().Snapshot(eventID)
+	return ok
+}
+
 func (rw *ReadWrite) Tasks(ctx context.Context) ([]config.TaskConfig, error) {
 	drivers := rw.drivers.Map()
 	confs := make([]config.TaskConfig, 0, len(drivers))
@@ -225,6 +424,11 @@ func configFromDriverTask(t *driver.Task) (config.TaskConfig, error) {
 	}
 
 	inputs := t.ModuleInputs()
+	postApply := t.PostApply()
+	preApply := t.PreApply()
+	policies := t.Policies()
+	guardrails := t.Guardrails()
+	changeWindows := t.ChangeWindows()
 	tfcWs := t.TFCWorkspace()
 
 	return config.TaskConfig{
@@ -236,6 +440,12 @@ func configFromDriverTask(t *driver.Task) (config.TaskConfig, error) {
 		Module:             config.String(t.Module()),
 		Variables:          vars, // TODO: omit or safe to return?
 		Version:            config.String(t.Version()),
+		Imports:            t.Imports(),
+		PostApply:          &postApply,
+		PreApply:           &preApply,
+		Policies:           &policies,
+		Guardrails:         &guardrails,
+		ChangeWindows:      &changeWindows,
 		BufferPeriod:       &bpConf,
 		Condition:          t.Condition(),
 		ModuleInputs:       &inputs,
@@ -247,7 +457,7 @@ func configFromDriverTask(t *driver.Task) (config.TaskConfig, error) {
 	}, nil
 }
 
-func (rw ReadWrite) cleanupTask(ctx context.Context, name string) {
+func (rw *ReadWrite) cleanupTask(ctx context.Context, name string) {
 	err := rw.TaskDelete(ctx, name)
 	if err != nil {
 		rw.logger.Error("unable to cleanup task after error", "task_name", name)
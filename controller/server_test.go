@@ -239,7 +239,7 @@ func TestServer_TaskCreateAndRun(t *testing.T) {
 			On("InitTask", ctx).Return(nil).
 			On("OverrideNotifier").Return().
 			On("RenderTemplate", mock.Anything).Return(true, nil).
-			On("ApplyTask", ctx).Return(fmt.Errorf("apply err"))
+			On("ApplyTask", mock.Anything).Return(fmt.Errorf("apply err"))
 		ctrl.state = state.NewInMemoryStore(conf)
 		ctrl.drivers = driver.NewDrivers()
 		ctrl.newDriver = func(*config.Config, *driver.Task, templates.Watcher) (driver.Driver, error) {
@@ -255,6 +255,26 @@ func TestServer_TaskCreateAndRun(t *testing.T) {
 		events := ctrl.state.GetTaskEvents("task")
 		assert.Len(t, events, 0, "event is only stored on successful creation and run")
 	})
+
+	t.Run("paused-error", func(t *testing.T) {
+		mockD := new(mocksD.Driver)
+		ctrl.state = state.NewInMemoryStore(conf)
+		ctrl.drivers = driver.NewDrivers()
+		ctrl.newDriver = func(*config.Config, *driver.Task, templates.Watcher) (driver.Driver, error) {
+			return mockD, nil
+		}
+		ctrl.Pause(ctx)
+		defer ctrl.Resume(ctx)
+
+		_, err := ctrl.TaskCreateAndRun(ctx, validTaskConf)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "paused")
+		mockD.AssertNotCalled(t, "InitTask", mock.Anything)
+		mockD.AssertNotCalled(t, "ApplyTask", mock.Anything)
+
+		_, ok := ctrl.drivers.Get("task")
+		assert.False(t, ok, "task is not created while paused")
+	})
 }
 
 func TestServer_TaskDelete(t *testing.T) {
@@ -416,6 +436,192 @@ func TestServer_TaskUpdate(t *testing.T) {
 		events := ctrl.state.GetTaskEvents(taskName)
 		assert.Len(t, events, 1)
 	})
+
+	t.Run("task-run-now-paused-error", func(t *testing.T) {
+		taskName := "task_d"
+		d := new(mocksD.Driver)
+		mockDriver(ctx, d, &driver.Task{})
+		err := ctrl.drivers.Add(taskName, d)
+		require.NoError(t, err)
+
+		updateConf := config.TaskConfig{
+			Name:    &taskName,
+			Enabled: config.Bool(true),
+		}
+
+		ctrl.Pause(ctx)
+		defer ctrl.Resume(ctx)
+		_, _, _, err = ctrl.TaskUpdate(ctx, updateConf, driver.RunOptionNow)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "paused")
+		d.AssertNotCalled(t, "UpdateTask", mock.Anything, mock.Anything)
+
+		events := ctrl.state.GetTaskEvents(taskName)
+		assert.Empty(t, events)
+	})
+}
+
+func TestServer_TaskApprove(t *testing.T) {
+	t.Parallel()
+
+	conf := &config.Config{}
+	conf.Finalize()
+	ctx := context.Background()
+	ctrl := ReadWrite{
+		baseController: &baseController{
+			state:   state.NewInMemoryStore(conf),
+			drivers: driver.NewDrivers(),
+			logger:  logging.NewNullLogger(),
+		},
+	}
+
+	t.Run("blocked-task", func(t *testing.T) {
+		taskName := "task_a"
+		task := enabledTestTask(t, taskName)
+		task.Block(driver.Blocked{Reason: "plan destroys 2 resources"})
+
+		d := new(mocksD.Driver)
+		d.On("Task").Return(task)
+		d.On("TemplateIDs").Return(nil)
+		d.On("ApproveTask", mock.Anything, false).Return(nil).Once()
+		err := ctrl.drivers.Add(taskName, d)
+		require.NoError(t, err)
+
+		reason, ok := ctrl.TaskBlocked(ctx, taskName)
+		assert.True(t, ok)
+		assert.Equal(t, "plan destroys 2 resources", reason)
+
+		err = ctrl.TaskApprove(ctx, taskName, false)
+		require.NoError(t, err)
+		d.AssertExpectations(t)
+
+		events := ctrl.state.GetTaskEvents(taskName)
+		assert.Len(t, events[taskName], 1)
+	})
+
+	t.Run("task-not-blocked-error", func(t *testing.T) {
+		taskName := "task_b"
+		d := new(mocksD.Driver)
+		mockDriver(ctx, d, enabledTestTask(t, taskName))
+		err := ctrl.drivers.Add(taskName, d)
+		require.NoError(t, err)
+
+		_, ok := ctrl.TaskBlocked(ctx, taskName)
+		assert.False(t, ok)
+
+		err = ctrl.TaskApprove(ctx, taskName, true)
+		require.Error(t, err)
+		d.AssertNotCalled(t, "ApproveTask", mock.Anything, mock.Anything)
+	})
+
+	t.Run("paused-error", func(t *testing.T) {
+		taskName := "task_c"
+		task := enabledTestTask(t, taskName)
+		task.Block(driver.Blocked{Reason: "plan destroys 2 resources"})
+
+		d := new(mocksD.Driver)
+		d.On("Task").Return(task)
+		d.On("TemplateIDs").Return(nil)
+		err := ctrl.drivers.Add(taskName, d)
+		require.NoError(t, err)
+
+		ctrl.Pause(ctx)
+		defer ctrl.Resume(ctx)
+		err = ctrl.TaskApprove(ctx, taskName, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "paused")
+		d.AssertNotCalled(t, "ApproveTask", mock.Anything, mock.Anything)
+		assert.True(t, task.IsBlocked())
+	})
+
+	t.Run("task-not-found-error", func(t *testing.T) {
+		err := ctrl.TaskApprove(ctx, "non-existent-task", false)
+		require.Error(t, err)
+	})
+}
+
+func TestServer_TaskRollback(t *testing.T) {
+	t.Parallel()
+
+	conf := &config.Config{}
+	conf.Finalize()
+	ctx := context.Background()
+	ctrl := ReadWrite{
+		baseController: &baseController{
+			state:   state.NewInMemoryStore(conf),
+			drivers: driver.NewDrivers(),
+			logger:  logging.NewNullLogger(),
+		},
+	}
+
+	t.Run("rolled-back", func(t *testing.T) {
+		taskName := "task_a"
+		task := enabledTestTask(t, taskName)
+		task.AddSnapshot(driver.Snapshot{EventID: "event"})
+
+		d := new(mocksD.Driver)
+		d.On("Task").Return(task)
+		d.On("TemplateIDs").Return(nil)
+		d.On("RollbackTask", mock.Anything, "event").Return(nil).Once()
+		err := ctrl.drivers.Add(taskName, d)
+		require.NoError(t, err)
+
+		assert.True(t, ctrl.TaskSnapshot(ctx, taskName, "event"))
+		assert.False(t, ctrl.TaskSnapshot(ctx, taskName, "other_event"))
+
+		err = ctrl.TaskRollback(ctx, taskName, "event")
+		require.NoError(t, err)
+		d.AssertExpectations(t)
+
+		events := ctrl.state.GetTaskEvents(taskName)
+		assert.Len(t, events[taskName], 1)
+	})
+
+	t.Run("rollback-error", func(t *testing.T) {
+		taskName := "task_b"
+		d := new(mocksD.Driver)
+		d.On("Task").Return(enabledTestTask(t, taskName))
+		d.On("TemplateIDs").Return(nil)
+		d.On("RollbackTask", mock.Anything, "event").
+			Return(fmt.Errorf("no snapshot")).Once()
+		err := ctrl.drivers.Add(taskName, d)
+		require.NoError(t, err)
+
+		err = ctrl.TaskRollback(ctx, taskName, "event")
+		require.Error(t, err)
+
+		events := ctrl.state.GetTaskEvents(taskName)
+		require.Len(t, events[taskName], 1)
+		assert.False(t, events[taskName][0].Success)
+	})
+
+	t.Run("paused-error", func(t *testing.T) {
+		taskName := "task_c"
+		task := enabledTestTask(t, taskName)
+		task.AddSnapshot(driver.Snapshot{EventID: "event"})
+
+		d := new(mocksD.Driver)
+		d.On("Task").Return(task)
+		d.On("TemplateIDs").Return(nil)
+		err := ctrl.drivers.Add(taskName, d)
+		require.NoError(t, err)
+
+		ctrl.Pause(ctx)
+		defer ctrl.Resume(ctx)
+		err = ctrl.TaskRollback(ctx, taskName, "event")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "paused")
+		d.AssertNotCalled(t, "RollbackTask", mock.Anything, mock.Anything)
+
+		events := ctrl.state.GetTaskEvents(taskName)
+		assert.Empty(t, events)
+	})
+
+	t.Run("task-not-found-error", func(t *testing.T) {
+		err := ctrl.TaskRollback(ctx, "non-existent-task", "event")
+		require.Error(t, err)
+		assert.False(t, ctrl.TaskSnapshot(ctx, "non-existent-task", "event"))
+	})
 }
 
 // mockDriver sets up a mock driver with the happy path for all methods
@@ -424,5 +630,31 @@ func mockDriver(ctx context.Context, d *mocksD.Driver, task *driver.Task) {
 		On("InitTask", ctx).Return(nil).
 		On("TemplateIDs").Return(nil).
 		On("RenderTemplate", mock.Anything).Return(true, nil).
-		On("ApplyTask", ctx).Return(nil)
+		On("ApplyTask", mock.Anything).Return(nil)
+}
+
+func TestServer_PauseResume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := ReadWrite{
+		baseController: &baseController{
+			logger: logging.NewNullLogger(),
+		},
+		resumeCh: make(chan struct{}, 1),
+	}
+	assert.False(t, ctrl.Paused(ctx))
+
+	ctrl.Pause(ctx)
+	ctrl.Pause(ctx)
+	assert.True(t, ctrl.Paused(ctx))
+
+	ctrl.Resume(ctx)
+	assert.False(t, ctrl.Paused(ctx))
+	assert.Len(t, ctrl.resumeCh, 1)
+
+	// resuming when not paused does not trigger pending tasks again
+	<-ctrl.resumeCh
+	ctrl.Resume(ctx)
+	assert.Empty(t, ctrl.resumeCh)
 }
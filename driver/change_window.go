@@ -0,0 +1,77 @@
+package driver
+
+import (
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/cronexpr"
+)
+
+// changeWindowsOpen returns whether the change windows allow changes to apply
+// at the given time. When the windows do not allow changes, the earliest time
+// that they could allow changes is also returned. The returned time is zero
+// if the windows never allow changes again.
+//
+// Changes are not allowed during any deny window. When allow windows are
+// configured, changes are only allowed during one of the allow windows.
+func changeWindowsOpen(windows config.ChangeWindowConfigs, now time.Time) (bool, time.Time) {
+	var hasAllow, inAllow, inDeny bool
+	var allowNext, denyEnd time.Time
+
+	for _, w := range windows {
+		if w == nil {
+			continue
+		}
+
+		// Validated when the configuration is loaded
+		expr, err := cronexpr.Parse(config.StringVal(w.Cron))
+		if err != nil {
+			continue
+		}
+		duration := config.TimeDurationVal(w.Duration)
+
+		// The window is active when it opened within its duration of now
+		start := expr.Next(now.Add(-duration))
+		active := !start.IsZero() && !start.After(now)
+
+		switch config.StringVal(w.Type) {
+		case config.ChangeWindowAllow:
+			hasAllow = true
+			if active {
+				inAllow = true
+				continue
+			}
+			next := expr.Next(now)
+			if !next.IsZero() && (allowNext.IsZero() || next.Before(allowNext)) {
+				allowNext = next
+			}
+		case config.ChangeWindowDeny:
+			if !active {
+				continue
+			}
+			inDeny = true
+			end := start.Add(duration)
+			if denyEnd.IsZero() || end.Before(denyEnd) {
+				denyEnd = end
+			}
+		}
+	}
+
+	allowClosed := hasAllow && !inAllow
+	if !inDeny && !allowClosed {
+		return true, time.Time{}
+	}
+
+	if allowClosed && allowNext.IsZero() {
+		// none of the allow windows open again
+		return false, time.Time{}
+	}
+
+	// Neither a deny window can close nor an allow window can open any
+	// earlier, so the later of the two is the earliest the windows can open
+	next := denyEnd
+	if allowClosed && allowNext.After(next) {
+		next = allowNext
+	}
+	return false, next
+}
@@ -0,0 +1,215 @@
+package driver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeWindowsOpen(t *testing.T) {
+	t.Parallel()
+
+	// Monday at noon
+	now := time.Date(2026, time.January, 5, 12, 0, 0, 0, time.UTC)
+	at := func(hour int) time.Time {
+		return time.Date(2026, time.January, 5, hour, 0, 0, 0, time.UTC)
+	}
+
+	cases := []struct {
+		name         string
+		windows      config.ChangeWindowConfigs
+		expectedOpen bool
+		expectedNext time.Time
+	}{
+		{
+			"no windows",
+			config.ChangeWindowConfigs{},
+			true,
+			time.Time{},
+		},
+		{
+			"in allow window",
+			config.ChangeWindowConfigs{
+				testChangeWindow(config.ChangeWindowAllow, "0 10 * * *", 4*time.Hour),
+			},
+			true,
+			time.Time{},
+		},
+		{
+			"outside allow window",
+			config.ChangeWindowConfigs{
+				testChangeWindow(config.ChangeWindowAllow, "0 22 * * *", 4*time.Hour),
+			},
+			false,
+			at(22),
+		},
+		{
+			"earliest allow window",
+			config.ChangeWindowConfigs{
+				testChangeWindow(config.ChangeWindowAllow, "0 22 * * *", 4*time.Hour),
+				testChangeWindow(config.ChangeWindowAllow, "0 18 * * *", time.Hour),
+			},
+			false,
+			at(18),
+		},
+		{
+			"in deny window",
+			config.ChangeWindowConfigs{
+				testChangeWindow(config.ChangeWindowDeny, "0 10 * * *", 4*time.Hour),
+			},
+			false,
+			at(14),
+		},
+		{
+			"outside deny window",
+			config.ChangeWindowConfigs{
+				testChangeWindow(config.ChangeWindowDeny, "0 22 * * *", 4*time.Hour),
+			},
+			true,
+			time.Time{},
+		},
+		{
+			"in deny window and outside allow window",
+			config.ChangeWindowConfigs{
+				testChangeWindow(config.ChangeWindowDeny, "0 10 * * *", 4*time.Hour),
+				testChangeWindow(config.ChangeWindowAllow, "0 20 * * *", time.Hour),
+			},
+			false,
+			at(20),
+		},
+		{
+			"allow window opens during deny window",
+			config.ChangeWindowConfigs{
+				testChangeWindow(config.ChangeWindowDeny, "0 10 * * *", 4*time.Hour),
+				testChangeWindow(config.ChangeWindowAllow, "0 13 * * *", 2*time.Hour),
+			},
+			false,
+			at(14),
+		},
+		{
+			"in allow and deny windows",
+			config.ChangeWindowConfigs{
+				testChangeWindow(config.ChangeWindowAllow, "0 8 * * *", 8*time.Hour),
+				testChangeWindow(config.ChangeWindowDeny, "0 11 * * *", 2*time.Hour),
+			},
+			false,
+			at(13),
+		},
+		{
+			"allow window never opens again",
+			config.ChangeWindowConfigs{
+				testChangeWindow(config.ChangeWindowAllow, "0 0 1 1 * 2020", time.Hour),
+			},
+			false,
+			time.Time{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			open, next := changeWindowsOpen(tc.windows, now)
+			assert.Equal(t, tc.expectedOpen, open)
+			assert.Equal(t, tc.expectedNext, next)
+		})
+	}
+}
+
+func TestTask_InChangeWindow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.January, 5, 12, 0, 0, 0, time.UTC)
+	at := func(hour int) time.Time {
+		return time.Date(2026, time.January, 5, hour, 0, 0, 0, time.UTC)
+	}
+	deny := config.ChangeWindowConfigs{
+		testChangeWindow(config.ChangeWindowDeny, "0 10 * * *", 4*time.Hour),
+	}
+	allow := config.ChangeWindowConfigs{
+		testChangeWindow(config.ChangeWindowAllow, "0 20 * * *", time.Hour),
+	}
+
+	cases := []struct {
+		name         string
+		task         config.ChangeWindowConfigs
+		global       config.ChangeWindowConfigs
+		expectedOpen bool
+		expectedNext time.Time
+	}{
+		{
+			"no windows",
+			nil,
+			nil,
+			true,
+			time.Time{},
+		},
+		{
+			"global deny window",
+			nil,
+			deny,
+			false,
+			at(14),
+		},
+		{
+			"task allow window",
+			allow,
+			nil,
+			false,
+			at(20),
+		},
+		{
+			"task and global windows closed",
+			allow,
+			deny,
+			false,
+			at(20),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			task, err := NewTask(TaskConfig{
+				Name:                "task",
+				Enabled:             true,
+				ChangeWindows:       tc.task,
+				GlobalChangeWindows: tc.global,
+			})
+			require.NoError(t, err)
+
+			open, next := task.InChangeWindow(now)
+			assert.Equal(t, tc.expectedOpen, open)
+			assert.Equal(t, tc.expectedNext, next)
+		})
+	}
+}
+
+func TestTask_Deferred(t *testing.T) {
+	t.Parallel()
+
+	task, err := NewTask(TaskConfig{Name: "task", Enabled: true})
+	require.NoError(t, err)
+
+	_, ok := task.Deferred()
+	assert.False(t, ok)
+	assert.False(t, task.IsDeferred())
+
+	until := time.Now().Add(time.Hour)
+	task.Defer(until)
+	actual, ok := task.Deferred()
+	assert.True(t, ok)
+	assert.Equal(t, until, actual)
+	assert.True(t, task.IsDeferred())
+
+	task.ClearDeferred()
+	assert.False(t, task.IsDeferred())
+}
+
+func testChangeWindow(windowType, cron string, duration time.Duration) *config.ChangeWindowConfig {
+	return &config.ChangeWindowConfig{
+		Type:     config.String(windowType),
+		Cron:     config.String(cron),
+		Duration: config.TimeDuration(duration),
+	}
+}
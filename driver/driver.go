@@ -32,6 +32,14 @@ type Driver interface {
 	// UpdateTask supports updating certain fields of a task
 	UpdateTask(ctx context.Context, task PatchTask) (InspectPlan, error)
 
+	// ApproveTask applies a task that is blocked by its guardrails. When
+	// override is true, the guardrails are not checked for the apply.
+	ApproveTask(ctx context.Context, override bool) error
+
+	// RollbackTask applies the snapshot of the task's run for a prior event
+	// and pins the task to the snapshot until the task is re-enabled
+	RollbackTask(ctx context.Context, eventID string) error
+
 	// DestroyTask destroys task dependencies so that it can be safely deleted
 	DestroyTask(ctx context.Context)
 
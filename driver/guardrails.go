@@ -0,0 +1,223 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/handler"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// Blocked is an apply of a task that exceeded the task's guardrails. The task
+// does not apply again until the apply is approved or overridden.
+type Blocked struct {
+	// Reason describes the guardrails that the plan exceeded
+	Reason string
+
+	// Changes are the changes of the blocked plan
+	Changes PlanChanges
+}
+
+// PlanChanges summarizes the changes to managed resources of a structured plan
+type PlanChanges struct {
+	// Changes is the number of resources to create, update, or destroy
+	Changes int
+
+	// Destroy is the number of resources to destroy, including replacements
+	Destroy int
+
+	// Existing is the number of existing resources to update or destroy
+	Existing int
+
+	// Managed is the number of resources in the state prior to the plan
+	Managed int
+}
+
+// newPlanChanges counts the changes of the plan. Data sources are not counted.
+// Resources to destroy are counted the same as the max_destroy plan policy.
+func newPlanChanges(plan *tfjson.Plan) PlanChanges {
+	var c PlanChanges
+	if plan == nil {
+		return c
+	}
+
+	if plan.PriorState != nil && plan.PriorState.Values != nil {
+		c.Managed = countManagedResources(plan.PriorState.Values.RootModule)
+	}
+
+	for _, rc := range plan.ResourceChanges {
+		if rc == nil || rc.Change == nil || rc.Mode != tfjson.ManagedResourceMode {
+			continue
+		}
+
+		actions := rc.Change.Actions
+		if actions.NoOp() || actions.Read() {
+			continue
+		}
+
+		c.Changes++
+		if !actions.Create() {
+			c.Existing++
+		}
+	}
+	c.Destroy = handler.CountDestroy(plan)
+	return c
+}
+
+// countManagedResources returns the number of managed resources of the module
+// and its child modules
+func countManagedResources(m *tfjson.StateModule) int {
+	if m == nil {
+		return 0
+	}
+
+	count := 0
+	for _, r := range m.Resources {
+		if r != nil && r.Mode == tfjson.ManagedResourceMode {
+			count++
+		}
+	}
+	for _, child := range m.ChildModules {
+		count += countManagedResources(child)
+	}
+	return count
+}
+
+// within returns whether the changes do not exceed the other changes
+func (c PlanChanges) within(o PlanChanges) bool {
+	return c.Changes <= o.Changes && c.Destroy <= o.Destroy &&
+		c.Existing <= o.Existing
+}
+
+// guardrailViolations returns a description of each guardrail that the
+// changes exceed
+func guardrailViolations(conf config.GuardrailsConfig, c PlanChanges) []string {
+	var violations []string
+
+	if conf.MaxDestroy != nil {
+		if err := handler.CheckMaxDestroy(c.Destroy, *conf.MaxDestroy); err != nil {
+			violations = append(violations, err.Error())
+		}
+	}
+
+	if conf.MaxChanges != nil && c.Changes > *conf.MaxChanges {
+		violations = append(violations, fmt.Sprintf("plan changes %d "+
+			"resources, more than max_changes %d", c.Changes, *conf.MaxChanges))
+	}
+
+	if conf.MaxChangePercent != nil && c.Managed > 0 {
+		percent := float64(c.Existing) * 100 / float64(c.Managed)
+		if percent > float64(*conf.MaxChangePercent) {
+			violations = append(violations, fmt.Sprintf("plan updates or "+
+				"destroys %d of %d managed resources (%.0f%%), more than "+
+				"max_change_percent %d%%", c.Existing, c.Managed, percent,
+				*conf.MaxChangePercent))
+		}
+	}
+
+	return violations
+}
+
+// approval is an operator's approval of an apply blocked by the guardrails
+type approval struct {
+	blocked  Blocked
+	override bool
+}
+
+// ApproveTask applies the task after its guardrails blocked an apply. The
+// task plans again, and the apply is approved when the changes of the new plan
+// do not exceed the changes of the blocked plan. Otherwise the task remains
+// blocked with the new plan. When override is true, the guardrails are not
+// checked for the apply.
+func (tf *Terraform) ApproveTask(ctx context.Context, override bool) error {
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
+
+	taskName := tf.task.Name()
+	blocked, ok := tf.task.Blocked()
+	if !ok {
+		return fmt.Errorf("task '%s' is not blocked by its guardrails", taskName)
+	}
+
+	if !tf.task.IsEnabled() {
+		return fmt.Errorf("task '%s' is disabled. Enable the task before "+
+			"approving the apply", taskName)
+	}
+
+	tf.logger.Info("approving blocked apply", taskNameLogKey, taskName,
+		"override", override)
+	tf.approval = &approval{blocked: blocked, override: override}
+	defer func() { tf.approval = nil }()
+
+	return tf.applyTask(ctx)
+}
+
+// inspectGuardrails adds the guardrails of the task that an inspected plan
+// exceeds to the inspection. The task is not blocked.
+func (tf *Terraform) inspectGuardrails(ctx context.Context, plan *InspectPlan) error {
+	conf := tf.task.Guardrails()
+	if conf.IsEmpty() || !plan.ChangesPresent {
+		return nil
+	}
+
+	p, err := tf.showPlan(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	plan.Guardrails = guardrailViolations(conf, newPlanChanges(p))
+	if len(plan.Guardrails) > 0 {
+		plan.Plan = fmt.Sprintf("%s\nGuardrails exceeded:\n  %s\n",
+			plan.Plan, strings.Join(plan.Guardrails, "\n  "))
+	}
+	return nil
+}
+
+// checkGuardrails checks the changes of the most recent plan against the
+// task's guardrails. The task is blocked and an error is returned if the plan
+// exceeds the guardrails and the apply is not approved.
+func (tf *Terraform) checkGuardrails(ctx context.Context, plan *InspectPlan) error {
+	conf := tf.task.Guardrails()
+	if conf.IsEmpty() {
+		return nil
+	}
+
+	taskName := tf.task.Name()
+	if tf.approval != nil && tf.approval.override {
+		tf.logger.Info("guardrails overridden", taskNameLogKey, taskName)
+		tf.task.Unblock()
+		return nil
+	}
+
+	if !plan.ChangesPresent {
+		tf.task.Unblock()
+		return nil
+	}
+
+	p, err := tf.showPlan(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	changes := newPlanChanges(p)
+	violations := guardrailViolations(conf, changes)
+	if len(violations) == 0 {
+		tf.task.Unblock()
+		return nil
+	}
+
+	if tf.approval != nil && changes.within(tf.approval.blocked.Changes) {
+		tf.logger.Info("blocked apply approved", taskNameLogKey, taskName)
+		tf.task.Unblock()
+		return nil
+	}
+
+	reason := strings.Join(violations, "; ")
+	tf.task.Block(Blocked{Reason: reason, Changes: changes})
+	tf.logger.Warn("apply blocked by guardrails", taskNameLogKey, taskName,
+		"reason", reason)
+	return fmt.Errorf("guardrails blocked tf-apply for '%s': %s. The task "+
+		"will not apply until the apply is approved", taskName, reason)
+}
@@ -0,0 +1,292 @@
+package driver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/client"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPlanChanges(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		plan     *tfjson.Plan
+		expected PlanChanges
+	}{
+		{
+			"nil plan",
+			nil,
+			PlanChanges{},
+		},
+		{
+			"no changes",
+			&tfjson.Plan{FormatVersion: "0.2"},
+			PlanChanges{},
+		},
+		{
+			"mixed changes",
+			&tfjson.Plan{
+				FormatVersion: "0.2",
+				PriorState: &tfjson.State{
+					Values: &tfjson.StateValues{
+						RootModule: &tfjson.StateModule{
+							Resources: []*tfjson.StateResource{
+								{Mode: tfjson.ManagedResourceMode},
+								{Mode: tfjson.DataResourceMode},
+							},
+							ChildModules: []*tfjson.StateModule{{
+								Resources: []*tfjson.StateResource{
+									{Mode: tfjson.ManagedResourceMode},
+									{Mode: tfjson.ManagedResourceMode},
+								},
+							}},
+						},
+					},
+				},
+				ResourceChanges: []*tfjson.ResourceChange{
+					testResourceChange(tfjson.ManagedResourceMode, tfjson.ActionCreate),
+					testResourceChange(tfjson.ManagedResourceMode, tfjson.ActionUpdate),
+					testResourceChange(tfjson.ManagedResourceMode, tfjson.ActionDelete),
+					testResourceChange(tfjson.ManagedResourceMode, tfjson.ActionDelete, tfjson.ActionCreate),
+					testResourceChange(tfjson.ManagedResourceMode, tfjson.ActionNoop),
+					testResourceChange(tfjson.DataResourceMode, tfjson.ActionRead),
+				},
+			},
+			PlanChanges{Changes: 4, Destroy: 2, Existing: 3, Managed: 3},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, newPlanChanges(tc.plan))
+		})
+	}
+}
+
+func TestGuardrailViolations(t *testing.T) {
+	t.Parallel()
+
+	changes := PlanChanges{Changes: 4, Destroy: 2, Existing: 3, Managed: 10}
+	cases := []struct {
+		name     string
+		conf     config.GuardrailsConfig
+		expected int
+	}{
+		{
+			"no limits",
+			config.GuardrailsConfig{},
+			0,
+		},
+		{
+			"within limits",
+			config.GuardrailsConfig{
+				MaxDestroy:       config.Int(2),
+				MaxChanges:       config.Int(4),
+				MaxChangePercent: config.Int(30),
+			},
+			0,
+		},
+		{
+			"exceeds max_destroy",
+			config.GuardrailsConfig{MaxDestroy: config.Int(1)},
+			1,
+		},
+		{
+			"exceeds all limits",
+			config.GuardrailsConfig{
+				MaxDestroy:       config.Int(0),
+				MaxChanges:       config.Int(3),
+				MaxChangePercent: config.Int(20),
+			},
+			3,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			violations := guardrailViolations(tc.conf, changes)
+			assert.Len(t, violations, tc.expected)
+		})
+	}
+
+	t.Run("percent skipped without managed resources", func(t *testing.T) {
+		conf := config.GuardrailsConfig{MaxChangePercent: config.Int(0)}
+		violations := guardrailViolations(conf, PlanChanges{Changes: 1})
+		assert.Empty(t, violations)
+	})
+}
+
+func TestApplyTask_Guardrails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	guardrails := config.GuardrailsConfig{MaxDestroy: config.Int(0)}
+
+	t.Run("blocks apply", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("SetStdout", mock.Anything).Twice()
+		c.On("Plan", ctx).Return(true, nil).Once()
+		c.On("ShowPlan", ctx).Return(testDeletePlan(), nil).Once()
+		c.On("DiscardPlan").Return(nil).Once()
+		tf := testGuardrailsTerraform(t, c, guardrails)
+
+		err := tf.ApplyTask(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "more than max_destroy 0")
+
+		blocked, ok := tf.task.Blocked()
+		require.True(t, ok)
+		assert.Equal(t, PlanChanges{Changes: 1, Destroy: 1, Existing: 1}, blocked.Changes)
+		c.AssertExpectations(t)
+
+		// The task does not plan again until the apply is approved
+		err = tf.ApplyTask(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "until the apply is approved")
+		c.AssertNumberOfCalls(t, "Plan", 1)
+	})
+
+	t.Run("approve within blocked changes", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("SetStdout", mock.Anything).Twice()
+		c.On("Plan", ctx).Return(true, nil).Once()
+		c.On("ShowPlan", ctx).Return(testDeletePlan(), nil).Once()
+		c.On("Apply", ctx).Return(nil).Once()
+		tf := testGuardrailsTerraform(t, c, guardrails)
+		tf.task.Block(Blocked{Changes: PlanChanges{Changes: 1, Destroy: 1, Existing: 1}})
+
+		err := tf.ApproveTask(ctx, false)
+		require.NoError(t, err)
+		assert.False(t, tf.task.IsBlocked())
+		c.AssertExpectations(t)
+	})
+
+	t.Run("approve exceeds blocked changes", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("SetStdout", mock.Anything).Twice()
+		c.On("Plan", ctx).Return(true, nil).Once()
+		c.On("ShowPlan", ctx).Return(testDeletePlan(), nil).Once()
+		c.On("DiscardPlan").Return(nil).Once()
+		tf := testGuardrailsTerraform(t, c, guardrails)
+		tf.task.Block(Blocked{Changes: PlanChanges{}})
+
+		err := tf.ApproveTask(ctx, false)
+		require.Error(t, err)
+		assert.True(t, tf.task.IsBlocked())
+		c.AssertNotCalled(t, "Apply", ctx)
+	})
+
+	t.Run("override", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("SetStdout", mock.Anything).Twice()
+		c.On("Plan", ctx).Return(true, nil).Once()
+		c.On("Apply", ctx).Return(nil).Once()
+		tf := testGuardrailsTerraform(t, c, guardrails)
+		tf.task.Block(Blocked{Reason: "plan destroys 1 resources"})
+
+		err := tf.ApproveTask(ctx, true)
+		require.NoError(t, err)
+		assert.False(t, tf.task.IsBlocked())
+		c.AssertNotCalled(t, "ShowPlan", ctx)
+		c.AssertExpectations(t)
+	})
+
+	t.Run("shares plan with plan policy", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("SetStdout", mock.Anything).Twice()
+		c.On("Plan", ctx).Return(true, nil).Once()
+		c.On("ShowPlan", ctx).Return(testDeletePlan(), nil).Once()
+		c.On("Apply", ctx).Return(nil).Once()
+		tf := testGuardrailsTerraform(t, c,
+			config.GuardrailsConfig{MaxDestroy: config.Int(1)})
+		tf.task.preApply = config.PreApplyConfigs{{MaxDestroy: config.Int(1)}}
+		preH, err := getPreApplyHandlers(tf.task)
+		require.NoError(t, err)
+		tf.preApply = preH
+
+		require.NoError(t, tf.ApplyTask(ctx))
+		c.AssertExpectations(t)
+	})
+
+	t.Run("approve not blocked", func(t *testing.T) {
+		c := new(mocks.Client)
+		tf := testGuardrailsTerraform(t, c, guardrails)
+
+		err := tf.ApproveTask(ctx, false)
+		require.Error(t, err)
+		c.AssertNotCalled(t, "Plan", ctx)
+	})
+}
+
+func TestInspectGuardrails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("exceeds guardrails", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("ShowPlan", ctx).Return(testDeletePlan(), nil).Once()
+		tf := testGuardrailsTerraform(t, c,
+			config.GuardrailsConfig{MaxDestroy: config.Int(0)})
+
+		plan := InspectPlan{ChangesPresent: true, Plan: "plan"}
+		require.NoError(t, tf.inspectGuardrails(ctx, &plan))
+		assert.Len(t, plan.Guardrails, 1)
+		assert.Contains(t, plan.Plan, "Guardrails exceeded")
+		assert.False(t, tf.task.IsBlocked(), "inspecting does not block")
+		c.AssertExpectations(t)
+	})
+
+	t.Run("within guardrails", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("ShowPlan", ctx).Return(testDeletePlan(), nil).Once()
+		tf := testGuardrailsTerraform(t, c,
+			config.GuardrailsConfig{MaxDestroy: config.Int(5)})
+
+		plan := InspectPlan{ChangesPresent: true, Plan: "plan"}
+		require.NoError(t, tf.inspectGuardrails(ctx, &plan))
+		assert.Empty(t, plan.Guardrails)
+		assert.Equal(t, "plan", plan.Plan)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		c := new(mocks.Client)
+		tf := testGuardrailsTerraform(t, c,
+			config.GuardrailsConfig{MaxDestroy: config.Int(0)})
+
+		plan := InspectPlan{}
+		require.NoError(t, tf.inspectGuardrails(ctx, &plan))
+		assert.Empty(t, plan.Guardrails)
+		c.AssertNotCalled(t, "ShowPlan", ctx)
+	})
+}
+
+func testGuardrailsTerraform(tb testing.TB, c *mocks.Client, guardrails config.GuardrailsConfig) *Terraform {
+	task, err := NewTask(TaskConfig{
+		Name:       "task",
+		Enabled:    true,
+		Guardrails: guardrails,
+	})
+	require.NoError(tb, err)
+
+	return &Terraform{
+		task:   task,
+		client: c,
+		logger: logging.NewNullLogger(),
+	}
+}
+
+func testResourceChange(mode tfjson.ResourceMode, actions ...tfjson.Action) *tfjson.ResourceChange {
+	return &tfjson.ResourceChange{
+		Mode:   mode,
+		Change: &tfjson.Change{Actions: actions},
+	}
+}
@@ -0,0 +1,99 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl"
+)
+
+// snapshotCountLimit is the number of snapshots kept per task. It matches the
+// number of events stored per task.
+const snapshotCountLimit = 5
+
+// Snapshot is the input of a successful run of a task: the rendered input
+// variables and the version of the module. The task can be rolled back to
+// the snapshot of the event of the run.
+type Snapshot struct {
+	EventID       string
+	TFVars        []byte
+	ModuleVersion string
+}
+
+// pinnedSnapshot is the snapshot a task is rolled back to, along with the
+// configured module version to restore once the task is unpinned
+type pinnedSnapshot struct {
+	snapshot Snapshot
+	version  string
+}
+
+// RollbackTask applies the snapshot of the task's run for a prior event. The
+// task is pinned to the snapshot and disabled so that dependency changes do
+// not apply until the task is re-enabled.
+func (tf *Terraform) RollbackTask(ctx context.Context, eventID string) error {
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
+
+	taskName := tf.task.Name()
+	s, ok := tf.task.Snapshot(eventID)
+	if !ok {
+		return fmt.Errorf("task '%s' does not have a snapshot for event '%s'",
+			taskName, eventID)
+	}
+
+	tf.logger.Info("rolling back task", taskNameLogKey, taskName,
+		"event_id", eventID, "module_version", s.ModuleVersion)
+	if err := tf.pin(ctx, s); err != nil {
+		return err
+	}
+
+	return tf.applyTask(ctx)
+}
+
+// pin pins the task to the snapshot, and restores the snapshot's input
+// variables and module version to the task's working directory
+func (tf *Terraform) pin(ctx context.Context, s Snapshot) error {
+	taskName := tf.task.Name()
+	reinit := s.ModuleVersion != tf.task.Version()
+	tf.task.Pin(s)
+
+	if reinit {
+		if err := tf.initTask(ctx); err != nil {
+			return fmt.Errorf("error pinning task '%s' to module version '%s': %s",
+				taskName, s.ModuleVersion, err)
+		}
+	}
+
+	if _, err := tf.template.Render(s.TFVars); err != nil {
+		return fmt.Errorf("error restoring input variables of task '%s' for "+
+			"event '%s': %s", taskName, s.EventID, err)
+	}
+	return nil
+}
+
+// snapshot stores the input of the task's run for the event that is applied.
+// Nothing is stored when the apply is not for an event.
+func (tf *Terraform) snapshot(ctx context.Context) {
+	eventID := event.IDFromContext(ctx)
+	if eventID == "" {
+		return
+	}
+
+	taskName := tf.task.Name()
+	path := filepath.Join(tf.task.WorkingDir(), tftmpl.TFVarsFilename)
+	tfvars, err := tf.fileReader(path)
+	if err != nil {
+		// the run succeeded, so only log that it can't be rolled back to
+		tf.logger.Warn("unable to snapshot input variables for task",
+			taskNameLogKey, taskName, "event_id", eventID, "error", err)
+		return
+	}
+
+	tf.task.AddSnapshot(Snapshot{
+		EventID:       eventID,
+		TFVars:        tfvars,
+		ModuleVersion: tf.task.Version(),
+	})
+}
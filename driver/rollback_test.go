@@ -0,0 +1,274 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/client"
+	mocksTmpl "github.com/hashicorp/consul-terraform-sync/mocks/templates"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl"
+	"github.com/hashicorp/consul-terraform-sync/testutils"
+	"github.com/hashicorp/hcat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTask_Snapshots(t *testing.T) {
+	t.Parallel()
+
+	task, err := NewTask(TaskConfig{Name: "task", Enabled: true})
+	require.NoError(t, err)
+
+	_, ok := task.Snapshot("event")
+	assert.False(t, ok)
+
+	for i := 0; i < snapshotCountLimit+1; i++ {
+		task.AddSnapshot(Snapshot{EventID: fmt.Sprintf("event_%d", i)})
+	}
+
+	// the oldest snapshot is no longer kept
+	_, ok = task.Snapshot("event_0")
+	assert.False(t, ok)
+
+	s, ok := task.Snapshot("event_1")
+	assert.True(t, ok)
+	assert.Equal(t, "event_1", s.EventID)
+}
+
+func TestTask_Pin(t *testing.T) {
+	t.Parallel()
+
+	task, err := NewTask(TaskConfig{Name: "task", Enabled: true, Version: "1.1.0"})
+	require.NoError(t, err)
+
+	_, ok := task.Pinned()
+	assert.False(t, ok)
+
+	s := Snapshot{EventID: "event", ModuleVersion: "1.0.0"}
+	task.Pin(s)
+	pinned, ok := task.Pinned()
+	assert.True(t, ok)
+	assert.Equal(t, s, pinned)
+	assert.False(t, task.IsEnabled())
+	assert.Equal(t, "1.0.0", task.Version())
+
+	// pinning again keeps the configured version to restore
+	task.Pin(Snapshot{EventID: "event_2", ModuleVersion: "0.9.0"})
+	task.Unpin()
+	_, ok = task.Pinned()
+	assert.False(t, ok)
+	assert.False(t, task.IsEnabled())
+	assert.Equal(t, "1.1.0", task.Version())
+}
+
+func TestApplyTask_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cases := []struct {
+		name     string
+		eventID  string
+		readErr  error
+		expectOK bool
+	}{
+		{
+			"event",
+			"event",
+			nil,
+			true,
+		},
+		{
+			"no event",
+			"",
+			nil,
+			false,
+		},
+		{
+			"read error",
+			"event",
+			errors.New("read error"),
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := new(mocks.Client)
+			c.On("Apply", mock.Anything).Return(nil).Once()
+			task, err := NewTask(TaskConfig{
+				Name:       "task",
+				Enabled:    true,
+				Version:    "1.0.0",
+				WorkingDir: "sync-tasks/task",
+			})
+			require.NoError(t, err)
+
+			var readPath string
+			tf := &Terraform{
+				task:   task,
+				client: c,
+				logger: logging.NewNullLogger(),
+				fileReader: func(path string) ([]byte, error) {
+					readPath = path
+					return []byte("services = {}"), tc.readErr
+				},
+			}
+
+			err = tf.ApplyTask(event.WithID(ctx, tc.eventID))
+			require.NoError(t, err)
+
+			s, ok := task.Snapshot(tc.eventID)
+			assert.Equal(t, tc.expectOK, ok)
+			if tc.expectOK {
+				assert.Equal(t, filepath.Join("sync-tasks/task", tftmpl.TFVarsFilename), readPath)
+				assert.Equal(t, Snapshot{
+					EventID:       tc.eventID,
+					TFVars:        []byte("services = {}"),
+					ModuleVersion: "1.0.0",
+				}, s)
+			}
+		})
+	}
+}
+
+func TestRollbackTask(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	snapshot := Snapshot{
+		EventID:       "event",
+		TFVars:        []byte("services = {}"),
+		ModuleVersion: "1.0.0",
+	}
+
+	t.Run("rolled back", func(t *testing.T) {
+		task, err := NewTask(TaskConfig{Name: "task", Enabled: true, Version: "1.0.0"})
+		require.NoError(t, err)
+		task.AddSnapshot(snapshot)
+
+		c := new(mocks.Client)
+		c.On("Apply", ctx).Return(nil).Once()
+		tmpl := new(mocksTmpl.Template)
+		tmpl.On("Render", snapshot.TFVars).Return(hcat.RenderResult{}, nil).Once()
+		tf := &Terraform{
+			task:     task,
+			client:   c,
+			template: tmpl,
+			logger:   logging.NewNullLogger(),
+		}
+
+		err = tf.RollbackTask(ctx, "event")
+		require.NoError(t, err)
+		c.AssertExpectations(t)
+		tmpl.AssertExpectations(t)
+
+		pinned, ok := task.Pinned()
+		assert.True(t, ok)
+		assert.Equal(t, snapshot, pinned)
+		assert.False(t, task.IsEnabled())
+	})
+
+	t.Run("no snapshot", func(t *testing.T) {
+		task, err := NewTask(TaskConfig{Name: "task", Enabled: true})
+		require.NoError(t, err)
+
+		c := new(mocks.Client)
+		tf := &Terraform{
+			task:   task,
+			client: c,
+			logger: logging.NewNullLogger(),
+		}
+
+		err = tf.RollbackTask(ctx, "event")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not have a snapshot")
+		c.AssertNotCalled(t, "Apply", mock.Anything)
+
+		_, ok := task.Pinned()
+		assert.False(t, ok)
+		assert.True(t, task.IsEnabled())
+	})
+
+	t.Run("render error", func(t *testing.T) {
+		task, err := NewTask(TaskConfig{Name: "task", Enabled: true, Version: "1.0.0"})
+		require.NoError(t, err)
+		task.AddSnapshot(snapshot)
+
+		c := new(mocks.Client)
+		tmpl := new(mocksTmpl.Template)
+		tmpl.On("Render", snapshot.TFVars).
+			Return(hcat.RenderResult{}, errors.New("render error")).Once()
+		tf := &Terraform{
+			task:     task,
+			client:   c,
+			template: tmpl,
+			logger:   logging.NewNullLogger(),
+		}
+
+		err = tf.RollbackTask(ctx, "event")
+		require.Error(t, err)
+		c.AssertNotCalled(t, "Apply", mock.Anything)
+	})
+}
+
+func TestUpdateTask_Unpin(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dirName := "update-task-unpin"
+	deleteTemp := testutils.MakeTempDir(t, dirName)
+	defer deleteTemp()
+
+	task, err := NewTask(TaskConfig{
+		Name:       "task",
+		Enabled:    true,
+		Version:    "1.1.0",
+		WorkingDir: dirName,
+	})
+	require.NoError(t, err)
+	task.Pin(Snapshot{EventID: "event", ModuleVersion: "1.1.0"})
+
+	// the template has no new changes since it last rendered
+	r := new(mocksTmpl.Resolver)
+	r.On("Run", mock.Anything, mock.Anything).Return(hcat.ResolveEvent{
+		Complete: true,
+		NoChange: true,
+		Contents: []byte("latest"),
+	}, nil).Once()
+	c := new(mocks.Client)
+	c.On("Init", ctx).Return(nil).Once()
+	c.On("Validate", ctx).Return(nil).Once()
+	w := new(mocksTmpl.Watcher)
+	w.On("Register", mock.Anything).Return(nil).Once()
+	w.On("Clients").Return(nil).Once()
+
+	tf := &Terraform{
+		task:         task,
+		client:       c,
+		resolver:     r,
+		watcher:      w,
+		logger:       logging.NewNullLogger(),
+		renderedOnce: true,
+		fileReader:   func(string) ([]byte, error) { return []byte{}, nil },
+	}
+
+	_, err = tf.UpdateTask(ctx, PatchTask{Enabled: true})
+	require.NoError(t, err)
+
+	_, ok := task.Pinned()
+	assert.False(t, ok)
+	assert.True(t, task.IsEnabled())
+	assert.Equal(t, "1.1.0", task.Version())
+
+	// the latest content replaces the snapshot
+	content, err := ioutil.ReadFile(filepath.Join(dirName, tftmpl.TFVarsFilename))
+	require.NoError(t, err)
+	assert.Equal(t, "latest", string(content))
+}
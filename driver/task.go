@@ -3,6 +3,7 @@ package driver
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -30,6 +31,10 @@ type PatchTask struct {
 	RunOption string
 
 	Enabled bool
+
+	// Version is the module version to update the task to. The version of the
+	// task is not changed when empty.
+	Version string
 }
 
 // Service contains service configuration information
@@ -63,12 +68,34 @@ type Task struct {
 	module       string
 	variables    hcltmpl.Variables // loaded variables from varFiles
 	version      string
+	imports      map[string]string // resource address to existing ID
+	postApply    config.PostApplyConfigs
+	preApply     config.PreApplyConfigs
+	policies     config.PolicyConfigs
+	guardrails   config.GuardrailsConfig
+	blocked      *Blocked      // nil unless the guardrails blocked an apply
 	bufferPeriod *BufferPeriod // nil when disabled
 	condition    config.ConditionConfig
 	moduleInputs config.ModuleInputConfigs
 	workingDir   string
 	logger       logging.Logger
 
+	changeWindows       config.ChangeWindowConfigs
+	globalChangeWindows config.ChangeWindowConfigs
+	deferred            *time.Time // nil unless changes wait for the change windows
+
+	// lastTriggered is when the task last ran for a schedule condition
+	// configured with on_change_interval. triggerQueued is set while a run
+	// triggered by dependency changes waits for the interval to elapse.
+	// triggerGen is incremented on each run to detect queued runs that were
+	// superseded while waiting.
+	lastTriggered time.Time
+	triggerQueued bool
+	triggerGen    uint64
+
+	snapshots []Snapshot      // inputs of the most recent successful runs
+	pinned    *pinnedSnapshot // nil unless rolled back to a snapshot
+
 	// Enterprise
 	tfVersion    string
 	tfcWorkspace config.TerraformCloudWorkspaceConfig
@@ -86,13 +113,23 @@ type TaskConfig struct {
 	VarFiles     []string
 	Variables    map[string]string
 	Version      string
+	Imports      map[string]string
+	PostApply    config.PostApplyConfigs
+	PreApply     config.PreApplyConfigs
+	Policies     config.PolicyConfigs
+	Guardrails   config.GuardrailsConfig
 	BufferPeriod *BufferPeriod
 	Condition    config.ConditionConfig
 	ModuleInputs config.ModuleInputConfigs
 	WorkingDir   string
+	TFVersion    string
+
+	// ChangeWindows are the task's change windows, and GlobalChangeWindows
+	// are the change windows that apply to all tasks
+	ChangeWindows       config.ChangeWindowConfigs
+	GlobalChangeWindows config.ChangeWindowConfigs
 
 	// Enterprise
-	TFVersion    string
 	TFCWorkspace config.TerraformCloudWorkspaceConfig
 }
 
@@ -134,12 +171,20 @@ func NewTask(conf TaskConfig) (*Task, error) {
 		module:       conf.Module,
 		variables:    loadedVars,
 		version:      conf.Version,
+		imports:      conf.Imports,
+		postApply:    conf.PostApply,
+		preApply:     conf.PreApply,
+		policies:     conf.Policies,
+		guardrails:   conf.Guardrails,
 		bufferPeriod: conf.BufferPeriod,
 		condition:    conf.Condition,
 		moduleInputs: conf.ModuleInputs,
 		workingDir:   conf.WorkingDir,
 		logger:       logging.Global().Named(logSystemName),
 
+		changeWindows:       conf.ChangeWindows,
+		globalChangeWindows: conf.GlobalChangeWindows,
+
 		// Enterprise
 		tfVersion:    conf.TFVersion,
 		tfcWorkspace: conf.TFCWorkspace,
@@ -171,12 +216,63 @@ func (t *Task) ModuleInputs() config.ModuleInputConfigs {
 	return *t.moduleInputs.Copy()
 }
 
-// IsScheduled returns if the task is a scheduled task or not (a dynamic task)
+// IsScheduled returns if the task is a scheduled task or not (a dynamic task).
+// A task with a composite condition that includes a schedule condition is a
+// scheduled task.
 func (t *Task) IsScheduled() bool {
+	return t.Schedule() != nil
+}
+
+// IsDynamic returns if the task is triggered by changes to the dependencies
+// monitored by its condition. Only scheduled tasks that are triggered solely
+// by the schedule are not dynamic.
+func (t *Task) IsDynamic() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	switch v := t.condition.(type) {
+	case *config.ScheduleConditionConfig:
+		return false
+	case *config.CompositeConditionConfig:
+		return !v.IsAll() || v.ScheduleCondition() == nil
+	}
+	return true
+}
+
+// Schedule returns the schedule condition that triggers the task. Returns nil
+// if the task is not a scheduled task.
+func (t *Task) Schedule() *config.ScheduleConditionConfig {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	switch v := t.condition.(type) {
+	case *config.ScheduleConditionConfig:
+		return v
+	case *config.CompositeConditionConfig:
+		return v.ScheduleCondition()
+	}
+	return nil
+}
+
+// OnChangeInterval returns the minimum interval between runs of a scheduled
+// task that is also triggered by changes to its module inputs. Returns 0 if the
+// task is only triggered by its schedule or is not a scheduled task.
+func (t *Task) OnChangeInterval() time.Duration {
+	if v := t.Schedule(); v != nil {
+		return config.TimeDurationVal(v.OnChangeInterval)
+	}
+	return 0
+}
+
+// UsesVaultSecrets returns whether the task has a vault_secrets module input,
+// whose values are passed to Terraform as a sensitive variable.
+func (t *Task) UsesVaultSecrets() bool {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
-	_, ok := t.condition.(*config.ScheduleConditionConfig)
-	return ok
+	for _, input := range t.moduleInputs {
+		if _, ok := input.(*config.VaultSecretsModuleInputConfig); ok {
+			return true
+		}
+	}
+	return false
 }
 
 // Description returns the task description
@@ -295,6 +391,264 @@ func (t *Task) Version() string {
 	return t.version
 }
 
+// setVersion sets the version for the module of the task
+func (t *Task) setVersion(version string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.version = version
+}
+
+// Imports returns a copy of the resource addresses mapped to the IDs of
+// existing infrastructure to import for the task.
+func (t *Task) Imports() map[string]string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	imports := make(map[string]string)
+	for k, v := range t.imports {
+		imports[k] = v
+	}
+	return imports
+}
+
+// PostApply returns a copy of the commands to execute after the task applies
+func (t *Task) PostApply() config.PostApplyConfigs {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return *t.postApply.Copy()
+}
+
+// PreApply returns a copy of the checks to run before the task applies
+func (t *Task) PreApply() config.PreApplyConfigs {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return *t.preApply.Copy()
+}
+
+// Policies returns a copy of the policies the task's plan is checked against
+// before the task applies
+func (t *Task) Policies() config.PolicyConfigs {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return *t.policies.Copy()
+}
+
+// Guardrails returns a copy of the limits on the changes of the task's plan
+func (t *Task) Guardrails() config.GuardrailsConfig {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return *t.guardrails.Copy()
+}
+
+// Blocked returns the apply that the task's guardrails blocked. The second
+// parameter returns false if the task is not blocked.
+func (t *Task) Blocked() (Blocked, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.blocked == nil {
+		return Blocked{}, false
+	}
+	return *t.blocked, true
+}
+
+// IsBlocked returns whether the task is blocked from applying by its
+// guardrails
+func (t *Task) IsBlocked() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.blocked != nil
+}
+
+// Block blocks the task from applying until it is unblocked
+func (t *Task) Block(b Blocked) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.blocked = &b
+}
+
+// Unblock allows the task to apply again
+func (t *Task) Unblock() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.blocked = nil
+}
+
+// ChangeWindows returns a copy of the task's change windows. The global
+// change windows are not included.
+func (t *Task) ChangeWindows() config.ChangeWindowConfigs {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return *t.changeWindows.Copy()
+}
+
+// InChangeWindow returns whether both the task's change windows and the
+// global change windows allow the task to apply at the given time. If not,
+// the earliest time the windows could allow the task to apply is returned,
+// which is zero if the windows never allow the task to apply again.
+func (t *Task) InChangeWindow(now time.Time) (bool, time.Time) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	taskOpen, taskNext := changeWindowsOpen(t.changeWindows, now)
+	globalOpen, globalNext := changeWindowsOpen(t.globalChangeWindows, now)
+	switch {
+	case taskOpen && globalOpen:
+		return true, time.Time{}
+	case taskOpen:
+		return false, globalNext
+	case globalOpen:
+		return false, taskNext
+	case taskNext.IsZero() || globalNext.IsZero():
+		return false, time.Time{}
+	case taskNext.After(globalNext):
+		return false, taskNext
+	default:
+		return false, globalNext
+	}
+}
+
+// Deferred returns the time the task's deferred changes are next checked
+// against the change windows. The second parameter returns false if the task
+// does not have deferred changes.
+func (t *Task) Deferred() (time.Time, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.deferred == nil {
+		return time.Time{}, false
+	}
+	return *t.deferred, true
+}
+
+// IsDeferred returns whether the task has changes that are waiting for the
+// change windows to allow the task to apply
+func (t *Task) IsDeferred() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.deferred != nil
+}
+
+// Defer marks the task as having deferred changes that are next checked at
+// the given time
+func (t *Task) Defer(until time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.deferred = &until
+}
+
+// ClearDeferred marks the task as not having deferred changes
+func (t *Task) ClearDeferred() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.deferred = nil
+}
+
+// QueueTrigger queues a run of the task triggered by dependency changes and
+// returns how long to wait so that the task runs at most once per interval,
+// along with the generation of the queued run to pass to RunQueuedTrigger.
+// The third parameter returns false if a run is already queued, in which case
+// the queued run will include the changes.
+func (t *Task) QueueTrigger(now time.Time, interval time.Duration) (time.Duration, uint64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.triggerQueued {
+		return 0, 0, false
+	}
+	t.triggerQueued = true
+
+	wait := t.lastTriggered.Add(interval).Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	return wait, t.triggerGen, true
+}
+
+// RunQueuedTrigger records the time the queued run of the given generation
+// was run. Returns false if the task has run since the run was queued, e.g. by
+// its schedule, in which case the queued run is superseded and should not run.
+func (t *Task) RunQueuedTrigger(gen uint64, at time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.triggerQueued || t.triggerGen != gen {
+		return false
+	}
+	t.triggered(at)
+	return true
+}
+
+// Triggered records the time the task was run, clearing any queued run
+func (t *Task) Triggered(at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.triggered(at)
+}
+
+func (t *Task) triggered(at time.Time) {
+	t.lastTriggered = at
+	t.triggerQueued = false
+	t.triggerGen++
+}
+
+// AddSnapshot stores the snapshot of a run of the task. Only the most recent
+// snapshots are kept.
+func (t *Task) AddSnapshot(s Snapshot) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.snapshots = append(t.snapshots, s)
+	if len(t.snapshots) > snapshotCountLimit {
+		t.snapshots = t.snapshots[len(t.snapshots)-snapshotCountLimit:]
+	}
+}
+
+// Snapshot returns the snapshot of the task's run for an event. The second
+// parameter returns false if there is no snapshot for the event.
+func (t *Task) Snapshot(eventID string) (Snapshot, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for _, s := range t.snapshots {
+		if s.EventID == eventID {
+			return s, true
+		}
+	}
+	return Snapshot{}, false
+}
+
+// Pin disables the task and sets the module version to the version of the
+// snapshot that the task is rolled back to. The task remains pinned until
+// Unpin is called.
+func (t *Task) Pin(s Snapshot) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.pinned == nil {
+		t.pinned = &pinnedSnapshot{version: t.version}
+	}
+	t.pinned.snapshot = s
+	t.version = s.ModuleVersion
+	t.enabled = false
+}
+
+// Pinned returns the snapshot that the task is pinned to. The second
+// parameter returns false if the task is not pinned.
+func (t *Task) Pinned() (Snapshot, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.pinned == nil {
+		return Snapshot{}, false
+	}
+	return t.pinned.snapshot, true
+}
+
+// Unpin restores the configured module version of a pinned task. It does not
+// enable the task.
+func (t *Task) Unpin() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.pinned == nil {
+		return
+	}
+	t.version = t.pinned.version
+	t.pinned = nil
+}
+
 // WorkingDir returns the working directory to manage generated artifacts for
 // the task.
 func (t *Task) WorkingDir() string {
@@ -303,8 +657,8 @@ func (t *Task) WorkingDir() string {
 	return t.workingDir
 }
 
-// TFVersion returns the Terraform version to use when using the Terraform Cloud
-// driver. Enterprise.
+// TFVersion returns the Terraform version pinned by the task. An empty string
+// means the task uses the driver's default version.
 func (t *Task) TFVersion() string {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
@@ -370,48 +724,11 @@ func (t *Task) configureRootModuleInput(input *tftmpl.RootModuleInputData) error
 			fmt.Sprintf("%T", template))
 	}
 
-	var condition tftmpl.Template
-	switch v := t.condition.(type) {
-	case *config.CatalogServicesConditionConfig:
-		condition = &tftmpl.CatalogServicesTemplate{
-			Regexp:     *v.Regexp,
-			Datacenter: *v.Datacenter,
-			Namespace:  *v.Namespace,
-			NodeMeta:   v.NodeMeta,
-			RenderVar:  *v.UseAsModuleInput,
-		}
-	case *config.ServicesConditionConfig:
-		if v.Regexp != nil {
-			condition = &tftmpl.ServicesRegexTemplate{
-				Regexp:     *v.Regexp,
-				Datacenter: *v.Datacenter,
-				Namespace:  *v.Namespace,
-				Filter:     *v.Filter,
-				RenderVar:  *v.UseAsModuleInput,
-			}
-		} else {
-			condition = &tftmpl.ServicesTemplate{
-				Names:      v.Names,
-				Datacenter: *v.Datacenter,
-				Namespace:  *v.Namespace,
-				Filter:     *v.Filter,
-				RenderVar:  *v.UseAsModuleInput,
-			}
+	for _, cond := range config.FlattenCondition(t.condition) {
+		condition := newConditionTemplate(cond)
+		if condition == nil {
+			continue
 		}
-	case *config.ConsulKVConditionConfig:
-		condition = &tftmpl.ConsulKVTemplate{
-			Path:       *v.Path,
-			Datacenter: *v.Datacenter,
-			Recurse:    *v.Recurse,
-			Namespace:  *v.Namespace,
-			RenderVar:  *v.UseAsModuleInput,
-		}
-	default:
-		// no-op: condition block currently not required since services.list
-		// can be used alternatively
-	}
-
-	if condition != nil {
 		templates = append(templates, condition)
 		t.logger.Trace("condition block template configured", "template_type",
 			fmt.Sprintf("%T", condition))
@@ -422,34 +739,40 @@ func (t *Task) configureRootModuleInput(input *tftmpl.RootModuleInputData) error
 	for ix, moduleInput := range t.moduleInputs {
 		switch v := moduleInput.(type) {
 		case *config.ServicesModuleInputConfig:
-			if v.Regexp != nil {
-				moduleInputs[ix] = &tftmpl.ServicesRegexTemplate{
-					Regexp:     *v.Regexp,
-					Datacenter: *v.Datacenter,
-					Namespace:  *v.Namespace,
-					Filter:     *v.Filter,
-					// always render var for module_input config
-					RenderVar: true,
-				}
-			} else {
-				moduleInputs[ix] = &tftmpl.ServicesTemplate{
-					Names:      v.Names,
-					Datacenter: *v.Datacenter,
-					Namespace:  *v.Namespace,
-					Filter:     *v.Filter,
-					// always render var for module_input config
-					RenderVar: true,
-				}
-			}
+			// always render var for module_input config
+			moduleInputs[ix] = newServicesTemplate(&v.ServicesMonitorConfig, true)
 		case *config.ConsulKVModuleInputConfig:
 			moduleInputs[ix] = &tftmpl.ConsulKVTemplate{
 				Path:       *v.Path,
 				Datacenter: *v.Datacenter,
 				Recurse:    *v.Recurse,
 				Namespace:  *v.Namespace,
+				Partition:  config.StringVal(v.Partition),
+				Decode:     config.StringVal(v.Decode),
 				// always render var for module_input config
 				RenderVar: true,
 			}
+		case *config.IntentionsModuleInputConfig:
+			// always render var for module_input config
+			moduleInputs[ix] = newIntentionsTemplate(&v.IntentionsMonitorConfig, true)
+		case *config.NodesModuleInputConfig:
+			moduleInputs[ix] = &tftmpl.NodesTemplate{
+				Datacenter: *v.Datacenter,
+				Filter:     *v.Filter,
+				// always render var for module_input config
+				RenderVar: true,
+			}
+		case *config.ConfigEntriesModuleInputConfig:
+			moduleInputs[ix] = &tftmpl.ConfigEntriesTemplate{
+				Kind:       *v.Kind,
+				Names:      v.Names,
+				Datacenter: *v.Datacenter,
+				Namespace:  *v.Namespace,
+			}
+		case *config.VaultSecretsModuleInputConfig:
+			moduleInputs[ix] = &tftmpl.VaultSecretsTemplate{
+				Paths: v.Paths,
+			}
 		default:
 			return fmt.Errorf("task %q has unsupported type of module_input "+
 				" block configuration %T", t.name, v)
@@ -480,6 +803,120 @@ func (t *Task) configureRootModuleInput(input *tftmpl.RootModuleInputData) error
 	return nil
 }
 
+// newConditionTemplate creates the template for a condition that monitors
+// Consul objects. Returns nil for conditions that do not have a template.
+func newConditionTemplate(cond config.ConditionConfig) tftmpl.Template {
+	var condition tftmpl.Template
+	switch v := cond.(type) {
+	case *config.CatalogServicesConditionConfig:
+		condition = &tftmpl.CatalogServicesTemplate{
+			Regexp:      *v.Regexp,
+			Datacenter:  *v.Datacenter,
+			Datacenters: v.Datacenters,
+			Namespace:   *v.Namespace,
+			Partition:   config.StringVal(v.Partition),
+			Peer:        config.StringVal(v.Peer),
+			NodeMeta:    v.NodeMeta,
+			RenderVar:   *v.UseAsModuleInput,
+		}
+	case *config.ServicesConditionConfig:
+		condition = newServicesTemplate(&v.ServicesMonitorConfig,
+			*v.UseAsModuleInput)
+	case *config.ConsulKVConditionConfig:
+		condition = &tftmpl.ConsulKVTemplate{
+			Path:       *v.Path,
+			Datacenter: *v.Datacenter,
+			Recurse:    *v.Recurse,
+			Namespace:  *v.Namespace,
+			Partition:  config.StringVal(v.Partition),
+			Decode:     config.StringVal(v.Decode),
+			RenderVar:  *v.UseAsModuleInput,
+		}
+	case *config.IntentionsConditionConfig:
+		condition = newIntentionsTemplate(&v.IntentionsMonitorConfig,
+			*v.UseAsModuleInput)
+	case *config.NodesConditionConfig:
+		condition = &tftmpl.NodesTemplate{
+			Datacenter: *v.Datacenter,
+			Filter:     *v.Filter,
+			RenderVar:  *v.UseAsModuleInput,
+		}
+	default:
+		// no-op: condition block currently not required since services.list
+		// can be used alternatively. schedule condition does not monitor
+		// Consul objects
+	}
+
+	return condition
+}
+
+// newServicesTemplate configures the template for a services condition or
+// module_input. Services configured by name are queried by an anchored regular
+// expression when health-aware or multi-datacenter options are set, so that all
+// of the service instances are monitored by a single tmplfunc.
+func newServicesTemplate(c *config.ServicesMonitorConfig, renderVar bool) tftmpl.Template {
+	if !isServicesRegexQuery(c) {
+		return &tftmpl.ServicesTemplate{
+			Names:      c.Names,
+			Datacenter: *c.Datacenter,
+			Namespace:  *c.Namespace,
+			Filter:     *c.Filter,
+			RenderVar:  renderVar,
+		}
+	}
+
+	var regex string
+	if c.Regexp != nil {
+		regex = *c.Regexp
+	} else {
+		names := make([]string, len(c.Names))
+		for i, name := range c.Names {
+			names[i] = regexp.QuoteMeta(name)
+		}
+		regex = fmt.Sprintf("^(?:%s)$", strings.Join(names, "|"))
+	}
+
+	return &tftmpl.ServicesRegexTemplate{
+		Regexp:            regex,
+		Datacenter:        *c.Datacenter,
+		Namespace:         *c.Namespace,
+		Partition:         config.StringVal(c.Partition),
+		Peer:              config.StringVal(c.Peer),
+		Filter:            *c.Filter,
+		Datacenters:       c.Datacenters,
+		IncludeNonPassing: config.BoolVal(c.IncludeNonPassing),
+		RenderVar:         renderVar,
+	}
+}
+
+// isServicesRegexQuery returns whether the services of a services condition or
+// module_input are queried with a single regex tmplfunc rather than a tmplfunc
+// per service name. hcat's service tmplfunc does not support the partition and
+// peer query parameters.
+func isServicesRegexQuery(c *config.ServicesMonitorConfig) bool {
+	return c.Regexp != nil || c.IsHealthAware() || len(c.Datacenters) > 0 ||
+		config.StringVal(c.Partition) != "" || config.StringVal(c.Peer) != ""
+}
+
+// newIntentionsTemplate configures the template for an intentions condition or
+// module_input
+func newIntentionsTemplate(c *config.IntentionsMonitorConfig, renderVar bool) *tftmpl.IntentionsTemplate {
+	tmpl := &tftmpl.IntentionsTemplate{
+		Datacenter: *c.Datacenter,
+		Namespace:  *c.Namespace,
+		RenderVar:  renderVar,
+	}
+	if c.SourceServices != nil {
+		tmpl.SourceNames = c.SourceServices.Names
+		tmpl.SourceRegexp = config.StringVal(c.SourceServices.Regexp)
+	}
+	if c.DestinationServices != nil {
+		tmpl.DestinationNames = c.DestinationServices.Names
+		tmpl.DestinationRegexp = config.StringVal(c.DestinationServices.Regexp)
+	}
+	return tmpl
+}
+
 // clientConfig configures a driver client for a task
 type clientConfig struct {
 	clientType string
@@ -487,6 +924,7 @@ type clientConfig struct {
 	taskName   string
 	persistLog bool
 	path       string
+	execName   string
 	workingDir string
 }
 
@@ -515,6 +953,7 @@ func newClient(conf *clientConfig) (client.Client, error) {
 			Log:        conf.log,
 			PersistLog: conf.persistLog,
 			ExecPath:   conf.path,
+			ExecName:   conf.execName,
 			WorkingDir: conf.workingDir,
 			Workspace:  taskName,
 		})
@@ -3,6 +3,7 @@ package driver
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/client"
 	"github.com/hashicorp/consul-terraform-sync/config"
@@ -131,6 +132,28 @@ func TestTask_IsScheduled(t *testing.T) {
 			condition:   &config.ConsulKVConditionConfig{},
 			isScheduled: false,
 		},
+		{
+			name: "composite condition with schedule",
+			condition: &config.CompositeConditionConfig{
+				Operator: config.String("any"),
+				Conditions: []config.ConditionConfig{
+					&config.ConsulKVConditionConfig{},
+					&config.ScheduleConditionConfig{},
+				},
+			},
+			isScheduled: true,
+		},
+		{
+			name: "composite condition without schedule",
+			condition: &config.CompositeConditionConfig{
+				Operator: config.String("all"),
+				Conditions: []config.ConditionConfig{
+					&config.ConsulKVConditionConfig{},
+					&config.NodesConditionConfig{},
+				},
+			},
+			isScheduled: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -143,6 +166,211 @@ func TestTask_IsScheduled(t *testing.T) {
 	}
 }
 
+func TestTask_IsDynamic(t *testing.T) {
+	cases := []struct {
+		name      string
+		condition config.ConditionConfig
+		isDynamic bool
+	}{
+		{
+			name:      "schedule condition",
+			condition: &config.ScheduleConditionConfig{},
+			isDynamic: false,
+		},
+		{
+			name:      "non schedule condition",
+			condition: &config.ConsulKVConditionConfig{},
+			isDynamic: true,
+		},
+		{
+			name: "any condition with schedule",
+			condition: &config.CompositeConditionConfig{
+				Operator: config.String("any"),
+				Conditions: []config.ConditionConfig{
+					&config.ConsulKVConditionConfig{},
+					&config.ScheduleConditionConfig{},
+				},
+			},
+			isDynamic: true,
+		},
+		{
+			name: "all condition with schedule",
+			condition: &config.CompositeConditionConfig{
+				Operator: config.String("all"),
+				Conditions: []config.ConditionConfig{
+					&config.ConsulKVConditionConfig{},
+					&config.ScheduleConditionConfig{},
+				},
+			},
+			isDynamic: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var task Task
+			task.condition = tc.condition
+			assert.Equal(t, tc.isDynamic, task.IsDynamic())
+		})
+	}
+}
+
+func TestTask_OnChangeInterval(t *testing.T) {
+	cases := []struct {
+		name      string
+		condition config.ConditionConfig
+		expected  time.Duration
+	}{
+		{
+			name:      "schedule condition",
+			condition: &config.ScheduleConditionConfig{},
+			expected:  0,
+		},
+		{
+			name: "schedule condition with on_change_interval",
+			condition: &config.ScheduleConditionConfig{
+				OnChangeInterval: config.TimeDuration(time.Minute),
+			},
+			expected: time.Minute,
+		},
+		{
+			name: "composite condition with on_change_interval",
+			condition: &config.CompositeConditionConfig{
+				Operator: config.String("any"),
+				Conditions: []config.ConditionConfig{
+					&config.ScheduleConditionConfig{
+						OnChangeInterval: config.TimeDuration(time.Minute),
+					},
+					&config.ConsulKVConditionConfig{},
+				},
+			},
+			expected: time.Minute,
+		},
+		{
+			name:      "non schedule condition",
+			condition: &config.ConsulKVConditionConfig{},
+			expected:  0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var task Task
+			task.condition = tc.condition
+			assert.Equal(t, tc.expected, task.OnChangeInterval())
+		})
+	}
+}
+
+func TestTask_UsesVaultSecrets(t *testing.T) {
+	cases := []struct {
+		name         string
+		moduleInputs config.ModuleInputConfigs
+		expected     bool
+	}{
+		{
+			name:         "no module inputs",
+			moduleInputs: config.ModuleInputConfigs{},
+			expected:     false,
+		},
+		{
+			name: "vault_secrets module input",
+			moduleInputs: config.ModuleInputConfigs{
+				&config.ConsulKVModuleInputConfig{},
+				&config.VaultSecretsModuleInputConfig{},
+			},
+			expected: true,
+		},
+		{
+			name: "other module inputs",
+			moduleInputs: config.ModuleInputConfigs{
+				&config.ConsulKVModuleInputConfig{},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var task Task
+			task.moduleInputs = tc.moduleInputs
+			assert.Equal(t, tc.expected, task.UsesVaultSecrets())
+		})
+	}
+}
+
+func TestTask_QueueTrigger(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	var task Task
+
+	// never triggered, no wait
+	wait, _, ok := task.QueueTrigger(now, time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+
+	// already queued
+	_, _, ok = task.QueueTrigger(now, time.Minute)
+	assert.False(t, ok)
+
+	// recently triggered, wait for the remainder of the interval
+	task.Triggered(now)
+	wait, _, ok = task.QueueTrigger(now.Add(20*time.Second), time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, 40*time.Second, wait)
+
+	// interval elapsed, no wait
+	task.Triggered(now)
+	wait, _, ok = task.QueueTrigger(now.Add(2*time.Minute), time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+}
+
+func TestTask_RunQueuedTrigger(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	t.Run("queued run", func(t *testing.T) {
+		var task Task
+		_, gen, ok := task.QueueTrigger(now, time.Minute)
+		require.True(t, ok)
+
+		assert.True(t, task.RunQueuedTrigger(gen, now))
+		assert.Equal(t, now, task.lastTriggered)
+		assert.False(t, task.triggerQueued)
+
+		// queued run only runs once
+		assert.False(t, task.RunQueuedTrigger(gen, now))
+	})
+
+	t.Run("superseded by scheduled run", func(t *testing.T) {
+		var task Task
+		_, gen, ok := task.QueueTrigger(now, time.Minute)
+		require.True(t, ok)
+
+		task.Triggered(now)
+		assert.False(t, task.RunQueuedTrigger(gen, now.Add(time.Minute)))
+		assert.Equal(t, now, task.lastTriggered)
+	})
+
+	t.Run("superseded by requeued run", func(t *testing.T) {
+		var task Task
+		_, gen, ok := task.QueueTrigger(now, time.Minute)
+		require.True(t, ok)
+
+		// scheduled run then new dependency changes queue another run
+		task.Triggered(now)
+		_, gen2, ok := task.QueueTrigger(now, time.Minute)
+		require.True(t, ok)
+
+		assert.False(t, task.RunQueuedTrigger(gen, now.Add(time.Minute)))
+		assert.True(t, task.triggerQueued)
+		assert.True(t, task.RunQueuedTrigger(gen2, now.Add(time.Minute)))
+	})
+}
+
 func TestTask_Description(t *testing.T) {
 	t.Parallel()
 
@@ -443,6 +671,99 @@ func TestTask_configureRootModuleInput(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "templates: services cond names health-aware",
+			task: Task{
+				condition: &config.ServicesConditionConfig{
+					ServicesMonitorConfig: config.ServicesMonitorConfig{
+						Names:             []string{"api", "web.v2"},
+						Datacenter:        config.String("dc1"),
+						Namespace:         config.String("ns1"),
+						Filter:            config.String("filter"),
+						TriggerOn:         config.String(config.ServicesTriggerHealthTransition),
+						IncludeNonPassing: config.Bool(true),
+					},
+					UseAsModuleInput: config.Bool(true),
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.ServicesRegexTemplate{
+					Regexp:            `^(?:api|web\.v2)$`,
+					Datacenter:        "dc1",
+					Namespace:         "ns1",
+					Filter:            "filter",
+					IncludeNonPassing: true,
+					RenderVar:         true,
+				},
+			},
+		},
+		{
+			name: "templates: services cond names multi-datacenter",
+			task: Task{
+				condition: &config.ServicesConditionConfig{
+					ServicesMonitorConfig: config.ServicesMonitorConfig{
+						Names:       []string{"api"},
+						Datacenter:  config.String(""),
+						Datacenters: []string{"dc1", "dc2"},
+						Namespace:   config.String(""),
+						Filter:      config.String(""),
+					},
+					UseAsModuleInput: config.Bool(true),
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.ServicesRegexTemplate{
+					Regexp:      `^(?:api)$`,
+					Datacenters: []string{"dc1", "dc2"},
+					RenderVar:   true,
+				},
+			},
+		},
+		{
+			name: "templates: services cond names partition and peer",
+			task: Task{
+				condition: &config.ServicesConditionConfig{
+					ServicesMonitorConfig: config.ServicesMonitorConfig{
+						Names:      []string{"api"},
+						Datacenter: config.String(""),
+						Namespace:  config.String(""),
+						Partition:  config.String("ap1"),
+						Peer:       config.String("cluster-02"),
+						Filter:     config.String(""),
+					},
+					UseAsModuleInput: config.Bool(true),
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.ServicesRegexTemplate{
+					Regexp:    `^(?:api)$`,
+					Partition: "ap1",
+					Peer:      "cluster-02",
+					RenderVar: true,
+				},
+			},
+		},
+		{
+			name: "templates: catalog services condition multi-datacenter",
+			task: Task{
+				condition: &config.CatalogServicesConditionConfig{
+					CatalogServicesMonitorConfig: config.CatalogServicesMonitorConfig{
+						Regexp:           config.String("^web.*"),
+						Datacenter:       config.String(""),
+						Datacenters:      []string{"all"},
+						Namespace:        config.String(""),
+						UseAsModuleInput: config.Bool(true),
+					},
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.CatalogServicesTemplate{
+					Regexp:      "^web.*",
+					Datacenters: []string{"all"},
+					RenderVar:   true,
+				},
+			},
+		},
 		{
 			name: "templates: catalog services condition",
 			task: Task{
@@ -451,6 +772,8 @@ func TestTask_configureRootModuleInput(t *testing.T) {
 						Regexp:           config.String("^web.*"),
 						Datacenter:       config.String("dc1"),
 						Namespace:        config.String("ns1"),
+						Partition:        config.String("ap1"),
+						Peer:             config.String("cluster-02"),
 						NodeMeta:         map[string]string{"test": "test"},
 						UseAsModuleInput: config.Bool(true),
 					},
@@ -461,6 +784,8 @@ func TestTask_configureRootModuleInput(t *testing.T) {
 					Regexp:     "^web.*",
 					Datacenter: "dc1",
 					Namespace:  "ns1",
+					Partition:  "ap1",
+					Peer:       "cluster-02",
 					NodeMeta:   map[string]string{"test": "test"},
 					RenderVar:  true,
 				},
@@ -474,7 +799,9 @@ func TestTask_configureRootModuleInput(t *testing.T) {
 						Path:       config.String("/path/to/key"),
 						Datacenter: config.String("dc1"),
 						Namespace:  config.String("ns1"),
+						Partition:  config.String("ap1"),
 						Recurse:    config.Bool(true),
+						Decode:     config.String("json"),
 					},
 					UseAsModuleInput: config.Bool(true),
 				},
@@ -484,11 +811,177 @@ func TestTask_configureRootModuleInput(t *testing.T) {
 					Path:       "/path/to/key",
 					Datacenter: "dc1",
 					Namespace:  "ns1",
+					Partition:  "ap1",
 					Recurse:    true,
+					Decode:     "json",
 					RenderVar:  true,
 				},
 			},
 		},
+		{
+			name: "templates: intentions condition",
+			task: Task{
+				condition: &config.IntentionsConditionConfig{
+					IntentionsMonitorConfig: config.IntentionsMonitorConfig{
+						Datacenter: config.String("dc1"),
+						Namespace:  config.String("ns1"),
+						SourceServices: &config.IntentionsServicesConfig{
+							Regexp: config.String("^web.*"),
+						},
+					},
+					UseAsModuleInput: config.Bool(false),
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.IntentionsTemplate{
+					SourceRegexp: "^web.*",
+					Datacenter:   "dc1",
+					Namespace:    "ns1",
+					RenderVar:    false,
+				},
+			},
+		},
+		{
+			name: "templates: intentions module_input",
+			task: Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.IntentionsModuleInputConfig{
+						IntentionsMonitorConfig: config.IntentionsMonitorConfig{
+							Datacenter: config.String(""),
+							Namespace:  config.String(""),
+							SourceServices: &config.IntentionsServicesConfig{
+								Names: []string{"web"},
+							},
+							DestinationServices: &config.IntentionsServicesConfig{
+								Names: []string{"api", "db"},
+							},
+						},
+					},
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.IntentionsTemplate{
+					SourceNames:      []string{"web"},
+					DestinationNames: []string{"api", "db"},
+					RenderVar:        true,
+				},
+			},
+		},
+		{
+			name: "templates: nodes condition",
+			task: Task{
+				condition: &config.NodesConditionConfig{
+					NodesMonitorConfig: config.NodesMonitorConfig{
+						Datacenter: config.String("dc1"),
+						Filter:     config.String("Meta.env == production"),
+					},
+					UseAsModuleInput: config.Bool(true),
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.NodesTemplate{
+					Datacenter: "dc1",
+					Filter:     "Meta.env == production",
+					RenderVar:  true,
+				},
+			},
+		},
+		{
+			name: "templates: composite condition",
+			task: Task{
+				condition: &config.CompositeConditionConfig{
+					Operator: config.String("any"),
+					Conditions: []config.ConditionConfig{
+						&config.ScheduleConditionConfig{
+							Cron: config.String("* * * * *"),
+						},
+						&config.NodesConditionConfig{
+							NodesMonitorConfig: config.NodesMonitorConfig{
+								Datacenter: config.String("dc1"),
+								Filter:     config.String(""),
+							},
+							UseAsModuleInput: config.Bool(false),
+						},
+						&config.ConsulKVConditionConfig{
+							ConsulKVMonitorConfig: config.ConsulKVMonitorConfig{
+								Path:       config.String("key"),
+								Datacenter: config.String(""),
+								Recurse:    config.Bool(false),
+								Namespace:  config.String(""),
+							},
+							UseAsModuleInput: config.Bool(true),
+						},
+					},
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.NodesTemplate{
+					Datacenter: "dc1",
+					RenderVar:  false,
+				},
+				&tftmpl.ConsulKVTemplate{
+					Path:      "key",
+					RenderVar: true,
+				},
+			},
+		},
+		{
+			name: "templates: nodes module_input",
+			task: Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.NodesModuleInputConfig{
+						NodesMonitorConfig: config.NodesMonitorConfig{
+							Datacenter: config.String(""),
+							Filter:     config.String(""),
+						},
+					},
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.NodesTemplate{
+					RenderVar: true,
+				},
+			},
+		},
+		{
+			name: "templates: config_entries module_input",
+			task: Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.ConfigEntriesModuleInputConfig{
+						ConfigEntriesMonitorConfig: config.ConfigEntriesMonitorConfig{
+							Kind:       config.String("ingress-gateway"),
+							Names:      []string{"ingress"},
+							Datacenter: config.String("dc1"),
+							Namespace:  config.String(""),
+						},
+					},
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.ConfigEntriesTemplate{
+					Kind:       "ingress-gateway",
+					Names:      []string{"ingress"},
+					Datacenter: "dc1",
+				},
+			},
+		},
+		{
+			name: "templates: vault_secrets module_input",
+			task: Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.VaultSecretsModuleInputConfig{
+						VaultSecretsMonitorConfig: config.VaultSecretsMonitorConfig{
+							Paths: []string{"secret/data/app"},
+						},
+					},
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.VaultSecretsTemplate{
+					Paths: []string{"secret/data/app"},
+				},
+			},
+		},
 		{
 			name: "templates: services module_input regex",
 			task: Task{
@@ -554,6 +1047,7 @@ func TestTask_configureRootModuleInput(t *testing.T) {
 							Recurse:    config.Bool(true),
 							Datacenter: config.String("dc1"),
 							Namespace:  config.String("ns1"),
+							Decode:     config.String("yaml"),
 						},
 					},
 				},
@@ -571,6 +1065,7 @@ func TestTask_configureRootModuleInput(t *testing.T) {
 					Recurse:    true,
 					Datacenter: "dc1",
 					Namespace:  "ns1",
+					Decode:     "yaml",
 					RenderVar:  true,
 				},
 			},
@@ -8,6 +8,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
@@ -15,12 +16,16 @@ import (
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/handler"
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/policy"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
 	"github.com/hashicorp/consul-terraform-sync/templates"
 	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl"
 	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/notifier"
 	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
+	goVersion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/hcat"
 	"github.com/hashicorp/hcat/dep"
+	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/pkg/errors"
 )
 
@@ -53,18 +58,45 @@ type Terraform struct {
 	task              *Task
 	backend           map[string]interface{}
 	requiredProviders map[string]interface{}
+	requiredVersion   string
+
+	// version is set when the task pins a Terraform version, otherwise the
+	// globally installed TerraformVersion is used
+	version *goVersion.Version
 
 	resolver   templates.Resolver
 	template   templates.Template
 	watcher    templates.Watcher
 	fileReader func(string) ([]byte, error)
 
+	// vaultSecrets holds the Vault secrets read by the template, which are
+	// passed to the client as a sensitive variable instead of being rendered
+	vaultSecrets *tmplfunc.VaultSecrets
+
 	client    client.Client
 	logClient bool
 	postApply handler.Handler
 
+	// postApplyExec runs the task's post_apply commands. It is the end of the
+	// postApply chain, and is run on its own when an apply fails so that the
+	// commands are notified of the failure.
+	postApplyExec handler.Handler
+
+	// preApply checks the plan of the task before it applies, and any of its
+	// handlers can veto the apply
+	preApply handler.Handler
+
+	// policies are checked against the structured plan of the task when it
+	// is inspected and before it applies
+	policies policy.Policies
+
+	// approval is set while an apply that was blocked by the task's
+	// guardrails is approved
+	approval *approval
+
 	inited       bool
 	renderedOnce bool
+	imported     bool
 
 	logger logging.Logger
 
@@ -82,6 +114,12 @@ type TerraformConfig struct {
 	Watcher           templates.Watcher
 	// empty/unknown string will default to TerraformCLI client
 	ClientType string
+
+	// ExecName is the file name of the CLI binary within Path, and
+	// RequiredVersion is the version constraint set in the generated root
+	// module. Both default to the values for HashiCorp Terraform when empty.
+	ExecName        string
+	RequiredVersion string
 }
 
 // NewTerraform configures and initializes a new Terraform driver for a task.
@@ -91,11 +129,16 @@ func NewTerraform(config *TerraformConfig) (*Terraform, error) {
 	taskName := task.Name()
 	wd := task.WorkingDir()
 	logger := logging.Global().Named(logSystemName).Named(terraformSubsystemName)
-	if _, err := os.Stat(wd); os.IsNotExist(err) {
-		if err := os.MkdirAll(wd, workingDirPerms); err != nil {
-			logger.Error("error creating task work directory", "error", err)
-			return nil, err
-		}
+	if err := ensureWorkingDir(wd); err != nil {
+		logger.Error("error creating task work directory", "error", err)
+		return nil, err
+	}
+
+	// Tasks that pin a Terraform version use the binary installed for that
+	// version, see InstallTerraformVersion
+	path := config.Path
+	if v := task.TFVersion(); v != "" {
+		path = TerraformVersionPath(path, v)
 	}
 
 	tfClient, err := newClient(&clientConfig{
@@ -103,7 +146,8 @@ func NewTerraform(config *TerraformConfig) (*Terraform, error) {
 		log:        config.Log,
 		taskName:   taskName,
 		persistLog: config.PersistLog,
-		path:       config.Path,
+		path:       path,
+		execName:   config.ExecName,
 		workingDir: wd,
 	})
 	if err != nil {
@@ -129,28 +173,88 @@ func NewTerraform(config *TerraformConfig) (*Terraform, error) {
 		}
 	}
 
-	h, err := getTerraformHandlers(taskName, task.Providers())
+	return newTerraform(config, tfClient)
+}
+
+// newTerraform sets up the Terraform driver for a task with an initialized
+// client and the out-of-band handlers for the task's providers.
+func newTerraform(config *TerraformConfig, tfClient client.Client) (*Terraform, error) {
+	execH, err := getPostApplyHandlers(config.Task)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := getTerraformHandlers(config.Task.Name(), config.Task.Providers(), execH)
+	if err != nil {
+		return nil, err
+	}
+
+	preH, err := getPreApplyHandlers(config.Task)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := policy.NewPolicies(config.Task.Policies())
 	if err != nil {
 		return nil, err
 	}
 
+	var version *goVersion.Version
+	if v := config.Task.TFVersion(); v != "" {
+		version, err = goVersion.NewVersion(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tfVersion := version
+	if tfVersion == nil {
+		tfVersion = TerraformVersion
+	}
+	if err := isTaskTFCompatible(config.Task, tfVersion); err != nil {
+		return nil, err
+	}
+
 	return &Terraform{
 		task:              config.Task,
 		backend:           config.Backend,
 		requiredProviders: config.RequiredProviders,
+		requiredVersion:   config.RequiredVersion,
+		version:           version,
 		client:            tfClient,
 		logClient:         config.Log,
 		postApply:         h,
+		postApplyExec:     execH,
+		preApply:          preH,
+		policies:          policies,
 		resolver:          hcat.NewResolver(),
 		watcher:           config.Watcher,
 		fileReader:        ioutil.ReadFile,
-		logger:            logger,
+		logger:            logging.Global().Named(logSystemName).Named(terraformSubsystemName),
 	}, nil
 }
 
+// ensureWorkingDir creates the working directory for a task if it does not
+// exist
+func ensureWorkingDir(wd string) error {
+	if _, err := os.Stat(wd); os.IsNotExist(err) {
+		return os.MkdirAll(wd, workingDirPerms)
+	}
+	return nil
+}
+
 // Version returns the Terraform CLI version for the Terraform driver.
 func (tf *Terraform) Version() string {
-	return TerraformVersion.String()
+	return tf.terraformVersion().String()
+}
+
+// terraformVersion returns the version pinned by the task, or the globally
+// installed version
+func (tf *Terraform) terraformVersion() *goVersion.Version {
+	if tf.version != nil {
+		return tf.version
+	}
+	return TerraformVersion
 }
 
 // Task returns the task config info
@@ -257,6 +361,10 @@ func (tf *Terraform) InspectTask(ctx context.Context) (InspectPlan, error) {
 	}
 
 	plan, err := tf.inspectTask(ctx, true)
+	if err == nil {
+		err = tf.inspectGuardrails(ctx, &plan)
+	}
+	tf.discardPlan()
 	tf.deregisterTemplate(ctx)
 	return plan, err
 }
@@ -277,9 +385,16 @@ func (tf *Terraform) ApplyTask(ctx context.Context) error {
 
 // InspectPlan stores return the information about what
 type InspectPlan struct {
-	ChangesPresent bool   `json:"changes_present"`
-	Plan           string `json:"plan"`
-	URL            string `json:"url,omitempty"`
+	ChangesPresent bool           `json:"changes_present"`
+	Plan           string         `json:"plan"`
+	URL            string         `json:"url,omitempty"`
+	Policies       policy.Results `json:"policies,omitempty"`
+
+	// Guardrails describes the guardrails of the task that the plan exceeds
+	Guardrails []string `json:"guardrails,omitempty"`
+
+	// structured is the structured plan, when it was read for the inspection
+	structured *tfjson.Plan
 }
 
 // UpdateTask updates the task on the driver. Makes any calls to re-init
@@ -300,11 +415,19 @@ func (tf *Terraform) UpdateTask(ctx context.Context, patch PatchTask) (InspectPl
 	defer tf.mu.Unlock()
 
 	originalEnabled := tf.task.IsEnabled()
+	pinned, isPinned := tf.task.Pinned()
 
 	// for inspect, dry-run the task with the planned change and then make sure
 	// to reset the task back to the way it was
 	if patch.RunOption == RunOptionInspect && originalEnabled != patch.Enabled {
 		defer func() {
+			if isPinned {
+				if err := tf.pin(ctx, pinned); err != nil {
+					tf.logger.Error("unable to re-pin task after inspecting",
+						taskNameLogKey, taskName, "error", err)
+				}
+				return
+			}
 			if originalEnabled {
 				tf.task.Enable()
 			} else {
@@ -317,6 +440,9 @@ func (tf *Terraform) UpdateTask(ctx context.Context, patch PatchTask) (InspectPl
 
 	if originalEnabled != patch.Enabled {
 		if patch.Enabled {
+			// re-enabling a task that was rolled back unpins it, and the
+			// latest rendered changes replace the snapshot
+			tf.task.Unpin()
 			tf.task.Enable()
 			reinit = true
 		} else {
@@ -324,6 +450,23 @@ func (tf *Terraform) UpdateTask(ctx context.Context, patch PatchTask) (InspectPl
 		}
 	}
 
+	originalVersion := tf.task.Version()
+	if patch.Version != "" && patch.Version != originalVersion {
+		tf.logger.Debug("updating module version", taskNameLogKey, taskName,
+			"previous_version", originalVersion, "version", patch.Version)
+		tf.task.setVersion(patch.Version)
+		if patch.Enabled {
+			// regenerate the root module with the new version
+			reinit = true
+		}
+
+		if patch.RunOption == RunOptionInspect {
+			defer func() {
+				tf.restoreVersion(ctx, originalVersion, reinit)
+			}()
+		}
+	}
+
 	// identify cases where resources are not impacted and we can return early
 	switch {
 	case patch.Enabled == false:
@@ -347,6 +490,13 @@ func (tf *Terraform) UpdateTask(ctx context.Context, patch PatchTask) (InspectPl
 			if (result.Complete && !result.NoChange) || (result.Complete && result.NoChange && tf.renderedOnce) {
 				// Continue if the template has completed or the template had already
 				// completed prior to enabling the task and there is no change.
+				if isPinned && result.NoChange {
+					// The snapshot is still in place of the latest content
+					if _, err := tf.template.Render(result.Contents); err != nil {
+						return InspectPlan{}, fmt.Errorf("Error updating task '%s'. "+
+							"Unable to render template for task: %s", taskName, err)
+					}
+				}
 				break
 			}
 		}
@@ -355,6 +505,10 @@ func (tf *Terraform) UpdateTask(ctx context.Context, patch PatchTask) (InspectPl
 	if patch.RunOption == RunOptionInspect {
 		tf.logger.Trace("update task. inspect run option", taskNameLogKey, taskName)
 		plan, err := tf.inspectTask(ctx, true)
+		if err == nil {
+			err = tf.inspectGuardrails(ctx, &plan)
+		}
+		tf.discardPlan()
 		if err != nil {
 			return InspectPlan{}, fmt.Errorf("Error updating task '%s'. Unable to inspect "+
 				"task: %s", taskName, err)
@@ -364,13 +518,32 @@ func (tf *Terraform) UpdateTask(ctx context.Context, patch PatchTask) (InspectPl
 
 	if patch.RunOption == RunOptionNow {
 		tf.logger.Trace("update task. run now option", taskNameLogKey, taskName)
-		return InspectPlan{}, tf.applyTask(ctx)
+		err := tf.applyTask(ctx)
+		if err != nil && tf.task.Version() != originalVersion {
+			// the task is not upgraded when the new version fails to apply
+			tf.restoreVersion(ctx, originalVersion, reinit)
+		}
+		return InspectPlan{}, err
 	}
 
 	// allow the task to update naturally!
 	return InspectPlan{}, nil
 }
 
+// restoreVersion restores the module version of the task after an update.
+// The root module is regenerated with the version if it was regenerated for
+// the update.
+func (tf *Terraform) restoreVersion(ctx context.Context, version string, reinit bool) {
+	tf.task.setVersion(version)
+	if !reinit {
+		return
+	}
+	if err := tf.initTask(ctx); err != nil {
+		tf.logger.Error("unable to restore module version", taskNameLogKey,
+			tf.task.Name(), "version", version, "error", err)
+	}
+}
+
 // init initializes the Terraform workspace if needed
 func (tf *Terraform) init(ctx context.Context) error {
 	taskName := tf.task.Name()
@@ -391,7 +564,8 @@ func (tf *Terraform) init(ctx context.Context) error {
 // initTask initializes the task
 func (tf *Terraform) initTask(ctx context.Context) error {
 	input := tftmpl.RootModuleInputData{
-		TerraformVersion: TerraformVersion,
+		TerraformVersion: tf.terraformVersion(),
+		RequiredVersion:  tf.requiredVersion,
 		Backend:          tf.backend,
 		Path:             tf.task.WorkingDir(),
 		FilePerms:        filePerms,
@@ -480,11 +654,28 @@ func (tf *Terraform) renderTemplate(ctx context.Context) (hcat.ResolveEvent, err
 		}
 		tnlog.Trace("template for task rendered", "rendered_template", rendered)
 		tf.renderedOnce = true
+
+		if err := tf.setVaultSecretsVar(); err != nil {
+			tnlog.Error("setting vault_secrets variable for task", "error", err)
+			return hcat.ResolveEvent{}, err
+		}
 	}
 
 	return result, nil
 }
 
+// setVaultSecretsVar sets the Vault secrets read by the most recent render of
+// the template as the vault_secrets variable of the client
+func (tf *Terraform) setVaultSecretsVar() error {
+	if tf.vaultSecrets == nil {
+		return nil
+	}
+
+	return tf.client.SetVars(map[string]interface{}{
+		"vault_secrets": tf.vaultSecrets.Values(),
+	})
+}
+
 // inspectTask inspects the task changes. Option to return inspection plan
 // details rather than logging out
 func (tf *Terraform) inspectTask(ctx context.Context, returnPlan bool) (InspectPlan, error) {
@@ -510,19 +701,99 @@ func (tf *Terraform) inspectTask(ctx context.Context, returnPlan bool) (InspectP
 			fmt.Sprintf("error tf-plan for '%s'", taskName))
 	}
 
-	return InspectPlan{
+	plan := InspectPlan{
 		ChangesPresent: c,
 		Plan:           buf.String(),
-	}, nil
+	}
+
+	if len(tf.policies) > 0 {
+		p, err := tf.showPlan(ctx, &plan)
+		if err != nil {
+			return InspectPlan{}, err
+		}
+		results, err := tf.checkPolicies(p)
+		if err != nil {
+			return InspectPlan{}, err
+		}
+		plan.Policies = results
+		if returnPlan {
+			plan.Plan = fmt.Sprintf("%s\n%s", plan.Plan, results.String())
+		}
+	}
+
+	return plan, nil
+}
+
+// discardPlan discards the plan of an inspection so that it is not applied by
+// a later apply
+func (tf *Terraform) discardPlan() {
+	if err := tf.client.DiscardPlan(); err != nil {
+		tf.logger.Warn("unable to discard plan", taskNameLogKey, tf.task.Name(),
+			"error", err)
+	}
+}
+
+// showPlan returns the structured plan of the inspected plan. The structured
+// plan is only read once for each inspection.
+func (tf *Terraform) showPlan(ctx context.Context, plan *InspectPlan) (*tfjson.Plan, error) {
+	if plan.structured != nil {
+		return plan.structured, nil
+	}
+
+	taskName := tf.task.Name()
+	tf.logger.Trace("show plan", taskNameLogKey, taskName)
+	p, err := tf.client.ShowPlan(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			fmt.Sprintf("error tf-show for '%s'", taskName))
+	}
+	plan.structured = p
+	return p, nil
+}
+
+// checkPolicies evaluates the task's policies against the structured plan
+func (tf *Terraform) checkPolicies(p *tfjson.Plan) (policy.Results, error) {
+	taskName := tf.task.Name()
+
+	results, err := tf.policies.Evaluate(taskName, p)
+	if err != nil {
+		return nil, errors.Wrap(err,
+			fmt.Sprintf("error checking policies for '%s'", taskName))
+	}
+
+	for _, r := range results.Failed(config.PolicyEnforcementAdvisory) {
+		tf.logger.Warn("advisory policy failed", taskNameLogKey, taskName,
+			"policy", r.Name, "message", r.Message)
+	}
+	return results, nil
 }
 
 // applyTask applies the task changes.
 func (tf *Terraform) applyTask(ctx context.Context) error {
 	taskName := tf.task.Name()
 
+	if blocked, ok := tf.task.Blocked(); ok && tf.approval == nil {
+		return fmt.Errorf("task '%s' is blocked by its guardrails until the "+
+			"apply is approved: %s", taskName, blocked.Reason)
+	}
+
+	if err := tf.importResources(ctx); err != nil {
+		return err
+	}
+
+	if err := tf.checkPlan(ctx); err != nil {
+		tf.discardPlan()
+		return err
+	}
+
 	tf.logger.Trace("apply", taskNameLogKey, taskName)
 	if err := tf.client.Apply(ctx); err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error tf-apply for '%s'", taskName))
+		err = errors.Wrap(err, fmt.Sprintf("error tf-apply for '%s'", taskName))
+		if tf.postApplyExec != nil {
+			tf.logger.Trace("post-apply commands for failed task", taskNameLogKey, taskName)
+			return tf.postApplyExec.Do(ctx, err)
+		}
+		return err
 	}
 
 	if tf.postApply != nil {
@@ -532,6 +803,117 @@ func (tf *Terraform) applyTask(ctx context.Context) error {
 		}
 	}
 
+	tf.snapshot(ctx)
+	return nil
+}
+
+// checkPlan plans the task, checks the plan against the task's policies and
+// guardrails, and runs the pre-apply handlers with the plan. An error is
+// returned if any mandatory policy fails, the plan exceeds the guardrails, or
+// any of the handlers vetoes the apply.
+func (tf *Terraform) checkPlan(ctx context.Context) error {
+	guardrails := tf.task.Guardrails()
+	if tf.preApply == nil && len(tf.policies) == 0 && guardrails.IsEmpty() {
+		return nil
+	}
+
+	taskName := tf.task.Name()
+	plan, err := tf.inspectTask(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	if failed := plan.Policies.Failed(config.PolicyEnforcementMandatory); len(failed) > 0 {
+		tf.logger.Info("apply stopped by mandatory policies", taskNameLogKey, taskName)
+		return fmt.Errorf("mandatory policies failed tf-apply for '%s': %s",
+			taskName, failed.Error())
+	}
+
+	if err := tf.checkGuardrails(ctx, &plan); err != nil {
+		return err
+	}
+
+	if tf.preApply == nil {
+		return nil
+	}
+
+	handlerPlan := handler.Plan{
+		ChangesPresent: plan.ChangesPresent,
+		Plan:           plan.Plan,
+		URL:            plan.URL,
+	}
+	if plan.ChangesPresent && hasPlanPolicy(tf.task.PreApply()) {
+		if handlerPlan.Structured, err = tf.showPlan(ctx, &plan); err != nil {
+			return err
+		}
+	}
+
+	tf.logger.Trace("pre-apply checks for task", taskNameLogKey, taskName,
+		"changes_present", plan.ChangesPresent)
+	ctx = handler.WithPlan(ctx, handlerPlan)
+	if err := tf.preApply.Do(ctx, nil); err != nil {
+		tf.logger.Info("apply vetoed by pre-apply checks", taskNameLogKey, taskName)
+		return errors.Wrap(err, fmt.Sprintf("pre-apply checks vetoed tf-apply for '%s'", taskName))
+	}
+
+	return nil
+}
+
+// hasPlanPolicy returns whether any of the pre-apply checks is a plan policy,
+// which checks the structured plan
+func hasPlanPolicy(checks config.PreApplyConfigs) bool {
+	for _, c := range checks {
+		if c != nil && c.MaxDestroy != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// importResources imports the task's configured existing infrastructure into
+// the workspace state. Importing requires the root module's input variables, so
+// it is deferred from initTask until the template has rendered and the task is
+// about to apply for the first time. Once all resources are imported, it is not
+// attempted again. The imported addresses, and the addresses skipped because
+// they are already in the state, are recorded on the event of the apply.
+func (tf *Terraform) importResources(ctx context.Context) error {
+	if tf.imported {
+		return nil
+	}
+
+	taskName := tf.task.Name()
+	imports := tf.task.Imports()
+	addrs := make([]string, 0, len(imports))
+	for addr := range imports {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	results := event.Imports{Imported: []string{}, Skipped: []string{}}
+	for _, addr := range addrs {
+		id := imports[addr]
+		tf.logger.Trace("import", taskNameLogKey, taskName, "address", addr, "id", id)
+		err := tf.client.Import(ctx, addr, id)
+		switch {
+		case errors.Is(err, client.ErrAlreadyManaged):
+			results.Skipped = append(results.Skipped, addr)
+		case err != nil:
+			return errors.Wrap(err, fmt.Sprintf("error tf-import for '%s' importing "+
+				"'%s' with ID '%s'", taskName, addr, id))
+		default:
+			results.Imported = append(results.Imported, addr)
+		}
+	}
+
+	if len(addrs) > 0 {
+		tf.logger.Info("imported existing resources for task",
+			taskNameLogKey, taskName, "imported", results.Imported,
+			"skipped", results.Skipped)
+		if ev := event.FromContext(ctx); ev != nil {
+			ev.Imports = &results
+		}
+	}
+	tf.imported = true
 	return nil
 }
 
@@ -558,10 +940,15 @@ func (tf *Terraform) initTaskTemplate() error {
 		return err
 	}
 
+	var vaultSecrets *tmplfunc.VaultSecrets
+	if tf.task.UsesVaultSecrets() {
+		vaultSecrets = tmplfunc.NewVaultSecrets()
+	}
+
 	tmpl := hcat.NewTemplate(hcat.TemplateInput{
 		Contents:     string(content),
 		Renderer:     renderer,
-		FuncMapMerge: tmplfunc.HCLMap(servicesMeta),
+		FuncMapMerge: tmplfunc.HCLMap(servicesMeta, vaultSecrets),
 	})
 
 	if tf.template != nil {
@@ -582,6 +969,7 @@ func (tf *Terraform) initTaskTemplate() error {
 		tf.watcher.Sweep(tf.template)
 	}
 
+	tf.vaultSecrets = vaultSecrets
 	tf.setNotifier(tmpl)
 
 	logger.Debug("validating template")
@@ -627,30 +1015,70 @@ func (tf *Terraform) setNotifier(tmpl templates.Template) error {
 		return err
 	}
 
-	switch tf.task.Condition().(type) {
+	var n conditionNotifier
+	switch cond := tf.task.Condition().(type) {
+	case *config.CompositeConditionConfig:
+		var conditions []notifier.ConditionNotifierFunc
+		for _, c := range cond.Conditions {
+			if _, ok := c.(*config.ScheduleConditionConfig); ok {
+				// schedule condition is not triggered by dependencies
+				continue
+			}
+			c := c
+			conditions = append(conditions, func(t templates.Template) templates.Template {
+				return newConditionNotifier(t, tmplFuncTotal, c)
+			})
+		}
+		n = notifier.NewComposite(tmpl, tmplFuncTotal, cond.IsAll(),
+			cond.ScheduleCondition() != nil, conditions...)
+	default:
+		n = newConditionNotifier(tmpl, tmplFuncTotal, cond)
+	}
+
+	if tf.task.UsesVaultSecrets() {
+		// rotated secrets also trigger the task
+		n = notifier.NewVaultSecrets(n)
+	}
+
+	tf.template = n
+	tf.overrider = n
+	return nil
+}
+
+// conditionNotifier is a notifier for the template of a task's condition
+type conditionNotifier interface {
+	templates.Template
+	notifier.Overrider
+}
+
+// newConditionNotifier creates the notifier for a condition that is not
+// composed of other conditions
+func newConditionNotifier(tmpl templates.Template, tmplFuncTotal int,
+	condition config.ConditionConfig) conditionNotifier {
+
+	switch cond := condition.(type) {
 	case *config.ServicesConditionConfig:
-		tmpl := notifier.NewServices(tmpl, tmplFuncTotal)
-		tf.template = tmpl
-		tf.overrider = tmpl
+		return notifier.NewServicesWithTrigger(tmpl, tmplFuncTotal,
+			config.StringVal(cond.TriggerOn))
 	case *config.CatalogServicesConditionConfig:
-		tmpl := notifier.NewCatalogServicesRegistration(tmpl, tmplFuncTotal)
-		tf.template = tmpl
-		tf.overrider = tmpl
+		return notifier.NewCatalogServicesRegistration(tmpl, tmplFuncTotal)
 	case *config.ConsulKVConditionConfig:
-		tmpl := notifier.NewConsulKV(tmpl, tmplFuncTotal)
-		tf.template = tmpl
-		tf.overrider = tmpl
+		return notifier.NewConsulKV(tmpl, tmplFuncTotal)
+	case *config.IntentionsConditionConfig:
+		return notifier.NewIntentions(tmpl, tmplFuncTotal)
+	case *config.NodesConditionConfig:
+		return notifier.NewNodes(tmpl, tmplFuncTotal)
 	case *config.ScheduleConditionConfig:
-		tmpl := notifier.NewSuppressNotification(tmpl, tmplFuncTotal)
-		tf.template = tmpl
-		tf.overrider = tmpl
+		if config.TimeDurationVal(cond.OnChangeInterval) > 0 {
+			// module input changes also trigger the task, rate-limited by
+			// the controller
+			return notifier.NewOnChange(tmpl, tmplFuncTotal)
+		}
+		return notifier.NewSuppressNotification(tmpl, tmplFuncTotal)
 	default:
 		// services list
-		tmpl := notifier.NewServices(tmpl, tmplFuncTotal)
-		tf.template = tmpl
-		tf.overrider = tmpl
+		return notifier.NewServices(tmpl, tmplFuncTotal)
 	}
-	return nil
 }
 
 // countTmplFunc counts the number of template functions (tmplfunc) that are
@@ -663,33 +1091,47 @@ func (tf *Terraform) countTmplFunc() (int, error) {
 	serviceCount := len(tf.task.Services())
 	nonServiceCount := 0
 
-	switch cond := tf.task.Condition().(type) {
-	case *config.CatalogServicesConditionConfig:
-		nonServiceCount++
-	case *config.ServicesConditionConfig:
-		if cond.Regexp != nil {
-			serviceCount = 1
-		} else {
-			serviceCount = len(cond.Names)
+	for _, condition := range config.FlattenCondition(tf.task.Condition()) {
+		switch cond := condition.(type) {
+		case *config.CatalogServicesConditionConfig:
+			nonServiceCount++
+		case *config.ServicesConditionConfig:
+			if isServicesRegexQuery(&cond.ServicesMonitorConfig) {
+				serviceCount = 1
+			} else {
+				serviceCount = len(cond.Names)
+			}
+		case *config.ConsulKVConditionConfig:
+			nonServiceCount++
+		case *config.IntentionsConditionConfig:
+			nonServiceCount++
+		case *config.NodesConditionConfig:
+			nonServiceCount++
+		default:
+			// no-op: condition block currently not required since services list
+			// can be used alternatively. enforced by config validation
 		}
-	case *config.ConsulKVConditionConfig:
-		nonServiceCount++
-	default:
-		// no-op: condition block currently not required since services list
-		// can be used alternatively. enforced by config validation
 	}
 
 	for _, moduleInput := range tf.task.ModuleInputs() {
 		switch input := moduleInput.(type) {
 		case *config.ServicesModuleInputConfig:
 			// relies on config validation to restrict to one ServicesModuleInput
-			if input.Regexp != nil {
+			if isServicesRegexQuery(&input.ServicesMonitorConfig) {
 				serviceCount = 1
 			} else {
 				serviceCount = len(input.Names)
 			}
 		case *config.ConsulKVModuleInputConfig:
 			nonServiceCount++
+		case *config.IntentionsModuleInputConfig:
+			nonServiceCount++
+		case *config.NodesModuleInputConfig:
+			nonServiceCount++
+		case *config.ConfigEntriesModuleInputConfig:
+			nonServiceCount++
+		case *config.VaultSecretsModuleInputConfig:
+			nonServiceCount++
 		default:
 			return 0, fmt.Errorf("task %q has unsupported type of module_input "+
 				"block configuration %T", tf.task.name, input)
@@ -708,13 +1150,14 @@ func (tf *Terraform) validateTask(ctx context.Context) error {
 }
 
 // getTerraformHandlers returns the first handler in a chain of handlers
-// for a Terraform driver.
+// for a Terraform driver. The handlers for providers are chained ahead of the
+// next handler.
 //
 // Returned handler may be nil even if returned err is nil. This happens when
-// no providers have a handler.
-func getTerraformHandlers(taskName string, providers TerraformProviderBlocks) (handler.Handler, error) {
+// no providers have a handler and next is nil.
+func getTerraformHandlers(taskName string, providers TerraformProviderBlocks,
+	next handler.Handler) (handler.Handler, error) {
 	counter := 0
-	var next handler.Handler
 	logger := logging.Global().Named(logSystemName).Named(terraformSubsystemName)
 	for _, p := range providers {
 		h, err := handler.TerraformProviderHandler(p.Name(), p.ProviderBlock().RawConfig())
@@ -734,6 +1177,40 @@ func getTerraformHandlers(taskName string, providers TerraformProviderBlocks) (h
 	return next, nil
 }
 
+// getPostApplyHandlers returns the chain of exec handlers for the task's
+// post_apply commands in the order they are configured. The returned handler
+// is nil if the task has no commands configured.
+func getPostApplyHandlers(task *Task) (handler.Handler, error) {
+	confs := task.PostApply()
+	var next handler.Handler
+	for i := len(confs) - 1; i >= 0; i-- {
+		h, err := handler.NewExec(task.Name(), task.WorkingDir(), confs[i])
+		if err != nil {
+			return nil, err
+		}
+		h.SetNext(next)
+		next = h
+	}
+	return next, nil
+}
+
+// getPreApplyHandlers returns the chain of handlers for the task's pre_apply
+// checks in the order they are configured. The returned handler is nil if the
+// task has no checks configured.
+func getPreApplyHandlers(task *Task) (handler.Handler, error) {
+	confs := task.PreApply()
+	var next handler.Handler
+	for i := len(confs) - 1; i >= 0; i-- {
+		h, err := handler.NewPreApply(task.Name(), task.WorkingDir(), confs[i])
+		if err != nil {
+			return nil, err
+		}
+		h.SetNext(next)
+		next = h
+	}
+	return next, nil
+}
+
 // getServicesMetaData helps retrieve metadata which can come from a number of
 // configuration sources: task.services' related service block, condition
 // "service" block, module_input "service" block.
@@ -0,0 +1,153 @@
+package driver
+
+import (
+	"context"
+
+	"github.com/hashicorp/consul-terraform-sync/client"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/templates"
+)
+
+const (
+	terraformCloudSubsystemName = "terraformcloud"
+
+	// tfcLatestVersion is reported as the driver version when the task does
+	// not pin a Terraform version for its workspace
+	tfcLatestVersion = "latest"
+)
+
+var _ Driver = (*TerraformCloud)(nil)
+
+// TerraformCloud is a Sync driver that executes tasks as remote runs on
+// Terraform Cloud or Terraform Enterprise. Each task is managed in its own
+// workspace. Rendering the root module and handling templates is shared with
+// the Terraform driver.
+type TerraformCloud struct {
+	*Terraform
+
+	// tfc is nil when an alternative client type is used for development or
+	// testing
+	tfc *client.TerraformCloud
+}
+
+// TerraformCloudConfig configures the Terraform Cloud driver
+type TerraformCloudConfig struct {
+	Task              *Task
+	Address           string
+	Organization      string
+	Token             string
+	WorkspacePrefix   string
+	RequiredProviders map[string]interface{}
+	Watcher           templates.Watcher
+	// empty/unknown string will default to TerraformCloud client
+	ClientType string
+}
+
+// NewTerraformCloud configures and initializes a new Terraform Cloud driver
+// for a task.
+func NewTerraformCloud(conf *TerraformCloudConfig) (*TerraformCloud, error) {
+	task := conf.Task
+	taskName := task.Name()
+	logger := logging.Global().Named(logSystemName).Named(terraformCloudSubsystemName)
+	if err := ensureWorkingDir(task.WorkingDir()); err != nil {
+		logger.Error("error creating task work directory", "error", err)
+		return nil, err
+	}
+
+	var tfClient client.Client
+	var tfc *client.TerraformCloud
+	var err error
+	switch conf.ClientType {
+	case developmentClient, testClient:
+		tfClient, err = newClient(&clientConfig{
+			clientType: conf.ClientType,
+			taskName:   taskName,
+			workingDir: task.WorkingDir(),
+		})
+	default:
+		ws := task.TFCWorkspace()
+		tfc, err = client.NewTerraformCloud(&client.TerraformCloudConfig{
+			Address:       conf.Address,
+			Token:         conf.Token,
+			Organization:  conf.Organization,
+			Workspace:     conf.WorkspacePrefix + taskName,
+			WorkingDir:    task.WorkingDir(),
+			TFVersion:     task.TFVersion(),
+			ExecutionMode: config.StringVal(ws.ExecutionMode),
+			AgentPoolID:   config.StringVal(ws.AgentPoolID),
+			AgentPoolName: config.StringVal(ws.AgentPoolName),
+		})
+		tfClient = tfc
+	}
+	if err != nil {
+		logger.Error("init client type error", "client_type", conf.ClientType, "error", err)
+		return nil, err
+	}
+
+	return newTerraformCloud(conf, tfClient, tfc)
+}
+
+// newTerraformCloud configures the Terraform Cloud driver with the client.
+// tfc is the same client when it is a Terraform Cloud client, otherwise nil.
+func newTerraformCloud(conf *TerraformCloudConfig, tfClient client.Client,
+	tfc *client.TerraformCloud) (*TerraformCloud, error) {
+
+	task := conf.Task
+	logger := logging.Global().Named(logSystemName).Named(terraformCloudSubsystemName)
+
+	// Only the task environment is set on the workspace. The local
+	// environment of CTS is not relevant to remote runs.
+	if taskEnv := task.Env(); len(taskEnv) > 0 {
+		if err := tfClient.SetEnv(taskEnv); err != nil {
+			logger.Error("error setting the environment for the client",
+				"client_type", conf.ClientType, "error", err)
+			return nil, err
+		}
+	}
+
+	tf, err := newTerraform(&TerraformConfig{
+		Task:              task,
+		RequiredProviders: conf.RequiredProviders,
+		Watcher:           conf.Watcher,
+	}, tfClient)
+	if err != nil {
+		return nil, err
+	}
+	tf.logger = logger
+
+	return &TerraformCloud{Terraform: tf, tfc: tfc}, nil
+}
+
+// Version returns the Terraform version of the task's workspace.
+func (tf *TerraformCloud) Version() string {
+	if v := tf.task.TFVersion(); v != "" {
+		return v
+	}
+	return tfcLatestVersion
+}
+
+// InspectTask inspects the task with a speculative plan on Terraform Cloud.
+// The returned plan includes the URL of the run.
+func (tf *TerraformCloud) InspectTask(ctx context.Context) (InspectPlan, error) {
+	plan, err := tf.Terraform.InspectTask(ctx)
+	plan.URL = tf.runURL()
+	return plan, err
+}
+
+// UpdateTask updates the task on the driver. The returned plan includes the
+// URL of the run when the task is inspected.
+func (tf *TerraformCloud) UpdateTask(ctx context.Context, patch PatchTask) (InspectPlan, error) {
+	plan, err := tf.Terraform.UpdateTask(ctx, patch)
+	if patch.RunOption == RunOptionInspect {
+		plan.URL = tf.runURL()
+	}
+	return plan, err
+}
+
+func (tf *TerraformCloud) runURL() string {
+	if tf.tfc == nil {
+		return ""
+	}
+	return tf.tfc.RunURL()
+}
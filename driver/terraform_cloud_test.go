@@ -0,0 +1,127 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/client"
+	mocksTmpl "github.com/hashicorp/consul-terraform-sync/mocks/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTerraformCloud(t *testing.T) {
+	t.Parallel()
+
+	task, err := NewTask(TaskConfig{
+		Name:       "task",
+		Enabled:    true,
+		WorkingDir: t.TempDir(),
+	})
+	require.NoError(t, err)
+
+	tf, err := NewTerraformCloud(&TerraformCloudConfig{
+		Task:         task,
+		Address:      "https://app.terraform.io",
+		Organization: "org",
+		Token:        "token",
+		Watcher:      new(mocksTmpl.Watcher),
+		ClientType:   testClient,
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, tf.Terraform)
+	assert.Nil(t, tf.tfc)
+
+	t.Run("env", func(t *testing.T) {
+		task, err := NewTask(TaskConfig{
+			Name:       "task",
+			Enabled:    true,
+			WorkingDir: t.TempDir(),
+			Env:        map[string]string{"KEY": "value"},
+		})
+		require.NoError(t, err)
+
+		// only the task environment is set for remote runs
+		c := new(mocks.Client)
+		c.On("SetEnv", map[string]string{"KEY": "value"}).Return(nil).Once()
+
+		tf, err := newTerraformCloud(&TerraformCloudConfig{
+			Task:    task,
+			Watcher: new(mocksTmpl.Watcher),
+		}, c, nil)
+		require.NoError(t, err)
+		assert.NotNil(t, tf.Terraform)
+		c.AssertExpectations(t)
+	})
+
+	t.Run("env error", func(t *testing.T) {
+		task, err := NewTask(TaskConfig{
+			Name:       "task",
+			Enabled:    true,
+			WorkingDir: t.TempDir(),
+			Env:        map[string]string{"KEY": "value"},
+		})
+		require.NoError(t, err)
+
+		c := new(mocks.Client)
+		c.On("SetEnv", mock.Anything).Return(errors.New("error")).Once()
+
+		_, err = newTerraformCloud(&TerraformCloudConfig{
+			Task:    task,
+			Watcher: new(mocksTmpl.Watcher),
+		}, c, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestTerraformCloud_Version(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		tfVersion string
+		expected  string
+	}{
+		{"latest", "", "latest"},
+		{"pinned", "1.1.0", "1.1.0"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tf := &TerraformCloud{
+				Terraform: &Terraform{task: &Task{tfVersion: tc.tfVersion}},
+			}
+			assert.Equal(t, tc.expected, tf.Version())
+		})
+	}
+}
+
+func TestTerraformCloud_InspectTask(t *testing.T) {
+	t.Parallel()
+
+	var w mocksTmpl.Watcher
+	var c mocks.Client
+	tf := &TerraformCloud{
+		Terraform: &Terraform{
+			task:    &Task{enabled: true},
+			logger:  logging.NewNullLogger(),
+			watcher: &w,
+			client:  &c,
+		},
+	}
+
+	ctx := context.Background()
+	w.On("Deregister", mock.Anything).Return()
+	c.On("Plan", ctx).Return(true, nil).Once()
+	c.On("SetStdout", mock.Anything).Twice()
+	c.On("DiscardPlan").Return(nil).Once()
+
+	plan, err := tf.InspectTask(ctx)
+	assert.NoError(t, err)
+	assert.True(t, plan.ChangesPresent)
+	// Run URL is only available with a Terraform Cloud client
+	assert.Empty(t, plan.URL)
+}
@@ -7,10 +7,10 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/logging"
-	ctsVersion "github.com/hashicorp/consul-terraform-sync/version"
 	"github.com/hashicorp/go-checkpoint"
 	goVersion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-exec/tfexec"
@@ -22,53 +22,111 @@ const (
 	terraformSubsystemName = "terraform"
 )
 
-const fallbackTFVersion = "0.13.7"
+const (
+	fallbackTFVersion       = "0.13.7"
+	fallbackOpenTofuVersion = "1.6.2"
+)
 
 // TerraformVersion is the version of Terraform CLI for the Terraform driver.
+// Tasks that pin a version use their own installation instead.
 var TerraformVersion *goVersion.Version
 
+// versionsDir is the directory within the install path where versions pinned
+// by tasks are installed side-by-side
+const versionsDir = "versions"
+
+// installMu serializes installations of versions pinned by tasks, which can
+// be requested concurrently by tasks created through the API
+var installMu sync.Mutex
+
 // InstallTerraform installs the Terraform binary to the configured path.
 // If an existing Terraform exists in the path, it is checked for compatibility.
 func InstallTerraform(ctx context.Context, conf *config.TerraformConfig) error {
+	tfVersion, err := ensureTerraform(ctx, conf)
+	if tfVersion != nil {
+		// Set the global variable to the installed version
+		TerraformVersion = tfVersion
+	}
+	return err
+}
+
+// InstallTerraformVersion installs an exact version of Terraform for tasks
+// that pin a version. Each version is installed in its own directory within
+// the configured path, see TerraformVersionPath, and is only installed once.
+func InstallTerraformVersion(ctx context.Context, conf *config.TerraformConfig, version string) error {
+	if archive := config.StringVal(conf.Archive); archive != "" && !isDir(archive) {
+		return fmt.Errorf("unable to install %s %s from the archive %q, "+
+			"configure 'mirror_url' or a mirror directory for 'archive' to "+
+			"install versions for tasks", conf.ProductName(), version, archive)
+	}
+
+	installMu.Lock()
+	defer installMu.Unlock()
+
+	vConf := conf.Copy()
+	vConf.Version = config.String(version)
+	vConf.Path = config.String(TerraformVersionPath(*conf.Path, version))
+	// The configured checksum is for the archive of the default version
+	vConf.SHA256 = config.String("")
+
+	tfVersion, err := ensureTerraform(ctx, vConf)
+	if err != nil {
+		return err
+	}
+
+	if tfVersion.String() != version {
+		return fmt.Errorf("found %s version %s at %s, expected version %s",
+			conf.ProductName(), tfVersion.String(), *vConf.Path, version)
+	}
+	return nil
+}
+
+// TerraformVersionPath returns the directory where a version of Terraform
+// pinned by tasks is installed.
+func TerraformVersionPath(path, version string) string {
+	return filepath.Join(path, versionsDir, version)
+}
+
+// ensureTerraform installs the Terraform binary to the configured path if it
+// does not exist, and returns the version of the installed binary.
+func ensureTerraform(ctx context.Context, conf *config.TerraformConfig) (*goVersion.Version, error) {
 	path := *conf.Path
 
-	logger := logging.Global().Named(logSystemName).Named(terraformSubsystemName)
-	if isTFInstalled(path) {
+	logger := logging.Global().Named(logSystemName).Named(terraformSubsystemName).With(
+		"flavor", conf.BinaryName())
+	if isTFInstalled(path, conf.BinaryName()) {
 		tfVersion, compatible, err := verifyInstalledTF(ctx, conf)
 		if err != nil {
 			if strings.Contains(err.Error(), "exec format error") {
-				return errIncompatibleTerraformBinary
+				return nil, errIncompatibleTerraformBinary
 			}
-			return err
+			return nil, err
 		}
 
-		// Set the global variable to the installed version
-		TerraformVersion = tfVersion
 		if !compatible {
-			return errUnsupportedTerraformVersion
+			return tfVersion, errUnsupportedTerraformVersion
 		}
 		logger.Info("skipping install, terraform already exists",
 			"tf_version", tfVersion.String(), "install_path", path)
 
-		return nil
+		return tfVersion, nil
 	}
 
 	logger.Info("install terraform", "install_path", path)
 	tfVersion, err := installTerraform(ctx, conf)
 	if err != nil {
 		logger.Error("error installing terraform", "error", err)
-		return err
+		return nil, err
 	}
 	logger.Info("successfully installed terraform")
 
-	// Set the global variable to the installed version
-	TerraformVersion = tfVersion
-	return nil
+	return tfVersion, nil
 }
 
-// isTFInstalled checks to see if terraform already exists at path.
-func isTFInstalled(tfPath string) bool {
-	tfPath = filepath.Join(tfPath, "terraform")
+// isTFInstalled checks to see if the terraform binary, or its equivalent
+// for other flavors, already exists at path.
+func isTFInstalled(tfPath, execName string) bool {
+	tfPath = filepath.Join(tfPath, execName)
 
 	// Check if terraform exists in target path
 	if _, err := os.Stat(tfPath); err == nil {
@@ -77,7 +135,7 @@ func isTFInstalled(tfPath string) bool {
 
 	// Check if terraform exists in $PATH to notify users about the new
 	// installation for Sync
-	path, err := exec.LookPath("terraform")
+	path, err := exec.LookPath(execName)
 	if err != nil {
 		return false
 	}
@@ -103,7 +161,7 @@ func verifyInstalledTF(ctx context.Context, conf *config.TerraformConfig) (*goVe
 
 	// Verify version for existing terraform
 	logger := logging.Global().Named(logSystemName).Named(terraformSubsystemName)
-	tf, err := tfexec.NewTerraform(wd, filepath.Join(tfPath, "terraform"))
+	tf, err := tfexec.NewTerraform(wd, filepath.Join(tfPath, conf.BinaryName()))
 	if err != nil {
 		logger.Error("unable to setup Terraform client", "terraform_path", tfPath, "error", err)
 		return nil, false, err
@@ -115,10 +173,11 @@ func verifyInstalledTF(ctx context.Context, conf *config.TerraformConfig) (*goVe
 		return nil, false, err
 	}
 
-	if !ctsVersion.TerraformConstraint.Check(tfVersion) {
+	if !conf.VersionConstraint().Check(tfVersion) {
 		logger.Error("found Terraform version does not satisfy the version constraint",
 			"terraform_path", tfPath, "version", tfVersion.String(),
-			"compatible_version_constraint", ctsVersion.CompatibleTerraformVersionConstraint)
+			"flavor", conf.BinaryName(),
+			"compatible_version_constraint", conf.CompatibleVersionConstraint())
 		return tfVersion, false, nil
 	}
 
@@ -154,14 +213,84 @@ func isTFCompatible(conf *config.TerraformConfig, version *goVersion.Version) er
 	return nil
 }
 
+// isTaskTFCompatible checks compatibility of the version of Terraform with the
+// configuration of a task. The version is not checked when it is unknown.
+func isTaskTFCompatible(task *Task, version *goVersion.Version) error {
+	if version == nil {
+		return nil
+	}
+
+	// vault_secrets is passed to the module as a sensitive variable
+	if task.UsesVaultSecrets() {
+		sensitiveConstraint, err := goVersion.NewConstraint(">= 0.14")
+		if err != nil {
+			return err
+		}
+
+		if !sensitiveConstraint.Check(version) {
+			return fmt.Errorf("Consul-Terraform-Sync does not support "+
+				"vault_secrets module input for task '%s' with Terraform "+
+				"<= 0.13: %s", task.Name(), version.String())
+		}
+	}
+
+	return nil
+}
+
 // installTerraform attempts to install the latest version of Terraform into
 // the path. If the latest version is outside of the known supported range for
-//  Sync, the fall back version 0.13.5 is downloaded.
+// Sync, the fall back version is downloaded. OpenTofu, and installations from
+// a configured mirror or local archive, are installed from a release archive
+// instead of through tfinstall.
 func installTerraform(ctx context.Context, conf *config.TerraformConfig) (*goVersion.Version, error) {
-	var v *goVersion.Version
 	logger := logging.Global().Named(logSystemName).Named(terraformSubsystemName)
+
+	// Create path if one doesn't already exist
+	os.MkdirAll(*conf.Path, os.ModePerm)
+
+	archive := config.StringVal(conf.Archive)
+	if archive != "" && !isDir(archive) {
+		logger.Debug("installing from local archive", "archive", archive)
+		if err := installRelease(ctx, conf, localArchive(archive)); err != nil {
+			return nil, err
+		}
+
+		// The version of a local archive is only known once it is installed
+		v, compatible, err := verifyInstalledTF(ctx, conf)
+		if err != nil {
+			return nil, err
+		}
+		if !compatible {
+			return v, errUnsupportedTerraformVersion
+		}
+		return v, nil
+	}
+
+	// Hosts installing from a mirror are expected to be without internet
+	// access, so the latest version is not looked up
+	mirror := config.StringVal(conf.MirrorURL)
+	if archive != "" {
+		mirror = archive
+	}
+	if mirror != "" && config.StringVal(conf.Version) == "" {
+		return nil, fmt.Errorf("the %s version to install from the mirror %q "+
+			"must be configured, the latest version cannot be determined "+
+			"without internet access", conf.ProductName(), mirror)
+	}
+
+	fallbackVersion := fallbackTFVersion
+	if conf.IsOpenTofu() {
+		fallbackVersion = fallbackOpenTofuVersion
+	}
+
+	var v *goVersion.Version
 	if conf.Version != nil && *conf.Version != "" {
 		v = goVersion.Must(goVersion.NewVersion(*conf.Version))
+	} else if conf.IsOpenTofu() {
+		// Checkpoint only tracks HashiCorp products
+		logger.Info("version is not configured, installing the fallback version",
+			"fallback_version", fallbackVersion)
+		v = goVersion.Must(goVersion.NewVersion(fallbackVersion))
 	} else {
 		// Fetch the latest
 		resp, err := checkpoint.Check(&checkpoint.CheckParams{Product: "terraform"})
@@ -171,27 +300,38 @@ func installTerraform(ctx context.Context, conf *config.TerraformConfig) (*goVer
 			v = goVersion.Must(goVersion.NewVersion(resp.CurrentVersion))
 		}
 	}
-	if v == nil || !ctsVersion.TerraformConstraint.Check(v) {
+	if v == nil || !conf.VersionConstraint().Check(v) {
 		// Configured version shouldn't be invalid our outside of the constraint at
 		// this point if the configuration was validated.
 		//
 		// At this point we cannot guarantee compatibility of the latest Terraform
 		// version, so we will move forward with a safe fallback version.
 		logger.Warn("could not determine latest version of terraform using Checkpoint, fallback to fallback version",
-			"fallback_version", fallbackTFVersion)
-		v = goVersion.Must(goVersion.NewVersion(fallbackTFVersion))
+			"fallback_version", fallbackVersion)
+		v = goVersion.Must(goVersion.NewVersion(fallbackVersion))
 	}
 
 	if err := isTFCompatible(conf, v); err != nil {
 		return nil, err
 	}
 
-	// Create path if one doesn't already exist
-	os.MkdirAll(*conf.Path, os.ModePerm)
+	if conf.IsOpenTofu() || mirror != "" {
+		r := mirrorRelease(conf, mirror, v)
+		logger.Debug("installing release archive", "location", r.location,
+			"archive", r.archive)
+		if err := installRelease(ctx, conf, r); err != nil {
+			return nil, err
+		}
+		logger.Debug("successfully installed terraform", "version", v.String(),
+			"install_path", *conf.Path, "flavor", conf.BinaryName())
+		return v, nil
+	}
 
 	installedPath, err := tfinstall.ExactVersion(v.String(), *conf.Path).ExecPath(ctx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to download Terraform %s, configure "+
+			"'archive' or 'mirror_url' for the Terraform driver to install "+
+			"without internet access: %s", v.String(), err)
 	}
 
 	logger.Debug("successfully installed terraform", "version", v.String(), "install_path", installedPath)
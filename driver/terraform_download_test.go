@@ -1,11 +1,18 @@
 package driver
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/go-version"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsTFCompatible(t *testing.T) {
@@ -50,3 +57,99 @@ func TestIsTFCompatible(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTaskTFCompatible(t *testing.T) {
+	vaultSecrets := config.ModuleInputConfigs{&config.VaultSecretsModuleInputConfig{}}
+
+	cases := []struct {
+		name       string
+		version    *version.Version
+		task       *Task
+		compatible bool
+	}{
+		{
+			"valid",
+			version.Must(version.NewSemver("0.13.7")),
+			&Task{name: "task"},
+			true,
+		}, {
+			"vault_secrets compatible",
+			version.Must(version.NewSemver("0.14.0")),
+			&Task{name: "task", moduleInputs: vaultSecrets},
+			true,
+		}, {
+			"vault_secrets incompatible",
+			version.Must(version.NewSemver("0.13.7")),
+			&Task{name: "task", moduleInputs: vaultSecrets},
+			false,
+		}, {
+			"vault_secrets unknown version",
+			nil,
+			&Task{name: "task", moduleInputs: vaultSecrets},
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := isTaskTFCompatible(tc.task, tc.version)
+			if tc.compatible {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTerraformVersionPath(t *testing.T) {
+	assert.Equal(t, filepath.Join("path", "versions", "1.0.0"),
+		TerraformVersionPath("path", "1.0.0"))
+}
+
+func TestInstallTerraformVersion(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("stub terraform binary requires a shell")
+	}
+
+	t.Run("already installed", func(t *testing.T) {
+		path := t.TempDir()
+		writeStubTerraform(t, TerraformVersionPath(path, "1.0.0"), "1.0.0")
+
+		err := InstallTerraformVersion(context.Background(), testTerraformConfig(path), "1.0.0")
+		assert.NoError(t, err)
+	})
+
+	t.Run("installed version mismatch", func(t *testing.T) {
+		path := t.TempDir()
+		writeStubTerraform(t, TerraformVersionPath(path, "1.0.0"), "1.1.0")
+
+		err := InstallTerraformVersion(context.Background(), testTerraformConfig(path), "1.0.0")
+		assert.Error(t, err)
+	})
+
+	t.Run("archive file", func(t *testing.T) {
+		conf := testTerraformConfig(t.TempDir())
+		conf.Archive = config.String(filepath.Join(t.TempDir(), "terraform.zip"))
+		require.NoError(t, ioutil.WriteFile(*conf.Archive, []byte{}, 0644))
+
+		err := InstallTerraformVersion(context.Background(), conf, "1.0.0")
+		assert.Error(t, err)
+	})
+}
+
+func testTerraformConfig(path string) *config.TerraformConfig {
+	conf := &config.TerraformConfig{Path: config.String(path)}
+	conf.Finalize(nil)
+	return conf
+}
+
+// writeStubTerraform writes an executable to dir that reports the version
+// like the Terraform CLI
+func writeStubTerraform(t *testing.T, dir, version string) {
+	require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+	script := fmt.Sprintf("#!/bin/sh\necho '{\"terraform_version\": \"%s\", "+
+		"\"platform\": \"linux_amd64\", \"provider_selections\": {}}'\n", version)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "terraform"),
+		[]byte(script), 0755))
+}
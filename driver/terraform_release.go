@@ -0,0 +1,265 @@
+package driver
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	goVersion "github.com/hashicorp/go-version"
+)
+
+// defaultOpenTofuMirrorURL is where OpenTofu release archives are downloaded
+// from when a mirror is not configured. Mirrors for OpenTofu are expected to
+// have the same layout as the GitHub releases.
+const defaultOpenTofuMirrorURL = "https://github.com/opentofu/opentofu/releases/download"
+
+// sumsSignatureExts are the file extensions of the detached signature for the
+// SHA256SUMS file of a release. HashiCorp publishes ".sig" and OpenTofu
+// publishes ".gpgsig".
+var sumsSignatureExts = []string{".sig", ".gpgsig"}
+
+// release describes the files of a Terraform or OpenTofu release. The
+// location is either an HTTP(S) URL or a directory on the local filesystem.
+type release struct {
+	location string
+	archive  string
+	sums     string
+}
+
+// mirrorRelease returns the release for the version and the current platform
+// from a mirror. Terraform mirrors follow the layout of
+// releases.hashicorp.com/terraform and OpenTofu mirrors follow the layout of
+// the OpenTofu GitHub releases.
+func mirrorRelease(conf *config.TerraformConfig, mirror string, v *goVersion.Version) release {
+	mirror = strings.TrimSuffix(mirror, "/")
+	dir := v.String()
+	if conf.IsOpenTofu() {
+		if mirror == "" {
+			mirror = defaultOpenTofuMirrorURL
+		}
+		dir = "v" + dir
+	}
+
+	prefix := fmt.Sprintf("%s_%s", conf.BinaryName(), v.String())
+	location := filepath.Join(mirror, dir)
+	if isURL(mirror) {
+		location = fmt.Sprintf("%s/%s", mirror, dir)
+	}
+
+	return release{
+		location: location,
+		archive:  fmt.Sprintf("%s_%s_%s.zip", prefix, runtime.GOOS, runtime.GOARCH),
+		sums:     prefix + "_SHA256SUMS",
+	}
+}
+
+// localArchive returns the release for a local archive. The SHA256SUMS file
+// and its signature are expected in the same directory as the archive.
+func localArchive(path string) release {
+	name := filepath.Base(path)
+	prefix := strings.TrimSuffix(name,
+		fmt.Sprintf("_%s_%s.zip", runtime.GOOS, runtime.GOARCH))
+
+	return release{
+		location: filepath.Dir(path),
+		archive:  name,
+		sums:     prefix + "_SHA256SUMS",
+	}
+}
+
+// path returns the full path or URL of a release file
+func (r release) path(name string) string {
+	if isURL(r.location) {
+		return fmt.Sprintf("%s/%s", r.location, name)
+	}
+	return filepath.Join(r.location, name)
+}
+
+// read returns the content of a release file
+func (r release) read(ctx context.Context, name string) ([]byte, error) {
+	path := r.path(name)
+	if !isURL(path) {
+		return ioutil.ReadFile(path)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading %s: %s", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error downloading %s: unexpected status %s",
+			path, resp.Status)
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+// installRelease reads the release archive, verifies it with the configured
+// checksum and signature key, and installs the binary into the path.
+func installRelease(ctx context.Context, conf *config.TerraformConfig, r release) error {
+	data, err := r.read(ctx, r.archive)
+	if err != nil {
+		return err
+	}
+
+	if err := verifyRelease(ctx, conf, r, data); err != nil {
+		return err
+	}
+
+	return installFromArchive(data, conf.BinaryName(), *conf.Path)
+}
+
+// verifyRelease verifies the release archive against the entry in the
+// release's SHA256SUMS file and the configured SHA256 checksum. When a
+// signature key is configured, the signature of the SHA256SUMS file is
+// verified first. A missing SHA256SUMS file is only accepted when the archive
+// is pinned with a SHA256 checksum and no signature key is configured.
+func verifyRelease(ctx context.Context, conf *config.TerraformConfig, r release, data []byte) error {
+	hash := sha256.Sum256(data)
+	sum := hex.EncodeToString(hash[:])
+
+	pinned := config.StringVal(conf.SHA256)
+	if pinned != "" && !strings.EqualFold(pinned, sum) {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, found %s",
+			r.archive, pinned, sum)
+	}
+
+	keyPath := config.StringVal(conf.SignatureKey)
+	sums, err := r.read(ctx, r.sums)
+	if err != nil {
+		if pinned != "" && keyPath == "" {
+			return nil
+		}
+		return err
+	}
+
+	if keyPath != "" {
+		key, err := ioutil.ReadFile(keyPath)
+		if err != nil {
+			return fmt.Errorf("error reading signature key: %s", err)
+		}
+
+		var sig []byte
+		for _, ext := range sumsSignatureExts {
+			if sig, err = r.read(ctx, r.sums+ext); err == nil {
+				break
+			}
+		}
+		if sig == nil {
+			return fmt.Errorf("unable to find the signature of %s: %s", r.sums, err)
+		}
+
+		if err := verifySignature(key, sums, sig); err != nil {
+			return fmt.Errorf("error verifying the signature of %s: %s", r.sums, err)
+		}
+	}
+
+	expected, err := findChecksum(sums, r.archive)
+	if err != nil {
+		return err
+	}
+	if expected != sum {
+		return fmt.Errorf("checksum mismatch for %s: expected %s from %s, found %s",
+			r.archive, expected, r.sums, sum)
+	}
+
+	return nil
+}
+
+// verifySignature checks the detached signature of the data with an
+// armored PGP public key. Both armored and binary signatures are supported.
+func verifySignature(key, data, sig []byte) error {
+	keyring, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(key))
+	if err != nil {
+		return err
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(sig), []byte("-----BEGIN")) {
+		_, err = openpgp.CheckArmoredDetachedSignature(keyring,
+			bytes.NewReader(data), bytes.NewReader(sig), nil)
+		return err
+	}
+
+	_, err = openpgp.CheckDetachedSignature(keyring, bytes.NewReader(data),
+		bytes.NewReader(sig), nil)
+	return err
+}
+
+// findChecksum returns the checksum for the file name from the content of a
+// SHA256SUMS file
+func findChecksum(sums []byte, name string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(sums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[1] == name {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("checksum for %s not found", name)
+}
+
+// installFromArchive extracts the binary named execName from the content of
+// a release zip archive into the path
+func installFromArchive(data []byte, execName, path string) error {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return fmt.Errorf("error opening release archive: %s", err)
+	}
+
+	for _, f := range r.File {
+		if f.Name != execName {
+			continue
+		}
+
+		src, err := f.Open()
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+
+		dst, err := os.OpenFile(filepath.Join(path, execName),
+			os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+		if err != nil {
+			return err
+		}
+		defer dst.Close()
+
+		_, err = io.Copy(dst, src)
+		return err
+	}
+
+	return fmt.Errorf("release archive does not contain the %s binary", execName)
+}
+
+func isURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,348 @@
+package driver
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMirrorRelease(t *testing.T) {
+	v := version.Must(version.NewSemver("1.6.2"))
+	platform := fmt.Sprintf("%s_%s", runtime.GOOS, runtime.GOARCH)
+
+	cases := []struct {
+		name     string
+		config   *config.TerraformConfig
+		mirror   string
+		expected release
+	}{
+		{
+			"opentofu default mirror",
+			&config.TerraformConfig{
+				Flavor: config.String(config.TerraformFlavorOpenTofu),
+			},
+			"",
+			release{
+				location: defaultOpenTofuMirrorURL + "/v1.6.2",
+				archive:  "tofu_1.6.2_" + platform + ".zip",
+				sums:     "tofu_1.6.2_SHA256SUMS",
+			},
+		}, {
+			"opentofu mirror",
+			&config.TerraformConfig{
+				Flavor: config.String(config.TerraformFlavorOpenTofu),
+			},
+			"https://mirror.example.com/tofu/",
+			release{
+				location: "https://mirror.example.com/tofu/v1.6.2",
+				archive:  "tofu_1.6.2_" + platform + ".zip",
+				sums:     "tofu_1.6.2_SHA256SUMS",
+			},
+		}, {
+			"terraform mirror",
+			&config.TerraformConfig{
+				Flavor: config.String(config.TerraformFlavorTerraform),
+			},
+			"https://mirror.example.com/terraform",
+			release{
+				location: "https://mirror.example.com/terraform/1.6.2",
+				archive:  "terraform_1.6.2_" + platform + ".zip",
+				sums:     "terraform_1.6.2_SHA256SUMS",
+			},
+		}, {
+			"terraform mirror directory",
+			&config.TerraformConfig{
+				Flavor: config.String(config.TerraformFlavorTerraform),
+			},
+			"/opt/mirror/terraform",
+			release{
+				location: filepath.Join("/opt/mirror/terraform", "1.6.2"),
+				archive:  "terraform_1.6.2_" + platform + ".zip",
+				sums:     "terraform_1.6.2_SHA256SUMS",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, mirrorRelease(tc.config, tc.mirror, v))
+		})
+	}
+}
+
+func TestLocalArchive(t *testing.T) {
+	name := fmt.Sprintf("terraform_1.1.0_%s_%s.zip", runtime.GOOS, runtime.GOARCH)
+	r := localArchive(filepath.Join("/opt", "releases", name))
+	assert.Equal(t, release{
+		location: filepath.Join("/opt", "releases"),
+		archive:  name,
+		sums:     "terraform_1.1.0_SHA256SUMS",
+	}, r)
+}
+
+func TestInstallFromArchive(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		data := testArchive(t, map[string]string{
+			"LICENSE": "license",
+			"tofu":    "binary",
+		})
+		dir := t.TempDir()
+
+		require.NoError(t, installFromArchive(data, "tofu", dir))
+		content, err := ioutil.ReadFile(filepath.Join(dir, "tofu"))
+		require.NoError(t, err)
+		assert.Equal(t, "binary", string(content))
+	})
+
+	t.Run("missing binary", func(t *testing.T) {
+		data := testArchive(t, map[string]string{"terraform": "binary"})
+		err := installFromArchive(data, "tofu", t.TempDir())
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid archive", func(t *testing.T) {
+		err := installFromArchive([]byte("not a zip"), "tofu", t.TempDir())
+		assert.Error(t, err)
+	})
+}
+
+func TestInstallRelease(t *testing.T) {
+	archive := testArchive(t, map[string]string{"tofu": "binary"})
+	hash := sha256.Sum256(archive)
+	sum := hex.EncodeToString(hash[:])
+	sums := []byte(fmt.Sprintf("%s  tofu.zip\n%s  other.zip\n", sum, sum))
+
+	entity, err := openpgp.NewEntity("cts", "", "cts@example.com", nil)
+	require.NoError(t, err)
+	var sig bytes.Buffer
+	require.NoError(t, openpgp.DetachSign(&sig, entity, bytes.NewReader(sums), nil))
+
+	keyPath := filepath.Join(t.TempDir(), "key.asc")
+	writeArmoredKey(t, entity, keyPath)
+
+	otherEntity, err := openpgp.NewEntity("other", "", "other@example.com", nil)
+	require.NoError(t, err)
+	otherKeyPath := filepath.Join(t.TempDir(), "other.asc")
+	writeArmoredKey(t, otherEntity, otherKeyPath)
+
+	badSums := []byte(fmt.Sprintf("%064d  tofu.zip\n", 0))
+
+	files := map[string][]byte{
+		"/v1.6.2/tofu.zip":                     archive,
+		"/v1.6.2/tofu_1.6.2_SHA256SUMS":        sums,
+		"/v1.6.2/tofu_1.6.2_SHA256SUMS.gpgsig": sig.Bytes(),
+		"/v1.6.2/bad_SHA256SUMS":               badSums,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := files[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(content)
+	}))
+	defer ts.Close()
+
+	r := release{
+		location: ts.URL + "/v1.6.2",
+		archive:  "tofu.zip",
+		sums:     "tofu_1.6.2_SHA256SUMS",
+	}
+
+	cases := []struct {
+		name         string
+		release      release
+		sha256       string
+		signatureKey string
+		expectError  bool
+	}{
+		{
+			"sums",
+			r,
+			"",
+			"",
+			false,
+		}, {
+			"sums mismatch",
+			release{
+				location: r.location,
+				archive:  "tofu.zip",
+				sums:     "bad_SHA256SUMS",
+			},
+			"",
+			"",
+			true,
+		}, {
+			"sums not found",
+			release{
+				location: r.location,
+				archive:  "tofu.zip",
+				sums:     "missing_SHA256SUMS",
+			},
+			"",
+			"",
+			true,
+		}, {
+			"sha256 sums not found",
+			release{
+				location: r.location,
+				archive:  "tofu.zip",
+				sums:     "missing_SHA256SUMS",
+			},
+			sum,
+			"",
+			false,
+		}, {
+			"sha256 sums mismatch",
+			release{
+				location: r.location,
+				archive:  "tofu.zip",
+				sums:     "bad_SHA256SUMS",
+			},
+			sum,
+			"",
+			true,
+		}, {
+			"sha256",
+			r,
+			sum,
+			"",
+			false,
+		}, {
+			"sha256 mismatch",
+			r,
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"",
+			true,
+		}, {
+			"signature",
+			r,
+			"",
+			keyPath,
+			false,
+		}, {
+			"signature untrusted key",
+			r,
+			"",
+			otherKeyPath,
+			true,
+		}, {
+			"signature archive not in sums",
+			release{
+				location: r.location,
+				archive:  "tofu.zip",
+				sums:     "missing_SHA256SUMS",
+			},
+			"",
+			keyPath,
+			true,
+		}, {
+			"archive not found",
+			release{
+				location: ts.URL + "/v0.0.0",
+				archive:  "tofu.zip",
+			},
+			"",
+			"",
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			conf := &config.TerraformConfig{
+				Flavor:       config.String(config.TerraformFlavorOpenTofu),
+				Path:         config.String(dir),
+				SHA256:       config.String(tc.sha256),
+				SignatureKey: config.String(tc.signatureKey),
+			}
+
+			err := installRelease(context.Background(), conf, tc.release)
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.NoFileExists(t, filepath.Join(dir, "tofu"))
+				return
+			}
+			assert.NoError(t, err)
+			assert.FileExists(t, filepath.Join(dir, "tofu"))
+		})
+	}
+
+	t.Run("local directory", func(t *testing.T) {
+		mirror := t.TempDir()
+		for name, content := range files {
+			path := filepath.Join(mirror, filepath.FromSlash(name))
+			require.NoError(t, ensureWorkingDir(filepath.Dir(path)))
+			require.NoError(t, ioutil.WriteFile(path, content, 0644))
+		}
+
+		dir := t.TempDir()
+		conf := &config.TerraformConfig{
+			Flavor:       config.String(config.TerraformFlavorOpenTofu),
+			Path:         config.String(dir),
+			SignatureKey: config.String(keyPath),
+		}
+		err := installRelease(context.Background(), conf, release{
+			location: filepath.Join(mirror, "v1.6.2"),
+			archive:  "tofu.zip",
+			sums:     "tofu_1.6.2_SHA256SUMS",
+		})
+		assert.NoError(t, err)
+		assert.FileExists(t, filepath.Join(dir, "tofu"))
+	})
+}
+
+func TestInstallTerraform_MirrorWithoutVersion(t *testing.T) {
+	conf := &config.TerraformConfig{
+		Version:   config.String(""),
+		Flavor:    config.String(config.TerraformFlavorTerraform),
+		MirrorURL: config.String("https://mirror.example.com/terraform"),
+		Archive:   config.String(""),
+		Path:      config.String(t.TempDir()),
+	}
+
+	_, err := installTerraform(context.Background(), conf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be configured")
+}
+
+// testArchive returns the content of a zip archive with the files
+func testArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		fw, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+// writeArmoredKey writes the armored public key of the entity to the path
+func writeArmoredKey(t *testing.T, entity *openpgp.Entity, path string) {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+	require.NoError(t, ioutil.WriteFile(path, buf.Bytes(), 0644))
+}
@@ -3,16 +3,23 @@ package driver
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/consul-terraform-sync/client"
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/handler"
 	"github.com/hashicorp/consul-terraform-sync/logging"
 	mocks "github.com/hashicorp/consul-terraform-sync/mocks/client"
 	mocksNoti "github.com/hashicorp/consul-terraform-sync/mocks/notifier"
 	mocksTmpl "github.com/hashicorp/consul-terraform-sync/mocks/templates"
+	"github.com/hashicorp/consul-terraform-sync/policy"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
 	"github.com/hashicorp/consul-terraform-sync/templates"
 	"github.com/hashicorp/consul-terraform-sync/templates/hcltmpl"
 	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/notifier"
@@ -21,6 +28,7 @@ import (
 	"github.com/hashicorp/go-uuid"
 	goVersion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/hcat"
+	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -110,6 +118,62 @@ func TestRenderTemplate(t *testing.T) {
 	}
 }
 
+func TestRenderTemplate_VaultSecrets(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		setVarsErr  error
+		expectError bool
+	}{
+		{
+			"happy path",
+			nil,
+			false,
+		},
+		{
+			"error on client.SetVars()",
+			errors.New("error on client.SetVars()"),
+			true,
+		},
+	}
+
+	ctx := context.Background()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := new(mocksTmpl.Resolver)
+			r.On("Run", mock.Anything, mock.Anything).
+				Return(hcat.ResolveEvent{Complete: true}, nil).Once()
+
+			tmpl := new(mocksTmpl.Template)
+			tmpl.On("Render", mock.Anything).Return(hcat.RenderResult{}, nil).Once()
+
+			c := new(mocks.Client)
+			c.On("SetVars", map[string]interface{}{
+				"vault_secrets": map[string]map[string]string{},
+			}).Return(tc.setVarsErr).Once()
+
+			tf := &Terraform{
+				task:         &Task{name: "RenderTemplateTest", enabled: true, logger: logging.NewNullLogger()},
+				resolver:     r,
+				template:     tmpl,
+				watcher:      new(mocksTmpl.Watcher),
+				client:       c,
+				vaultSecrets: tmplfunc.NewVaultSecrets(),
+				logger:       logging.NewNullLogger(),
+			}
+
+			_, err := tf.RenderTemplate(ctx)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			c.AssertExpectations(t)
+		})
+	}
+}
+
 func TestTerraform_Version(t *testing.T) {
 	var err error
 	TerraformVersion, err = goVersion.NewVersion("1.2")
@@ -119,6 +183,11 @@ func TestTerraform_Version(t *testing.T) {
 	assert.Equal(t, "1.2.0", s)
 }
 
+func TestTerraform_Version_Task(t *testing.T) {
+	tf := Terraform{version: goVersion.Must(goVersion.NewVersion("1.0.11"))}
+	assert.Equal(t, "1.0.11", tf.Version())
+}
+
 func TestInspectTask(t *testing.T) {
 	// Task Disabled
 	t.Run("task disabled", func(t *testing.T) {
@@ -150,11 +219,42 @@ func TestInspectTask(t *testing.T) {
 		w.On("Deregister", mock.Anything).Return()
 		c.On("Plan", ctx).Return(true, nil).Once()
 		c.On("SetStdout", mock.Anything).Twice()
+		c.On("DiscardPlan").Return(nil).Once()
 
 		ctx = context.Background()
 		plan, err := tf.InspectTask(ctx)
 		assert.NoError(t, err)
 		require.Equal(t, "", plan.Plan)
+		c.AssertExpectations(t)
+	})
+
+	t.Run("policies", func(t *testing.T) {
+		var w mocksTmpl.Watcher
+		c := new(mocks.Client)
+		tf := Terraform{
+			task: &Task{
+				name:    "task",
+				enabled: true,
+			},
+			logger:   logging.NewNullLogger(),
+			watcher:  &w,
+			client:   c,
+			policies: testPolicies(t, config.PolicyEnforcementAdvisory),
+		}
+
+		ctx := context.Background()
+		w.On("Deregister", mock.Anything).Return()
+		c.On("Plan", ctx).Return(true, nil).Once()
+		c.On("SetStdout", mock.Anything).Twice()
+		c.On("ShowPlan", ctx).Return(testDeletePlan(), nil).Once()
+		c.On("DiscardPlan").Return(nil).Once()
+
+		plan, err := tf.InspectTask(ctx)
+		require.NoError(t, err)
+		require.Len(t, plan.Policies, 1)
+		assert.False(t, plan.Policies[0].Passed)
+		assert.Contains(t, plan.Plan, "no-deletes (advisory): failed")
+		c.AssertExpectations(t)
 	})
 }
 
@@ -215,6 +315,383 @@ func TestApplyTask(t *testing.T) {
 	}
 }
 
+func TestApplyTask_PostApplyExec(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name            string
+		applyReturn     error
+		postApply       bool
+		expectedOutcome string
+	}{
+		{
+			"success",
+			nil,
+			false,
+			handler.OutcomeSuccess,
+		},
+		{
+			"success after provider handler",
+			nil,
+			true,
+			handler.OutcomeSuccess,
+		},
+		{
+			"apply error",
+			errors.New("apply error"),
+			true,
+			handler.OutcomeFailure,
+		},
+	}
+
+	ctx := context.Background()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wd := t.TempDir()
+			task := &Task{
+				name:       "task",
+				enabled:    true,
+				workingDir: wd,
+				postApply: config.PostApplyConfigs{{
+					Command: []string{"sh", "-c", `printf %s "$CTS_OUTCOME" > outcome`},
+				}},
+				logger: logging.NewNullLogger(),
+			}
+			execH, err := getPostApplyHandlers(task)
+			require.NoError(t, err)
+
+			postApply := execH
+			if tc.postApply {
+				postApply = testHandler(false)
+				postApply.SetNext(execH)
+			}
+
+			c := new(mocks.Client)
+			c.On("Apply", ctx).Return(tc.applyReturn).Once()
+			tf := &Terraform{
+				task:          task,
+				client:        c,
+				postApply:     postApply,
+				postApplyExec: execH,
+				logger:        logging.NewNullLogger(),
+			}
+
+			err = tf.ApplyTask(ctx)
+			if tc.applyReturn != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			outcome, err := ioutil.ReadFile(filepath.Join(wd, "outcome"))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedOutcome, string(outcome))
+		})
+	}
+}
+
+func TestApplyTask_PreApply(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		plan        string
+		structured  *tfjson.Plan
+		preApply    config.PreApplyConfigs
+		expectApply bool
+	}{
+		{
+			"within policy",
+			"Plan: 1 to add, 0 to change, 0 to destroy.",
+			&tfjson.Plan{FormatVersion: "0.2"},
+			config.PreApplyConfigs{{MaxDestroy: config.Int(0)}},
+			true,
+		},
+		{
+			"vetoed by policy",
+			"Plan: 0 to add, 0 to change, 1 to destroy.",
+			testDeletePlan(),
+			config.PreApplyConfigs{{MaxDestroy: config.Int(0)}},
+			false,
+		},
+		{
+			"vetoed by command",
+			"Plan: 1 to add, 0 to change, 0 to destroy.",
+			nil,
+			config.PreApplyConfigs{
+				{Command: []string{"sh", "-c", "exit 1"}},
+			},
+			false,
+		},
+		{
+			"first veto skips later checks",
+			"Plan: 0 to add, 0 to change, 1 to destroy.",
+			testDeletePlan(),
+			config.PreApplyConfigs{
+				{MaxDestroy: config.Int(0)},
+				{Command: []string{"sh", "-c", "touch lock"}},
+			},
+			false,
+		},
+	}
+
+	ctx := context.Background()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wd := t.TempDir()
+			task := &Task{
+				name:       "task",
+				enabled:    true,
+				workingDir: wd,
+				preApply:   tc.preApply,
+				logger:     logging.NewNullLogger(),
+			}
+			preH, err := getPreApplyHandlers(task)
+			require.NoError(t, err)
+
+			// write the plan to the stdout set by the driver
+			var stdout io.Writer
+			c := new(mocks.Client)
+			c.On("SetStdout", mock.Anything).Run(func(args mock.Arguments) {
+				stdout = args.Get(0).(io.Writer)
+			}).Twice()
+			c.On("Plan", ctx).Run(func(mock.Arguments) {
+				stdout.Write([]byte(tc.plan))
+			}).Return(true, nil).Once()
+			if tc.structured != nil {
+				c.On("ShowPlan", ctx).Return(tc.structured, nil).Once()
+			}
+			if tc.expectApply {
+				c.On("Apply", ctx).Return(nil).Once()
+			} else {
+				c.On("DiscardPlan").Return(nil).Once()
+			}
+
+			tf := &Terraform{
+				task:     task,
+				client:   c,
+				preApply: preH,
+				logger:   logging.NewNullLogger(),
+			}
+
+			err = tf.ApplyTask(ctx)
+			if tc.expectApply {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "vetoed")
+			}
+			assert.NoFileExists(t, filepath.Join(wd, "lock"))
+			c.AssertExpectations(t)
+		})
+	}
+}
+
+func TestApplyTask_Policies(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		level       string
+		plan        *tfjson.Plan
+		showErr     error
+		expectApply bool
+	}{
+		{
+			"mandatory policy passes",
+			config.PolicyEnforcementMandatory,
+			&tfjson.Plan{FormatVersion: "0.2"},
+			nil,
+			true,
+		},
+		{
+			"mandatory policy fails",
+			config.PolicyEnforcementMandatory,
+			testDeletePlan(),
+			nil,
+			false,
+		},
+		{
+			"advisory policy fails",
+			config.PolicyEnforcementAdvisory,
+			testDeletePlan(),
+			nil,
+			true,
+		},
+		{
+			"error showing plan",
+			config.PolicyEnforcementAdvisory,
+			nil,
+			errors.New("show error"),
+			false,
+		},
+	}
+
+	ctx := context.Background()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := new(mocks.Client)
+			c.On("SetStdout", mock.Anything).Twice()
+			c.On("Plan", ctx).Return(true, nil).Once()
+			c.On("ShowPlan", ctx).Return(tc.plan, tc.showErr).Once()
+			if tc.expectApply {
+				c.On("Apply", ctx).Return(nil).Once()
+			} else {
+				c.On("DiscardPlan").Return(nil).Once()
+			}
+
+			tf := &Terraform{
+				task:     &Task{name: "task", enabled: true},
+				client:   c,
+				policies: testPolicies(t, tc.level),
+				logger:   logging.NewNullLogger(),
+			}
+
+			err := tf.ApplyTask(ctx)
+			if tc.expectApply {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+			if tc.level == config.PolicyEnforcementMandatory && !tc.expectApply {
+				assert.Contains(t, err.Error(), "no-deletes: resources must not be deleted")
+			}
+			c.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetPreApplyHandlers(t *testing.T) {
+	h, err := getPreApplyHandlers(&Task{name: "task"})
+	assert.NoError(t, err)
+	assert.Nil(t, h)
+
+	h, err = getPreApplyHandlers(&Task{
+		name: "task",
+		preApply: config.PreApplyConfigs{
+			{MaxDestroy: config.Int(0)},
+			{URL: config.String("https://lock.example.com")},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, h)
+
+	_, err = getPreApplyHandlers(&Task{
+		name:     "task",
+		preApply: config.PreApplyConfigs{{}},
+	})
+	assert.Error(t, err)
+}
+
+func TestGetPostApplyHandlers(t *testing.T) {
+	t.Run("no commands", func(t *testing.T) {
+		h, err := getPostApplyHandlers(&Task{name: "task"})
+		assert.NoError(t, err)
+		assert.Nil(t, h)
+	})
+
+	t.Run("commands run in order", func(t *testing.T) {
+		wd := t.TempDir()
+		task := &Task{
+			name:       "task",
+			workingDir: wd,
+			postApply: config.PostApplyConfigs{
+				{Command: []string{"sh", "-c", "echo first >> order"}},
+				{Command: []string{"sh", "-c", "echo second >> order"}},
+			},
+		}
+		h, err := getPostApplyHandlers(task)
+		require.NoError(t, err)
+		require.NoError(t, h.Do(context.Background(), nil))
+
+		order, err := ioutil.ReadFile(filepath.Join(wd, "order"))
+		require.NoError(t, err)
+		assert.Equal(t, "first\nsecond\n", string(order))
+	})
+
+	t.Run("invalid command", func(t *testing.T) {
+		_, err := getPostApplyHandlers(&Task{
+			name:      "task",
+			postApply: config.PostApplyConfigs{{}},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestApplyTask_Import(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	imports := map[string]string{
+		"module.task.local_file.b": "b.txt",
+		"module.task.local_file.a": "a.txt",
+	}
+
+	t.Run("imports once before apply", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("Import", ctx, "module.task.local_file.a", "a.txt").Return(nil).Once()
+		c.On("Import", ctx, "module.task.local_file.b", "b.txt").Return(nil).Once()
+		c.On("Apply", ctx).Return(nil).Twice()
+
+		tf := &Terraform{
+			task: &Task{name: "task", enabled: true, imports: imports,
+				logger: logging.NewNullLogger()},
+			client: c,
+			logger: logging.NewNullLogger(),
+		}
+
+		assert.NoError(t, tf.ApplyTask(ctx))
+		assert.True(t, tf.imported)
+
+		// subsequent applies do not re-import
+		assert.NoError(t, tf.ApplyTask(ctx))
+		c.AssertExpectations(t)
+	})
+
+	t.Run("import results on event", func(t *testing.T) {
+		ev := &event.Event{ID: "123"}
+		evCtx := event.WithEvent(ctx, ev)
+
+		c := new(mocks.Client)
+		c.On("Import", evCtx, "module.task.local_file.a", "a.txt").
+			Return(client.ErrAlreadyManaged).Once()
+		c.On("Import", evCtx, "module.task.local_file.b", "b.txt").Return(nil).Once()
+
+		tf := &Terraform{
+			task: &Task{name: "task", enabled: true, imports: imports,
+				logger: logging.NewNullLogger()},
+			client: c,
+			logger: logging.NewNullLogger(),
+		}
+
+		assert.NoError(t, tf.importResources(evCtx))
+		assert.Equal(t, &event.Imports{
+			Imported: []string{"module.task.local_file.b"},
+			Skipped:  []string{"module.task.local_file.a"},
+		}, ev.Imports)
+		c.AssertExpectations(t)
+	})
+
+	t.Run("import error", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("Import", ctx, "module.task.local_file.a", "a.txt").
+			Return(errors.New("import error")).Once()
+
+		tf := &Terraform{
+			task: &Task{name: "task", enabled: true, imports: imports,
+				logger: logging.NewNullLogger()},
+			client: c,
+			logger: logging.NewNullLogger(),
+		}
+
+		err := tf.ApplyTask(ctx)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "module.task.local_file.a")
+		assert.False(t, tf.imported)
+		c.AssertNotCalled(t, "Apply", ctx)
+	})
+}
+
 func TestUpdateTask(t *testing.T) {
 	t.Parallel()
 
@@ -307,6 +784,7 @@ func TestUpdateTask(t *testing.T) {
 			if tc.callInspect {
 				c.On("Plan", ctx).Return(true, nil).Once()
 				c.On("SetStdout", mock.Anything).Twice()
+				c.On("DiscardPlan").Return(nil).Once()
 			}
 			if tc.callApply {
 				c.On("Apply", ctx).Return(nil).Once()
@@ -418,6 +896,7 @@ func TestUpdateTask(t *testing.T) {
 			c.On("Validate", ctx).Return(nil).Once()
 			c.On("Plan", ctx).Return(true, tc.planErr).Once()
 			c.On("SetStdout", mock.Anything).Twice()
+			c.On("DiscardPlan").Return(nil).Once()
 			c.On("Apply", ctx).Return(tc.applyErr).Once()
 
 			w := new(mocksTmpl.Watcher)
@@ -491,6 +970,7 @@ func TestUpdateTask_Inspect(t *testing.T) {
 			c.On("Validate", ctx).Return(nil).Once()
 			c.On("Plan", ctx).Return(true, nil)
 			c.On("SetStdout", mock.Anything)
+			c.On("DiscardPlan").Return(nil)
 
 			w := new(mocksTmpl.Watcher)
 			w.On("Register", mock.Anything).Return(nil)
@@ -529,6 +1009,111 @@ func TestUpdateTask_Inspect(t *testing.T) {
 	}
 }
 
+func TestUpdateTask_Version(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name            string
+		runOption       string
+		numInit         int
+		callApply       bool
+		applyErr        error
+		expectedVersion string
+	}{
+		{
+			"run now",
+			RunOptionNow,
+			1,
+			true,
+			nil,
+			"1.1.0",
+		},
+		{
+			"run now apply error",
+			RunOptionNow,
+			2, // the root module is restored to the original version
+			true,
+			errors.New("apply error"),
+			"1.0.0",
+		},
+		{
+			"inspect",
+			RunOptionInspect,
+			2, // the root module is restored to the original version
+			false,
+			nil,
+			"1.0.0",
+		},
+	}
+
+	ctx := context.Background()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			workingDir := "update-version-" + strings.ReplaceAll(tc.name, " ", "-")
+			deleteTemp := testutils.MakeTempDir(t, workingDir)
+			defer deleteTemp()
+
+			r := new(mocksTmpl.Resolver)
+			r.On("Run", mock.Anything, mock.Anything).
+				Return(hcat.ResolveEvent{Complete: true, NoChange: false}, nil).Once()
+
+			c := new(mocks.Client)
+			c.On("Init", ctx).Return(nil).Times(tc.numInit)
+			c.On("Validate", ctx).Return(nil).Times(tc.numInit)
+			if tc.callApply {
+				c.On("Apply", ctx).Return(tc.applyErr).Once()
+			} else {
+				c.On("Plan", ctx).Return(true, nil).Once()
+				c.On("SetStdout", mock.Anything)
+				c.On("DiscardPlan").Return(nil).Once()
+			}
+
+			w := new(mocksTmpl.Watcher)
+			w.On("Register", mock.Anything).Return(nil)
+			w.On("Clients").Return(nil).Once()
+			w.On("BufferReset", mock.Anything).Return()
+			w.On("Deregister", mock.Anything).Return()
+
+			task, err := NewTask(TaskConfig{
+				Name:       "task",
+				Enabled:    true,
+				Module:     "org/module",
+				Version:    "1.0.0",
+				WorkingDir: workingDir,
+			})
+			require.NoError(t, err)
+			tf := &Terraform{
+				task:     task,
+				client:   c,
+				resolver: r,
+				watcher:  w,
+				logger:   logging.NewNullLogger(),
+				fileReader: func(string) ([]byte, error) {
+					return []byte{}, nil
+				},
+			}
+
+			_, err = tf.UpdateTask(ctx, PatchTask{
+				RunOption: tc.runOption,
+				Enabled:   true,
+				Version:   "1.1.0",
+			})
+			if tc.applyErr != nil {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			c.AssertExpectations(t)
+			assert.Equal(t, tc.expectedVersion, task.Version())
+
+			content, err := ioutil.ReadFile(filepath.Join(workingDir, "main.tf"))
+			require.NoError(t, err)
+			assert.Contains(t, string(content),
+				fmt.Sprintf(`version  = "%s"`, tc.expectedVersion))
+		})
+	}
+}
+
 func TestSetBufferPeriod(t *testing.T) {
 	t.Parallel()
 
@@ -698,7 +1283,7 @@ func TestGetTerraformHandlers(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			h, err := getTerraformHandlers(tc.name, tc.providers)
+			h, err := getTerraformHandlers(tc.name, tc.providers, nil)
 			if tc.expectError {
 				assert.Error(t, err)
 				return
@@ -923,6 +1508,24 @@ func TestTerraform_countTmplFunc(t *testing.T) {
 				condition: &config.CatalogServicesConditionConfig{},
 			},
 		},
+		{
+			"condition: composite",
+			3,
+			&Task{
+				condition: &config.CompositeConditionConfig{
+					Operator: config.String("all"),
+					Conditions: []config.ConditionConfig{
+						&config.ScheduleConditionConfig{},
+						&config.ConsulKVConditionConfig{},
+						&config.ServicesConditionConfig{
+							ServicesMonitorConfig: config.ServicesMonitorConfig{
+								Names: []string{"api", "web"},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			"condition: consul-kv",
 			1,
@@ -930,6 +1533,20 @@ func TestTerraform_countTmplFunc(t *testing.T) {
 				condition: &config.ConsulKVConditionConfig{},
 			},
 		},
+		{
+			"condition: intentions",
+			1,
+			&Task{
+				condition: &config.IntentionsConditionConfig{},
+			},
+		},
+		{
+			"condition: nodes",
+			1,
+			&Task{
+				condition: &config.NodesConditionConfig{},
+			},
+		},
 		{
 			"condition: services-regex",
 			1,
@@ -952,6 +1569,18 @@ func TestTerraform_countTmplFunc(t *testing.T) {
 				},
 			},
 		},
+		{
+			"condition: services-names health-aware",
+			1,
+			&Task{
+				condition: &config.ServicesConditionConfig{
+					ServicesMonitorConfig: config.ServicesMonitorConfig{
+						Names:     []string{"api", "db", "web"},
+						TriggerOn: config.String(config.ServicesTriggerMembership),
+					},
+				},
+			},
+		},
 		{
 			"module_input: consul-kv",
 			1,
@@ -961,6 +1590,42 @@ func TestTerraform_countTmplFunc(t *testing.T) {
 				},
 			},
 		},
+		{
+			"module_input: intentions",
+			1,
+			&Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.IntentionsModuleInputConfig{},
+				},
+			},
+		},
+		{
+			"module_input: nodes",
+			1,
+			&Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.NodesModuleInputConfig{},
+				},
+			},
+		},
+		{
+			"module_input: config_entries",
+			1,
+			&Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.ConfigEntriesModuleInputConfig{},
+				},
+			},
+		},
+		{
+			"module_input: vault_secrets",
+			1,
+			&Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.VaultSecretsModuleInputConfig{},
+				},
+			},
+		},
 		{
 			"module_input: services-regex",
 			1,
@@ -987,6 +1652,34 @@ func TestTerraform_countTmplFunc(t *testing.T) {
 				},
 			},
 		},
+		{
+			"module_input: services-names multi-datacenter",
+			1,
+			&Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.ServicesModuleInputConfig{
+						ServicesMonitorConfig: config.ServicesMonitorConfig{
+							Names:       []string{"api", "db", "web"},
+							Datacenters: []string{"all"},
+						},
+					},
+				},
+			},
+		},
+		{
+			"module_input: services-names include non-passing",
+			1,
+			&Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.ServicesModuleInputConfig{
+						ServicesMonitorConfig: config.ServicesMonitorConfig{
+							Names:             []string{"api", "db", "web"},
+							IncludeNonPassing: config.Bool(true),
+						},
+					},
+				},
+			},
+		},
 		{
 			"combination",
 			3,
@@ -1047,6 +1740,15 @@ func TestTerraform_setNotifier(t *testing.T) {
 			},
 			&notifier.SuppressNotification{},
 		},
+		{
+			"condition: schedule with on_change_interval",
+			&Task{
+				condition: &config.ScheduleConditionConfig{
+					OnChangeInterval: config.TimeDuration(time.Minute),
+				},
+			},
+			&notifier.OnChange{},
+		},
 		{
 			"condition: catalog-services",
 			&Task{
@@ -1061,6 +1763,20 @@ func TestTerraform_setNotifier(t *testing.T) {
 			},
 			&notifier.ConsulKV{},
 		},
+		{
+			"condition: intentions",
+			&Task{
+				condition: &config.IntentionsConditionConfig{},
+			},
+			&notifier.Intentions{},
+		},
+		{
+			"condition: nodes",
+			&Task{
+				condition: &config.NodesConditionConfig{},
+			},
+			&notifier.Nodes{},
+		},
 		{
 			"condition: services",
 			&Task{
@@ -1068,6 +1784,29 @@ func TestTerraform_setNotifier(t *testing.T) {
 			},
 			&notifier.Services{},
 		},
+		{
+			"condition: any",
+			&Task{
+				condition: &config.CompositeConditionConfig{
+					Operator: config.String("any"),
+					Conditions: []config.ConditionConfig{
+						&config.ScheduleConditionConfig{},
+						&config.ConsulKVConditionConfig{},
+					},
+				},
+			},
+			&notifier.Composite{},
+		},
+		{
+			"module_input: vault_secrets",
+			&Task{
+				condition: &config.ServicesConditionConfig{},
+				moduleInputs: config.ModuleInputConfigs{
+					&config.VaultSecretsModuleInputConfig{},
+				},
+			},
+			&notifier.VaultSecrets{},
+		},
 	}
 
 	for _, tc := range cases {
@@ -1174,3 +1913,28 @@ func testHandler(err bool) handler.Handler {
 	h, _ := handler.NewFake(config)
 	return h
 }
+
+// testPolicies returns a policy at the enforcement level that fails for plans
+// that delete resources
+func testPolicies(t *testing.T, level string) policy.Policies {
+	policies, err := policy.NewPolicies(config.PolicyConfigs{{
+		Name:             config.String("no-deletes"),
+		Expression:       config.String("plan.resource_changes.all(rc, !('delete' in rc.change.actions))"),
+		EnforcementLevel: config.String(level),
+		Message:          config.String("resources must not be deleted"),
+	}})
+	require.NoError(t, err)
+	return policies
+}
+
+// testDeletePlan returns a structured plan that deletes a resource
+func testDeletePlan() *tfjson.Plan {
+	return &tfjson.Plan{
+		FormatVersion: "0.2",
+		ResourceChanges: []*tfjson.ResourceChange{{
+			Address: "module.task.local_file.a",
+			Mode:    tfjson.ManagedResourceMode,
+			Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}},
+		}},
+	}
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+)
+
+const (
+	// TerraformProviderBigip is the name of the F5 BIG-IP Terraform provider.
+	TerraformProviderBigip = "bigip"
+
+	bigipSubsystemName = "bigip"
+)
+
+//go:generate mockery --name=bigipClient  --structname=BigipClient --output=../mocks/handler
+
+var _ bigipClient = (*iControlClient)(nil)
+
+type bigipClient interface {
+	Login(ctx context.Context) error
+	ConfigSync(ctx context.Context, deviceGroup string) error
+	WaitForSync(ctx context.Context, sleep time.Duration) error
+	String() string
+}
+
+var _ Handler = (*Bigip)(nil)
+
+// Bigip is the post-apply handler for the bigip Terraform Provider. It
+// performs the out-of-band config-sync of the device group needed after a
+// Terraform apply for the changes to reach all devices of an HA group.
+//
+// See https://registry.terraform.io/providers/F5Networks/bigip/latest/docs
+// for details on the bigip provider and the iControl REST API.
+type Bigip struct {
+	next        Handler
+	client      bigipClient
+	address     string
+	deviceGroup string
+	retry       retry.Retry
+	logger      logging.Logger
+}
+
+// NewBigip configures and returns a new bigip handler. The handler syncs the
+// device group configured with the internal device_group setting, and is a
+// no-op when no device group is configured. The address and credentials are
+// only required to sync a device group.
+func NewBigip(c map[string]interface{}) (*Bigip, error) {
+	logger := logging.Global().Named(logSystemName).Named(bigipSubsystemName)
+	logger.Info("creating handler")
+
+	deviceGroup := stringConfig(c, "device_group", "")
+
+	conf := bigipConfig{
+		Address:   stringConfig(c, "address", "BIGIP_HOST"),
+		Username:  stringConfig(c, "username", "BIGIP_USER"),
+		Password:  stringConfig(c, "password", "BIGIP_PASSWORD"),
+		Port:      stringConfig(c, "port", "BIGIP_PORT"),
+		LoginRef:  stringConfig(c, "login_ref", "BIGIP_LOGIN_REF"),
+		TokenAuth: boolConfig(c, "token_auth", "BIGIP_TOKEN_AUTH"),
+		Insecure:  boolConfig(c, "validate_certs_disable", "BIGIP_VERIFY_CERT_DISABLE"),
+	}
+	// port may be configured as a number
+	if val, ok := c["port"]; ok {
+		if _, ok := val.(string); !ok {
+			conf.Port = fmt.Sprint(val)
+		}
+	}
+	if deviceGroup != "" && (conf.Address == "" || conf.Username == "") {
+		return nil, errors.New("detected bigip provider with device_group and " +
+			"missing address or username. Configure the address and credentials " +
+			"for the bigip provider or set the BIGIP_HOST, BIGIP_USER, and " +
+			"BIGIP_PASSWORD environment variables.")
+	}
+
+	return &Bigip{
+		next:        nil,
+		client:      newIControlClient(conf),
+		address:     conf.Address,
+		deviceGroup: deviceGroup,
+		retry:       retry.NewRetry(maxRetries, time.Now().UnixNano()),
+		logger:      logger,
+	}, nil
+}
+
+// Do executes the out-of-band config-sync and calls next handler while
+// passing on relevant errors
+func (h *Bigip) Do(ctx context.Context, prevErr error) error {
+	syncing := "disabled"
+	if h.deviceGroup != "" {
+		syncing = "enabled"
+	}
+	h.logger.Trace("config-sync", "config-sync", syncing, "host", h.address,
+		"device_group", h.deviceGroup)
+	var err error
+	if h.deviceGroup != "" {
+		err = h.configSync(ctx)
+	}
+	return callNext(ctx, h.next, prevErr, err)
+}
+
+// configSync syncs the configuration of the device to the device group and
+// waits for the group to be in sync
+func (h *Bigip) configSync(ctx context.Context) error {
+	if err := h.client.Login(ctx); err != nil {
+		h.logger.Error("error logging into bigip", "error", err)
+		return err
+	}
+	h.logger.Trace("client config after login", "client", h.client.String())
+
+	trySync := func(ctx context.Context) error {
+		if err := h.client.ConfigSync(ctx, h.deviceGroup); err != nil {
+			h.logger.Error("error running config-sync", "device_group",
+				h.deviceGroup, "error", err)
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		if err := h.client.WaitForSync(ctx, time.Second); err != nil {
+			h.logger.Error("error waiting for bigip config-sync to finish",
+				"device_group", h.deviceGroup, "error", err)
+			return err
+		}
+		return nil
+	}
+
+	desc := fmt.Sprintf("bigip config-sync to %s", h.deviceGroup)
+	if err := h.retry.Do(ctx, trySync, desc); err != nil {
+		return err
+	}
+
+	h.logger.Info("config-sync successful", "device_group", h.deviceGroup)
+	return nil
+}
+
+// SetNext sets the next handler that should be called.
+func (h *Bigip) SetNext(next Handler) {
+	h.next = next
+}
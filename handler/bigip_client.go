@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// iControl REST endpoints used for the config-sync
+	bigipLoginPath      = "/mgmt/shared/authn/login"
+	bigipCMPath         = "/mgmt/tm/cm"
+	bigipSyncStatusPath = "/mgmt/tm/cm/sync-status"
+
+	bigipDefaultLoginRef = "tmos"
+	bigipStatusInSync    = "In Sync"
+	bigipClientTimeout   = 60 * time.Second
+
+	// bigipSyncTimeout is the maximum time to wait for the device group to be
+	// in sync after a config-sync
+	bigipSyncTimeout = 5 * time.Minute
+)
+
+// bigipSyncFailures are the sync statuses of a device group that will not
+// become in sync without intervention
+var bigipSyncFailures = map[string]bool{
+	"Sync Failure": true,
+	"Disconnected": true,
+	"Standalone":   true,
+}
+
+// bigipConfig is the subset of the bigip provider configuration needed to
+// sync the configuration to a device group
+type bigipConfig struct {
+	Address   string
+	Username  string
+	Password  string
+	Port      string
+	LoginRef  string
+	TokenAuth bool
+	Insecure  bool
+}
+
+// iControlClient runs the config-sync through the iControl REST API. It
+// authenticates with basic auth, or with a token from the login when the
+// provider is configured with token_auth.
+type iControlClient struct {
+	baseURL string
+	conf    bigipConfig
+	client  *http.Client
+	token   string
+}
+
+func newIControlClient(conf bigipConfig) *iControlClient {
+	baseURL := bigipBaseURL(conf.Address, conf.Port)
+	if conf.LoginRef == "" {
+		conf.LoginRef = bigipDefaultLoginRef
+	}
+
+	return &iControlClient{
+		baseURL: baseURL,
+		conf:    conf,
+		client: &http.Client{
+			Timeout: bigipClientTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: conf.Insecure},
+			},
+		},
+	}
+}
+
+// bigipBaseURL returns the base URL of the iControl REST API for the address.
+// The configured port replaces a port that is included in the address.
+func bigipBaseURL(address, port string) string {
+	baseURL := providerBaseURL(address)
+	if port == "" {
+		return baseURL
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL
+	}
+	u.Host = net.JoinHostPort(u.Hostname(), port)
+	return u.String()
+}
+
+// Login requests an authentication token when token auth is configured
+func (c *iControlClient) Login(ctx context.Context) error {
+	if !c.conf.TokenAuth {
+		return nil
+	}
+
+	var resp struct {
+		Token struct {
+			Token string `json:"token"`
+		} `json:"token"`
+	}
+	body := map[string]string{
+		"username":          c.conf.Username,
+		"password":          c.conf.Password,
+		"loginProviderName": c.conf.LoginRef,
+	}
+	if err := c.do(ctx, http.MethodPost, bigipLoginPath, body, &resp); err != nil {
+		return fmt.Errorf("error logging into bigip: %s", err)
+	}
+	if resp.Token.Token == "" {
+		return fmt.Errorf("error logging into bigip: no token returned")
+	}
+	c.token = resp.Token.Token
+	return nil
+}
+
+// ConfigSync syncs the configuration of the device to the device group
+func (c *iControlClient) ConfigSync(ctx context.Context, deviceGroup string) error {
+	body := map[string]string{
+		"command":     "run",
+		"utilCmdArgs": fmt.Sprintf("config-sync to-group %s", deviceGroup),
+	}
+	return c.do(ctx, http.MethodPost, bigipCMPath, body, nil)
+}
+
+// WaitForSync polls the sync status until the device group is in sync. It
+// returns an error if the sync failed or did not finish in time.
+func (c *iControlClient) WaitForSync(ctx context.Context, sleep time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, bigipSyncTimeout)
+	defer cancel()
+
+	for {
+		status, err := c.syncStatus(ctx)
+		if err != nil {
+			return err
+		}
+		if status == bigipStatusInSync {
+			return nil
+		}
+		if bigipSyncFailures[status] {
+			return fmt.Errorf("bigip sync status is %q", status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("bigip sync status is %q: %s", status, ctx.Err())
+		case <-time.After(sleep):
+		}
+	}
+}
+
+// syncStatus returns the description of the sync status, e.g. "In Sync" or
+// "Changes Pending"
+func (c *iControlClient) syncStatus(ctx context.Context) (string, error) {
+	var resp struct {
+		Entries map[string]struct {
+			NestedStats struct {
+				Entries struct {
+					Status struct {
+						Description string `json:"description"`
+					} `json:"status"`
+				} `json:"entries"`
+			} `json:"nestedStats"`
+		} `json:"entries"`
+	}
+	if err := c.do(ctx, http.MethodGet, bigipSyncStatusPath, nil, &resp); err != nil {
+		return "", fmt.Errorf("error getting bigip sync status: %s", err)
+	}
+
+	for _, entry := range resp.Entries {
+		return entry.NestedStats.Entries.Status.Description, nil
+	}
+	return "", fmt.Errorf("error getting bigip sync status: empty response")
+}
+
+func (c *iControlClient) String() string {
+	return fmt.Sprintf("bigip{address: %s, username: %s, token_auth: %t, "+
+		"insecure: %t}", c.baseURL, c.conf.Username, c.conf.TokenAuth,
+		c.conf.Insecure)
+}
+
+// do makes a request with the JSON body and decodes the JSON response into
+// result when it is not nil
+func (c *iControlClient) do(ctx context.Context, method, path string,
+	body, result interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	switch {
+	case c.token != "":
+		req.Header.Set("X-F5-Auth-Token", c.token)
+	case path != bigipLoginPath:
+		req.SetBasicAuth(c.conf.Username, c.conf.Password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseBody))
+		return fmt.Errorf("unexpected response %s: %s", resp.Status,
+			strings.TrimSpace(string(respBody)))
+	}
+
+	if result == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("error decoding response: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIControlClient(t *testing.T) {
+	cases := []struct {
+		name      string
+		tokenAuth bool
+		statuses  []string
+		expectErr bool
+	}{
+		{
+			"basic auth",
+			false,
+			[]string{"Changes Pending", "Syncing", "In Sync"},
+			false,
+		}, {
+			"token auth",
+			true,
+			[]string{"In Sync"},
+			false,
+		}, {
+			"sync failure",
+			false,
+			[]string{"Syncing", "Sync Failure"},
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var syncArgs string
+			polls := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == bigipLoginPath {
+					fmt.Fprint(w, `{"token": {"token": "abcd"}}`)
+					return
+				}
+				if tc.tokenAuth {
+					assert.Equal(t, "abcd", r.Header.Get("X-F5-Auth-Token"))
+				} else {
+					user, pass, ok := r.BasicAuth()
+					assert.True(t, ok)
+					assert.Equal(t, "admin", user)
+					assert.Equal(t, "pw123", pass)
+				}
+
+				switch r.URL.Path {
+				case bigipCMPath:
+					var body map[string]string
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					syncArgs = body["utilCmdArgs"]
+				case bigipSyncStatusPath:
+					status := tc.statuses[polls]
+					polls++
+					fmt.Fprintf(w, `{"entries": {"https://localhost/mgmt/tm/cm/`+
+						`sync-status/0": {"nestedStats": {"entries": {"status": `+
+						`{"description": %q}}}}}}`, status)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer ts.Close()
+
+			c := newIControlClient(bigipConfig{
+				Address:   ts.URL,
+				Username:  "admin",
+				Password:  "pw123",
+				TokenAuth: tc.tokenAuth,
+			})
+			ctx := context.Background()
+
+			require.NoError(t, c.Login(ctx))
+			require.NoError(t, c.ConfigSync(ctx, "ha-group"))
+			assert.Equal(t, "config-sync to-group ha-group", syncArgs)
+
+			err := c.WaitForSync(ctx, time.Millisecond)
+			assert.Equal(t, len(tc.statuses), polls)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("address with port", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, bigipCMPath, r.URL.Path)
+		}))
+		defer ts.Close()
+
+		u, err := url.Parse(ts.URL)
+		require.NoError(t, err)
+		c := newIControlClient(bigipConfig{
+			Address: ts.URL,
+			Port:    u.Port(),
+		})
+		assert.Equal(t, ts.URL, c.baseURL)
+		assert.NoError(t, c.ConfigSync(context.Background(), "ha-group"))
+	})
+
+	t.Run("unexpected response", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		c := newIControlClient(bigipConfig{Address: ts.URL, Username: "admin"})
+		assert.Error(t, c.ConfigSync(context.Background(), "ha-group"))
+	})
+}
+
+func TestBigipBaseURL(t *testing.T) {
+	cases := []struct {
+		name     string
+		address  string
+		port     string
+		expected string
+	}{
+		{
+			"hostname",
+			"bigip.example.com",
+			"",
+			"https://bigip.example.com",
+		}, {
+			"hostname with port",
+			"bigip.example.com",
+			"8443",
+			"https://bigip.example.com:8443",
+		}, {
+			"address with port",
+			"bigip.example.com:443",
+			"",
+			"https://bigip.example.com:443",
+		}, {
+			"address with port and port",
+			"bigip.example.com:443",
+			"8443",
+			"https://bigip.example.com:8443",
+		}, {
+			"url with port and port",
+			"https://10.0.0.1:443/",
+			"8443",
+			"https://10.0.0.1:8443",
+		}, {
+			"ipv6 with port and port",
+			"[::1]:443",
+			"8443",
+			"https://[::1]:8443",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, bigipBaseURL(tc.address, tc.port))
+		})
+	}
+}
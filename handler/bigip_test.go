@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/handler"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+	"github.com/hashicorp/consul-terraform-sync/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBigip(t *testing.T) {
+	cases := []struct {
+		name                string
+		expectError         bool
+		config              map[string]interface{}
+		expectedDeviceGroup string
+		expectedBaseURL     string
+	}{
+		{
+			"happy path",
+			false,
+			map[string]interface{}{
+				"address":      "10.10.10.10",
+				"username":     "admin",
+				"password":     "pw123",
+				"port":         8443,
+				"device_group": "ha-group",
+			},
+			"ha-group",
+			"https://10.10.10.10:8443",
+		}, {
+			"without device group",
+			false,
+			map[string]interface{}{
+				"address":  "https://bigip.example.com",
+				"username": "admin",
+				"password": "pw123",
+			},
+			"",
+			"https://bigip.example.com",
+		}, {
+			"without device group or credentials",
+			false,
+			map[string]interface{}{},
+			"",
+			"https://",
+		}, {
+			"device group missing required address",
+			true,
+			map[string]interface{}{
+				"username":     "admin",
+				"device_group": "ha-group",
+			},
+			"",
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := NewBigip(tc.config)
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, h)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedDeviceGroup, h.deviceGroup)
+			c, ok := h.client.(*iControlClient)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectedBaseURL, c.baseURL)
+		})
+	}
+
+	t.Run("credentials from env", func(t *testing.T) {
+		resetHost := testutils.Setenv("BIGIP_HOST", "10.10.10.10")
+		defer resetHost()
+		resetUser := testutils.Setenv("BIGIP_USER", "admin")
+		defer resetUser()
+
+		h, err := NewBigip(map[string]interface{}{"device_group": "ha-group"})
+		require.NoError(t, err)
+		assert.Equal(t, "10.10.10.10", h.address)
+	})
+}
+
+func TestBigipDo(t *testing.T) {
+	cases := []struct {
+		name string
+		next bool
+	}{
+		{
+			"happy path - with next handler",
+			true,
+		},
+		{
+			"happy path - no next handler",
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := new(mocks.BigipClient)
+			h := &Bigip{client: m, logger: logging.NewNullLogger()}
+			if tc.next {
+				next := &Bigip{client: m, logger: logging.NewNullLogger()}
+				h.SetNext(next)
+			}
+
+			assert.NoError(t, h.Do(context.Background(), nil))
+			m.AssertNotCalled(t, "ConfigSync", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("device group setting", func(t *testing.T) {
+		m := new(mocks.BigipClient)
+		m.On("Login", mock.Anything).Return(nil).Once()
+		m.On("ConfigSync", mock.Anything, "ha-group").Return(nil).Once()
+		m.On("WaitForSync", mock.Anything, mock.Anything).Return(nil).Once()
+		m.On("String").Return("client string").Once()
+
+		h := &Bigip{client: m, deviceGroup: "ha-group",
+			retry: retry.NewTestRetry(1), logger: logging.NewNullLogger()}
+		assert.NoError(t, h.Do(context.Background(), nil))
+		h.deviceGroup = ""
+		assert.NoError(t, h.Do(context.Background(), nil))
+		m.AssertExpectations(t)
+	})
+}
+
+func TestBigipConfigSync(t *testing.T) {
+	cases := []struct {
+		name      string
+		loginErr  error
+		syncErr   error
+		waitErr   error
+		expectErr bool
+		syncTries int
+		waitTries int
+	}{
+		{
+			"happy path",
+			nil,
+			nil,
+			nil,
+			false,
+			1,
+			1,
+		},
+		{
+			"error on login",
+			errors.New("login error"),
+			nil,
+			nil,
+			true,
+			0,
+			0,
+		},
+		{
+			"error on config-sync",
+			nil,
+			errors.New("sync error"),
+			nil,
+			true,
+			2,
+			0,
+		},
+		{
+			"error on wait",
+			nil,
+			nil,
+			errors.New("wait error"),
+			true,
+			2,
+			2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := new(mocks.BigipClient)
+			m.On("Login", mock.Anything).Return(tc.loginErr).Once()
+			m.On("ConfigSync", mock.Anything, mock.Anything).Return(tc.syncErr)
+			m.On("WaitForSync", mock.Anything, mock.Anything).Return(tc.waitErr)
+			m.On("String").Return("client string")
+
+			h := &Bigip{client: m, deviceGroup: "ha-group",
+				retry: retry.NewTestRetry(1), logger: logging.NewNullLogger()}
+			err := h.configSync(context.Background())
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			m.AssertNumberOfCalls(t, "ConfigSync", tc.syncTries)
+			m.AssertNumberOfCalls(t, "WaitForSync", tc.waitTries)
+		})
+	}
+}
+
+func TestBigipSetNext(t *testing.T) {
+	h := &Bigip{logger: logging.NewNullLogger()}
+	h.SetNext(&Bigip{logger: logging.NewNullLogger()})
+	assert.NotNil(t, h.next)
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+)
+
+const (
+	execSubsystemName = "exec"
+
+	// Environment variables set for the command of an exec handler
+	execEnvTaskName       = "CTS_TASK_NAME"
+	execEnvEventID        = "CTS_EVENT_ID"
+	execEnvStage          = "CTS_STAGE"
+	execEnvOutcome        = "CTS_OUTCOME"
+	execEnvError          = "CTS_ERROR"
+	execEnvChangesPresent = "CTS_CHANGES_PRESENT"
+)
+
+var _ Handler = (*Exec)(nil)
+
+// Exec is the handler for the commands of a task's post_apply and pre_apply
+// blocks. It runs the configured command with information about the task run
+// as JSON on stdin, and retries the command when it fails or times out.
+//
+// At the pre-apply stage, an error from the command vetoes the apply and the
+// command is not run if the apply was already vetoed by a preceding handler.
+type Exec struct {
+	next       Handler
+	taskName   string
+	workingDir string
+	stage      string
+	command    []string
+	timeout    time.Duration
+	retry      retry.Retry
+	logger     logging.Logger
+}
+
+// NewExec configures and returns a new exec handler for a task's post_apply
+// block. The command runs in the working directory of the task.
+func NewExec(taskName, workingDir string, conf *config.PostApplyConfig) (*Exec, error) {
+	if conf == nil || len(conf.Command) == 0 {
+		return nil, errors.New("exec handler: missing 'command' configuration")
+	}
+
+	return newExec(taskName, workingDir, StagePostApply, conf.Command,
+		config.TimeDurationVal(conf.Timeout), config.IntVal(conf.Retries)), nil
+}
+
+// newExec returns an exec handler for the command at the stage
+func newExec(taskName, workingDir, stage string, command []string,
+	timeout time.Duration, retries int) *Exec {
+	if timeout <= 0 {
+		timeout = config.DefaultPostApplyTimeout
+	}
+
+	var maxRetries uint
+	if retries > 0 {
+		maxRetries = uint(retries)
+	}
+
+	logger := logging.Global().Named(logSystemName).Named(execSubsystemName).With(
+		"task_name", taskName, "stage", stage)
+	logger.Info("creating handler", "command", command[0])
+
+	return &Exec{
+		taskName:   taskName,
+		workingDir: workingDir,
+		stage:      stage,
+		command:    append([]string{}, command...),
+		timeout:    timeout,
+		retry:      retry.NewRetry(maxRetries, time.Now().UnixNano()),
+		logger:     logger,
+	}
+}
+
+// Do runs the command and calls the next handler. At the post-apply stage,
+// the previous error is passed to the command as the failure outcome. An error
+// from the command is added to the errors passed on.
+func (h *Exec) Do(ctx context.Context, prevErr error) error {
+	if h.stage == StagePreApply && prevErr != nil {
+		h.logger.Trace("skipping command, apply is already vetoed")
+		return callNext(ctx, h.next, prevErr, nil)
+	}
+
+	taskRun := newTaskRun(ctx, h.taskName, h.stage, prevErr)
+	run := func(ctx context.Context) error {
+		return h.run(ctx, taskRun)
+	}
+	desc := fmt.Sprintf("%s command %s", h.stage, h.command[0])
+
+	var err error
+	if rErr := h.retry.Do(ctx, run, desc); rErr != nil {
+		h.logger.Error("error running command", "command", h.command[0],
+			"error", rErr)
+		err = fmt.Errorf("error running %s command %q for task %s: %s",
+			h.stage, h.command[0], h.taskName, rErr)
+	}
+
+	return callNext(ctx, h.next, prevErr, err)
+}
+
+// run executes the command once within the handler's timeout
+func (h *Exec) run(ctx context.Context, taskRun TaskRun) error {
+	stdin, err := json.Marshal(taskRun)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, h.command[0], h.command[1:]...)
+	cmd.Dir = h.workingDir
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("%s=%s", execEnvTaskName, taskRun.TaskName),
+		fmt.Sprintf("%s=%s", execEnvEventID, taskRun.EventID),
+		fmt.Sprintf("%s=%s", execEnvStage, taskRun.Stage),
+		fmt.Sprintf("%s=%s", execEnvOutcome, taskRun.Outcome),
+		fmt.Sprintf("%s=%s", execEnvError, taskRun.Error),
+	)
+	if taskRun.Plan != nil {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", execEnvChangesPresent,
+			strconv.FormatBool(taskRun.Plan.ChangesPresent)))
+	}
+
+	h.logger.Trace("running command", "command", h.command,
+		"outcome", taskRun.Outcome, "event_id", taskRun.EventID)
+	out, err := cmd.CombinedOutput()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("command timed out after %s", h.timeout)
+	}
+	if err != nil {
+		if output := strings.TrimSpace(string(out)); output != "" {
+			return fmt.Errorf("%s: %s", err, output)
+		}
+		return err
+	}
+
+	h.logger.Debug("command completed", "command", h.command[0],
+		"output", string(out))
+	return nil
+}
+
+// SetNext sets the next handler that should be called
+func (h *Exec) SetNext(next Handler) {
+	h.next = next
+}
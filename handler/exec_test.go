@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExec(t *testing.T) {
+	cases := []struct {
+		name            string
+		config          *config.PostApplyConfig
+		expectError     bool
+		expectedTimeout time.Duration
+	}{
+		{
+			"happy path",
+			&config.PostApplyConfig{
+				Command: []string{"./reload.sh", "--all"},
+				Timeout: config.TimeDuration(5 * time.Second),
+				Retries: config.Int(2),
+			},
+			false,
+			5 * time.Second,
+		}, {
+			"default timeout",
+			&config.PostApplyConfig{
+				Command: []string{"./reload.sh"},
+			},
+			false,
+			config.DefaultPostApplyTimeout,
+		}, {
+			"missing command",
+			&config.PostApplyConfig{},
+			true,
+			0,
+		}, {
+			"nil config",
+			nil,
+			true,
+			0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := NewExec("task", "wd", tc.config)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.config.Command, h.command)
+			assert.Equal(t, "wd", h.workingDir)
+			assert.Equal(t, tc.expectedTimeout, h.timeout)
+		})
+	}
+}
+
+func TestExecDo(t *testing.T) {
+	// script records the environment and stdin of the command in the working
+	// directory
+	script := `echo "$CTS_TASK_NAME $CTS_EVENT_ID $CTS_OUTCOME $CTS_ERROR" > env.txt; cat > stdin.json`
+
+	cases := []struct {
+		name     string
+		prevErr  error
+		expected TaskRun
+	}{
+		{
+			"success",
+			nil,
+			TaskRun{
+				TaskName: "task",
+				EventID:  "123",
+				Stage:    StagePostApply,
+				Outcome:  OutcomeSuccess,
+			},
+		}, {
+			"failure",
+			errors.New("apply error"),
+			TaskRun{
+				TaskName: "task",
+				EventID:  "123",
+				Stage:    StagePostApply,
+				Outcome:  OutcomeFailure,
+				Error:    "apply error",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wd := t.TempDir()
+			h := testExec(wd, "sh", "-c", script)
+
+			ctx := event.WithID(context.Background(), "123")
+			err := h.Do(ctx, tc.prevErr)
+			assert.Equal(t, tc.prevErr, err)
+
+			env, err := ioutil.ReadFile(filepath.Join(wd, "env.txt"))
+			require.NoError(t, err)
+			expectedEnv := strings.Join([]string{tc.expected.TaskName,
+				tc.expected.EventID, tc.expected.Outcome, tc.expected.Error}, " ")
+			assert.Equal(t, strings.TrimSpace(expectedEnv),
+				strings.TrimSpace(string(env)))
+
+			stdin, err := ioutil.ReadFile(filepath.Join(wd, "stdin.json"))
+			require.NoError(t, err)
+			var taskRun TaskRun
+			require.NoError(t, json.Unmarshal(stdin, &taskRun))
+			assert.Equal(t, tc.expected, taskRun)
+		})
+	}
+
+	t.Run("command error", func(t *testing.T) {
+		h := testExec(t.TempDir(), "sh", "-c", "echo flush failed; exit 1")
+		err := h.Do(context.Background(), nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "flush failed")
+	})
+
+	t.Run("command error with previous error", func(t *testing.T) {
+		h := testExec(t.TempDir(), "sh", "-c", "exit 1")
+		err := h.Do(context.Background(), errors.New("apply error"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "apply error")
+		assert.Contains(t, err.Error(), "post-apply command")
+	})
+
+	t.Run("pre-apply", func(t *testing.T) {
+		wd := t.TempDir()
+		h := testExec(wd, "sh", "-c", `echo "$CTS_CHANGES_PRESENT" > env.txt; cat > stdin.json`)
+		h.stage = StagePreApply
+
+		plan := Plan{ChangesPresent: true, Plan: "Plan: 1 to add, 0 to change, 0 to destroy."}
+		ctx := WithPlan(event.WithID(context.Background(), "123"), plan)
+		require.NoError(t, h.Do(ctx, nil))
+
+		env, err := ioutil.ReadFile(filepath.Join(wd, "env.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "true", strings.TrimSpace(string(env)))
+
+		stdin, err := ioutil.ReadFile(filepath.Join(wd, "stdin.json"))
+		require.NoError(t, err)
+		var taskRun TaskRun
+		require.NoError(t, json.Unmarshal(stdin, &taskRun))
+		assert.Equal(t, TaskRun{
+			TaskName: "task",
+			EventID:  "123",
+			Stage:    StagePreApply,
+			Plan:     &plan,
+		}, taskRun)
+	})
+
+	t.Run("pre-apply veto", func(t *testing.T) {
+		h := testExec(t.TempDir(), "sh", "-c", "echo window closed; exit 1")
+		h.stage = StagePreApply
+		err := h.Do(context.Background(), nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "window closed")
+	})
+
+	t.Run("pre-apply already vetoed", func(t *testing.T) {
+		wd := t.TempDir()
+		h := testExec(wd, "sh", "-c", "touch ran")
+		h.stage = StagePreApply
+		vetoErr := errors.New("vetoed")
+		assert.Equal(t, vetoErr, h.Do(context.Background(), vetoErr))
+		assert.NoFileExists(t, filepath.Join(wd, "ran"))
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		// fails on the first attempt and succeeds on the retry
+		h := testExec(t.TempDir(), "sh", "-c",
+			"if [ -f attempted ]; then exit 0; fi; touch attempted; exit 1")
+		h.retry = retry.NewTestRetry(1)
+		assert.NoError(t, h.Do(context.Background(), nil))
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		h := testExec(t.TempDir(), "sh", "-c", "exec sleep 5")
+		h.timeout = 10 * time.Millisecond
+		err := h.Do(context.Background(), nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "timed out")
+	})
+
+	t.Run("next handler", func(t *testing.T) {
+		h := testExec(t.TempDir(), "sh", "-c", "exit 1")
+		next := testExec(t.TempDir(), "sh", "-c", "touch ran")
+		h.SetNext(next)
+
+		err := h.Do(context.Background(), nil)
+		assert.Error(t, err)
+		assert.FileExists(t, filepath.Join(next.workingDir, "ran"))
+	})
+}
+
+func TestExecSetNext(t *testing.T) {
+	h := &Exec{logger: logging.NewNullLogger()}
+	h.SetNext(&Exec{logger: logging.NewNullLogger()})
+	assert.NotNil(t, h.next)
+}
+
+// testExec returns a post-apply exec handler without retries for the command
+func testExec(wd string, command ...string) *Exec {
+	return &Exec{
+		taskName:   "task",
+		workingDir: wd,
+		stage:      StagePostApply,
+		command:    command,
+		timeout:    5 * time.Second,
+		retry:      retry.NewTestRetry(0),
+		logger:     logging.NewNullLogger(),
+	}
+}
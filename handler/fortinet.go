@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+)
+
+const (
+	// TerraformProviderFortiOS is the name of the Fortinet FortiOS Terraform
+	// provider for FortiGate devices.
+	TerraformProviderFortiOS = "fortios"
+
+	// TerraformProviderFortiManager is the name of the Fortinet FortiManager
+	// Terraform provider.
+	TerraformProviderFortiManager = "fortimanager"
+
+	// defaultFortiManagerADOM is the administrative domain used when the
+	// fortimanager provider is not configured with one
+	defaultFortiManagerADOM = "root"
+
+	fortinetSubsystemName = "fortinet"
+)
+
+//go:generate mockery --name=fortinetClient  --structname=FortinetClient --output=../mocks/handler
+
+var (
+	_ fortinetClient = (*fortiOSClient)(nil)
+	_ fortinetClient = (*fortiManagerClient)(nil)
+)
+
+// fortinetClient commits changes made by Terraform on a Fortinet device. For
+// FortiOS, a commit saves the configuration. For FortiManager, a commit
+// installs the policy package to the devices of the package's scope.
+type fortinetClient interface {
+	Login(ctx context.Context) error
+	Commit(ctx context.Context) (uint, error)
+	WaitForTask(ctx context.Context, id uint, sleep time.Duration) error
+	Logout(ctx context.Context) error
+	String() string
+}
+
+var _ Handler = (*Fortinet)(nil)
+
+// Fortinet is the post-apply handler for the fortios and fortimanager
+// Terraform providers. It performs the out-of-band request to save the
+// FortiGate configuration or to install the FortiManager policy package
+// needed after a Terraform apply.
+//
+// See https://registry.terraform.io/providers/fortinetdev/fortios/latest/docs
+// and https://registry.terraform.io/providers/fortinetdev/fortimanager/latest/docs
+// for details on the providers.
+type Fortinet struct {
+	next       Handler
+	client     fortinetClient
+	provider   string
+	hostname   string
+	autoCommit bool
+	retry      retry.Retry
+	logger     logging.Logger
+}
+
+// NewFortinet configures and returns a new fortinet handler for the fortios
+// or fortimanager provider. The handler is a no-op unless auto_commit is
+// enabled, and the connection settings are only required for auto_commit.
+func NewFortinet(providerName string, c map[string]interface{}) (*Fortinet, error) {
+	logger := logging.Global().Named(logSystemName).Named(fortinetSubsystemName).With(
+		"provider", providerName)
+	logger.Info("creating handler")
+
+	// should we auto_commit?
+	autoCommit := boolConfig(c, "auto_commit", "")
+
+	var client fortinetClient
+	var hostname string
+	switch providerName {
+	case TerraformProviderFortiOS:
+		conf := fortiOSConfig{
+			Hostname:     stringConfig(c, "hostname", "FORTIOS_ACCESS_HOSTNAME"),
+			Token:        stringConfig(c, "token", "FORTIOS_ACCESS_TOKEN"),
+			Insecure:     boolConfig(c, "insecure", "FORTIOS_INSECURE"),
+			CABundleFile: stringConfig(c, "cabundlefile", "FORTIOS_CA_CABUNDLE"),
+			VDOM:         stringConfig(c, "vdom", ""),
+		}
+		if autoCommit && (conf.Hostname == "" || conf.Token == "") {
+			return nil, errors.New("detected fortios provider with auto_commit " +
+				"and missing hostname or token. Configure the hostname and API " +
+				"token for the fortios provider or set the FORTIOS_ACCESS_HOSTNAME " +
+				"and FORTIOS_ACCESS_TOKEN environment variables.")
+		}
+		fc, err := newFortiOSClient(conf)
+		if err != nil {
+			return nil, err
+		}
+		client, hostname = fc, conf.Hostname
+
+	case TerraformProviderFortiManager:
+		conf := fortiManagerConfig{
+			Hostname: stringConfig(c, "hostname", "FORTIMANAGER_ACCESS_HOSTNAME"),
+			Username: stringConfig(c, "username", "FORTIMANAGER_ACCESS_USERNAME"),
+			Password: stringConfig(c, "password", "FORTIMANAGER_ACCESS_PASSWORD"),
+			Insecure: boolConfig(c, "insecure", "FORTIMANAGER_INSECURE"),
+			ADOM:     stringConfig(c, "adom", ""),
+			Package:  stringConfig(c, "install_package", ""),
+		}
+		if conf.ADOM == "" {
+			conf.ADOM = defaultFortiManagerADOM
+		}
+		if autoCommit && (conf.Hostname == "" || conf.Username == "") {
+			return nil, errors.New("detected fortimanager provider with auto_commit " +
+				"and missing hostname or username. Configure the hostname and " +
+				"credentials for the fortimanager provider or set the " +
+				"FORTIMANAGER_ACCESS_HOSTNAME, FORTIMANAGER_ACCESS_USERNAME, and " +
+				"FORTIMANAGER_ACCESS_PASSWORD environment variables.")
+		}
+		// The policy package is required to limit the installation to the
+		// package managed by Consul-Terraform-Sync.
+		if autoCommit && conf.Package == "" {
+			return nil, errors.New("detected fortimanager provider with auto_commit " +
+				"and missing install_package. The name of the policy package to " +
+				"install after an apply is required for auto_commit.")
+		}
+		client, hostname = newFortiManagerClient(conf), conf.Hostname
+
+	default:
+		return nil, fmt.Errorf("unsupported fortinet provider %q", providerName)
+	}
+
+	return &Fortinet{
+		next:       nil,
+		client:     client,
+		provider:   providerName,
+		hostname:   hostname,
+		autoCommit: autoCommit,
+		retry:      retry.NewRetry(maxRetries, time.Now().UnixNano()),
+		logger:     logger,
+	}, nil
+}
+
+// Do executes the out-of-band commit request and calls next handler while
+// passing on relevant errors
+func (h *Fortinet) Do(ctx context.Context, prevErr error) error {
+	committing := "disabled"
+	if h.autoCommit {
+		committing = "enabled"
+	}
+	h.logger.Trace("commit", "commit", committing, "host", h.hostname)
+	var err error
+	if h.autoCommit {
+		err = h.commit(ctx)
+	}
+	return callNext(ctx, h.next, prevErr, err)
+}
+
+// commit logs into the device, commits the changes, and waits for the
+// resulting task to finish
+func (h *Fortinet) commit(ctx context.Context) error {
+	if err := h.client.Login(ctx); err != nil {
+		h.logger.Error("error logging into fortinet device", "error", err)
+		return err
+	}
+	defer func() {
+		if err := h.client.Logout(ctx); err != nil {
+			h.logger.Warn("error logging out of fortinet device", "error", err)
+		}
+	}()
+	h.logger.Trace("client config after login", "client", h.client.String())
+
+	tryCommit := func(ctx context.Context) error {
+		task, err := h.client.Commit(ctx)
+		if err != nil {
+			h.logger.Error("error committing", "error", err)
+			return err
+		}
+		if task == 0 {
+			// FortiOS saves the configuration synchronously
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		if err := h.client.WaitForTask(ctx, task, time.Second); err != nil {
+			h.logger.Error("error waiting for fortinet commit to finish",
+				"task", task, "error", err)
+			return err
+		}
+		return nil
+	}
+
+	desc := fmt.Sprintf("%s commit", h.provider)
+	if err := h.retry.Do(ctx, tryCommit, desc); err != nil {
+		return err
+	}
+
+	h.logger.Info("commit successful")
+	return nil
+}
+
+// SetNext sets the next handler that should be called.
+func (h *Fortinet) SetNext(next Handler) {
+	h.next = next
+}
@@ -0,0 +1,337 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// fortiOSSaveConfigPath is the FortiOS monitor API endpoint that saves a
+	// revision of the running configuration
+	fortiOSSaveConfigPath = "/api/v2/monitor/system/config-revision/save"
+
+	// fortiManagerJSONRPCPath is the FortiManager JSON-RPC API endpoint
+	fortiManagerJSONRPCPath = "/jsonrpc"
+
+	fortinetCommitComment = "Consul Terraform Sync Commit"
+	fortinetClientTimeout = 60 * time.Second
+)
+
+// fortiOSConfig is the subset of the fortios provider configuration needed to
+// save the configuration of a FortiGate
+type fortiOSConfig struct {
+	Hostname     string
+	Token        string
+	Insecure     bool
+	CABundleFile string
+	VDOM         string
+}
+
+// fortiOSClient saves the configuration of a FortiGate through the FortiOS
+// REST API. It authenticates with an API token so it does not hold a session.
+type fortiOSClient struct {
+	baseURL string
+	conf    fortiOSConfig
+	client  *http.Client
+}
+
+func newFortiOSClient(conf fortiOSConfig) (*fortiOSClient, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: conf.Insecure}
+	if conf.CABundleFile != "" {
+		ca, err := ioutil.ReadFile(conf.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading fortios CA bundle: %s", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found in fortios CA bundle %s",
+				conf.CABundleFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return &fortiOSClient{
+		baseURL: providerBaseURL(conf.Hostname),
+		conf:    conf,
+		client: &http.Client{
+			Timeout:   fortinetClientTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+// Login is a no-op for FortiOS which authenticates each request with the token
+func (c *fortiOSClient) Login(context.Context) error {
+	return nil
+}
+
+// Commit saves the running configuration. The save is synchronous so the
+// returned task ID is always 0.
+func (c *fortiOSClient) Commit(ctx context.Context) (uint, error) {
+	u := c.baseURL + fortiOSSaveConfigPath
+	if c.conf.VDOM != "" {
+		u = fmt.Sprintf("%s?vdom=%s", u, url.QueryEscape(c.conf.VDOM))
+	}
+
+	body, err := json.Marshal(map[string]string{"comments": fortinetCommitComment})
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u,
+		bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.conf.Token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Status string `json:"status"`
+	}
+	respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseBody))
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected response %s: %s", resp.Status,
+			strings.TrimSpace(string(respBody)))
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return 0, fmt.Errorf("error decoding fortios response: %s", err)
+	}
+	if result.Status != "success" {
+		return 0, fmt.Errorf("fortios config save returned status %q", result.Status)
+	}
+	return 0, nil
+}
+
+// WaitForTask is a no-op for FortiOS since saving the configuration does not
+// create a task
+func (c *fortiOSClient) WaitForTask(context.Context, uint, time.Duration) error {
+	return nil
+}
+
+// Logout is a no-op for FortiOS which authenticates each request with the token
+func (c *fortiOSClient) Logout(context.Context) error {
+	return nil
+}
+
+func (c *fortiOSClient) String() string {
+	return fmt.Sprintf("fortios{hostname: %s, vdom: %s, insecure: %t}",
+		c.conf.Hostname, c.conf.VDOM, c.conf.Insecure)
+}
+
+// fortiManagerConfig is the subset of the fortimanager provider configuration
+// needed to install a policy package
+type fortiManagerConfig struct {
+	Hostname string
+	Username string
+	Password string
+	Insecure bool
+	ADOM     string
+	Package  string
+}
+
+// fortiManagerClient installs a policy package through the FortiManager
+// JSON-RPC API. Requests are authenticated with the session of the login.
+type fortiManagerClient struct {
+	url     string
+	conf    fortiManagerConfig
+	client  *http.Client
+	session string
+	id      int
+}
+
+func newFortiManagerClient(conf fortiManagerConfig) *fortiManagerClient {
+	return &fortiManagerClient{
+		url:  providerBaseURL(conf.Hostname) + fortiManagerJSONRPCPath,
+		conf: conf,
+		client: &http.Client{
+			Timeout: fortinetClientTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: conf.Insecure},
+			},
+		},
+	}
+}
+
+// fortiManagerRequest is the body of a FortiManager JSON-RPC request
+type fortiManagerRequest struct {
+	ID      int                  `json:"id"`
+	Method  string               `json:"method"`
+	Params  []fortiManagerParams `json:"params"`
+	Session string               `json:"session,omitempty"`
+}
+
+type fortiManagerParams struct {
+	URL  string      `json:"url"`
+	Data interface{} `json:"data,omitempty"`
+}
+
+// fortiManagerResponse is the body of a FortiManager JSON-RPC response
+type fortiManagerResponse struct {
+	ID      int    `json:"id"`
+	Session string `json:"session"`
+	Result  []struct {
+		URL    string `json:"url"`
+		Status struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"status"`
+		Data json.RawMessage `json:"data"`
+	} `json:"result"`
+}
+
+// Login starts a session with the username and password
+func (c *fortiManagerClient) Login(ctx context.Context) error {
+	data := map[string]string{
+		"user":   c.conf.Username,
+		"passwd": c.conf.Password,
+	}
+	resp, err := c.call(ctx, "exec", "/sys/login/user", data)
+	if err != nil {
+		return fmt.Errorf("error logging into fortimanager: %s", err)
+	}
+	if resp.Session == "" {
+		return fmt.Errorf("error logging into fortimanager: no session returned")
+	}
+	c.session = resp.Session
+	return nil
+}
+
+// Commit starts the installation of the policy package and returns the ID of
+// the installation task
+func (c *fortiManagerClient) Commit(ctx context.Context) (uint, error) {
+	data := map[string]interface{}{
+		"adom":  c.conf.ADOM,
+		"pkg":   c.conf.Package,
+		"flags": []string{"none"},
+	}
+	resp, err := c.call(ctx, "exec", "/securityconsole/install/package", data)
+	if err != nil {
+		return 0, fmt.Errorf("error installing fortimanager policy package "+
+			"%s: %s", c.conf.Package, err)
+	}
+
+	var task struct {
+		Task uint `json:"task"`
+	}
+	if err := json.Unmarshal(resp.Result[0].Data, &task); err != nil {
+		return 0, fmt.Errorf("error decoding fortimanager install task: %s", err)
+	}
+	if task.Task == 0 {
+		return 0, fmt.Errorf("fortimanager did not return an install task for "+
+			"policy package %s", c.conf.Package)
+	}
+	return task.Task, nil
+}
+
+// WaitForTask polls the task until it completes and returns an error if the
+// task completed with errors
+func (c *fortiManagerClient) WaitForTask(ctx context.Context, id uint, sleep time.Duration) error {
+	taskURL := fmt.Sprintf("/task/task/%d", id)
+	for {
+		resp, err := c.call(ctx, "get", taskURL, nil)
+		if err != nil {
+			return fmt.Errorf("error getting fortimanager task %d: %s", id, err)
+		}
+
+		var task struct {
+			Percent int `json:"percent"`
+			NumErr  int `json:"num_err"`
+		}
+		if err := json.Unmarshal(resp.Result[0].Data, &task); err != nil {
+			return fmt.Errorf("error decoding fortimanager task %d: %s", id, err)
+		}
+		if task.Percent >= 100 {
+			if task.NumErr > 0 {
+				return fmt.Errorf("fortimanager task %d completed with %d errors",
+					id, task.NumErr)
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(sleep):
+		}
+	}
+}
+
+// Logout ends the session
+func (c *fortiManagerClient) Logout(ctx context.Context) error {
+	if c.session == "" {
+		return nil
+	}
+	_, err := c.call(ctx, "exec", "/sys/logout", nil)
+	c.session = ""
+	return err
+}
+
+func (c *fortiManagerClient) String() string {
+	return fmt.Sprintf("fortimanager{hostname: %s, adom: %s, package: %s, "+
+		"insecure: %t}", c.conf.Hostname, c.conf.ADOM, c.conf.Package,
+		c.conf.Insecure)
+}
+
+// call makes a JSON-RPC request and returns an error if the request or the
+// result was not successful
+func (c *fortiManagerClient) call(ctx context.Context, method, path string,
+	data interface{}) (*fortiManagerResponse, error) {
+	c.id++
+	body, err := json.Marshal(fortiManagerRequest{
+		ID:      c.id,
+		Method:  method,
+		Params:  []fortiManagerParams{{URL: path, Data: data}},
+		Session: c.session,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		respBody, _ := ioutil.ReadAll(io.LimitReader(httpResp.Body, maxHTTPResponseBody))
+		return nil, fmt.Errorf("unexpected response %s: %s", httpResp.Status,
+			strings.TrimSpace(string(respBody)))
+	}
+
+	var resp fortiManagerResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("error decoding response: %s", err)
+	}
+	if len(resp.Result) == 0 {
+		return nil, fmt.Errorf("empty result for %s", path)
+	}
+	if status := resp.Result[0].Status; status.Code != 0 {
+		return nil, fmt.Errorf("%s returned code %d: %s", path, status.Code,
+			status.Message)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFortiOSClient_Commit(t *testing.T) {
+	cases := []struct {
+		name        string
+		status      int
+		body        string
+		expectError bool
+	}{
+		{
+			"success",
+			http.StatusOK,
+			`{"status": "success", "http_status": 200}`,
+			false,
+		}, {
+			"error status",
+			http.StatusOK,
+			`{"status": "error", "http_status": 500}`,
+			true,
+		}, {
+			"unauthorized",
+			http.StatusUnauthorized,
+			"",
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var path, vdom, auth string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				vdom = r.URL.Query().Get("vdom")
+				auth = r.Header.Get("Authorization")
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			}))
+			defer ts.Close()
+
+			c, err := newFortiOSClient(fortiOSConfig{
+				Hostname: ts.URL,
+				Token:    "abcd",
+				VDOM:     "root",
+			})
+			require.NoError(t, err)
+
+			task, err := c.Commit(context.Background())
+			assert.Equal(t, uint(0), task)
+			assert.Equal(t, fortiOSSaveConfigPath, path)
+			assert.Equal(t, "root", vdom)
+			assert.Equal(t, "Bearer abcd", auth)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestFortiManagerClient(t *testing.T) {
+	var urls []string
+	var installData map[string]interface{}
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fortiManagerJSONRPCPath, r.URL.Path)
+
+		var req struct {
+			fortiManagerRequest
+			Params []struct {
+				URL  string                 `json:"url"`
+				Data map[string]interface{} `json:"data"`
+			} `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		url := req.Params[0].URL
+		urls = append(urls, url)
+		if url != "/sys/login/user" {
+			assert.Equal(t, "session-id", req.Session)
+		}
+
+		data := "{}"
+		switch url {
+		case "/securityconsole/install/package":
+			installData = req.Params[0].Data
+			data = `{"task": 7}`
+		case "/task/task/7":
+			polls++
+			data = fmt.Sprintf(`{"percent": %d, "num_err": 0}`, polls*50)
+		}
+		fmt.Fprintf(w, `{"id": %d, "session": "session-id", "result": [{"url": %q, `+
+			`"status": {"code": 0, "message": "OK"}, "data": %s}]}`, req.ID, url, data)
+	}))
+	defer ts.Close()
+
+	c := newFortiManagerClient(fortiManagerConfig{
+		Hostname: ts.URL,
+		Username: "user",
+		Password: "pw123",
+		ADOM:     "dc1",
+		Package:  "default",
+	})
+	ctx := context.Background()
+
+	require.NoError(t, c.Login(ctx))
+	task, err := c.Commit(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint(7), task)
+	require.NoError(t, c.WaitForTask(ctx, task, time.Millisecond))
+	require.NoError(t, c.Logout(ctx))
+
+	assert.Equal(t, []string{
+		"/sys/login/user",
+		"/securityconsole/install/package",
+		"/task/task/7",
+		"/task/task/7",
+		"/sys/logout",
+	}, urls)
+	assert.Equal(t, "dc1", installData["adom"])
+	assert.Equal(t, "default", installData["pkg"])
+	assert.Empty(t, c.session)
+}
+
+func TestFortiManagerClient_Errors(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+		code int
+		call func(*fortiManagerClient) error
+	}{
+		{
+			"login failure",
+			"{}",
+			-11,
+			func(c *fortiManagerClient) error {
+				return c.Login(context.Background())
+			},
+		}, {
+			"install failure",
+			"{}",
+			-6,
+			func(c *fortiManagerClient) error {
+				_, err := c.Commit(context.Background())
+				return err
+			},
+		}, {
+			"task with errors",
+			`{"percent": 100, "num_err": 2}`,
+			0,
+			func(c *fortiManagerClient) error {
+				return c.WaitForTask(context.Background(), 7, time.Millisecond)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"id": 1, "result": [{"status": {"code": %d, `+
+					`"message": "error"}, "data": %s}]}`, tc.code, tc.data)
+			}))
+			defer ts.Close()
+
+			c := newFortiManagerClient(fortiManagerConfig{Hostname: ts.URL})
+			assert.Error(t, tc.call(c))
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/handler"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+	"github.com/hashicorp/consul-terraform-sync/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFortinet(t *testing.T) {
+	cases := []struct {
+		name         string
+		provider     string
+		expectError  bool
+		config       map[string]interface{}
+		expectCommit bool
+	}{
+		{
+			"fortios happy path",
+			TerraformProviderFortiOS,
+			false,
+			map[string]interface{}{
+				"hostname":    "10.10.10.10",
+				"token":       "abcd",
+				"insecure":    true,
+				"vdom":        "root",
+				"auto_commit": true,
+			},
+			true,
+		}, {
+			"fortios auto_commit missing token",
+			TerraformProviderFortiOS,
+			true,
+			map[string]interface{}{
+				"hostname":    "10.10.10.10",
+				"auto_commit": true,
+			},
+			false,
+		}, {
+			"fortios without auto_commit missing token",
+			TerraformProviderFortiOS,
+			false,
+			map[string]interface{}{
+				"hostname": "10.10.10.10",
+			},
+			false,
+		}, {
+			"fortimanager happy path",
+			TerraformProviderFortiManager,
+			false,
+			map[string]interface{}{
+				"hostname":        "10.10.10.10",
+				"username":        "user",
+				"password":        "pw123",
+				"adom":            "dc1",
+				"install_package": "default",
+				"auto_commit":     true,
+			},
+			true,
+		}, {
+			"fortimanager without auto_commit",
+			TerraformProviderFortiManager,
+			false,
+			map[string]interface{}{
+				"hostname": "10.10.10.10",
+				"username": "user",
+				"password": "pw123",
+			},
+			false,
+		}, {
+			"fortimanager without auto_commit missing username",
+			TerraformProviderFortiManager,
+			false,
+			map[string]interface{}{
+				"hostname": "10.10.10.10",
+			},
+			false,
+		}, {
+			"fortimanager auto_commit missing username",
+			TerraformProviderFortiManager,
+			true,
+			map[string]interface{}{
+				"hostname":        "10.10.10.10",
+				"install_package": "default",
+				"auto_commit":     true,
+			},
+			false,
+		}, {
+			"fortimanager auto_commit missing package",
+			TerraformProviderFortiManager,
+			true,
+			map[string]interface{}{
+				"hostname":    "10.10.10.10",
+				"username":    "user",
+				"password":    "pw123",
+				"auto_commit": true,
+			},
+			false,
+		}, {
+			"unsupported provider",
+			"fortiweb",
+			true,
+			map[string]interface{}{},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := NewFortinet(tc.provider, tc.config)
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, h)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.provider, h.provider)
+			assert.Equal(t, "10.10.10.10", h.hostname)
+			assert.Equal(t, tc.expectCommit, h.autoCommit)
+		})
+	}
+
+	t.Run("fortimanager defaults", func(t *testing.T) {
+		h, err := NewFortinet(TerraformProviderFortiManager, map[string]interface{}{
+			"hostname": "10.10.10.10",
+			"username": "user",
+		})
+		require.NoError(t, err)
+		c, ok := h.client.(*fortiManagerClient)
+		require.True(t, ok)
+		assert.Equal(t, defaultFortiManagerADOM, c.conf.ADOM)
+		assert.Equal(t, "https://10.10.10.10/jsonrpc", c.url)
+	})
+
+	t.Run("fortios credentials from env", func(t *testing.T) {
+		resetHost := testutils.Setenv("FORTIOS_ACCESS_HOSTNAME", "10.10.10.10")
+		defer resetHost()
+		resetToken := testutils.Setenv("FORTIOS_ACCESS_TOKEN", "abcd")
+		defer resetToken()
+
+		h, err := NewFortinet(TerraformProviderFortiOS, map[string]interface{}{})
+		require.NoError(t, err)
+		c, ok := h.client.(*fortiOSClient)
+		require.True(t, ok)
+		assert.Equal(t, "10.10.10.10", c.conf.Hostname)
+		assert.Equal(t, "abcd", c.conf.Token)
+	})
+}
+
+func TestFortinetDo(t *testing.T) {
+	cases := []struct {
+		name string
+		next bool
+	}{
+		{
+			"happy path - with next handler",
+			true,
+		},
+		{
+			"happy path - no next handler",
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := new(mocks.FortinetClient)
+			h := &Fortinet{client: m, logger: logging.NewNullLogger()}
+			if tc.next {
+				next := &Fortinet{client: m, logger: logging.NewNullLogger()}
+				h.SetNext(next)
+			}
+
+			assert.NoError(t, h.Do(context.Background(), nil))
+			m.AssertNotCalled(t, "Commit", mock.Anything)
+		})
+	}
+
+	t.Run("autoCommit setting", func(t *testing.T) {
+		m := new(mocks.FortinetClient)
+		m.On("Login", mock.Anything).Return(nil).Once()
+		m.On("Commit", mock.Anything).Return(uint(1), nil).Once()
+		m.On("WaitForTask", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).Once()
+		m.On("Logout", mock.Anything).Return(nil).Once()
+		m.On("String").Return("client string").Once()
+
+		h := &Fortinet{client: m, autoCommit: true, retry: retry.NewTestRetry(1),
+			logger: logging.NewNullLogger()}
+		assert.NoError(t, h.Do(context.Background(), nil))
+		h.autoCommit = false
+		assert.NoError(t, h.Do(context.Background(), nil))
+		m.AssertExpectations(t)
+	})
+
+	t.Run("previous error", func(t *testing.T) {
+		m := new(mocks.FortinetClient)
+		h := &Fortinet{client: m, logger: logging.NewNullLogger()}
+		err := h.Do(context.Background(), errors.New("apply error"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "apply error")
+	})
+}
+
+func TestFortinetCommit(t *testing.T) {
+	cases := []struct {
+		name         string
+		loginReturn  error
+		commitTask   uint
+		commitReturn error
+		waitReturn   error
+		expectErr    bool
+	}{
+		{
+			"happy path",
+			nil,
+			100,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"error on login",
+			errors.New("login error"),
+			100,
+			nil,
+			nil,
+			true,
+		},
+		{
+			"error on commit",
+			nil,
+			100,
+			errors.New("commit error"),
+			nil,
+			true,
+		},
+		{
+			"synchronous commit",
+			nil,
+			0,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"error on wait",
+			nil,
+			10,
+			nil,
+			errors.New("wait error"),
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := new(mocks.FortinetClient)
+			m.On("Login", mock.Anything).Return(tc.loginReturn).Once()
+			m.On("Commit", mock.Anything).Return(tc.commitTask, tc.commitReturn)
+			m.On("WaitForTask", mock.Anything, mock.Anything, mock.Anything).
+				Return(tc.waitReturn)
+			m.On("Logout", mock.Anything).Return(nil)
+			m.On("String").Return("client string")
+
+			h := &Fortinet{client: m, provider: TerraformProviderFortiManager,
+				retry: retry.NewTestRetry(1), logger: logging.NewNullLogger()}
+			err := h.commit(context.Background())
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			expectedRetries := func() (commitTries, waitTries, logouts int) {
+				l, s := tc.loginReturn == nil, tc.commitTask == 0
+				c, w := tc.commitReturn == nil, tc.waitReturn == nil
+				switch {
+				case !l: // login fails, others not reached
+					return 0, 0, 0
+				case !c: // commit fails, wait never reached
+					return 2, 0, 1
+				case s: // synchronous commit, no task to wait for
+					return 1, 0, 1
+				case w: // everyone happy
+					return 1, 1, 1
+				default: // wait fails
+					return 2, 2, 1
+				}
+			}
+			commitTries, waitTries, logouts := expectedRetries()
+			m.AssertNumberOfCalls(t, "Commit", commitTries)
+			m.AssertNumberOfCalls(t, "WaitForTask", waitTries)
+			m.AssertNumberOfCalls(t, "Logout", logouts)
+		})
+	}
+}
+
+func TestFortinetSetNext(t *testing.T) {
+	h := &Fortinet{logger: logging.NewNullLogger()}
+	h.SetNext(&Fortinet{logger: logging.NewNullLogger()})
+	assert.NotNil(t, h.next)
+}
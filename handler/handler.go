@@ -3,12 +3,29 @@ package handler
 import (
 	"context"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/pkg/errors"
 )
 
 const (
 	logSystemName = "handler"
+
+	// StagePreApply and StagePostApply are the stages of a task run that
+	// handlers are executed at.
+	StagePreApply  = "pre-apply"
+	StagePostApply = "post-apply"
+
+	// OutcomeSuccess and OutcomeFailure are the outcomes of a task run for
+	// the post-apply stage. The outcome is a failure when the apply or a
+	// preceding handler returned an error.
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
 )
 
 // Handler handles additional actions that need to be executed. These can
@@ -40,6 +57,18 @@ func TerraformProviderHandler(providerName string, config interface{}) (Handler,
 	switch providerName {
 	case TerraformProviderPanos:
 		return NewPanos(c)
+	case TerraformProviderFortiOS, TerraformProviderFortiManager:
+		// The fortinet handler only commits changes with auto_commit
+		if !boolConfig(c, "auto_commit", "") {
+			return nil, nil
+		}
+		return NewFortinet(providerName, c)
+	case TerraformProviderBigip:
+		// The bigip handler only syncs a configured device group
+		if stringConfig(c, "device_group", "") == "" {
+			return nil, nil
+		}
+		return NewBigip(c)
 	case TerraformProviderFake:
 		return NewFake(c)
 	default:
@@ -47,6 +76,95 @@ func TerraformProviderHandler(providerName string, config interface{}) (Handler,
 	}
 }
 
+// NewPreApply returns the handler for a task's pre_apply block. The handler
+// receives the plan for the task through the context, see WithPlan, and
+// vetoes the apply by returning an error. Pre-apply handlers are skipped when
+// a preceding handler has already vetoed the apply.
+func NewPreApply(taskName, workingDir string, conf *config.PreApplyConfig) (Handler, error) {
+	if conf == nil {
+		return nil, errors.New("missing pre_apply configuration")
+	}
+
+	switch {
+	case len(conf.Command) > 0:
+		return newExec(taskName, workingDir, StagePreApply, conf.Command,
+			config.TimeDurationVal(conf.Timeout), config.IntVal(conf.Retries)), nil
+	case config.StringVal(conf.URL) != "":
+		return NewHTTP(taskName, conf)
+	case conf.MaxDestroy != nil:
+		return NewPlanPolicy(taskName, conf)
+	default:
+		return nil, errors.New("pre_apply requires one of command, url or max_destroy")
+	}
+}
+
+// TaskRun is the information about a run of a task that is passed to the
+// commands and endpoints of handlers configured by the task
+type TaskRun struct {
+	TaskName string `json:"task_name"`
+	EventID  string `json:"event_id"`
+	Stage    string `json:"stage"`
+
+	// Outcome and Error are set for the post-apply stage
+	Outcome string `json:"outcome,omitempty"`
+	Error   string `json:"error,omitempty"`
+
+	// Plan is set for the pre-apply stage
+	Plan *Plan `json:"plan,omitempty"`
+}
+
+// Plan is the plan of a task's changes that pre-apply handlers check before
+// the task applies
+type Plan struct {
+	ChangesPresent bool   `json:"changes_present"`
+	Plan           string `json:"plan"`
+	URL            string `json:"url,omitempty"`
+
+	// Structured is the structured plan for handlers that check the resource
+	// changes. It is only set when a handler requires it.
+	Structured *tfjson.Plan `json:"-"`
+}
+
+type planContextKey struct{}
+
+// WithPlan returns a copy of the context that carries the plan for
+// pre-apply handlers
+func WithPlan(ctx context.Context, plan Plan) context.Context {
+	return context.WithValue(ctx, planContextKey{}, plan)
+}
+
+// PlanFromContext returns the plan carried by the context. The second return
+// value is false if there is none.
+func PlanFromContext(ctx context.Context) (Plan, bool) {
+	plan, ok := ctx.Value(planContextKey{}).(Plan)
+	return plan, ok
+}
+
+// newTaskRun returns the information about the task run for a handler at the
+// stage. The previous error is the outcome for the post-apply stage.
+func newTaskRun(ctx context.Context, taskName, stage string, prevErr error) TaskRun {
+	run := TaskRun{
+		TaskName: taskName,
+		EventID:  event.IDFromContext(ctx),
+		Stage:    stage,
+	}
+
+	switch stage {
+	case StagePreApply:
+		if plan, ok := PlanFromContext(ctx); ok {
+			run.Plan = &plan
+		}
+	case StagePostApply:
+		run.Outcome = OutcomeSuccess
+		if prevErr != nil {
+			run.Outcome = OutcomeFailure
+			run.Error = prevErr.Error()
+		}
+	}
+
+	return run
+}
+
 // callNext should be called by a handler's Do() to call the next handler
 func callNext(ctx context.Context, nextH Handler, prevErr, err error) error {
 	nextErr := nextError(prevErr, err)
@@ -76,3 +194,41 @@ func nextError(prevErr error, err error) error {
 	// prevErr != nil && err != nil
 	return errors.Wrap(prevErr, err.Error())
 }
+
+// stringConfig returns the string value of the provider attribute, falling
+// back to the environment variable when the attribute is not set
+func stringConfig(c map[string]interface{}, attr, envVar string) string {
+	if val, ok := c[attr]; ok {
+		if v, ok := val.(string); ok {
+			return v
+		}
+	}
+	if envVar != "" {
+		return os.Getenv(envVar)
+	}
+	return ""
+}
+
+// boolConfig returns the bool value of the provider attribute, falling back
+// to the environment variable when the attribute is not set
+func boolConfig(c map[string]interface{}, attr, envVar string) bool {
+	if val, ok := c[attr]; ok {
+		if v, ok := val.(bool); ok {
+			return v
+		}
+	}
+	if envVar != "" {
+		v, _ := strconv.ParseBool(os.Getenv(envVar))
+		return v
+	}
+	return false
+}
+
+// providerBaseURL returns the base URL of the hostname of a provider. The
+// scheme defaults to HTTPS when the hostname does not include one.
+func providerBaseURL(hostname string) string {
+	if strings.HasPrefix(hostname, "http://") || strings.HasPrefix(hostname, "https://") {
+		return strings.TrimSuffix(hostname, "/")
+	}
+	return "https://" + strings.TrimSuffix(hostname, "/")
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -46,6 +47,69 @@ func TestTerraformProviderHandler(t *testing.T) {
 				"password": "pw123",
 			},
 		},
+		{
+			"fortios provider",
+			false,
+			false,
+			TerraformProviderFortiOS,
+			map[string]interface{}{
+				"hostname":    "10.10.10.10",
+				"token":       "abcd",
+				"auto_commit": true,
+			},
+		},
+		{
+			"fortios provider without auto_commit",
+			false,
+			true,
+			TerraformProviderFortiOS,
+			map[string]interface{}{
+				"hostname": "10.10.10.10",
+			},
+		},
+		{
+			"fortimanager provider",
+			false,
+			false,
+			TerraformProviderFortiManager,
+			map[string]interface{}{
+				"hostname":        "10.10.10.10",
+				"username":        "user",
+				"password":        "pw123",
+				"install_package": "default",
+				"auto_commit":     true,
+			},
+		},
+		{
+			"fortimanager provider without auto_commit",
+			false,
+			true,
+			TerraformProviderFortiManager,
+			map[string]interface{}{
+				"hostname": "10.10.10.10",
+			},
+		},
+		{
+			"bigip provider",
+			false,
+			false,
+			TerraformProviderBigip,
+			map[string]interface{}{
+				"address":      "10.10.10.10",
+				"username":     "admin",
+				"password":     "pw123",
+				"device_group": "ha-group",
+			},
+		},
+		{
+			"bigip provider without device group",
+			false,
+			true,
+			TerraformProviderBigip,
+			map[string]interface{}{
+				"password": "pw123",
+			},
+		},
 		{
 			"fake provider",
 			false,
@@ -127,6 +191,70 @@ func ExampleTerraformProviderHandler() {
 	// Handler Errors: error 4: error 2
 }
 
+func TestNewPreApply(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		expectError bool
+		config      *config.PreApplyConfig
+		expected    Handler
+	}{
+		{
+			"command",
+			false,
+			&config.PreApplyConfig{Command: []string{"./lock.sh"}},
+			&Exec{},
+		},
+		{
+			"url",
+			false,
+			&config.PreApplyConfig{URL: config.String("https://lock.example.com")},
+			&HTTP{},
+		},
+		{
+			"max_destroy",
+			false,
+			&config.PreApplyConfig{MaxDestroy: config.Int(0)},
+			&PlanPolicy{},
+		},
+		{
+			"empty",
+			true,
+			&config.PreApplyConfig{},
+			nil,
+		},
+		{
+			"nil",
+			true,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := NewPreApply("task", "wd", tc.config)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.IsType(t, tc.expected, h)
+		})
+	}
+}
+
+func TestPlanFromContext(t *testing.T) {
+	_, ok := PlanFromContext(context.Background())
+	assert.False(t, ok)
+
+	plan := Plan{ChangesPresent: true, Plan: "plan"}
+	actual, ok := PlanFromContext(WithPlan(context.Background(), plan))
+	assert.True(t, ok)
+	assert.Equal(t, plan, actual)
+}
+
 func TestCallNext(t *testing.T) {
 	cases := []struct {
 		name    string
@@ -203,3 +331,9 @@ func TestNextError(t *testing.T) {
 		})
 	}
 }
+
+func TestProviderBaseURL(t *testing.T) {
+	assert.Equal(t, "https://10.10.10.10", providerBaseURL("10.10.10.10"))
+	assert.Equal(t, "https://fmg.example.com", providerBaseURL("https://fmg.example.com/"))
+	assert.Equal(t, "http://127.0.0.1:8080", providerBaseURL("http://127.0.0.1:8080"))
+}
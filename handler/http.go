@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+)
+
+const (
+	httpSubsystemName = "http"
+
+	// maxHTTPResponseBody is the maximum size of a response body that is
+	// included in the error for a vetoed apply
+	maxHTTPResponseBody = 1024
+)
+
+var _ Handler = (*HTTP)(nil)
+
+// HTTP is the pre-apply handler for the URL of a task's pre_apply block. It
+// sends information about the task run, including the plan, as JSON to the
+// URL. A response with a 4xx status vetoes the apply, and other non-2xx
+// responses or failed requests are retried before vetoing the apply.
+type HTTP struct {
+	next     Handler
+	taskName string
+	url      string
+	method   string
+	headers  map[string]string
+	timeout  time.Duration
+	client   *http.Client
+	retry    retry.Retry
+	logger   logging.Logger
+}
+
+// NewHTTP configures and returns a new HTTP handler for a task's pre_apply
+// block
+func NewHTTP(taskName string, conf *config.PreApplyConfig) (*HTTP, error) {
+	if conf == nil || config.StringVal(conf.URL) == "" {
+		return nil, errors.New("http handler: missing 'url' configuration")
+	}
+
+	method := config.StringVal(conf.Method)
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	timeout := config.TimeDurationVal(conf.Timeout)
+	if timeout <= 0 {
+		timeout = config.DefaultPreApplyTimeout
+	}
+
+	var retries uint
+	if r := config.IntVal(conf.Retries); r > 0 {
+		retries = uint(r)
+	}
+
+	headers := make(map[string]string)
+	for k, v := range conf.Headers {
+		headers[k] = v
+	}
+
+	logger := logging.Global().Named(logSystemName).Named(httpSubsystemName).With(
+		"task_name", taskName)
+	logger.Info("creating handler", "url", *conf.URL)
+
+	return &HTTP{
+		taskName: taskName,
+		url:      *conf.URL,
+		method:   method,
+		headers:  headers,
+		timeout:  timeout,
+		client:   &http.Client{},
+		retry:    retry.NewRetry(retries, time.Now().UnixNano()),
+		logger:   logger,
+	}, nil
+}
+
+// Do sends the request and calls the next handler. The request is not sent
+// if the apply was already vetoed by a preceding handler.
+func (h *HTTP) Do(ctx context.Context, prevErr error) error {
+	if prevErr != nil {
+		h.logger.Trace("skipping request, apply is already vetoed")
+		return callNext(ctx, h.next, prevErr, nil)
+	}
+
+	body, err := json.Marshal(newTaskRun(ctx, h.taskName, StagePreApply, nil))
+	if err != nil {
+		return callNext(ctx, h.next, prevErr, err)
+	}
+
+	// veto is set when the endpoint rejects the apply. It is not an error for
+	// the retry so that the request is not retried.
+	var veto string
+	send := func(ctx context.Context) error {
+		var err error
+		veto, err = h.send(ctx, body)
+		return err
+	}
+	desc := fmt.Sprintf("%s request %s", StagePreApply, h.url)
+
+	err = h.retry.Do(ctx, send, desc)
+	switch {
+	case err != nil:
+		h.logger.Error("error sending request", "url", h.url, "error", err)
+		err = fmt.Errorf("error sending %s request to %s for task %s: %s",
+			StagePreApply, h.url, h.taskName, err)
+	case veto != "":
+		h.logger.Info("apply vetoed", "url", h.url, "reason", veto)
+		err = fmt.Errorf("%s request to %s vetoed the apply for task %s: %s",
+			StagePreApply, h.url, h.taskName, veto)
+	}
+
+	return callNext(ctx, h.next, prevErr, err)
+}
+
+// send makes the request once within the handler's timeout. It returns the
+// reason when the endpoint vetoes the apply, and an error when the request
+// should be retried.
+func (h *HTTP) send(ctx context.Context, body []byte) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, h.method, h.url,
+		bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseBody))
+	status := resp.Status
+	if msg := strings.TrimSpace(string(respBody)); msg != "" {
+		status = fmt.Sprintf("%s: %s", status, msg)
+	}
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		h.logger.Debug("request completed", "url", h.url, "status", resp.Status)
+		return "", nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		return status, nil
+	default:
+		return "", fmt.Errorf("unexpected response %s", status)
+	}
+}
+
+// SetNext sets the next handler that should be called
+func (h *HTTP) SetNext(next Handler) {
+	h.next = next
+}
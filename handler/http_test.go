@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTP(t *testing.T) {
+	cases := []struct {
+		name           string
+		config         *config.PreApplyConfig
+		expectError    bool
+		expectedMethod string
+	}{
+		{
+			"happy path",
+			&config.PreApplyConfig{
+				URL:     config.String("https://lock.example.com"),
+				Method:  config.String(http.MethodPut),
+				Headers: map[string]string{"X-Token": "token"},
+			},
+			false,
+			http.MethodPut,
+		}, {
+			"default method",
+			&config.PreApplyConfig{
+				URL: config.String("https://lock.example.com"),
+			},
+			false,
+			http.MethodPost,
+		}, {
+			"missing url",
+			&config.PreApplyConfig{},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := NewHTTP("task", tc.config)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, *tc.config.URL, h.url)
+			assert.Equal(t, tc.expectedMethod, h.method)
+			assert.Equal(t, config.DefaultPreApplyTimeout, h.timeout)
+		})
+	}
+}
+
+func TestHTTPDo(t *testing.T) {
+	plan := Plan{ChangesPresent: true, Plan: "Plan: 1 to add, 0 to change, 0 to destroy."}
+	ctx := WithPlan(event.WithID(context.Background(), "123"), plan)
+
+	t.Run("success", func(t *testing.T) {
+		var taskRun TaskRun
+		var token string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token = r.Header.Get("X-Token")
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&taskRun))
+		}))
+		defer ts.Close()
+
+		h := testHTTP(ts.URL)
+		h.headers = map[string]string{"X-Token": "token"}
+		require.NoError(t, h.Do(ctx, nil))
+
+		assert.Equal(t, "token", token)
+		assert.Equal(t, TaskRun{
+			TaskName: "task",
+			EventID:  "123",
+			Stage:    StagePreApply,
+			Plan:     &plan,
+		}, taskRun)
+	})
+
+	t.Run("veto", func(t *testing.T) {
+		var requests int
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte("lock is held"))
+		}))
+		defer ts.Close()
+
+		h := testHTTP(ts.URL)
+		h.retry = retry.NewTestRetry(1)
+		err := h.Do(ctx, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "vetoed")
+		assert.Contains(t, err.Error(), "lock is held")
+		assert.Equal(t, 1, requests, "4xx responses should not be retried")
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		var requests int
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+		defer ts.Close()
+
+		h := testHTTP(ts.URL)
+		h.retry = retry.NewTestRetry(1)
+		assert.NoError(t, h.Do(ctx, nil))
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		err := testHTTP(ts.URL).Do(ctx, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "500")
+	})
+
+	t.Run("already vetoed", func(t *testing.T) {
+		var requests int
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+		}))
+		defer ts.Close()
+
+		vetoErr := errors.New("vetoed")
+		assert.Equal(t, vetoErr, testHTTP(ts.URL).Do(ctx, vetoErr))
+		assert.Equal(t, 0, requests)
+	})
+}
+
+func TestHTTPSetNext(t *testing.T) {
+	h := &HTTP{logger: logging.NewNullLogger()}
+	h.SetNext(&HTTP{logger: logging.NewNullLogger()})
+	assert.NotNil(t, h.next)
+}
+
+// testHTTP returns an HTTP handler without retries for the URL
+func testHTTP(url string) *HTTP {
+	return &HTTP{
+		taskName: "task",
+		url:      url,
+		method:   http.MethodPost,
+		timeout:  5 * time.Second,
+		client:   &http.Client{},
+		retry:    retry.NewTestRetry(0),
+		logger:   logging.NewNullLogger(),
+	}
+}
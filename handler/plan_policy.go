@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+const planPolicySubsystemName = "planpolicy"
+
+// CountDestroy returns the number of managed resources that the structured
+// plan destroys, including resources that are replaced. Data sources are not
+// counted.
+func CountDestroy(plan *tfjson.Plan) int {
+	if plan == nil {
+		return 0
+	}
+
+	count := 0
+	for _, rc := range plan.ResourceChanges {
+		if rc == nil || rc.Change == nil || rc.Mode != tfjson.ManagedResourceMode {
+			continue
+		}
+		if rc.Change.Actions.Delete() || rc.Change.Actions.Replace() {
+			count++
+		}
+	}
+	return count
+}
+
+// CheckMaxDestroy returns an error if the number of resources to destroy
+// exceeds max_destroy
+func CheckMaxDestroy(destroy, maxDestroy int) error {
+	if destroy > maxDestroy {
+		return fmt.Errorf("plan destroys %d resources, more than max_destroy %d",
+			destroy, maxDestroy)
+	}
+	return nil
+}
+
+var _ Handler = (*PlanPolicy)(nil)
+
+// PlanPolicy is the pre-apply handler for the plan policy of a task's
+// pre_apply block. It vetoes the apply when the structured plan destroys more
+// resources than allowed, or when a plan with changes has no structured plan
+// to check.
+type PlanPolicy struct {
+	next       Handler
+	taskName   string
+	maxDestroy int
+	logger     logging.Logger
+}
+
+// NewPlanPolicy configures and returns a new plan policy handler for a task's
+// pre_apply block
+func NewPlanPolicy(taskName string, conf *config.PreApplyConfig) (*PlanPolicy, error) {
+	if conf == nil || conf.MaxDestroy == nil {
+		return nil, errors.New("plan policy handler: missing 'max_destroy' configuration")
+	}
+
+	logger := logging.Global().Named(logSystemName).Named(planPolicySubsystemName).With(
+		"task_name", taskName)
+	logger.Info("creating handler", "max_destroy", *conf.MaxDestroy)
+
+	return &PlanPolicy{
+		taskName:   taskName,
+		maxDestroy: *conf.MaxDestroy,
+		logger:     logger,
+	}, nil
+}
+
+// Do checks the plan against the policy and calls the next handler. The plan
+// is not checked if the apply was already vetoed by a preceding handler.
+func (h *PlanPolicy) Do(ctx context.Context, prevErr error) error {
+	if prevErr != nil {
+		h.logger.Trace("skipping plan policy, apply is already vetoed")
+		return callNext(ctx, h.next, prevErr, nil)
+	}
+
+	return callNext(ctx, h.next, prevErr, h.check(ctx))
+}
+
+// check returns an error if the plan violates the policy
+func (h *PlanPolicy) check(ctx context.Context) error {
+	plan, ok := PlanFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("plan policy for task %s: no plan to check", h.taskName)
+	}
+
+	if !plan.ChangesPresent {
+		return nil
+	}
+
+	if plan.Structured == nil {
+		return fmt.Errorf("plan policy for task %s: unable to determine the "+
+			"number of resources to destroy without a structured plan", h.taskName)
+	}
+
+	destroy := CountDestroy(plan.Structured)
+	h.logger.Trace("checking plan", "destroy", destroy)
+	if err := CheckMaxDestroy(destroy, h.maxDestroy); err != nil {
+		h.logger.Info("apply vetoed", "destroy", destroy,
+			"max_destroy", h.maxDestroy)
+		return fmt.Errorf("plan policy vetoed the apply for task %s: %s",
+			h.taskName, err)
+	}
+
+	return nil
+}
+
+// SetNext sets the next handler that should be called
+func (h *PlanPolicy) SetNext(next Handler) {
+	h.next = next
+}
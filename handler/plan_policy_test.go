@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountDestroy(t *testing.T) {
+	change := func(mode tfjson.ResourceMode, actions ...tfjson.Action) *tfjson.ResourceChange {
+		return &tfjson.ResourceChange{
+			Mode:   mode,
+			Change: &tfjson.Change{Actions: actions},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		plan     *tfjson.Plan
+		expected int
+	}{
+		{
+			"nil",
+			nil,
+			0,
+		}, {
+			"changes",
+			&tfjson.Plan{ResourceChanges: []*tfjson.ResourceChange{
+				change(tfjson.ManagedResourceMode, tfjson.ActionCreate),
+				change(tfjson.ManagedResourceMode, tfjson.ActionUpdate),
+				change(tfjson.ManagedResourceMode, tfjson.ActionDelete),
+				change(tfjson.ManagedResourceMode, tfjson.ActionDelete, tfjson.ActionCreate),
+				change(tfjson.ManagedResourceMode, tfjson.ActionCreate, tfjson.ActionDelete),
+				change(tfjson.DataResourceMode, tfjson.ActionDelete),
+				nil,
+			}},
+			3,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, CountDestroy(tc.plan))
+		})
+	}
+}
+
+func TestCheckMaxDestroy(t *testing.T) {
+	assert.NoError(t, CheckMaxDestroy(1, 1))
+	err := CheckMaxDestroy(2, 1)
+	require.Error(t, err)
+	assert.Equal(t, "plan destroys 2 resources, more than max_destroy 1", err.Error())
+}
+
+func TestNewPlanPolicy(t *testing.T) {
+	h, err := NewPlanPolicy("task", &config.PreApplyConfig{MaxDestroy: config.Int(2)})
+	require.NoError(t, err)
+	assert.Equal(t, 2, h.maxDestroy)
+
+	_, err = NewPlanPolicy("task", &config.PreApplyConfig{})
+	assert.Error(t, err)
+}
+
+func TestPlanPolicyDo(t *testing.T) {
+	cases := []struct {
+		name        string
+		plan        *Plan
+		maxDestroy  int
+		expectError bool
+	}{
+		{
+			"within policy",
+			&Plan{
+				ChangesPresent: true,
+				Structured:     testDestroyPlan(1),
+			},
+			1,
+			false,
+		}, {
+			"exceeds policy",
+			&Plan{
+				ChangesPresent: true,
+				Structured:     testDestroyPlan(2),
+			},
+			1,
+			true,
+		}, {
+			"no changes",
+			&Plan{ChangesPresent: false},
+			0,
+			false,
+		}, {
+			"changes without structured plan",
+			&Plan{ChangesPresent: true, Plan: "Plan: 0 to add, 0 to change, 1 to destroy."},
+			0,
+			true,
+		}, {
+			"no plan",
+			nil,
+			0,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.plan != nil {
+				ctx = WithPlan(ctx, *tc.plan)
+			}
+
+			h := &PlanPolicy{
+				taskName:   "task",
+				maxDestroy: tc.maxDestroy,
+				logger:     logging.NewNullLogger(),
+			}
+			err := h.Do(ctx, nil)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("already vetoed", func(t *testing.T) {
+		h := &PlanPolicy{taskName: "task", logger: logging.NewNullLogger()}
+		vetoErr := errors.New("vetoed")
+		assert.Equal(t, vetoErr, h.Do(context.Background(), vetoErr))
+	})
+}
+
+func TestPlanPolicySetNext(t *testing.T) {
+	h := &PlanPolicy{logger: logging.NewNullLogger()}
+	h.SetNext(&PlanPolicy{logger: logging.NewNullLogger()})
+	assert.NotNil(t, h.next)
+}
+
+// testDestroyPlan returns a structured plan that destroys n resources
+func testDestroyPlan(n int) *tfjson.Plan {
+	plan := &tfjson.Plan{}
+	for i := 0; i < n; i++ {
+		plan.ResourceChanges = append(plan.ResourceChanges, &tfjson.ResourceChange{
+			Address: fmt.Sprintf("local_file.f%d", i),
+			Mode:    tfjson.ManagedResourceMode,
+			Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}},
+		})
+	}
+	return plan
+}
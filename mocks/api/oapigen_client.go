@@ -16,6 +16,96 @@ type ClientWithResponsesInterface struct {
 	mock.Mock
 }
 
+// ApproveTaskByNameWithResponse provides a mock function with given fields: ctx, name, params, reqEditors
+func (_m *ClientWithResponsesInterface) ApproveTaskByNameWithResponse(ctx context.Context, name string, params *oapigen.ApproveTaskByNameParams, reqEditors ...oapigen.RequestEditorFn) (*oapigen.ApproveTaskByNameResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, name, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *oapigen.ApproveTaskByNameResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, *oapigen.ApproveTaskByNameParams, ...oapigen.RequestEditorFn) *oapigen.ApproveTaskByNameResponse); ok {
+		r0 = rf(ctx, name, params, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oapigen.ApproveTaskByNameResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *oapigen.ApproveTaskByNameParams, ...oapigen.RequestEditorFn) error); ok {
+		r1 = rf(ctx, name, params, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRolloutWithBodyWithResponse provides a mock function with given fields: ctx, contentType, body, reqEditors
+func (_m *ClientWithResponsesInterface) CreateRolloutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...oapigen.RequestEditorFn) (*oapigen.CreateRolloutResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, contentType, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *oapigen.CreateRolloutResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, ...oapigen.RequestEditorFn) *oapigen.CreateRolloutResponse); ok {
+		r0 = rf(ctx, contentType, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oapigen.CreateRolloutResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, io.Reader, ...oapigen.RequestEditorFn) error); ok {
+		r1 = rf(ctx, contentType, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRolloutWithResponse provides a mock function with given fields: ctx, body, reqEditors
+func (_m *ClientWithResponsesInterface) CreateRolloutWithResponse(ctx context.Context, body oapigen.CreateRolloutJSONRequestBody, reqEditors ...oapigen.RequestEditorFn) (*oapigen.CreateRolloutResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *oapigen.CreateRolloutResponse
+	if rf, ok := ret.Get(0).(func(context.Context, oapigen.CreateRolloutJSONRequestBody, ...oapigen.RequestEditorFn) *oapigen.CreateRolloutResponse); ok {
+		r0 = rf(ctx, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oapigen.CreateRolloutResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, oapigen.CreateRolloutJSONRequestBody, ...oapigen.RequestEditorFn) error); ok {
+		r1 = rf(ctx, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateTaskWithBodyWithResponse provides a mock function with given fields: ctx, params, contentType, body, reqEditors
 func (_m *ClientWithResponsesInterface) CreateTaskWithBodyWithResponse(ctx context.Context, params *oapigen.CreateTaskParams, contentType string, body io.Reader, reqEditors ...oapigen.RequestEditorFn) (*oapigen.CreateTaskResponse, error) {
 	_va := make([]interface{}, len(reqEditors))
@@ -136,6 +226,36 @@ func (_m *ClientWithResponsesInterface) GetAllTasksWithResponse(ctx context.Cont
 	return r0, r1
 }
 
+// GetRolloutWithResponse provides a mock function with given fields: ctx, id, reqEditors
+func (_m *ClientWithResponsesInterface) GetRolloutWithResponse(ctx context.Context, id string, reqEditors ...oapigen.RequestEditorFn) (*oapigen.GetRolloutResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *oapigen.GetRolloutResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...oapigen.RequestEditorFn) *oapigen.GetRolloutResponse); ok {
+		r0 = rf(ctx, id, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oapigen.GetRolloutResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...oapigen.RequestEditorFn) error); ok {
+		r1 = rf(ctx, id, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTaskByNameWithResponse provides a mock function with given fields: ctx, name, reqEditors
 func (_m *ClientWithResponsesInterface) GetTaskByNameWithResponse(ctx context.Context, name string, reqEditors ...oapigen.RequestEditorFn) (*oapigen.GetTaskByNameResponse, error) {
 	_va := make([]interface{}, len(reqEditors))
@@ -165,3 +285,93 @@ func (_m *ClientWithResponsesInterface) GetTaskByNameWithResponse(ctx context.Co
 
 	return r0, r1
 }
+
+// PauseWithResponse provides a mock function with given fields: ctx, reqEditors
+func (_m *ClientWithResponsesInterface) PauseWithResponse(ctx context.Context, reqEditors ...oapigen.RequestEditorFn) (*oapigen.PauseResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *oapigen.PauseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ...oapigen.RequestEditorFn) *oapigen.PauseResponse); ok {
+		r0 = rf(ctx, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oapigen.PauseResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...oapigen.RequestEditorFn) error); ok {
+		r1 = rf(ctx, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResumeWithResponse provides a mock function with given fields: ctx, reqEditors
+func (_m *ClientWithResponsesInterface) ResumeWithResponse(ctx context.Context, reqEditors ...oapigen.RequestEditorFn) (*oapigen.ResumeResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *oapigen.ResumeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, ...oapigen.RequestEditorFn) *oapigen.ResumeResponse); ok {
+		r0 = rf(ctx, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oapigen.ResumeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...oapigen.RequestEditorFn) error); ok {
+		r1 = rf(ctx, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RollbackTaskByNameWithResponse provides a mock function with given fields: ctx, name, params, reqEditors
+func (_m *ClientWithResponsesInterface) RollbackTaskByNameWithResponse(ctx context.Context, name string, params *oapigen.RollbackTaskByNameParams, reqEditors ...oapigen.RequestEditorFn) (*oapigen.RollbackTaskByNameResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, name, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *oapigen.RollbackTaskByNameResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, *oapigen.RollbackTaskByNameParams, ...oapigen.RequestEditorFn) *oapigen.RollbackTaskByNameResponse); ok {
+		r0 = rf(ctx, name, params, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oapigen.RollbackTaskByNameResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *oapigen.RollbackTaskByNameParams, ...oapigen.RequestEditorFn) error); ok {
+		r1 = rf(ctx, name, params, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -7,6 +7,8 @@ import (
 	io "io"
 
 	mock "github.com/stretchr/testify/mock"
+
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 // Client is an autogenerated mock type for the Client type
@@ -28,6 +30,20 @@ func (_m *Client) Apply(ctx context.Context) error {
 	return r0
 }
 
+// DiscardPlan provides a mock function with given fields:
+func (_m *Client) DiscardPlan() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GoString provides a mock function with given fields:
 func (_m *Client) GoString() string {
 	ret := _m.Called()
@@ -56,6 +72,20 @@ func (_m *Client) Init(ctx context.Context) error {
 	return r0
 }
 
+// Import provides a mock function with given fields: ctx, address, id
+func (_m *Client) Import(ctx context.Context, address string, id string) error {
+	ret := _m.Called(ctx, address, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, address, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Plan provides a mock function with given fields: ctx
 func (_m *Client) Plan(ctx context.Context) (bool, error) {
 	ret := _m.Called(ctx)
@@ -77,6 +107,29 @@ func (_m *Client) Plan(ctx context.Context) (bool, error) {
 	return r0, r1
 }
 
+// ShowPlan provides a mock function with given fields: ctx
+func (_m *Client) ShowPlan(ctx context.Context) (*tfjson.Plan, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *tfjson.Plan
+	if rf, ok := ret.Get(0).(func(context.Context) *tfjson.Plan); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tfjson.Plan)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetEnv provides a mock function with given fields: _a0
 func (_m *Client) SetEnv(_a0 map[string]string) error {
 	ret := _m.Called(_a0)
@@ -91,6 +144,20 @@ func (_m *Client) SetEnv(_a0 map[string]string) error {
 	return r0
 }
 
+// SetVars provides a mock function with given fields: _a0
+func (_m *Client) SetVars(_a0 map[string]interface{}) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]interface{}) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetStdout provides a mock function with given fields: w
 func (_m *Client) SetStdout(w io.Writer) {
 	_m.Called(w)
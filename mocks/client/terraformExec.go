@@ -39,6 +39,27 @@ func (_m *TerraformExec) Apply(ctx context.Context, opts ...tfexec.ApplyOption)
 	return r0
 }
 
+// Import provides a mock function with given fields: ctx, address, id, opts
+func (_m *TerraformExec) Import(ctx context.Context, address string, id string, opts ...tfexec.ImportOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, address, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...tfexec.ImportOption) error); ok {
+		r0 = rf(ctx, address, id, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Init provides a mock function with given fields: ctx, opts
 func (_m *TerraformExec) Init(ctx context.Context, opts ...tfexec.InitOption) error {
 	_va := make([]interface{}, len(opts))
@@ -88,6 +109,36 @@ func (_m *TerraformExec) Plan(ctx context.Context, opts ...tfexec.PlanOption) (b
 	return r0, r1
 }
 
+// ShowPlanFile provides a mock function with given fields: ctx, planPath, opts
+func (_m *TerraformExec) ShowPlanFile(ctx context.Context, planPath string, opts ...tfexec.ShowOption) (*tfjson.Plan, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, planPath)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *tfjson.Plan
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...tfexec.ShowOption) *tfjson.Plan); ok {
+		r0 = rf(ctx, planPath, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tfjson.Plan)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...tfexec.ShowOption) error); ok {
+		r1 = rf(ctx, planPath, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetEnv provides a mock function with given fields: env
 func (_m *TerraformExec) SetEnv(env map[string]string) error {
 	ret := _m.Called(env)
@@ -28,6 +28,20 @@ func (_m *Driver) ApplyTask(ctx context.Context) error {
 	return r0
 }
 
+// ApproveTask provides a mock function with given fields: ctx, override
+func (_m *Driver) ApproveTask(ctx context.Context, override bool) error {
+	ret := _m.Called(ctx, override)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) error); ok {
+		r0 = rf(ctx, override)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DestroyTask provides a mock function with given fields: ctx
 func (_m *Driver) DestroyTask(ctx context.Context) {
 	_m.Called(ctx)
@@ -94,6 +108,20 @@ func (_m *Driver) RenderTemplate(ctx context.Context) (bool, error) {
 	return r0, r1
 }
 
+// RollbackTask provides a mock function with given fields: ctx, eventID
+func (_m *Driver) RollbackTask(ctx context.Context, eventID string) error {
+	ret := _m.Called(ctx, eventID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetBufferPeriod provides a mock function with given fields:
 func (_m *Driver) SetBufferPeriod() {
 	_m.Called()
@@ -9,7 +9,11 @@ import (
 
 	event "github.com/hashicorp/consul-terraform-sync/state/event"
 
+	rollout "github.com/hashicorp/consul-terraform-sync/state/rollout"
+
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Server is an autogenerated mock type for the Server type
@@ -54,6 +58,72 @@ func (_m *Server) Events(ctx context.Context, taskName string) (map[string][]eve
 	return r0, r1
 }
 
+// Pause provides a mock function with given fields: ctx
+func (_m *Server) Pause(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// Paused provides a mock function with given fields: ctx
+func (_m *Server) Paused(ctx context.Context) bool {
+	ret := _m.Called(ctx)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Resume provides a mock function with given fields: ctx
+func (_m *Server) Resume(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// Rollout provides a mock function with given fields: ctx, id
+func (_m *Server) Rollout(ctx context.Context, id string) (rollout.Rollout, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 rollout.Rollout
+	if rf, ok := ret.Get(0).(func(context.Context, string) rollout.Rollout); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(rollout.Rollout)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RolloutCreate provides a mock function with given fields: ctx, module, version, batchSize
+func (_m *Server) RolloutCreate(ctx context.Context, module string, version string, batchSize int) (rollout.Rollout, error) {
+	ret := _m.Called(ctx, module, version, batchSize)
+
+	var r0 rollout.Rollout
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) rollout.Rollout); ok {
+		r0 = rf(ctx, module, version, batchSize)
+	} else {
+		r0 = ret.Get(0).(rollout.Rollout)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, module, version, batchSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Task provides a mock function with given fields: ctx, taskName
 func (_m *Server) Task(ctx context.Context, taskName string) (config.TaskConfig, error) {
 	ret := _m.Called(ctx, taskName)
@@ -75,6 +145,41 @@ func (_m *Server) Task(ctx context.Context, taskName string) (config.TaskConfig,
 	return r0, r1
 }
 
+// TaskApprove provides a mock function with given fields: ctx, taskName, override
+func (_m *Server) TaskApprove(ctx context.Context, taskName string, override bool) error {
+	ret := _m.Called(ctx, taskName, override)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, taskName, override)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TaskBlocked provides a mock function with given fields: ctx, taskName
+func (_m *Server) TaskBlocked(ctx context.Context, taskName string) (string, bool) {
+	ret := _m.Called(ctx, taskName)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, taskName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = rf(ctx, taskName)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // TaskCreate provides a mock function with given fields: _a0, _a1
 func (_m *Server) TaskCreate(_a0 context.Context, _a1 config.TaskConfig) (config.TaskConfig, error) {
 	ret := _m.Called(_a0, _a1)
@@ -117,6 +222,27 @@ func (_m *Server) TaskCreateAndRun(_a0 context.Context, _a1 config.TaskConfig) (
 	return r0, r1
 }
 
+// TaskDeferred provides a mock function with given fields: ctx, taskName
+func (_m *Server) TaskDeferred(ctx context.Context, taskName string) (time.Time, bool) {
+	ret := _m.Called(ctx, taskName)
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func(context.Context, string) time.Time); ok {
+		r0 = rf(ctx, taskName)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = rf(ctx, taskName)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // TaskDelete provides a mock function with given fields: ctx, taskName
 func (_m *Server) TaskDelete(ctx context.Context, taskName string) error {
 	ret := _m.Called(ctx, taskName)
@@ -166,6 +292,34 @@ func (_m *Server) TaskInspect(_a0 context.Context, _a1 config.TaskConfig) (bool,
 	return r0, r1, r2, r3
 }
 
+// TaskRollback provides a mock function with given fields: ctx, taskName, eventID
+func (_m *Server) TaskRollback(ctx context.Context, taskName string, eventID string) error {
+	ret := _m.Called(ctx, taskName, eventID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, taskName, eventID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TaskSnapshot provides a mock function with given fields: ctx, taskName, eventID
+func (_m *Server) TaskSnapshot(ctx context.Context, taskName string, eventID string) bool {
+	ret := _m.Called(ctx, taskName, eventID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, taskName, eventID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // TaskUpdate provides a mock function with given fields: ctx, updateConf, runOp
 func (_m *Server) TaskUpdate(ctx context.Context, updateConf config.TaskConfig, runOp string) (bool, string, string, error) {
 	ret := _m.Called(ctx, updateConf, runOp)
@@ -0,0 +1,243 @@
+// Package policy evaluates policy-as-code rules against the structured plan of
+// a task before the task applies. Rules are CEL expressions
+// (https://github.com/google/cel-spec) that are compiled and evaluated locally.
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+const (
+	// planVariable is the name of the CEL variable for the structured plan
+	planVariable = "plan"
+
+	// taskVariable is the name of the CEL variable for the task information
+	taskVariable = "task"
+)
+
+// Result is the outcome of evaluating a policy against a plan
+type Result struct {
+	Name             string `json:"name"`
+	EnforcementLevel string `json:"enforcement_level"`
+	Passed           bool   `json:"passed"`
+	Message          string `json:"message,omitempty"`
+}
+
+// Results is a list of policy results
+type Results []Result
+
+// Failed returns the results of the policies that did not pass at the
+// enforcement level
+func (r Results) Failed(level string) Results {
+	var failed Results
+	for _, result := range r {
+		if !result.Passed && result.EnforcementLevel == level {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// String returns a human-readable summary of the results
+func (r Results) String() string {
+	if len(r) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Policy results:\n")
+	for _, result := range r {
+		status := "passed"
+		if !result.Passed {
+			status = "failed"
+		}
+		fmt.Fprintf(&sb, "  %s (%s): %s", result.Name, result.EnforcementLevel,
+			status)
+		if !result.Passed && result.Message != "" {
+			fmt.Fprintf(&sb, ": %s", result.Message)
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// Error returns a message that lists the failed results
+func (r Results) Error() string {
+	msgs := make([]string, len(r))
+	for i, result := range r {
+		msgs[i] = fmt.Sprintf("%s: %s", result.Name, result.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Policy is a compiled policy
+type Policy struct {
+	name    string
+	level   string
+	message string
+	program cel.Program
+}
+
+// New compiles the policy's CEL expression, reading the expression from the
+// file if it is configured with one. An error is returned if the expression
+// does not compile or does not evaluate to a bool.
+func New(conf *config.PolicyConfig) (*Policy, error) {
+	if conf == nil {
+		return nil, fmt.Errorf("missing policy configuration")
+	}
+	name := config.StringVal(conf.Name)
+
+	expr := config.StringVal(conf.Expression)
+	if path := config.StringVal(conf.File); path != "" {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading file for policy %q: %s", name, err)
+		}
+		expr = string(content)
+	}
+
+	env, err := cel.NewEnv(
+		cel.Variable(planVariable, cel.DynType),
+		cel.Variable(taskVariable, cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("error compiling policy %q: %s", name, iss.Err())
+	}
+	if out := ast.OutputType(); out.String() != cel.BoolType.String() &&
+		out.String() != cel.DynType.String() {
+		return nil, fmt.Errorf("policy %q must evaluate to a bool, not %s",
+			name, out)
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("error creating program for policy %q: %s", name, err)
+	}
+
+	level := config.StringVal(conf.EnforcementLevel)
+	if level == "" {
+		level = config.DefaultPolicyEnforcementLevel
+	}
+
+	message := config.StringVal(conf.Message)
+	if message == "" {
+		message = "policy failed"
+	}
+
+	return &Policy{
+		name:    name,
+		level:   level,
+		message: message,
+		program: program,
+	}, nil
+}
+
+// Name returns the name of the policy
+func (p *Policy) Name() string {
+	return p.name
+}
+
+// Evaluate evaluates the policy with the input variables. A policy that errors
+// during evaluation, e.g. for a missing attribute, does not pass.
+func (p *Policy) Evaluate(input map[string]interface{}) Result {
+	result := Result{
+		Name:             p.name,
+		EnforcementLevel: p.level,
+	}
+
+	val, _, err := p.program.Eval(input)
+	if err != nil {
+		result.Message = fmt.Sprintf("error evaluating policy: %s", err)
+		return result
+	}
+
+	passed, ok := val.Value().(bool)
+	if !ok {
+		result.Message = fmt.Sprintf("policy evaluated to %v, not a bool",
+			val.Value())
+		return result
+	}
+
+	result.Passed = passed
+	if !passed {
+		result.Message = p.message
+	}
+	return result
+}
+
+// Policies is a list of compiled policies
+type Policies []*Policy
+
+// NewPolicies compiles the policies of a task
+func NewPolicies(confs config.PolicyConfigs) (Policies, error) {
+	policies := make(Policies, 0, len(confs))
+	for _, conf := range confs {
+		p, err := New(conf)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+// Evaluate evaluates all policies against the structured plan of the task.
+// The plan is available to the policies as the `plan` variable in the JSON
+// format of `terraform show -json`, and the task name as `task.name`.
+func (ps Policies) Evaluate(taskName string, plan *tfjson.Plan) (Results, error) {
+	if len(ps) == 0 {
+		return nil, nil
+	}
+
+	input, err := planInput(plan)
+	if err != nil {
+		return nil, err
+	}
+	vars := map[string]interface{}{
+		planVariable: input,
+		taskVariable: map[string]interface{}{"name": taskName},
+	}
+
+	results := make(Results, len(ps))
+	for i, p := range ps {
+		results[i] = p.Evaluate(vars)
+	}
+	return results, nil
+}
+
+// planInput converts the plan to generic JSON values so that policies can
+// reference attributes by their JSON names
+func planInput(plan *tfjson.Plan) (map[string]interface{}, error) {
+	if plan == nil {
+		plan = &tfjson.Plan{}
+	}
+
+	b, err := json.Marshal(plan)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding plan for policies: %s", err)
+	}
+
+	var input map[string]interface{}
+	if err := json.Unmarshal(b, &input); err != nil {
+		return nil, fmt.Errorf("error decoding plan for policies: %s", err)
+	}
+
+	// Policies commonly iterate over the resource changes, which are omitted
+	// from the JSON when there are no changes
+	if _, ok := input["resource_changes"]; !ok {
+		input["resource_changes"] = []interface{}{}
+	}
+	return input, nil
+}
@@ -0,0 +1,151 @@
+package policy
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const noDeletes = "plan.resource_changes.all(rc, !('delete' in rc.change.actions))"
+
+func TestNew(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "policy.cel")
+	require.NoError(t, ioutil.WriteFile(file, []byte(noDeletes), 0600))
+
+	cases := []struct {
+		name        string
+		conf        *config.PolicyConfig
+		expectError bool
+	}{
+		{
+			"expression",
+			&config.PolicyConfig{
+				Name:       config.String("no-deletes"),
+				Expression: config.String(noDeletes),
+			},
+			false,
+		}, {
+			"file",
+			&config.PolicyConfig{
+				Name: config.String("no-deletes"),
+				File: config.String(file),
+			},
+			false,
+		}, {
+			"missing file",
+			&config.PolicyConfig{
+				Name: config.String("no-deletes"),
+				File: config.String(filepath.Join(dir, "missing.cel")),
+			},
+			true,
+		}, {
+			"syntax error",
+			&config.PolicyConfig{
+				Name:       config.String("invalid"),
+				Expression: config.String("plan.resource_changes.all("),
+			},
+			true,
+		}, {
+			"not a bool",
+			&config.PolicyConfig{
+				Name:       config.String("count"),
+				Expression: config.String("1 + 1"),
+			},
+			true,
+		}, {
+			"nil",
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := New(tc.conf)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "no-deletes", p.Name())
+			assert.Equal(t, config.PolicyEnforcementMandatory, p.level)
+		})
+	}
+}
+
+func TestPolicies_Evaluate(t *testing.T) {
+	policies, err := NewPolicies(config.PolicyConfigs{
+		{
+			Name:             config.String("no-deletes"),
+			Expression:       config.String(noDeletes),
+			EnforcementLevel: config.String(config.PolicyEnforcementMandatory),
+			Message:          config.String("resources must not be deleted"),
+		}, {
+			Name:             config.String("task-name"),
+			Expression:       config.String("task.name == 'web'"),
+			EnforcementLevel: config.String(config.PolicyEnforcementAdvisory),
+		}, {
+			Name:             config.String("missing-attribute"),
+			Expression:       config.String("plan.missing.attribute == 1"),
+			EnforcementLevel: config.String(config.PolicyEnforcementAdvisory),
+		},
+	})
+	require.NoError(t, err)
+
+	plan := &tfjson.Plan{
+		FormatVersion: "0.2",
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "module.web.local_file.a",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}},
+			},
+		},
+	}
+
+	results, err := policies.Evaluate("web", plan)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.Equal(t, Result{
+		Name:             "no-deletes",
+		EnforcementLevel: config.PolicyEnforcementMandatory,
+		Message:          "resources must not be deleted",
+	}, results[0])
+	assert.True(t, results[1].Passed)
+	assert.False(t, results[2].Passed)
+	assert.Contains(t, results[2].Message, "error evaluating policy")
+
+	failed := results.Failed(config.PolicyEnforcementMandatory)
+	require.Len(t, failed, 1)
+	assert.Equal(t, "no-deletes: resources must not be deleted", failed.Error())
+	assert.Len(t, results.Failed(config.PolicyEnforcementAdvisory), 1)
+
+	t.Run("no changes", func(t *testing.T) {
+		results, err := policies.Evaluate("web", &tfjson.Plan{FormatVersion: "0.2"})
+		require.NoError(t, err)
+		assert.True(t, results[0].Passed)
+	})
+
+	t.Run("no policies", func(t *testing.T) {
+		results, err := Policies{}.Evaluate("web", plan)
+		assert.NoError(t, err)
+		assert.Nil(t, results)
+	})
+}
+
+func TestResults_String(t *testing.T) {
+	results := Results{
+		{Name: "a", EnforcementLevel: "mandatory", Passed: true},
+		{Name: "b", EnforcementLevel: "advisory", Message: "failed check"},
+	}
+	assert.Equal(t, "Policy results:\n"+
+		"  a (mandatory): passed\n"+
+		"  b (advisory): failed: failed check\n", results.String())
+	assert.Equal(t, "", Results{}.String())
+}
@@ -0,0 +1,70 @@
+package state
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Block is an apply of a task that the task's guardrails blocked. The task
+// does not apply again until the apply is approved or overridden.
+type Block struct {
+	TaskName string `json:"task_name"`
+
+	// Reason describes the guardrails that the plan exceeded
+	Reason string `json:"reason"`
+
+	// Changes, Destroy, and Existing are the number of resources the blocked
+	// plan creates, updates, or destroys, the number it destroys, and the
+	// number of existing resources it updates or destroys. Managed is the
+	// number of resources in the state prior to the plan.
+	Changes  int `json:"changes"`
+	Destroy  int `json:"destroy"`
+	Existing int `json:"existing"`
+	Managed  int `json:"managed"`
+}
+
+// blockStorage is the storage for the blocked applies of tasks
+type blockStorage struct {
+	mu *sync.RWMutex
+
+	blocks map[string]Block // taskname => block
+}
+
+// newBlockStorage returns a new storage for blocked applies
+func newBlockStorage() *blockStorage {
+	return &blockStorage{
+		mu:     &sync.RWMutex{},
+		blocks: make(map[string]Block),
+	}
+}
+
+// Set adds or replaces the blocked apply of a task
+func (s *blockStorage) Set(b Block) error {
+	if b.TaskName == "" {
+		return fmt.Errorf("error storing blocked apply: taskname cannot be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blocks[b.TaskName] = b
+	return nil
+}
+
+// Get returns the blocked apply of a task. The second parameter returns false
+// if the task is not blocked.
+func (s *blockStorage) Get(taskName string) (Block, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, ok := s.blocks[taskName]
+	return b, ok
+}
+
+// Delete deletes the blocked apply of a task
+func (s *blockStorage) Delete(taskName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.blocks, taskName)
+}
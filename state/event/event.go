@@ -1,6 +1,7 @@
 package event
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -25,6 +26,10 @@ type Event struct {
 	TaskName   string    `json:"task_name"`
 	EventError *Error    `json:"error"`
 
+	// Imports is set for the event of a task's first apply when the task
+	// imports existing infrastructure into its state
+	Imports *Imports `json:"imports,omitempty"`
+
 	// Config is deprecated in v0.5. This is configuration details about the
 	// task rather than status information. Users should switch to using the
 	// Get Task API to request the task's config information.
@@ -38,6 +43,14 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// Imports captures the resource addresses that were imported into the state
+// of a task, and the addresses that were skipped because they were already
+// managed by the state
+type Imports struct {
+	Imported []string `json:"imported"`
+	Skipped  []string `json:"skipped"`
+}
+
 // Config provides details on an event's task configuration. It is deprecated
 // in v0.5 and should be removed in 0.8
 type Config struct {
@@ -64,6 +77,37 @@ func NewEvent(taskName string, config *Config) (*Event, error) {
 	}, nil
 }
 
+type idContextKey struct{}
+
+// WithID returns a copy of the context that carries the ID of the event being
+// executed. Handlers use the ID to correlate their actions with the event.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, idContextKey{}, id)
+}
+
+type eventContextKey struct{}
+
+// WithEvent returns a copy of the context that carries the event being
+// executed and its ID. The driver records the results of the execution on the
+// event before it is stored.
+func WithEvent(ctx context.Context, e *Event) context.Context {
+	return context.WithValue(WithID(ctx, e.ID), eventContextKey{}, e)
+}
+
+// FromContext returns the event carried by the context, or nil if there is
+// none.
+func FromContext(ctx context.Context) *Event {
+	e, _ := ctx.Value(eventContextKey{}).(*Event)
+	return e
+}
+
+// IDFromContext returns the event ID carried by the context, or an empty
+// string if there is none.
+func IDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(idContextKey{}).(string)
+	return id
+}
+
 // Start sets the start time on an event. Can only be called once.
 func (e *Event) Start() {
 	if !e.StartTime.IsZero() {
@@ -124,6 +168,7 @@ func (e *Event) GoString() string {
 		"StartTime:%s, "+
 		"EndTime:%s, "+
 		"EventError:%s, "+
+		"Imports:%+v, "+
 		"Config:%s"+
 		"}",
 		e.ID,
@@ -132,6 +177,7 @@ func (e *Event) GoString() string {
 		e.StartTime,
 		e.EndTime,
 		e.EventError,
+		e.Imports,
 		e.Config.GoString(),
 	)
 }
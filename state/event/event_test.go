@@ -1,6 +1,7 @@
 package event
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -115,6 +116,24 @@ func TestNewEvent(t *testing.T) {
 	}
 }
 
+func TestIDFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, IDFromContext(ctx))
+
+	ctx = WithID(ctx, "123")
+	assert.Equal(t, "123", IDFromContext(ctx))
+}
+
+func TestFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, FromContext(ctx))
+
+	e := &Event{ID: "123"}
+	ctx = WithEvent(ctx, e)
+	assert.Equal(t, e, FromContext(ctx))
+	assert.Equal(t, "123", IDFromContext(ctx))
+}
+
 func TestEvent_Start(t *testing.T) {
 	t.Parallel()
 
@@ -207,6 +226,10 @@ func TestEvent_GoString(t *testing.T) {
 				EventError: &Error{
 					Message: "error!",
 				},
+				Imports: &Imports{
+					Imported: []string{"module.happy.a.b"},
+					Skipped:  []string{"module.happy.c.d"},
+				},
 				Config: &Config{
 					Providers: []string{"local"},
 					Services:  []string{"web", "api"},
@@ -216,6 +239,7 @@ func TestEvent_GoString(t *testing.T) {
 			"&Event{ID:123, TaskName:happy, Success:false, " +
 				"StartTime:0001-01-01 00:00:00 +0000 UTC, " +
 				"EndTime:0001-01-01 00:00:00 +0000 UTC, EventError:&{error!}, " +
+				"Imports:&{Imported:[module.happy.a.b] Skipped:[module.happy.c.d]}, " +
 				"Config:&Config{Providers:[local], Services:[web api], Source:/my-module}}",
 		},
 	}
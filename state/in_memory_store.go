@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/hashicorp/consul-terraform-sync/state/rollout"
 )
 
 var (
@@ -13,8 +14,10 @@ var (
 
 // InMemoryStore implements the CTS state Store interface.
 type InMemoryStore struct {
-	conf   *configStorage
-	events *eventStorage
+	conf     *configStorage
+	events   *eventStorage
+	rollouts *rolloutStorage
+	blocks   *blockStorage
 }
 
 // configStorage is the storage for the configuration with its own mutex lock
@@ -31,8 +34,10 @@ func NewInMemoryStore(conf *config.Config) *InMemoryStore {
 	}
 
 	return &InMemoryStore{
-		conf:   &configStorage{conf: *conf},
-		events: newEventStorage(),
+		conf:     &configStorage{conf: *conf},
+		events:   newEventStorage(),
+		rollouts: newRolloutStorage(),
+		blocks:   newBlockStorage(),
 	}
 }
 
@@ -44,6 +49,32 @@ func (s *InMemoryStore) GetConfig() config.Config {
 	return s.conf.conf
 }
 
+// SetTaskVersion updates the module version of a task in the config. The
+// config is unchanged if it does not have the task, for example when the task
+// was created by the API.
+func (s *InMemoryStore) SetTaskVersion(taskName, version string) {
+	s.conf.mu.Lock()
+	defer s.conf.mu.Unlock()
+
+	if s.conf.conf.Tasks == nil {
+		return
+	}
+
+	// the tasks are copied since previously returned configs share them
+	tasks := make(config.TaskConfigs, len(*s.conf.conf.Tasks))
+	copy(tasks, *s.conf.conf.Tasks)
+	for i, t := range tasks {
+		if t == nil || config.StringVal(t.Name) != taskName {
+			continue
+		}
+		t = t.Copy()
+		t.Version = config.String(version)
+		tasks[i] = t
+		s.conf.conf.Tasks = &tasks
+		return
+	}
+}
+
 // GetTaskEvents returns the events for a given task name. If no task name is
 // specified, then it returns events for all tasks
 func (s *InMemoryStore) GetTaskEvents(taskName string) map[string][]event.Event {
@@ -59,3 +90,32 @@ func (s *InMemoryStore) DeleteTaskEvents(taskName string) {
 func (s *InMemoryStore) AddTaskEvent(event event.Event) error {
 	return s.events.Add(event)
 }
+
+// GetRollout returns the rollout for an ID. The second parameter returns false
+// if there is no rollout for the ID.
+func (s *InMemoryStore) GetRollout(id string) (rollout.Rollout, bool) {
+	return s.rollouts.Get(id)
+}
+
+// SetRollout adds the rollout to the store, or updates the stored rollout
+// with the same ID
+func (s *InMemoryStore) SetRollout(r rollout.Rollout) error {
+	return s.rollouts.Set(r)
+}
+
+// GetTaskBlock returns the blocked apply of a task. The second parameter
+// returns false if the task is not blocked.
+func (s *InMemoryStore) GetTaskBlock(taskName string) (Block, bool) {
+	return s.blocks.Get(taskName)
+}
+
+// SetTaskBlock adds the blocked apply of the task to the store, or replaces
+// the stored blocked apply of the task
+func (s *InMemoryStore) SetTaskBlock(b Block) error {
+	return s.blocks.Set(b)
+}
+
+// DeleteTaskBlock deletes the blocked apply of a task
+func (s *InMemoryStore) DeleteTaskBlock(taskName string) {
+	s.blocks.Delete(taskName)
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/hashicorp/consul-terraform-sync/state/rollout"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_NewInMemoryStore(t *testing.T) {
@@ -23,7 +25,9 @@ func Test_NewInMemoryStore(t *testing.T) {
 				conf: &configStorage{
 					conf: *config.DefaultConfig(),
 				},
-				events: newEventStorage(),
+				events:   newEventStorage(),
+				rollouts: newRolloutStorage(),
+				blocks:   newBlockStorage(),
 			},
 		},
 		{
@@ -37,7 +41,9 @@ func Test_NewInMemoryStore(t *testing.T) {
 						Port: config.Int(1234),
 					},
 				},
-				events: newEventStorage(),
+				events:   newEventStorage(),
+				rollouts: newRolloutStorage(),
+				blocks:   newBlockStorage(),
 			},
 		},
 	}
@@ -75,6 +81,35 @@ func Test_InMemoryStore_GetConfig(t *testing.T) {
 	}
 }
 
+func Test_InMemoryStore_SetTaskVersion(t *testing.T) {
+	t.Parallel()
+
+	conf := &config.Config{
+		Tasks: &config.TaskConfigs{
+			{Name: config.String("task_a"), Version: config.String("1.0.0")},
+			{Name: config.String("task_b"), Version: config.String("1.0.0")},
+		},
+	}
+	store := NewInMemoryStore(conf)
+	previous := store.GetConfig()
+
+	store.SetTaskVersion("task_a", "1.1.0")
+	actual := store.GetConfig()
+	assert.Equal(t, "1.1.0", *(*actual.Tasks)[0].Version)
+	assert.Equal(t, "1.0.0", *(*actual.Tasks)[1].Version)
+	assert.Equal(t, "1.0.0", *(*previous.Tasks)[0].Version,
+		"previously returned config is not changed")
+
+	// task not in the config
+	store.SetTaskVersion("task_c", "1.1.0")
+	assert.Equal(t, actual, store.GetConfig())
+
+	// config without tasks
+	store = NewInMemoryStore(&config.Config{})
+	store.SetTaskVersion("task_a", "1.1.0")
+	assert.Nil(t, store.GetConfig().Tasks)
+}
+
 func Test_InMemoryStore_GetTaskEvents(t *testing.T) {
 	t.Parallel()
 
@@ -175,3 +210,39 @@ func Test_InMemoryStore_AddTaskEvent(t *testing.T) {
 		})
 	}
 }
+
+func Test_InMemoryStore_Rollouts(t *testing.T) {
+	t.Parallel()
+
+	store := NewInMemoryStore(nil)
+	_, ok := store.GetRollout("id")
+	assert.False(t, ok)
+
+	r := rollout.Rollout{ID: "id", Status: rollout.StatusRunning}
+	require.NoError(t, store.SetRollout(r))
+
+	actual, ok := store.GetRollout("id")
+	require.True(t, ok)
+	assert.Equal(t, rollout.StatusRunning, actual.Status)
+}
+
+func Test_InMemoryStore_TaskBlocks(t *testing.T) {
+	t.Parallel()
+
+	store := NewInMemoryStore(nil)
+	_, ok := store.GetTaskBlock("task")
+	assert.False(t, ok)
+
+	assert.Error(t, store.SetTaskBlock(Block{}))
+
+	b := Block{TaskName: "task", Reason: "plan destroys 1 resources", Destroy: 1}
+	require.NoError(t, store.SetTaskBlock(b))
+
+	actual, ok := store.GetTaskBlock("task")
+	require.True(t, ok)
+	assert.Equal(t, b, actual)
+
+	store.DeleteTaskBlock("task")
+	_, ok = store.GetTaskBlock("task")
+	assert.False(t, ok)
+}
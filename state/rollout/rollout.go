@@ -0,0 +1,153 @@
+package rollout
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+const (
+	// StatusRunning is the status of a rollout with batches left to upgrade
+	StatusRunning = "running"
+
+	// StatusSucceeded is the status of a rollout that upgraded all of its
+	// tasks, and the status of a task that upgraded successfully
+	StatusSucceeded = "succeeded"
+
+	// StatusFailed is the status of a rollout that stopped because a task
+	// failed to upgrade, and the status of the task that failed
+	StatusFailed = "failed"
+
+	// StatusPending is the status of a task that has not upgraded yet
+	StatusPending = "pending"
+
+	// StatusUpgrading is the status of a task in the batch being upgraded
+	StatusUpgrading = "upgrading"
+)
+
+// Rollout is a staged upgrade of the module version of the tasks that use a
+// module. Tasks are upgraded in batches, and the next batch is only upgraded
+// once all of the tasks of the previous batch upgraded successfully.
+type Rollout struct {
+	ID        string    `json:"id"`
+	Module    string    `json:"module"`
+	Version   string    `json:"version"`
+	BatchSize int       `json:"batch_size"`
+	Status    string    `json:"status"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Tasks     []Task    `json:"tasks"`
+}
+
+// Task is the progress of a task within a rollout
+type Task struct {
+	Name            string `json:"name"`
+	PreviousVersion string `json:"previous_version"`
+	Status          string `json:"status"`
+	Error           string `json:"error,omitempty"`
+}
+
+// NewRollout configures a new running rollout of a module version for the
+// tasks. The tasks are upgraded in the order given.
+func NewRollout(module, version string, batchSize int, tasks []Task) (*Rollout, error) {
+	if module == "" {
+		return nil, errors.New("error creating new rollout: module cannot be empty")
+	}
+	if version == "" {
+		return nil, errors.New("error creating new rollout: version cannot be empty")
+	}
+	if batchSize < 1 {
+		return nil, fmt.Errorf("error creating new rollout: batch size must be "+
+			"at least 1: %d", batchSize)
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Rollout{
+		ID:        id,
+		Module:    module,
+		Version:   version,
+		BatchSize: batchSize,
+		Status:    StatusRunning,
+		StartTime: time.Now(),
+		Tasks:     make([]Task, len(tasks)),
+	}
+	for i, t := range tasks {
+		t.Status = StatusPending
+		r.Tasks[i] = t
+	}
+	return r, nil
+}
+
+// Batches returns the indexes of the rollout's tasks grouped by batch
+func (r *Rollout) Batches() [][]int {
+	var batches [][]int
+	for start := 0; start < len(r.Tasks); start += r.BatchSize {
+		end := start + r.BatchSize
+		if end > len(r.Tasks) {
+			end = len(r.Tasks)
+		}
+
+		batch := make([]int, 0, end-start)
+		for i := start; i < end; i++ {
+			batch = append(batch, i)
+		}
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// End sets the end time and the final status of the rollout. Can only be
+// called once.
+func (r *Rollout) End(err error) {
+	if !r.EndTime.IsZero() {
+		return
+	}
+
+	r.EndTime = time.Now()
+	if err != nil {
+		r.Status = StatusFailed
+		return
+	}
+	r.Status = StatusSucceeded
+}
+
+// Copy returns a deep copy of the rollout
+func (r *Rollout) Copy() Rollout {
+	c := *r
+	c.Tasks = make([]Task, len(r.Tasks))
+	copy(c.Tasks, r.Tasks)
+	return c
+}
+
+// GoString defines the printable version of this struct.
+func (r *Rollout) GoString() string {
+	if r == nil {
+		return "(*Rollout)(nil)"
+	}
+
+	return fmt.Sprintf("&Rollout{"+
+		"ID:%s, "+
+		"Module:%s, "+
+		"Version:%s, "+
+		"BatchSize:%d, "+
+		"Status:%s, "+
+		"StartTime:%s, "+
+		"EndTime:%s, "+
+		"Tasks:%v"+
+		"}",
+		r.ID,
+		r.Module,
+		r.Version,
+		r.BatchSize,
+		r.Status,
+		r.StartTime,
+		r.EndTime,
+		r.Tasks,
+	)
+}
@@ -0,0 +1,145 @@
+package rollout
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRollout(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		module    string
+		version   string
+		batchSize int
+		expectErr bool
+	}{
+		{
+			"happy path",
+			"org/module",
+			"1.1.0",
+			2,
+			false,
+		},
+		{
+			"missing module",
+			"",
+			"1.1.0",
+			2,
+			true,
+		},
+		{
+			"missing version",
+			"org/module",
+			"",
+			2,
+			true,
+		},
+		{
+			"invalid batch size",
+			"org/module",
+			"1.1.0",
+			0,
+			true,
+		},
+	}
+
+	tasks := []Task{{Name: "task_a", PreviousVersion: "1.0.0"}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := NewRollout(tc.module, tc.version, tc.batchSize, tasks)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, r.ID)
+			assert.Equal(t, StatusRunning, r.Status)
+			assert.False(t, r.StartTime.IsZero())
+			assert.Equal(t, []Task{{
+				Name:            "task_a",
+				PreviousVersion: "1.0.0",
+				Status:          StatusPending,
+			}}, r.Tasks)
+		})
+	}
+}
+
+func TestRollout_Batches(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		numTasks  int
+		batchSize int
+		expected  [][]int
+	}{
+		{
+			"no tasks",
+			0,
+			2,
+			nil,
+		},
+		{
+			"even batches",
+			4,
+			2,
+			[][]int{{0, 1}, {2, 3}},
+		},
+		{
+			"partial last batch",
+			5,
+			2,
+			[][]int{{0, 1}, {2, 3}, {4}},
+		},
+		{
+			"batch larger than tasks",
+			2,
+			10,
+			[][]int{{0, 1}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Rollout{
+				BatchSize: tc.batchSize,
+				Tasks:     make([]Task, tc.numTasks),
+			}
+			assert.Equal(t, tc.expected, r.Batches())
+		})
+	}
+}
+
+func TestRollout_End(t *testing.T) {
+	t.Parallel()
+
+	r := &Rollout{Status: StatusRunning}
+	r.End(nil)
+	assert.Equal(t, StatusSucceeded, r.Status)
+	assert.False(t, r.EndTime.IsZero())
+
+	r = &Rollout{Status: StatusRunning}
+	r.End(errors.New("error"))
+	assert.Equal(t, StatusFailed, r.Status)
+
+	// ending again does not change the status
+	r.End(nil)
+	assert.Equal(t, StatusFailed, r.Status)
+}
+
+func TestRollout_Copy(t *testing.T) {
+	t.Parallel()
+
+	r := Rollout{ID: "id", Tasks: []Task{{Name: "task_a"}}}
+	c := r.Copy()
+	assert.Equal(t, r, c)
+
+	c.Tasks[0].Status = StatusFailed
+	assert.Empty(t, r.Tasks[0].Status)
+}
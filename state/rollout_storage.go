@@ -0,0 +1,65 @@
+package state
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/consul-terraform-sync/state/rollout"
+)
+
+const defaultRolloutCountLimit = 10
+
+// rolloutStorage is the storage for rollouts
+type rolloutStorage struct {
+	mu *sync.RWMutex
+
+	rollouts []*rollout.Rollout // in order of creation
+	limit    int
+}
+
+// newRolloutStorage returns a new storage for rollouts
+func newRolloutStorage() *rolloutStorage {
+	return &rolloutStorage{
+		mu:    &sync.RWMutex{},
+		limit: defaultRolloutCountLimit,
+	}
+}
+
+// Set adds a rollout or replaces the rollout with the same ID. The number of
+// rollouts stored is limited, and the oldest rollouts are removed first.
+func (s *rolloutStorage) Set(r rollout.Rollout) error {
+	if r.ID == "" {
+		return fmt.Errorf("error storing rollout: ID cannot be empty %s", r.GoString())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := r.Copy()
+	for i, stored := range s.rollouts {
+		if stored.ID == r.ID {
+			s.rollouts[i] = &c
+			return nil
+		}
+	}
+
+	s.rollouts = append(s.rollouts, &c)
+	if len(s.rollouts) > s.limit {
+		s.rollouts = s.rollouts[len(s.rollouts)-s.limit:]
+	}
+	return nil
+}
+
+// Get returns the rollout for an ID. The second parameter returns false if
+// there is no rollout for the ID.
+func (s *rolloutStorage) Get(id string) (rollout.Rollout, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, r := range s.rollouts {
+		if r.ID == id {
+			return r.Copy(), true
+		}
+	}
+	return rollout.Rollout{}, false
+}
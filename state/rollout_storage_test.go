@@ -0,0 +1,59 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/state/rollout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_rolloutStorage_Set(t *testing.T) {
+	t.Run("error: no ID", func(t *testing.T) {
+		storage := newRolloutStorage()
+		err := storage.Set(rollout.Rollout{})
+		assert.Error(t, err)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		storage := newRolloutStorage()
+		r := rollout.Rollout{
+			ID:     "id",
+			Status: rollout.StatusRunning,
+			Tasks:  []rollout.Task{{Name: "task", Status: rollout.StatusPending}},
+		}
+		require.NoError(t, storage.Set(r))
+
+		// the stored rollout is a copy
+		r.Tasks[0].Status = rollout.StatusSucceeded
+		actual, ok := storage.Get("id")
+		require.True(t, ok)
+		assert.Equal(t, rollout.StatusPending, actual.Tasks[0].Status)
+
+		r.Status = rollout.StatusSucceeded
+		require.NoError(t, storage.Set(r))
+		actual, ok = storage.Get("id")
+		require.True(t, ok)
+		assert.Equal(t, r, actual)
+		assert.Len(t, storage.rollouts, 1)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		storage := newRolloutStorage()
+		storage.limit = 2
+
+		for i := 0; i < 3; i++ {
+			err := storage.Set(rollout.Rollout{ID: fmt.Sprintf("%d", i)})
+			require.NoError(t, err)
+		}
+
+		// the oldest rollout is removed
+		_, ok := storage.Get("0")
+		assert.False(t, ok)
+		_, ok = storage.Get("1")
+		assert.True(t, ok)
+		_, ok = storage.Get("2")
+		assert.True(t, ok)
+	})
+}
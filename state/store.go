@@ -3,6 +3,7 @@ package state
 import (
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/hashicorp/consul-terraform-sync/state/rollout"
 )
 
 // Store stores the CTS state
@@ -11,6 +12,9 @@ type Store interface {
 	// GetConfig returns the CTS configuration
 	GetConfig() config.Config
 
+	// SetTaskVersion updates the module version of a task in the config
+	SetTaskVersion(taskName, version string)
+
 	// GetTaskEvents retrieves all the events for a task
 	GetTaskEvents(taskName string) map[string][]event.Event
 
@@ -19,4 +23,19 @@ type Store interface {
 
 	// AddTaskEvent adds an event for a task
 	AddTaskEvent(event event.Event) error
+
+	// GetRollout retrieves a rollout by its ID
+	GetRollout(id string) (rollout.Rollout, bool)
+
+	// SetRollout adds or updates a rollout
+	SetRollout(r rollout.Rollout) error
+
+	// GetTaskBlock retrieves the apply of a task blocked by its guardrails
+	GetTaskBlock(taskName string) (Block, bool)
+
+	// SetTaskBlock adds or updates the blocked apply of a task
+	SetTaskBlock(b Block) error
+
+	// DeleteTaskBlock deletes the blocked apply of a task
+	DeleteTaskBlock(taskName string)
 }
@@ -324,7 +324,7 @@ func TestRenderTFVarsTmpl(t *testing.T) {
 			input := hcat.TemplateInput{
 				Contents:      contents,
 				ErrMissingKey: true,
-				FuncMapMerge:  tmplfunc.HCLMap(nil),
+				FuncMapMerge:  tmplfunc.HCLMap(nil, nil),
 			}
 			tmpl := hcat.NewTemplate(input)
 			err = w.Register(tmpl)
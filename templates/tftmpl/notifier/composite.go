@@ -0,0 +1,188 @@
+package notifier
+
+import (
+	"sync"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/templates"
+)
+
+const compositeSubsystemName = "composite"
+
+// ConditionNotifierFunc creates the notifier for a single condition of a
+// composite condition, wrapping the given template.
+type ConditionNotifierFunc func(tmpl templates.Template) templates.Template
+
+// Composite is a custom notifier expected to be used for a template of a task
+// with an 'any' or 'all' condition that composes multiple conditions.
+//
+// Each composed condition is evaluated by its own notifier. Those notifiers
+// wrap a no-op template so that only the composite notifier determines when
+// the task's template is notified of new dependencies.
+type Composite struct {
+	templates.Template
+	logger logging.Logger
+
+	// all requires each condition to be triggered before notifying. Otherwise
+	// any condition that is triggered notifies.
+	all bool
+
+	// scheduled is true when one of the composed conditions is a schedule
+	// condition. Scheduled tasks are triggered by the schedule rather than the
+	// watcher.
+	scheduled bool
+
+	conditions []templates.Template
+
+	// pending tracks the conditions triggered since the last notification
+	pending []bool
+
+	// count all tmplfuncs needed to complete once-mode
+	once    bool
+	tfTotal int
+	counter int
+
+	mu sync.RWMutex
+}
+
+func (n *Composite) Override() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !n.once {
+		n.once = true
+	}
+}
+
+// NewComposite creates a new Composite notifier.
+//
+// tmplFuncTotal param: the total number of monitored tmplFuncs in the template.
+// This is the number of monitored tmplfuncs needed for all the composed
+// conditions and any module inputs. This number is equivalent to the number of
+// hashicat dependencies.
+//
+// all param: whether all conditions must be triggered to notify ('all'
+// condition) or any one condition ('any' condition).
+//
+// scheduled param: whether a schedule condition is composed. The schedule
+// condition does not have a notifier since it is not triggered by
+// dependencies.
+//
+// conditions param: the functions to create the notifiers of the composed
+// conditions that are triggered by dependencies.
+func NewComposite(tmpl templates.Template, tmplFuncTotal int, all, scheduled bool,
+	conditions ...ConditionNotifierFunc) *Composite {
+
+	logger := logging.Global().Named(logSystemName).Named(compositeSubsystemName)
+	logger.Trace("creating notifier", "type", compositeSubsystemName,
+		"tmpl_func_total", tmplFuncTotal, "all", all, "scheduled", scheduled)
+
+	n := &Composite{
+		Template:   tmpl,
+		tfTotal:    tmplFuncTotal,
+		all:        all,
+		scheduled:  scheduled,
+		conditions: make([]templates.Template, 0, len(conditions)),
+		pending:    make([]bool, len(conditions)),
+		logger:     logger,
+	}
+
+	sink := &noopTemplate{Template: tmpl}
+	for _, f := range conditions {
+		c := f(sink)
+		// once-mode is handled by the composite notifier
+		if o, ok := c.(Overrider); ok {
+			o.Override()
+		}
+		n.conditions = append(n.conditions, c)
+	}
+
+	return n
+}
+
+// Notify passes the dependency to the notifier of each composed condition and
+// notifies depending on which conditions are triggered.
+//
+// Notifications are sent when:
+// A. For an 'any' condition, any one of the conditions is triggered
+// B. For an 'all' condition, each condition has been triggered since the task
+// last notified
+// C. All the dependencies have been received for the first time.
+//
+// A schedule condition is always satisfied for an 'any' condition. The
+// template is updated on every dependency so that the schedule re-renders any
+// changes, and conditions triggered by a dependency notify in between
+// schedules. For an 'all' condition, notifications to the watcher are always
+// suppressed and the template is only updated once each of the other
+// conditions has been triggered, so that the next schedule re-renders.
+func (n *Composite) Notify(d interface{}) (notify bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	logDependency(n.logger, d)
+	notify = false
+
+	onceComplete := false
+	if !n.once {
+		n.counter++
+		// after a dependency is received for each tmplfunc, send notification
+		// so that once-mode can complete
+		if n.counter >= n.tfTotal {
+			n.logger.Debug("notify once-mode complete")
+			n.once = true
+			onceComplete = true
+		}
+	}
+
+	triggered := false
+	for ix, c := range n.conditions {
+		if c.Notify(d) {
+			n.pending[ix] = true
+			triggered = true
+		}
+	}
+
+	if n.all {
+		triggered = true
+		for _, p := range n.pending {
+			triggered = triggered && p
+		}
+	}
+
+	if triggered {
+		n.logger.Debug("composite condition triggered", "all", n.all)
+	}
+	if triggered || onceComplete {
+		// the task runs, conditions need to be triggered again
+		for ix := range n.pending {
+			n.pending[ix] = false
+		}
+	}
+
+	switch {
+	case onceComplete:
+		notify = true
+	case n.scheduled && n.all:
+		notify = false
+	default:
+		notify = triggered
+	}
+
+	if onceComplete || triggered || (n.scheduled && !n.all) {
+		n.Template.Notify(d)
+	}
+
+	return notify
+}
+
+// noopTemplate is the template wrapped by the notifiers of the composed
+// conditions. It discards notifications so that only the composite notifier
+// notifies the task's template.
+type noopTemplate struct {
+	templates.Template
+}
+
+// Notify discards the dependency
+func (t *noopTemplate) Notify(interface{}) bool {
+	return true
+}
@@ -0,0 +1,125 @@
+package notifier
+
+import (
+	"testing"
+
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/templates"
+	"github.com/hashicorp/consul-terraform-sync/templates"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Composite_Notify(t *testing.T) {
+	t.Parallel()
+
+	newServices := func(tmpl templates.Template) templates.Template {
+		return NewServices(tmpl, 1)
+	}
+	newConsulKV := func(tmpl templates.Template) templates.Template {
+		return NewConsulKV(tmpl, 1)
+	}
+
+	cases := []struct {
+		name      string
+		all       bool
+		scheduled bool
+		deps      []interface{}
+		expected  []bool
+		tmplCalls int
+	}{
+		{
+			"any: notify on each condition",
+			false,
+			false,
+			[]interface{}{
+				[]*dep.HealthService{},
+				&dep.KeyPair{Key: "key"},
+				[]*dep.Node{},
+			},
+			[]bool{true, true, false},
+			2,
+		},
+		{
+			"all: notify once each condition is triggered",
+			true,
+			false,
+			[]interface{}{
+				[]*dep.HealthService{},
+				[]*dep.HealthService{},
+				&dep.KeyPair{Key: "key"},
+				&dep.KeyPair{Key: "key"},
+			},
+			[]bool{false, false, true, false},
+			1,
+		},
+		{
+			"any with schedule: notify on conditions and update template",
+			false,
+			true,
+			[]interface{}{
+				[]*dep.Node{},
+				&dep.KeyPair{Key: "key"},
+			},
+			[]bool{false, true},
+			2,
+		},
+		{
+			"all with schedule: suppress and update template once triggered",
+			true,
+			true,
+			[]interface{}{
+				[]*dep.HealthService{},
+				[]*dep.Node{},
+				&dep.KeyPair{Key: "key"},
+			},
+			[]bool{false, false, false},
+			1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpl := new(mocks.Template)
+			tmpl.On("Notify", mock.Anything).Return(true)
+
+			n := NewComposite(tmpl, 1, tc.all, tc.scheduled, newServices, newConsulKV)
+			n.Override()
+
+			for ix, d := range tc.deps {
+				actual := n.Notify(d)
+				assert.Equal(t, tc.expected[ix], actual, "dependency %d", ix)
+			}
+			tmpl.AssertNumberOfCalls(t, "Notify", tc.tmplCalls)
+		})
+	}
+
+	t.Run("once-mode", func(t *testing.T) {
+		// Test that notifier notifies at the end of once-mode and that the
+		// conditions triggered during once-mode are reset
+
+		// Notifier in test will have two dependencies
+		// 1. receive services dependency, no notification for 'all' condition
+		// 2. receive consul-kv dependency, notify because once-mode complete
+		// 3. receive services dependency, no notification since the consul-kv
+		// condition was reset
+
+		tmpl := new(mocks.Template)
+		tmpl.On("Notify", mock.Anything).Return(true)
+		n := NewComposite(tmpl, 2, true, false, newServices, newConsulKV)
+
+		// 1. services dependency does not notify
+		notify := n.Notify([]*dep.HealthService{})
+		assert.False(t, notify, "services dep should not have notified")
+		assert.False(t, n.once, "got 1/2 deps. once-mode should not be completed")
+
+		// 2. consul-kv dependency notifies
+		notify = n.Notify(&dep.KeyPair{Key: "key"})
+		assert.True(t, notify, "consul-kv dep should have notified")
+		assert.True(t, n.once, "got 2/2 deps. once-mode should be completed")
+
+		// 3. services dependency does not notify
+		notify = n.Notify([]*dep.HealthService{})
+		assert.False(t, notify, "services dep should not have notified")
+	})
+}
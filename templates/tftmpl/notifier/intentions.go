@@ -0,0 +1,96 @@
+package notifier
+
+import (
+	"sync"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/templates"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
+)
+
+const intentionsSubsystemName = "intentions"
+
+// Intentions is a custom notifier expected to be used for a template that
+// contains the intentions template function.
+//
+// This notifier only notifies on changes to Consul intentions and once-mode.
+// It suppresses notifications for changes to other tmplfuncs.
+type Intentions struct {
+	templates.Template
+	logger logging.Logger
+
+	// count all tmplfuncs needed to complete once-mode
+	once    bool
+	tfTotal int
+	counter int
+
+	mu sync.RWMutex
+}
+
+func (n *Intentions) Override() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !n.once {
+		n.once = true
+	}
+}
+
+// NewIntentions creates a new Intentions notifier.
+//
+// tmplFuncTotal param: the total number of monitored tmplFuncs in the template.
+// This is the number of monitored tmplfuncs needed for both the intentions
+// condition and any module inputs. This number is equivalent to the number of
+// hashicat dependencies.
+func NewIntentions(tmpl templates.Template, tmplFuncTotal int) *Intentions {
+	logger := logging.Global().Named(logSystemName).Named(intentionsSubsystemName)
+	logger.Trace("creating notifier", "type", intentionsSubsystemName,
+		"tmpl_func_total", tmplFuncTotal)
+
+	return &Intentions{
+		Template: tmpl,
+		tfTotal:  tmplFuncTotal,
+		logger:   logger,
+	}
+}
+
+// Notify notifies when the intentions between the monitored services change.
+//
+// Notifications are sent when:
+// A. There is a change in the intentions dependency ([]*tmplfunc.Intention)
+// B. All the dependencies have been received for the first time. This is
+//    regardless of the dependency type that "completes" having received all the
+//    dependencies.
+//
+// Notification are suppressed when:
+//  - Other types of dependencies that are not intentions. For example,
+//    Services ([]*dep.HealthService).
+func (n *Intentions) Notify(d interface{}) (notify bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	logDependency(n.logger, d)
+	notify = false
+
+	if !n.once {
+		n.counter++
+		// after a dependency is received for each tmplfunc, send notification
+		// so that once-mode can complete
+		if n.counter >= n.tfTotal {
+			n.logger.Debug("notify once-mode complete")
+			n.once = true
+			notify = true
+		}
+	}
+
+	if _, ok := d.([]*tmplfunc.Intention); ok {
+		n.logger.Debug("notify intentions change")
+		notify = true
+	}
+
+	if notify {
+		n.Template.Notify(d)
+	}
+
+	return notify
+}
@@ -0,0 +1,80 @@
+package notifier
+
+import (
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/templates"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Intentions_Notify(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		dep      interface{}
+		expected bool
+	}{
+		{
+			"don't notify: other type of change",
+			[]*dep.HealthService{},
+			false,
+		},
+		{
+			"notify: intentions",
+			[]*tmplfunc.Intention{{ID: "ixn", SourceName: "web", DestinationName: "db"}},
+			true,
+		},
+		{
+			"notify: no intentions",
+			[]*tmplfunc.Intention{},
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpl := new(mocks.Template)
+			tmpl.On("Notify", mock.Anything).Return(true)
+
+			n := Intentions{Template: tmpl, once: true, logger: logging.NewNullLogger()}
+			actual := n.Notify(tc.dep)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_Intentions_Notify_Once_Mode(t *testing.T) {
+	// Test that notifier notifies at the end of once-mode when the services
+	// dependency (which normally does not notify) is received last.
+
+	// Notifier has 2 dependencies: 1 intentions and 1 services
+	// 1. receive intentions dependency, notify for intentions
+	// 2. receive services dependency, notify for once-mode
+
+	tmpl := new(mocks.Template)
+	tmpl.On("Notify", mock.Anything).Return(true).Twice()
+	n := NewIntentions(tmpl, 2)
+
+	// 1. intentions notifies
+	notify := n.Notify([]*tmplfunc.Intention{})
+	assert.True(t, notify, "intentions dep should have notified")
+	assert.False(t, n.once, "got 1/2 deps. once-mode should not be completed")
+	assert.Equal(t, 1, n.counter, "intentions dep should be 1st dep")
+
+	// 2. services notifies to complete once-mode
+	notify = n.Notify([]*dep.HealthService{})
+	assert.True(t, notify, "services dep should have notified")
+	assert.True(t, n.once, "got 2/2 deps. once-mode should be completed")
+	assert.Equal(t, 2, n.counter, "services dep should be 2nd dep")
+
+	// services do not notify after once-mode
+	notify = n.Notify([]*dep.HealthService{})
+	assert.False(t, notify, "services dep should not have notified")
+
+	tmpl.AssertExpectations(t)
+}
@@ -0,0 +1,96 @@
+package notifier
+
+import (
+	"sync"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/templates"
+	"github.com/hashicorp/hcat/dep"
+)
+
+const nodesSubsystemName = "nodes"
+
+// Nodes is a custom notifier expected to be used for a template that
+// contains the nodes template function.
+//
+// This notifier only notifies on changes to Consul catalog nodes and once-mode.
+// It suppresses notifications for changes to other tmplfuncs.
+type Nodes struct {
+	templates.Template
+	logger logging.Logger
+
+	// count all tmplfuncs needed to complete once-mode
+	once    bool
+	tfTotal int
+	counter int
+
+	mu sync.RWMutex
+}
+
+func (n *Nodes) Override() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !n.once {
+		n.once = true
+	}
+}
+
+// NewNodes creates a new Nodes notifier.
+//
+// tmplFuncTotal param: the total number of monitored tmplFuncs in the template.
+// This is the number of monitored tmplfuncs needed for both the nodes
+// condition and any module inputs. This number is equivalent to the number of
+// hashicat dependencies.
+func NewNodes(tmpl templates.Template, tmplFuncTotal int) *Nodes {
+	logger := logging.Global().Named(logSystemName).Named(nodesSubsystemName)
+	logger.Trace("creating notifier", "type", nodesSubsystemName,
+		"tmpl_func_total", tmplFuncTotal)
+
+	return &Nodes{
+		Template: tmpl,
+		tfTotal:  tmplFuncTotal,
+		logger:   logger,
+	}
+}
+
+// Notify notifies when the monitored catalog nodes change.
+//
+// Notifications are sent when:
+// A. There is a change in the nodes dependency ([]*dep.Node)
+// B. All the dependencies have been received for the first time. This is
+//    regardless of the dependency type that "completes" having received all the
+//    dependencies.
+//
+// Notification are suppressed when:
+//  - Other types of dependencies that are not nodes. For example,
+//    Services ([]*dep.HealthService).
+func (n *Nodes) Notify(d interface{}) (notify bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	logDependency(n.logger, d)
+	notify = false
+
+	if !n.once {
+		n.counter++
+		// after a dependency is received for each tmplfunc, send notification
+		// so that once-mode can complete
+		if n.counter >= n.tfTotal {
+			n.logger.Debug("notify once-mode complete")
+			n.once = true
+			notify = true
+		}
+	}
+
+	if _, ok := d.([]*dep.Node); ok {
+		n.logger.Debug("notify nodes change")
+		notify = true
+	}
+
+	if notify {
+		n.Template.Notify(d)
+	}
+
+	return notify
+}
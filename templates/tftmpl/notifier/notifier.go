@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
 	"github.com/hashicorp/hcat/dep"
 )
 
@@ -32,6 +33,13 @@ func logDependency(logger logging.Logger, dependency interface{}) {
 		}
 		logger.Debug("received dependency",
 			"variable", "services", "ids", serviceIDs)
+	case []*tmplfunc.HealthService:
+		serviceIDs := make([]string, len(d))
+		for ix, hs := range d {
+			serviceIDs[ix] = hs.ID
+		}
+		logger.Debug("received dependency",
+			"variable", "services", "ids", serviceIDs)
 	case []*dep.CatalogSnippet:
 		serviceNames := make([]string, len(d))
 		for ix, hs := range d {
@@ -50,6 +58,34 @@ func logDependency(logger logging.Logger, dependency interface{}) {
 		}
 		logger.Debug("received dependency",
 			"variable", "consul_kv", "recurse", true, "keys", keys)
+	case []*tmplfunc.Intention:
+		ids := make([]string, len(d))
+		for ix, ixn := range d {
+			ids[ix] = ixn.ID
+		}
+		logger.Debug("received dependency",
+			"variable", "intentions", "ids", ids)
+	case []*dep.Node:
+		names := make([]string, len(d))
+		for ix, node := range d {
+			names[ix] = node.Node
+		}
+		logger.Debug("received dependency",
+			"variable", "nodes", "names", names)
+	case []*tmplfunc.ConfigEntry:
+		names := make([]string, len(d))
+		for ix, entry := range d {
+			names[ix] = entry.Name
+		}
+		logger.Debug("received dependency",
+			"variable", "config_entries", "names", names)
+	case []*tmplfunc.VaultSecret:
+		paths := make([]string, len(d))
+		for ix, secret := range d {
+			paths[ix] = secret.Path
+		}
+		logger.Debug("received dependency",
+			"variable", "vault_secrets", "paths", paths)
 	default:
 		logger.Debug("received unknown dependency",
 			"variable", fmt.Sprintf("%T", dependency))
@@ -0,0 +1,80 @@
+package notifier
+
+import (
+	"sync"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/templates"
+)
+
+const onChangeSubsystemName = "on-change"
+
+// OnChange is a custom notifier expected to be used for scheduled tasks that
+// are also triggered by changes to their module inputs, i.e. a schedule
+// condition configured with on_change_interval.
+//
+// The notifier notifies on every dependency change. The controller is
+// responsible for rate-limiting how often the notifications trigger the task.
+type OnChange struct {
+	templates.Template
+	logger logging.Logger
+
+	// count all dependencies needed to complete once-mode
+	once    bool
+	tfTotal int
+	counter int
+
+	mu sync.RWMutex
+}
+
+func (n *OnChange) Override() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !n.once {
+		n.once = true
+	}
+}
+
+// NewOnChange creates a new OnChange notifier.
+//
+// tmplFuncTotal param: the total number of monitored tmplFuncs in the template.
+// This is the number of monitored tmplfuncs needed for the scheduled task's
+// module inputs. This number is equivalent to the number of hashicat dependencies
+func NewOnChange(tmpl templates.Template, tmplFuncTotal int) *OnChange {
+	logger := logging.Global().Named(logSystemName).Named(onChangeSubsystemName)
+	logger.Trace("creating notifier", "type", onChangeSubsystemName,
+		"tmpl_func_total", tmplFuncTotal)
+
+	return &OnChange{
+		Template: tmpl,
+		tfTotal:  tmplFuncTotal,
+		logger:   logger,
+	}
+}
+
+// Notify notifies on any dependency change once all the dependencies have been
+// received for the first time. During once-mode, it only notifies when the
+// last of the dependencies is received in order to complete once-mode.
+func (n *OnChange) Notify(d interface{}) (notify bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	logDependency(n.logger, d)
+	notify = true
+
+	if !n.once {
+		n.counter++
+		notify = false
+		// after a dependency for each tmplfunc is received, send notification
+		// so that once-mode can complete
+		if n.counter >= n.tfTotal {
+			n.logger.Debug("notify once-mode complete")
+			n.once = true
+			notify = true
+		}
+	}
+
+	n.Template.Notify(d)
+	return notify
+}
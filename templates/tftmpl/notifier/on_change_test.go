@@ -0,0 +1,56 @@
+package notifier
+
+import (
+	"testing"
+
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/templates"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_OnChange_Notify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		tmpl := new(mocks.Template)
+		tmpl.On("Notify", mock.Anything).Return(true)
+
+		n := NewOnChange(tmpl, 2)
+		n.Override()
+
+		assert.True(t, n.Notify([]*dep.HealthService{}))
+		assert.True(t, n.Notify(&dep.KeyPair{Key: "key"}))
+		tmpl.AssertNumberOfCalls(t, "Notify", 2)
+	})
+
+	t.Run("once-mode", func(t *testing.T) {
+		// Test that notifier notifies when once-mode completes and on every
+		// dependency after
+
+		// Notifier in test will have two dependencies
+		// 1. receive 1st services dependency, no notification
+		// 2. receive 2nd services dependency, notify because once-mode complete
+		// 3. receive subsequent dependencies, notify on each
+
+		tmpl := new(mocks.Template)
+		tmpl.On("Notify", mock.Anything).Return(true)
+		n := NewOnChange(tmpl, 2)
+
+		// 1. first services dependency does not notify
+		notify := n.Notify([]*dep.HealthService{})
+		assert.False(t, notify, "first services dep should not have notified")
+		assert.False(t, n.once, "got 1/2 deps. once-mode should not be completed")
+
+		// 2. second services dependency should notify
+		notify = n.Notify([]*dep.HealthService{})
+		assert.True(t, notify, "second service dep should have notified")
+		assert.True(t, n.once, "got 2/2 deps. once-mode should be completed")
+
+		// 3. subsequent dependencies notify
+		notify = n.Notify([]*dep.HealthService{})
+		assert.True(t, notify, "third services dep should have notified")
+		notify = n.Notify(&dep.KeyPair{Key: "key"})
+		assert.True(t, notify, "consul-kv dep should have notified")
+	})
+}
@@ -1,25 +1,50 @@
 package notifier
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
 	"github.com/hashicorp/consul-terraform-sync/templates"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
+	consulapi "github.com/hashicorp/consul/api"
 	"github.com/hashicorp/hcat/dep"
 )
 
 const servicesSubsystemName = "services"
 
+const (
+	// triggerMembership only notifies when service instances are added or
+	// removed
+	triggerMembership = "membership"
+
+	// triggerHealthTransition only notifies when service instances are added
+	// or removed, or transition between passing and critical
+	triggerHealthTransition = "health_transition"
+)
+
 // Services is a custom notifier expected to be used for a template that
 // contains {{ service }} or {{ servicesRegex }} template function (tmplfuncs)
 // for the condition and any other tmplfuncs for module inputs
 //
 // This notifier only notifies on changes to services instances information and
 // once-mode. It suppresses notifications for changes to other tmplfuncs.
+//
+// The trigger can further limit notifications to changes in the membership of
+// the service instances or to the instances' transitions between passing and
+// critical health.
 type Services struct {
 	templates.Template
 	logger logging.Logger
 
+	// trigger is the type of services change to notify on. Defaults to any
+	// change when empty.
+	trigger string
+
+	// instances is the last received snapshot of the service instances mapped
+	// to their normalized health status. Only tracked for non-default triggers.
+	instances map[string]string
+
 	// count all tmplfuncs needed to complete once-mode
 	once    bool
 	tfTotal int
@@ -49,13 +74,26 @@ func (n *Services) Override() {
 // - services-name: len(services) tmplfuncs
 // - consul-kv: 1 tmplfunc
 func NewServices(tmpl templates.Template, tmplFuncTotal int) *Services {
+	return NewServicesWithTrigger(tmpl, tmplFuncTotal, "")
+}
+
+// NewServicesWithTrigger creates a new Services notifier that only notifies on
+// the type of services change configured by the trigger: "any", "membership",
+// or "health_transition". An empty trigger is equivalent to "any".
+//
+// Non-default triggers compare the snapshot of the service instances across
+// dependencies and require the template to contain a single services tmplfunc.
+func NewServicesWithTrigger(tmpl templates.Template, tmplFuncTotal int,
+	trigger string) *Services {
+
 	logger := logging.Global().Named(logSystemName).Named(servicesSubsystemName)
 	logger.Trace("creating notifier", "type", servicesSubsystemName,
-		"tmpl_func_total", tmplFuncTotal)
+		"tmpl_func_total", tmplFuncTotal, "trigger", trigger)
 
 	return &Services{
 		Template: tmpl,
 		tfTotal:  tmplFuncTotal,
+		trigger:  trigger,
 		logger:   logger,
 	}
 }
@@ -84,9 +122,11 @@ func (n *Services) Notify(d interface{}) (notify bool) {
 	}
 
 	// dependency for {{ servicesRegex }} or {{ service }}
-	if _, ok := d.([]*dep.HealthService); ok {
-		n.logger.Debug("notify services change")
-		notify = true
+	if services, ok := healthServices(d); ok {
+		if n.servicesChanged(services) {
+			n.logger.Debug("notify services change")
+			notify = true
+		}
 	}
 
 	// let the template know that its dependencies have updated so that it will
@@ -95,3 +135,83 @@ func (n *Services) Notify(d interface{}) (notify bool) {
 
 	return notify
 }
+
+// servicesChanged returns whether the service instances have changed in a way
+// that is relevant to the notifier's trigger. The snapshot of the instances is
+// updated for triggers that compare against the previous dependency.
+func (n *Services) servicesChanged(services []*tmplfunc.HealthService) bool {
+	var includeStatus bool
+	switch n.trigger {
+	case triggerMembership:
+	case triggerHealthTransition:
+		includeStatus = true
+	default:
+		return true
+	}
+
+	instances := make(map[string]string, len(services))
+	for _, s := range services {
+		status := ""
+		if includeStatus {
+			status = normalizeHealthStatus(s.Status)
+		}
+		instances[instanceKey(s)] = status
+	}
+
+	// the first dependency establishes the snapshot
+	prev := n.instances
+	n.instances = instances
+	if prev == nil {
+		return true
+	}
+
+	if len(prev) != len(instances) {
+		return true
+	}
+	for k, status := range instances {
+		prevStatus, ok := prev[k]
+		if !ok || prevStatus != status {
+			return true
+		}
+	}
+
+	n.logger.Trace("suppressing services change", "trigger", n.trigger)
+	return false
+}
+
+// healthServices returns the service instances of a dependency for
+// {{ servicesRegex }} or {{ service }}. Services of {{ service }} do not have
+// an admin partition or cluster peer.
+func healthServices(d interface{}) ([]*tmplfunc.HealthService, bool) {
+	switch v := d.(type) {
+	case []*tmplfunc.HealthService:
+		return v, true
+	case []*dep.HealthService:
+		services := make([]*tmplfunc.HealthService, len(v))
+		for ix, s := range v {
+			services[ix] = &tmplfunc.HealthService{HealthService: *s}
+		}
+		return services, true
+	default:
+		return nil, false
+	}
+}
+
+// instanceKey uniquely identifies a service instance across nodes,
+// namespaces, admin partitions, cluster peers, and datacenters
+func instanceKey(s *tmplfunc.HealthService) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s/%s", s.Name, s.ID, s.Node,
+		s.Namespace, s.Partition, s.Peer, s.NodeDatacenter)
+}
+
+// normalizeHealthStatus reduces the aggregated health status of a service
+// instance to either critical or passing. Maintenance mode is considered
+// critical and warning is considered passing.
+func normalizeHealthStatus(status string) string {
+	switch status {
+	case consulapi.HealthCritical, consulapi.HealthMaint:
+		return consulapi.HealthCritical
+	default:
+		return consulapi.HealthPassing
+	}
+}
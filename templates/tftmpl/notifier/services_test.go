@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
 	mocks "github.com/hashicorp/consul-terraform-sync/mocks/templates"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
 	"github.com/hashicorp/hcat/dep"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -23,6 +24,11 @@ func Test_Services_Notify_DaemonMode(t *testing.T) {
 			[]*dep.HealthService{},
 			true,
 		},
+		{
+			"notify: services regex dep",
+			[]*tmplfunc.HealthService{},
+			true,
+		},
 		{
 			"don't notify: non-services dep",
 			&dep.KeyPair{Key: "k", Value: "v"},
@@ -101,3 +107,113 @@ func Test_Services_Notify_OnceMode(t *testing.T) {
 		assert.Equal(t, 2, n.counter, "services dep should be 2nd dep")
 	})
 }
+
+func Test_Services_Notify_Trigger(t *testing.T) {
+	t.Parallel()
+
+	api := func(node, status string) *dep.HealthService {
+		return &dep.HealthService{Name: "api", ID: "api", Node: node, Status: status}
+	}
+
+	cases := []struct {
+		name    string
+		trigger string
+		deps    [][]*dep.HealthService
+		notify  []bool
+	}{
+		{
+			"any: notifies on every change",
+			"any",
+			[][]*dep.HealthService{
+				{api("n1", "passing")},
+				{api("n1", "passing")},
+				{api("n1", "warning")},
+			},
+			[]bool{true, true, true},
+		},
+		{
+			"membership: instance added and removed",
+			"membership",
+			[][]*dep.HealthService{
+				{api("n1", "passing")},
+				{api("n1", "passing"), api("n2", "passing")},
+				{api("n2", "passing")},
+			},
+			[]bool{true, true, true},
+		},
+		{
+			"membership: suppresses health changes",
+			"membership",
+			[][]*dep.HealthService{
+				{api("n1", "passing")},
+				{api("n1", "critical")},
+				{api("n1", "passing")},
+			},
+			[]bool{true, false, false},
+		},
+		{
+			"health_transition: passing and critical transitions",
+			"health_transition",
+			[][]*dep.HealthService{
+				{api("n1", "passing")},
+				{api("n1", "critical")},
+				{api("n1", "maintenance")},
+				{api("n1", "passing")},
+			},
+			[]bool{true, true, false, true},
+		},
+		{
+			"health_transition: suppresses warning and other changes",
+			"health_transition",
+			[][]*dep.HealthService{
+				{api("n1", "passing")},
+				{api("n1", "warning")},
+				{{Name: "api", ID: "api", Node: "n1", Status: "passing", Port: 8080}},
+			},
+			[]bool{true, false, false},
+		},
+		{
+			"health_transition: instance added",
+			"health_transition",
+			[][]*dep.HealthService{
+				{api("n1", "passing")},
+				{api("n1", "passing"), api("n2", "critical")},
+			},
+			[]bool{true, true},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpl := new(mocks.Template)
+			tmpl.On("Notify", mock.Anything).Return(true)
+
+			n := NewServicesWithTrigger(tmpl, 1, tc.trigger)
+			for i, d := range tc.deps {
+				actual := n.Notify(d)
+				assert.Equal(t, tc.notify[i], actual, "dependency %d", i)
+			}
+		})
+	}
+}
+
+func Test_Services_Notify_Trigger_Peer(t *testing.T) {
+	t.Parallel()
+
+	api := func(peer string) *tmplfunc.HealthService {
+		return &tmplfunc.HealthService{
+			HealthService: dep.HealthService{Name: "api", ID: "api", Node: "n1"},
+			Peer:          peer,
+		}
+	}
+
+	tmpl := new(mocks.Template)
+	tmpl.On("Notify", mock.Anything).Return(true)
+
+	n := NewServicesWithTrigger(tmpl, 1, "membership")
+	assert.True(t, n.Notify([]*tmplfunc.HealthService{api("")}))
+	assert.False(t, n.Notify([]*tmplfunc.HealthService{api("")}))
+
+	// same instance imported from a cluster peer is a different instance
+	assert.True(t, n.Notify([]*tmplfunc.HealthService{api("cluster-02")}))
+}
@@ -0,0 +1,68 @@
+package notifier
+
+import (
+	"sync"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/templates"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
+)
+
+const vaultSecretsSubsystemName = "vault-secrets"
+
+// VaultSecrets is a custom notifier expected to be used for a template that
+// contains the {{ vaultSecrets }} template function (tmplfunc) for a module
+// input. It wraps the notifier of the task's condition.
+//
+// This notifier notifies on the condition notifier's notifications, and also
+// when the Vault secrets rotate so that the task runs with the new values. The
+// first Vault secrets dependency is handled by the condition notifier's
+// once-mode.
+type VaultSecrets struct {
+	templates.Template
+	logger logging.Logger
+
+	received bool
+
+	mu sync.Mutex
+}
+
+// NewVaultSecrets creates a new VaultSecrets notifier that wraps the notifier
+// of the task's condition.
+func NewVaultSecrets(tmpl templates.Template) *VaultSecrets {
+	logger := logging.Global().Named(logSystemName).Named(vaultSecretsSubsystemName)
+	logger.Trace("creating notifier", "type", vaultSecretsSubsystemName)
+
+	return &VaultSecrets{
+		Template: tmpl,
+		logger:   logger,
+	}
+}
+
+// Override overrides the once-mode of the condition notifier
+func (n *VaultSecrets) Override() {
+	if o, ok := n.Template.(Overrider); ok {
+		o.Override()
+	}
+}
+
+// Notify notifies when the condition notifier notifies, or when the Vault
+// secrets change after they were first received.
+func (n *VaultSecrets) Notify(d interface{}) (notify bool) {
+	notify = n.Template.Notify(d)
+
+	if _, ok := d.([]*tmplfunc.VaultSecret); !ok {
+		return notify
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.received && !notify {
+		n.logger.Debug("notify vault secrets change")
+		notify = true
+	}
+	n.received = true
+
+	return notify
+}
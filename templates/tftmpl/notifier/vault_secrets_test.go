@@ -0,0 +1,58 @@
+package notifier
+
+import (
+	"testing"
+
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/templates"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_VaultSecrets_Notify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("condition notifies", func(t *testing.T) {
+		tmpl := new(mocks.Template)
+		tmpl.On("Notify", mock.Anything).Return(true)
+		n := NewVaultSecrets(NewServices(tmpl, 2))
+
+		// once-mode completes with the condition notifier
+		assert.False(t, n.Notify(&dep.KeyPair{Key: "key"}))
+		assert.True(t, n.Notify([]*tmplfunc.VaultSecret{}))
+
+		// services changes notify
+		assert.True(t, n.Notify([]*dep.HealthService{}))
+		tmpl.AssertNumberOfCalls(t, "Notify", 3)
+	})
+
+	t.Run("secrets rotate", func(t *testing.T) {
+		tmpl := new(mocks.Template)
+		tmpl.On("Notify", mock.Anything).Return(true)
+		n := NewVaultSecrets(NewServices(tmpl, 2))
+
+		// first secrets dependency does not notify before once-mode completes
+		assert.False(t, n.Notify([]*tmplfunc.VaultSecret{}))
+		assert.True(t, n.Notify(&dep.KeyPair{Key: "key"}))
+
+		// condition notifier suppresses other module inputs
+		assert.False(t, n.Notify(&dep.KeyPair{Key: "key"}))
+
+		// rotated secrets notify
+		assert.True(t, n.Notify([]*tmplfunc.VaultSecret{}))
+		tmpl.AssertNumberOfCalls(t, "Notify", 4)
+	})
+}
+
+func Test_VaultSecrets_Override(t *testing.T) {
+	t.Parallel()
+
+	tmpl := new(mocks.Template)
+	tmpl.On("Notify", mock.Anything).Return(true)
+	services := NewServices(tmpl, 2)
+	n := NewVaultSecrets(services)
+
+	n.Override()
+	assert.True(t, services.once)
+}
@@ -108,6 +108,10 @@ type RootModuleInputData struct {
 	Variables        hcltmpl.Variables
 	Templates        []Template
 
+	// RequiredVersion is the version constraint pinned to the root module.
+	// Defaults to TerraformRequiredVersion when empty.
+	RequiredVersion string
+
 	Path      string
 	FilePerms os.FileMode
 
@@ -121,6 +125,10 @@ type RootModuleInputData struct {
 // converts the RootModuleInputData values into HCL objects compatible for
 // Terraform configuration syntax.
 func (d *RootModuleInputData) init() {
+	if d.RequiredVersion == "" {
+		d.RequiredVersion = TerraformRequiredVersion
+	}
+
 	if d.Backend != nil {
 		block := hcltmpl.NewNamedBlock(d.Backend)
 		d.backend = &block
@@ -206,7 +214,7 @@ func newMainTF(w io.Writer, filename string, input *RootModuleInputData) error {
 	hclFile := hclwrite.NewEmptyFile()
 	rootBody := hclFile.Body()
 	rootBody.AppendNewline()
-	appendRootTerraformBlock(rootBody, input.backend, input.ProviderInfo)
+	appendRootTerraformBlock(rootBody, input.RequiredVersion, input.backend, input.ProviderInfo)
 	rootBody.AppendNewline()
 	appendRootProviderBlocks(rootBody, input.Providers)
 	rootBody.AppendNewline()
@@ -221,12 +229,12 @@ func newMainTF(w io.Writer, filename string, input *RootModuleInputData) error {
 
 // appendRootTerraformBlock appends the Terraform block with version constraint
 // and backend.
-func appendRootTerraformBlock(body *hclwrite.Body, backend *hcltmpl.NamedBlock,
-	providerInfo map[string]interface{}) {
+func appendRootTerraformBlock(body *hclwrite.Body, requiredVersion string,
+	backend *hcltmpl.NamedBlock, providerInfo map[string]interface{}) {
 
 	tfBlock := body.AppendNewBlock("terraform", nil)
 	tfBody := tfBlock.Body()
-	tfBody.SetAttributeValue("required_version", cty.StringVal(TerraformRequiredVersion))
+	tfBody.SetAttributeValue("required_version", cty.StringVal(requiredVersion))
 
 	if len(providerInfo) != 0 {
 		requiredProvidersBody := tfBody.AppendNewBlock("required_providers", nil).Body()
@@ -249,6 +257,25 @@ func appendRootTerraformBlock(body *hclwrite.Body, backend *hcltmpl.NamedBlock,
 	}
 }
 
+// internalProviderAttrs are the provider specific arguments, by provider
+// name, that configure post-apply handlers and are not provider arguments.
+var internalProviderAttrs = map[string][]string{
+	"fortios":      {"install_package"},
+	"fortimanager": {"install_package"},
+	"bigip":        {"device_group"},
+}
+
+// isInternalProviderAttr returns whether the attribute of the provider is an
+// internal handler setting.
+func isInternalProviderAttr(provider, attr string) bool {
+	for _, a := range internalProviderAttrs[provider] {
+		if a == attr {
+			return true
+		}
+	}
+	return false
+}
+
 // appendRootProviderBlocks appends Terraform provider blocks for the providers
 // the task requires.
 func appendRootProviderBlocks(body *hclwrite.Body, providers []hcltmpl.NamedBlock) {
@@ -270,8 +297,8 @@ func appendRootProviderBlocks(body *hclwrite.Body, providers []hcltmpl.NamedBloc
 			if attr == "alias" {
 				continue
 			}
-			// auto_commit is an internal setting
-			if attr == "auto_commit" {
+			// auto_commit and provider specific handler settings are internal
+			if attr == "auto_commit" || isInternalProviderAttr(p.Name, attr) {
 				continue
 			}
 
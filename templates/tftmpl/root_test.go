@@ -103,7 +103,7 @@ func TestAppendRootTerraformBlock_backend(t *testing.T) {
 				b := hcltmpl.NewNamedBlock(tc.rawBackend)
 				backend = &b
 			}
-			appendRootTerraformBlock(body, backend, nil)
+			appendRootTerraformBlock(body, TerraformRequiredVersion, backend, nil)
 
 			content := hclFile.Bytes()
 			content = hclwrite.Format(content)
@@ -112,6 +112,17 @@ func TestAppendRootTerraformBlock_backend(t *testing.T) {
 	}
 }
 
+func TestAppendRootTerraformBlock_requiredVersion(t *testing.T) {
+	hclFile := hclwrite.NewEmptyFile()
+	appendRootTerraformBlock(hclFile.Body(), ">= 1.6.0, < 1.9.0", nil, nil)
+
+	expected := `terraform {
+  required_version = ">= 1.6.0, < 1.9.0"
+}
+`
+	assert.Equal(t, expected, string(hclwrite.Format(hclFile.Bytes())))
+}
+
 func TestAppendRootProviderBlocks(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -145,6 +156,40 @@ func TestAppendRootProviderBlocks(t *testing.T) {
 			}},
 			`provider "foo" {
 }
+`,
+		}, {
+			"internal install_package leak",
+			map[string]interface{}{"fortios": map[string]interface{}{
+				"install_package": "default",
+			}},
+			`provider "fortios" {
+}
+`,
+		}, {
+			"install_package for other provider",
+			map[string]interface{}{"foo": map[string]interface{}{
+				"install_package": "default",
+			}},
+			`provider "foo" {
+  install_package = var.foo.install_package
+}
+`,
+		}, {
+			"internal device_group leak",
+			map[string]interface{}{"bigip": map[string]interface{}{
+				"device_group": "ha-group",
+			}},
+			`provider "bigip" {
+}
+`,
+		}, {
+			"device_group for other provider",
+			map[string]interface{}{"foo": map[string]interface{}{
+				"device_group": "ha-group",
+			}},
+			`provider "foo" {
+  device_group = var.foo.device_group
+}
 `,
 		}, {
 			"invalid structure",
@@ -19,8 +19,14 @@ type CatalogServicesTemplate struct {
 	Regexp     string
 	Datacenter string
 	Namespace  string
+	Partition  string
+	Peer       string
 	NodeMeta   map[string]string
 
+	// Datacenters queries multiple datacenters and merges the tags of the
+	// services. Cannot be set with Datacenter.
+	Datacenters []string
+
 	// RenderVar informs whether the template should render the variable or not.
 	// Aligns with the task condition configuration `UseAsModuleInput``
 	RenderVar bool
@@ -78,10 +84,23 @@ func (t CatalogServicesTemplate) hcatQuery() string {
 		opts = append(opts, fmt.Sprintf("dc=%s", t.Datacenter))
 	}
 
+	if len(t.Datacenters) > 0 {
+		opts = append(opts, fmt.Sprintf("datacenters=%s",
+			strings.Join(t.Datacenters, ",")))
+	}
+
 	if t.Namespace != "" {
 		opts = append(opts, fmt.Sprintf("ns=%s", t.Namespace))
 	}
 
+	if t.Partition != "" {
+		opts = append(opts, fmt.Sprintf("partition=%s", t.Partition))
+	}
+
+	if t.Peer != "" {
+		opts = append(opts, fmt.Sprintf("peer=%s", t.Peer))
+	}
+
 	for k, v := range t.NodeMeta {
 		opts = append(opts, fmt.Sprintf("node-meta=%s:%s", k, v))
 	}
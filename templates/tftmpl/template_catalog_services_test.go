@@ -0,0 +1,48 @@
+package tftmpl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogServicesTemplate_hcatQuery(t *testing.T) {
+	testcase := []struct {
+		name string
+		c    *CatalogServicesTemplate
+		exp  string
+	}{
+		{
+			"empty",
+			&CatalogServicesTemplate{},
+			"",
+		},
+		{
+			"all_parameters",
+			&CatalogServicesTemplate{
+				Regexp:     ".*",
+				Datacenter: "dc2",
+				Namespace:  "test-ns",
+				Partition:  "ap1",
+				Peer:       "cluster-02",
+				NodeMeta:   map[string]string{"k": "v"},
+			},
+			`"regexp=.*" "dc=dc2" "ns=test-ns" "partition=ap1" "peer=cluster-02" "node-meta=k:v" `,
+		},
+		{
+			"datacenters",
+			&CatalogServicesTemplate{
+				Regexp:      ".*",
+				Datacenters: []string{"all"},
+			},
+			`"regexp=.*" "datacenters=all" `,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.c.hcatQuery()
+			assert.Equal(t, tc.exp, actual)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package tftmpl
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+var (
+	_ Template = (*ConfigEntriesTemplate)(nil)
+)
+
+// ConfigEntriesTemplate handles the template for the config_entries variable
+// for the template function: `{{ configEntries }}`
+type ConfigEntriesTemplate struct {
+	Kind       string
+	Names      []string
+	Datacenter string
+	Namespace  string
+}
+
+// IsServicesVar returns false because the template returns a config_entries
+// variable, not a services variable
+func (t ConfigEntriesTemplate) IsServicesVar() bool {
+	return false
+}
+
+// RendersVar returns true because config entries are only supported as a
+// module_input, which always renders the variable
+func (t ConfigEntriesTemplate) RendersVar() bool {
+	return true
+}
+
+func (t ConfigEntriesTemplate) appendModuleAttribute(body *hclwrite.Body) {
+	body.SetAttributeTraversal("config_entries", hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: "config_entries"},
+	})
+}
+
+func (t ConfigEntriesTemplate) appendTemplate(w io.Writer) error {
+	q := t.hcatQuery()
+
+	if _, err := fmt.Fprintf(w, configEntriesSetVarTmpl, q); err != nil {
+		err = fmt.Errorf("unable to write config entries template with variable, error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (t ConfigEntriesTemplate) appendVariable(w io.Writer) error {
+	_, err := w.Write(variableConfigEntries)
+	return err
+}
+
+func (t ConfigEntriesTemplate) hcatQuery() string {
+	opts := []string{fmt.Sprintf("kind=%s", t.Kind)}
+
+	for _, name := range t.Names {
+		opts = append(opts, fmt.Sprintf("name=%s", name))
+	}
+
+	if t.Datacenter != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", t.Datacenter))
+	}
+
+	if t.Namespace != "" {
+		opts = append(opts, fmt.Sprintf("ns=%s", t.Namespace))
+	}
+
+	return `"` + strings.Join(opts, `" "`) + `" ` // deliberate space at end
+}
+
+var configEntriesSetVarTmpl = fmt.Sprintf(`
+config_entries = [%s]
+`, configEntriesBaseTmpl)
+
+const configEntriesBaseTmpl = `
+{{- with $entries := configEntries %s}}
+  {{- range $entry := $entries }}
+  {
+    kind      = "{{ $entry.Kind }}"
+    name      = "{{ $entry.Name }}"
+    namespace = "{{ $entry.Namespace }}"
+    protocol  = "{{ $entry.Protocol }}"
+    meta = {
+      {{- range $k, $v := $entry.Meta }}
+      "{{ $k }}" = "{{ $v }}"
+      {{- end }}
+    }
+    services = [
+      {{- range $s := $entry.Services }}
+      {
+        name      = "{{ $s.Name }}"
+        namespace = "{{ $s.Namespace }}"
+        port      = {{ $s.Port }}
+        protocol  = "{{ $s.Protocol }}"
+        hosts     = [{{ range $i, $h := $s.Hosts }}{{ if $i }}, {{ end }}"{{ $h }}"{{ end }}]
+        ca_file   = "{{ $s.CAFile }}"
+        cert_file = "{{ $s.CertFile }}"
+        key_file  = "{{ $s.KeyFile }}"
+        sni       = "{{ $s.SNI }}"
+      },
+      {{- end }}
+    ]
+  },
+{{- end}}{{- end}}
+`
+
+// variableConfigEntries is required for modules that include config entries
+// information. It is versioned to track compatibility between the generated
+// root module and modules that include config entries.
+var variableConfigEntries = []byte(`
+# Config entries definition protocol v0
+variable "config_entries" {
+  description = "Consul config entries of the monitored kind. Fields that do not apply to the kind are empty."
+  type = list(object({
+    kind      = string
+    name      = string
+    namespace = string
+    protocol  = string
+    meta      = map(string)
+    services = list(object({
+      name      = string
+      namespace = string
+      port      = number
+      protocol  = string
+      hosts     = list(string)
+      ca_file   = string
+      cert_file = string
+      key_file  = string
+      sni       = string
+    }))
+  }))
+}
+`)
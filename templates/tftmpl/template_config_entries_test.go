@@ -0,0 +1,106 @@
+package tftmpl
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigEntriesTemplate_hcatQuery(t *testing.T) {
+	testcase := []struct {
+		name string
+		c    *ConfigEntriesTemplate
+		exp  string
+	}{
+		{
+			"kind",
+			&ConfigEntriesTemplate{
+				Kind: "service-defaults",
+			},
+			`"kind=service-defaults" `,
+		},
+		{
+			"all_parameters",
+			&ConfigEntriesTemplate{
+				Kind:       "ingress-gateway",
+				Names:      []string{"ingress", "edge"},
+				Datacenter: "dc2",
+				Namespace:  "test-ns",
+			},
+			`"kind=ingress-gateway" "name=ingress" "name=edge" "dc=dc2" "ns=test-ns" `,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.c.hcatQuery()
+			assert.Equal(t, tc.exp, actual)
+		})
+	}
+}
+
+func TestConfigEntriesTemplate_appendTemplate(t *testing.T) {
+	c := &ConfigEntriesTemplate{Kind: "terminating-gateway"}
+	assert.True(t, c.RendersVar())
+
+	w := new(strings.Builder)
+	require.NoError(t, c.appendTemplate(w))
+	assert.True(t, strings.HasPrefix(w.String(), `
+config_entries = [
+{{- with $entries := configEntries "kind=terminating-gateway" }}`))
+}
+
+func TestConfigEntriesTemplate_render(t *testing.T) {
+	// Render the template with stubbed config entries to check that the output
+	// is valid HCL
+	ct := &ConfigEntriesTemplate{Kind: "ingress-gateway"}
+	w := new(strings.Builder)
+	require.NoError(t, ct.appendTemplate(w))
+
+	tmpl, err := template.New("tfvars").Funcs(template.FuncMap{
+		"configEntries": func(opts ...string) ([]*tmplfunc.ConfigEntry, error) {
+			return []*tmplfunc.ConfigEntry{
+				{
+					Kind: "ingress-gateway",
+					Name: "ingress",
+					Meta: map[string]string{"env": "prod"},
+					Services: []tmplfunc.ConfigEntryService{
+						{
+							Name:     "web",
+							Port:     8080,
+							Protocol: "http",
+							Hosts:    []string{"web.example.com", "www.example.com"},
+						},
+						{
+							Name:     "db",
+							Port:     9090,
+							Protocol: "tcp",
+							Hosts:    []string{},
+						},
+					},
+				},
+				{
+					Kind:     "ingress-gateway",
+					Name:     "empty",
+					Meta:     map[string]string{},
+					Services: []tmplfunc.ConfigEntryService{},
+				},
+			}, nil
+		},
+	}).Parse(w.String())
+	require.NoError(t, err)
+
+	rendered := new(strings.Builder)
+	require.NoError(t, tmpl.Execute(rendered, nil))
+	assert.Contains(t, rendered.String(),
+		`hosts     = ["web.example.com", "www.example.com"]`)
+	assert.Contains(t, rendered.String(), `port      = 9090`)
+
+	_, diags := hclparse.NewParser().ParseHCL([]byte(rendered.String()), "terraform.tfvars")
+	assert.False(t, diags.HasErrors(), diags.Error())
+}
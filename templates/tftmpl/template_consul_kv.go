@@ -15,12 +15,19 @@ var (
 )
 
 // ConsulKVTemplate handles the template for the consul_kv variable for the
-// template functions: `{{ key }}` and `{{ keyExistsGet }}`
+// template functions: `{{ keys }}` and `{{ keyExistsGet }}`, or
+// `{{ consulKVList }}` and `{{ consulKVGet }}` for a KV path of an admin
+// partition
 type ConsulKVTemplate struct {
 	Path       string
 	Recurse    bool
 	Datacenter string
 	Namespace  string
+	Partition  string
+
+	// Decode is the format of the KV values, "json" or "yaml", to render the
+	// values as typed HCL values. Values are rendered as strings when empty.
+	Decode string
 
 	// RenderVar informs whether the template should render the variable or not.
 	// Aligns with the task condition configuration `UseAsModuleInput``
@@ -52,13 +59,14 @@ func (t ConsulKVTemplate) appendModuleAttribute(body *hclwrite.Body) {
 func (t ConsulKVTemplate) appendTemplate(w io.Writer) error {
 	logger := logging.Global().Named(logSystemName).Named(tftmplSubsystemName)
 	q := t.hcatQuery()
+	fn := t.hcatFunc()
 
 	if t.RenderVar {
 		var baseTmpl string
 		if t.Recurse {
-			baseTmpl = fmt.Sprintf(consulKVRecurseBaseTmpl, q)
+			baseTmpl = fmt.Sprintf(consulKVRecurseBaseTmpl, fn, q, t.valueTmpl())
 		} else {
-			baseTmpl = fmt.Sprintf(consulKVBaseTmpl, q)
+			baseTmpl = fmt.Sprintf(consulKVBaseTmpl, fn, q, t.valueTmpl())
 		}
 
 		if _, err := fmt.Fprintf(w, consulKVSetVarTmpl, baseTmpl); err != nil {
@@ -70,9 +78,9 @@ func (t ConsulKVTemplate) appendTemplate(w io.Writer) error {
 
 	var emptyTmpl string
 	if t.Recurse {
-		emptyTmpl = fmt.Sprintf(consulKVRecurseEmptyTmpl, q)
+		emptyTmpl = fmt.Sprintf(consulKVRecurseEmptyTmpl, fn, q)
 	} else {
-		emptyTmpl = fmt.Sprintf(consulKVEmptyTmpl, q)
+		emptyTmpl = fmt.Sprintf(consulKVEmptyTmpl, fn, q)
 	}
 	if _, err := w.Write([]byte(emptyTmpl)); err != nil {
 		logger.Error("unable to write consul-kv empty template", "error", err)
@@ -82,7 +90,11 @@ func (t ConsulKVTemplate) appendTemplate(w io.Writer) error {
 }
 
 func (t ConsulKVTemplate) appendVariable(w io.Writer) error {
-	_, err := w.Write(variableConsulKV)
+	v := variableConsulKV
+	if t.Decode != "" {
+		v = variableConsulKVDecoded
+	}
+	_, err := w.Write(v)
 	return err
 }
 
@@ -99,40 +111,71 @@ func (t ConsulKVTemplate) hcatQuery() string {
 		opts = append(opts, fmt.Sprintf("ns=%s", t.Namespace))
 	}
 
+	if t.Partition != "" {
+		opts = append(opts, fmt.Sprintf("partition=%s", t.Partition))
+	}
+
 	if len(opts) > 0 {
 		return `"` + strings.Join(opts, `" "`) + `"`
 	}
 	return ""
 }
 
+// valueTmpl returns the template to render the value of a KV pair. Decoded
+// values are rendered as HCL expressions, otherwise values are rendered as
+// strings.
+func (t ConsulKVTemplate) valueTmpl() string {
+	if t.Decode != "" {
+		return fmt.Sprintf(`{{ HCLDecode .Value %q }}`, t.Decode)
+	}
+	return `"{{ .Value }}"`
+}
+
+// hcatFunc returns the name of the template function for the query. hcat's KV
+// template functions do not support admin partitions, so the CTS template
+// functions are used when a partition is configured.
+func (t ConsulKVTemplate) hcatFunc() string {
+	if t.Partition == "" {
+		if t.Recurse {
+			return "keys"
+		}
+		return "keyExistsGet"
+	}
+
+	if t.Recurse {
+		return "consulKVList"
+	}
+	return "consulKVGet"
+}
+
 var consulKVSetVarTmpl = `
 consul_kv = {%s}
 `
 
 const consulKVBaseTmpl = `
-{{- with $kv := keyExistsGet %s }}
+{{- with $kv := %s %s }}
   {{- if .Exists }}
-  "{{ .Path }}" = "{{ .Value }}"
+  "{{ .Path }}" = %s
   {{- end}}
 {{- end}}
 `
 
 const consulKVRecurseBaseTmpl = `
-{{- with $kv := keys %s }}
+{{- with $kv := %s %s }}
   {{- range $k := $kv }}
-  "{{ .Path }}" = "{{ .Value }}"
+  "{{ .Path }}" = %s
   {{- end}}
 {{- end}}
 `
 
 const consulKVEmptyTmpl = `
-{{- with $kv := keyExistsGet %s }}
+{{- with $kv := %s %s }}
   {{- /* Empty template. Detects changes in Consul KV */ -}}
 {{- end}}
 `
 
 const consulKVRecurseEmptyTmpl = `
-{{- with $kv := keys %s }}
+{{- with $kv := %s %s }}
   {{- range $k, $v := $kv }}
   {{- /* Empty template. Detects changes in Consul KV */ -}}
   {{- end}}
@@ -149,3 +192,13 @@ variable "consul_kv" {
   type        = map(string)
 }
 `)
+
+// variableConsulKVDecoded is used instead of variableConsulKV when the values
+// of the Consul KV pairs are decoded. The values may be of any type.
+var variableConsulKVDecoded = []byte(`
+# Consul KV definition protocol v0
+variable "consul_kv" {
+  description = "Consul KV pair with decoded values"
+  type        = any
+}
+`)
@@ -27,8 +27,9 @@ func TestConsulKVTemplate_hcatQuery(t *testing.T) {
 				Path:       "key-path",
 				Datacenter: "dc2",
 				Namespace:  "test-ns",
+				Partition:  "ap1",
 			},
-			"\"key-path\" \"dc=dc2\" \"ns=test-ns\"",
+			"\"key-path\" \"dc=dc2\" \"ns=test-ns\" \"partition=ap1\"",
 		},
 	}
 
@@ -114,6 +115,58 @@ consul_kv = {
   {{- /* Empty template. Detects changes in Consul KV */ -}}
   {{- end}}
 {{- end}}
+`,
+		},
+		{
+			"partition recurse false & render var",
+			&ConsulKVTemplate{
+				Path:      "path",
+				Recurse:   false,
+				Partition: "ap1",
+				RenderVar: true,
+			},
+			`
+consul_kv = {
+{{- with $kv := consulKVGet "path" "partition=ap1" }}
+  {{- if .Exists }}
+  "{{ .Path }}" = "{{ .Value }}"
+  {{- end}}
+{{- end}}
+}
+`,
+		},
+		{
+			"decode json & render var",
+			&ConsulKVTemplate{
+				Path:      "path",
+				Recurse:   true,
+				Decode:    "json",
+				RenderVar: true,
+			},
+			`
+consul_kv = {
+{{- with $kv := keys "path" }}
+  {{- range $k := $kv }}
+  "{{ .Path }}" = {{ HCLDecode .Value "json" }}
+  {{- end}}
+{{- end}}
+}
+`,
+		},
+		{
+			"partition recurse true & no var",
+			&ConsulKVTemplate{
+				Path:      "path",
+				Recurse:   true,
+				Partition: "ap1",
+				RenderVar: false,
+			},
+			`
+{{- with $kv := consulKVList "path" "partition=ap1" }}
+  {{- range $k, $v := $kv }}
+  {{- /* Empty template. Detects changes in Consul KV */ -}}
+  {{- end}}
+{{- end}}
 `,
 		},
 	}
@@ -127,3 +180,31 @@ consul_kv = {
 		})
 	}
 }
+
+func TestConsulKVTemplate_appendVariable(t *testing.T) {
+	testcases := []struct {
+		name string
+		c    *ConsulKVTemplate
+		exp  []byte
+	}{
+		{
+			"string values",
+			&ConsulKVTemplate{Path: "path"},
+			variableConsulKV,
+		},
+		{
+			"decoded values",
+			&ConsulKVTemplate{Path: "path", Decode: "yaml"},
+			variableConsulKVDecoded,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := new(strings.Builder)
+			err := tc.c.appendVariable(w)
+			require.NoError(t, err)
+			assert.Equal(t, string(tc.exp), w.String())
+		})
+	}
+}
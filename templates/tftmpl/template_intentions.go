@@ -0,0 +1,147 @@
+package tftmpl
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+var (
+	_ Template = (*IntentionsTemplate)(nil)
+)
+
+// IntentionsTemplate handles the template for the intentions variable for the
+// template function: `{{ intentions }}`
+type IntentionsTemplate struct {
+	SourceNames       []string
+	SourceRegexp      string
+	DestinationNames  []string
+	DestinationRegexp string
+	Datacenter        string
+	Namespace         string
+
+	// RenderVar informs whether the template should render the variable or not.
+	// Aligns with the task condition configuration `UseAsModuleInput``
+	RenderVar bool
+}
+
+// IsServicesVar returns false because the template returns an intentions
+// variable, not a services variable
+func (t IntentionsTemplate) IsServicesVar() bool {
+	return false
+}
+
+func (t IntentionsTemplate) RendersVar() bool {
+	return t.RenderVar
+}
+
+func (t IntentionsTemplate) appendModuleAttribute(body *hclwrite.Body) {
+	body.SetAttributeTraversal("intentions", hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: "intentions"},
+	})
+}
+
+func (t IntentionsTemplate) appendTemplate(w io.Writer) error {
+	q := t.hcatQuery()
+
+	if t.RenderVar {
+		if _, err := fmt.Fprintf(w, intentionsSetVarTmpl, q); err != nil {
+			err = fmt.Errorf("unable to write intentions template with variable, error: %v", err)
+			return err
+		}
+		return nil
+	}
+
+	if _, err := fmt.Fprintf(w, intentionsEmptyTmpl, q); err != nil {
+		err = fmt.Errorf("unable to write intentions empty template, error %v", err)
+		return err
+	}
+	return nil
+}
+
+func (t IntentionsTemplate) appendVariable(w io.Writer) error {
+	_, err := w.Write(variableIntentions)
+	return err
+}
+
+func (t IntentionsTemplate) hcatQuery() string {
+	var opts []string
+
+	for _, name := range t.SourceNames {
+		opts = append(opts, fmt.Sprintf("source=%s", name))
+	}
+
+	if t.SourceRegexp != "" {
+		opts = append(opts, fmt.Sprintf("source-regexp=%s", t.SourceRegexp))
+	}
+
+	for _, name := range t.DestinationNames {
+		opts = append(opts, fmt.Sprintf("destination=%s", name))
+	}
+
+	if t.DestinationRegexp != "" {
+		opts = append(opts, fmt.Sprintf("destination-regexp=%s", t.DestinationRegexp))
+	}
+
+	if t.Datacenter != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", t.Datacenter))
+	}
+
+	if t.Namespace != "" {
+		opts = append(opts, fmt.Sprintf("ns=%s", t.Namespace))
+	}
+
+	if len(opts) > 0 {
+		return `"` + strings.Join(opts, `" "`) + `" ` // deliberate space at end
+	}
+	return ""
+}
+
+var intentionsSetVarTmpl = fmt.Sprintf(`
+intentions = [%s]
+`, intentionsBaseTmpl)
+
+const intentionsBaseTmpl = `
+{{- with $intentions := intentions %s}}
+  {{- range $ixn := $intentions }}
+  {
+    id                    = "{{ $ixn.ID }}"
+    source_name           = "{{ $ixn.SourceName }}"
+    source_namespace      = "{{ $ixn.SourceNamespace }}"
+    destination_name      = "{{ $ixn.DestinationName }}"
+    destination_namespace = "{{ $ixn.DestinationNamespace }}"
+    action                = "{{ $ixn.Action }}"
+    precedence            = {{ $ixn.Precedence }}
+  },
+{{- end}}{{- end}}
+`
+
+const intentionsEmptyTmpl = `
+{{- with $intentions := intentions %s}}
+  {{- range $ixn := $intentions }}
+    {{- /* Empty template. Detects changes in intentions */ -}}
+{{- end}}{{- end}}
+`
+
+// variableIntentions is required for modules that include intentions
+// information. It is versioned to track compatibility between the generated
+// root module and modules that include intentions.
+var variableIntentions = []byte(`
+# Intentions definition protocol v0
+variable "intentions" {
+  description = "Consul intentions between the monitored source and destination services, in order of precedence"
+  type = list(object({
+    id                    = string
+    source_name           = string
+    source_namespace      = string
+    destination_name      = string
+    destination_namespace = string
+    action                = string
+    precedence            = number
+  }))
+}
+`)
@@ -0,0 +1,147 @@
+package tftmpl
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntentionsTemplate_hcatQuery(t *testing.T) {
+	testcase := []struct {
+		name string
+		c    *IntentionsTemplate
+		exp  string
+	}{
+		{
+			"empty",
+			&IntentionsTemplate{},
+			"",
+		},
+		{
+			"names",
+			&IntentionsTemplate{
+				SourceNames:      []string{"web", "api"},
+				DestinationNames: []string{"db"},
+			},
+			`"source=web" "source=api" "destination=db" `,
+		},
+		{
+			"all_parameters",
+			&IntentionsTemplate{
+				SourceRegexp:      "^web",
+				DestinationRegexp: "^db",
+				Datacenter:        "dc2",
+				Namespace:         "test-ns",
+			},
+			`"source-regexp=^web" "destination-regexp=^db" "dc=dc2" "ns=test-ns" `,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.c.hcatQuery()
+			assert.Equal(t, tc.exp, actual)
+		})
+	}
+}
+
+func TestIntentionsTemplate_appendTemplate(t *testing.T) {
+	testcases := []struct {
+		name string
+		c    *IntentionsTemplate
+		exp  string
+	}{
+		{
+			"render var",
+			&IntentionsTemplate{
+				DestinationNames: []string{"db"},
+				RenderVar:        true,
+			},
+			`
+intentions = [
+{{- with $intentions := intentions "destination=db" }}
+  {{- range $ixn := $intentions }}
+  {
+    id                    = "{{ $ixn.ID }}"
+    source_name           = "{{ $ixn.SourceName }}"
+    source_namespace      = "{{ $ixn.SourceNamespace }}"
+    destination_name      = "{{ $ixn.DestinationName }}"
+    destination_namespace = "{{ $ixn.DestinationNamespace }}"
+    action                = "{{ $ixn.Action }}"
+    precedence            = {{ $ixn.Precedence }}
+  },
+{{- end}}{{- end}}
+]
+`,
+		},
+		{
+			"no var",
+			&IntentionsTemplate{
+				DestinationNames: []string{"db"},
+				RenderVar:        false,
+			},
+			`
+{{- with $intentions := intentions "destination=db" }}
+  {{- range $ixn := $intentions }}
+    {{- /* Empty template. Detects changes in intentions */ -}}
+{{- end}}{{- end}}
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := new(strings.Builder)
+			err := tc.c.appendTemplate(w)
+			require.NoError(t, err)
+			assert.Equal(t, tc.exp, w.String())
+		})
+	}
+}
+
+func TestIntentionsTemplate_render(t *testing.T) {
+	// Render the template with stubbed intentions to check that the output is
+	// valid HCL
+	it := &IntentionsTemplate{SourceRegexp: ".*", RenderVar: true}
+	w := new(strings.Builder)
+	require.NoError(t, it.appendTemplate(w))
+
+	tmpl, err := template.New("tfvars").Funcs(template.FuncMap{
+		"intentions": func(opts ...string) ([]*tmplfunc.Intention, error) {
+			return []*tmplfunc.Intention{
+				{
+					ID:                   "ixn-1",
+					SourceName:           "web",
+					SourceNamespace:      "default",
+					DestinationName:      "db",
+					DestinationNamespace: "default",
+					Action:               "allow",
+					Precedence:           9,
+				},
+				{
+					ID:                   "ixn-2",
+					SourceName:           "*",
+					SourceNamespace:      "default",
+					DestinationName:      "db",
+					DestinationNamespace: "default",
+					Action:               "deny",
+					Precedence:           8,
+				},
+			}, nil
+		},
+	}).Parse(w.String())
+	require.NoError(t, err)
+
+	rendered := new(strings.Builder)
+	require.NoError(t, tmpl.Execute(rendered, nil))
+	assert.Contains(t, rendered.String(), `source_name           = "web"`)
+	assert.Contains(t, rendered.String(), `precedence            = 8`)
+
+	_, diags := hclparse.NewParser().ParseHCL([]byte(rendered.String()), "terraform.tfvars")
+	assert.False(t, diags.HasErrors(), diags.Error())
+}
@@ -0,0 +1,150 @@
+package tftmpl
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/hcat/dep"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodesTemplate_hcatQuery(t *testing.T) {
+	testcase := []struct {
+		name string
+		c    *NodesTemplate
+		exp  string
+	}{
+		{
+			"empty",
+			&NodesTemplate{},
+			"",
+		},
+		{
+			"datacenter",
+			&NodesTemplate{
+				Datacenter: "dc2",
+			},
+			`"dc=dc2" `,
+		},
+		{
+			"all_parameters",
+			&NodesTemplate{
+				Datacenter: "dc2",
+				Filter:     `Meta.env == "production"`,
+			},
+			`"dc=dc2" "Meta.env == \"production\"" `,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.c.hcatQuery()
+			assert.Equal(t, tc.exp, actual)
+		})
+	}
+}
+
+func TestNodesTemplate_appendTemplate(t *testing.T) {
+	testcases := []struct {
+		name string
+		c    *NodesTemplate
+		exp  string
+	}{
+		{
+			"render var",
+			&NodesTemplate{
+				Datacenter: "dc2",
+				RenderVar:  true,
+			},
+			`
+nodes = [
+{{- with $nodes := catalogNodes "dc=dc2" }}
+  {{- range $node := $nodes }}
+  {
+    id         = "{{ $node.ID }}"
+    node       = "{{ $node.Node }}"
+    address    = "{{ $node.Address }}"
+    datacenter = "{{ $node.Datacenter }}"
+    tagged_addresses = {
+      {{- range $k, $v := $node.TaggedAddresses }}
+      "{{ $k }}" = "{{ $v }}"
+      {{- end }}
+    }
+    meta = {
+      {{- range $k, $v := $node.Meta }}
+      "{{ $k }}" = "{{ $v }}"
+      {{- end }}
+    }
+  },
+{{- end}}{{- end}}
+]
+`,
+		},
+		{
+			"no var",
+			&NodesTemplate{
+				Datacenter: "dc2",
+				RenderVar:  false,
+			},
+			`
+{{- with $nodes := catalogNodes "dc=dc2" }}
+  {{- range $node := $nodes }}
+    {{- /* Empty template. Detects changes in nodes */ -}}
+{{- end}}{{- end}}
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := new(strings.Builder)
+			err := tc.c.appendTemplate(w)
+			require.NoError(t, err)
+			assert.Equal(t, tc.exp, w.String())
+		})
+	}
+}
+
+func TestNodesTemplate_render(t *testing.T) {
+	// Render the template with stubbed nodes to check that the output is
+	// valid HCL
+	nt := &NodesTemplate{RenderVar: true}
+	w := new(strings.Builder)
+	require.NoError(t, nt.appendTemplate(w))
+
+	tmpl, err := template.New("tfvars").Funcs(template.FuncMap{
+		"catalogNodes": func(opts ...string) ([]*dep.Node, error) {
+			return []*dep.Node{
+				{
+					ID:         "node-id-1",
+					Node:       "node-a",
+					Address:    "10.0.0.1",
+					Datacenter: "dc1",
+					TaggedAddresses: map[string]string{
+						"lan": "10.0.0.1",
+						"wan": "192.168.0.1",
+					},
+					Meta: map[string]string{"env": "production"},
+				},
+				{
+					ID:         "node-id-2",
+					Node:       "node-b",
+					Address:    "10.0.0.2",
+					Datacenter: "dc1",
+				},
+			}, nil
+		},
+	}).Parse(w.String())
+	require.NoError(t, err)
+
+	rendered := new(strings.Builder)
+	require.NoError(t, tmpl.Execute(rendered, nil))
+	assert.Contains(t, rendered.String(), `node       = "node-a"`)
+	assert.Contains(t, rendered.String(), `"wan" = "192.168.0.1"`)
+
+	_, diags := hclparse.NewParser().ParseHCL([]byte(rendered.String()), "terraform.tfvars")
+	assert.False(t, diags.HasErrors(), diags.Error())
+}
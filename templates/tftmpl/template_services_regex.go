@@ -19,8 +19,18 @@ type ServicesRegexTemplate struct {
 	Regexp     string
 	Datacenter string
 	Namespace  string
+	Partition  string
+	Peer       string
 	Filter     string
 
+	// Datacenters queries multiple datacenters and merges the service
+	// instances. Cannot be set with Datacenter.
+	Datacenters []string
+
+	// IncludeNonPassing queries for service instances of any health status
+	// instead of only passing instances
+	IncludeNonPassing bool
+
 	// RenderVar informs whether the template should render the variable or not.
 	// Aligns with the task condition configuration `UseAsModuleInput``
 	RenderVar bool
@@ -69,10 +79,27 @@ func (t ServicesRegexTemplate) hcatQuery() string {
 		opts = append(opts, fmt.Sprintf("dc=%s", t.Datacenter))
 	}
 
+	if len(t.Datacenters) > 0 {
+		opts = append(opts, fmt.Sprintf("datacenters=%s",
+			strings.Join(t.Datacenters, ",")))
+	}
+
 	if t.Namespace != "" {
 		opts = append(opts, fmt.Sprintf("ns=%s", t.Namespace))
 	}
 
+	if t.Partition != "" {
+		opts = append(opts, fmt.Sprintf("partition=%s", t.Partition))
+	}
+
+	if t.Peer != "" {
+		opts = append(opts, fmt.Sprintf("peer=%s", t.Peer))
+	}
+
+	if t.IncludeNonPassing {
+		opts = append(opts, "status=any")
+	}
+
 	if t.Filter != "" {
 		filter := strings.ReplaceAll(t.Filter, `"`, `\"`)
 		filter = strings.Trim(filter, "\n")
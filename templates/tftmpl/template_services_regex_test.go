@@ -50,6 +50,26 @@ services = {
   {{- /* Empty template. Detects changes in Services */ -}}
   {{- end}}
 {{- end}}
+`,
+		},
+		{
+			"partition and peer & render var",
+			&ServicesRegexTemplate{
+				Regexp:    ".*",
+				Partition: "ap1",
+				Peer:      "cluster-02",
+				RenderVar: true,
+			},
+			`
+services = {
+{{- with $srv := servicesRegex "regexp=.*" "partition=ap1" "peer=cluster-02" }}
+  {{- range $s := $srv}}
+  "{{ joinStrings "." .ID .Node .Namespace .NodeDatacenter }}" = {
+{{ HCLService $s | indent 4 }}
+  },
+  {{- end}}
+{{- end}}
+}
 `,
 		},
 		{
@@ -108,6 +128,31 @@ func TestServicesRegexTemplate_hcatQuery(t *testing.T) {
 			},
 			`"regexp=.*" "dc=datacenter" "ns=namespace" "filter"`,
 		},
+		{
+			"include_non_passing",
+			&ServicesRegexTemplate{
+				Regexp:            "^api$",
+				IncludeNonPassing: true,
+			},
+			`"regexp=^api$" "status=any"`,
+		},
+		{
+			"datacenters",
+			&ServicesRegexTemplate{
+				Regexp:      ".*",
+				Datacenters: []string{"dc1", "dc2"},
+			},
+			`"regexp=.*" "datacenters=dc1,dc2"`,
+		},
+		{
+			"partition_and_peer",
+			&ServicesRegexTemplate{
+				Regexp:    ".*",
+				Partition: "ap1",
+				Peer:      "cluster-02",
+			},
+			`"regexp=.*" "partition=ap1" "peer=cluster-02"`,
+		},
 	}
 
 	for _, tc := range testcase {
@@ -0,0 +1,83 @@
+package tftmpl
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+var (
+	_ Template = (*VaultSecretsTemplate)(nil)
+)
+
+// VaultSecretsTemplate handles the template for the vault_secrets variable for
+// the template function: `{{ vaultSecrets }}`
+//
+// The secret values are never rendered. The template only renders the keys of
+// the secrets as comments, and the values are passed to Terraform as a
+// sensitive variable by the driver.
+type VaultSecretsTemplate struct {
+	Paths []string
+}
+
+// IsServicesVar returns false because the template returns a vault_secrets
+// variable, not a services variable
+func (t VaultSecretsTemplate) IsServicesVar() bool {
+	return false
+}
+
+// RendersVar returns true because vault secrets are only supported as a
+// module_input, which always renders the variable
+func (t VaultSecretsTemplate) RendersVar() bool {
+	return true
+}
+
+func (t VaultSecretsTemplate) appendModuleAttribute(body *hclwrite.Body) {
+	body.SetAttributeTraversal("vault_secrets", hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: "vault_secrets"},
+	})
+}
+
+func (t VaultSecretsTemplate) appendTemplate(w io.Writer) error {
+	q := t.hcatQuery()
+
+	if _, err := fmt.Fprintf(w, vaultSecretsSetVarTmpl, q); err != nil {
+		err = fmt.Errorf("unable to write vault secrets template with variable, error: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (t VaultSecretsTemplate) appendVariable(w io.Writer) error {
+	_, err := w.Write(variableVaultSecrets)
+	return err
+}
+
+func (t VaultSecretsTemplate) hcatQuery() string {
+	return `"` + strings.Join(t.Paths, `" "`) + `" ` // deliberate space at end
+}
+
+const vaultSecretsSetVarTmpl = `
+# vault_secrets is set as a sensitive variable with the values of the keys:
+{{- with $secrets := vaultSecrets %s}}
+  {{- range $s := $secrets }}
+#   {{ $s.Path }}: {{ range $i, $k := $s.Keys }}{{ if $i }}, {{ end }}{{ $k }}{{ end }}
+{{- end}}{{- end}}
+`
+
+// variableVaultSecrets is required for modules that include Vault secrets. It
+// is versioned to track compatibility between the generated root module and
+// modules that include Vault secrets. Sensitive variables require Terraform
+// 0.14 or later.
+var variableVaultSecrets = []byte(`
+# Vault secrets definition protocol v0
+variable "vault_secrets" {
+  description = "Data of the Vault KV secrets mapped by secret path. Values that are not strings are JSON encoded."
+  type        = map(map(string))
+  sensitive   = true
+}
+`)
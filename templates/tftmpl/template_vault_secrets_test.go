@@ -0,0 +1,92 @@
+package tftmpl
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultSecretsTemplate_hcatQuery(t *testing.T) {
+	testcase := []struct {
+		name string
+		c    *VaultSecretsTemplate
+		exp  string
+	}{
+		{
+			"path",
+			&VaultSecretsTemplate{
+				Paths: []string{"secret/data/app"},
+			},
+			`"secret/data/app" `,
+		},
+		{
+			"paths",
+			&VaultSecretsTemplate{
+				Paths: []string{"secret/data/app", "kv/db"},
+			},
+			`"secret/data/app" "kv/db" `,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.c.hcatQuery()
+			assert.Equal(t, tc.exp, actual)
+		})
+	}
+}
+
+func TestVaultSecretsTemplate_appendTemplate(t *testing.T) {
+	c := &VaultSecretsTemplate{Paths: []string{"kv/db"}}
+	w := new(strings.Builder)
+	require.NoError(t, c.appendTemplate(w))
+	assert.Contains(t, w.String(), `{{- with $secrets := vaultSecrets "kv/db" }}`)
+}
+
+func TestVaultSecretsTemplate_render(t *testing.T) {
+	// Render the template with stubbed secrets to check that the output is
+	// valid HCL and does not include the secret values
+	vt := &VaultSecretsTemplate{Paths: []string{"secret/data/app", "kv/db"}}
+	w := new(strings.Builder)
+	require.NoError(t, vt.appendTemplate(w))
+
+	tmpl, err := template.New("tfvars").Funcs(template.FuncMap{
+		"vaultSecrets": func(paths ...string) ([]*tmplfunc.VaultSecret, error) {
+			return []*tmplfunc.VaultSecret{
+				{
+					Path: "secret/data/app",
+					Data: map[string]string{"username": "admin", "password": "hunter2"},
+				},
+				{
+					Path: "kv/db",
+					Data: map[string]string{},
+				},
+			}, nil
+		},
+	}).Parse(w.String())
+	require.NoError(t, err)
+
+	rendered := new(strings.Builder)
+	require.NoError(t, tmpl.Execute(rendered, nil))
+	assert.Contains(t, rendered.String(), "#   secret/data/app: password, username\n")
+	assert.Contains(t, rendered.String(), "#   kv/db: \n")
+	assert.NotContains(t, rendered.String(), "hunter2")
+	assert.NotContains(t, rendered.String(), "admin")
+
+	_, diags := hclparse.NewParser().ParseHCL([]byte(rendered.String()), "terraform.tfvars")
+	assert.False(t, diags.HasErrors(), diags.Error())
+}
+
+func TestVaultSecretsTemplate_appendVariable(t *testing.T) {
+	w := new(strings.Builder)
+	require.NoError(t, VaultSecretsTemplate{}.appendVariable(w))
+
+	f, diags := hclparse.NewParser().ParseHCL([]byte(w.String()), "variables.tf")
+	require.False(t, diags.HasErrors(), diags.Error())
+	assert.Contains(t, string(f.Bytes), "sensitive   = true")
+}
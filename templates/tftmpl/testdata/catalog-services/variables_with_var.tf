@@ -7,7 +7,7 @@
 # Task: test
 # Description: user description for task named 'test'
 
-# Service definition protocol v0
+# Service definition protocol v1
 variable "services" {
   description = "Consul services monitored by Consul Terraform Sync"
   type = map(
@@ -20,6 +20,8 @@ variable "services" {
       meta      = map(string)
       tags      = list(string)
       namespace = string
+      partition = string
+      peer      = string
       status    = string
 
       node                  = string
@@ -0,0 +1,178 @@
+package tmplfunc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/pkg/errors"
+)
+
+var _ hcatQuery = (*catalogNodesQuery)(nil)
+
+// catalogNodesFunc returns information on the nodes registered in the Consul
+// catalog. It queries the Catalog List Nodes API and supports the query
+// parameter dc and filter expressions. It is registered as catalogNodes so
+// that it does not replace the nodes function of hcat.
+//
+// Endpoint: /v1/catalog/nodes
+// Template: {{ catalogNodes <filter options> ... }}
+func catalogNodesFunc(recall hcat.Recaller) interface{} {
+	return func(opts ...string) ([]*dep.Node, error) {
+		result := []*dep.Node{}
+
+		d, err := newCatalogNodesQuery(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if value, ok := recall(d); ok {
+			return value.([]*dep.Node), nil
+		}
+
+		return result, nil
+	}
+}
+
+// catalogNodesQuery is the representation of a requested catalog nodes query
+// from inside a template.
+type catalogNodesQuery struct {
+	isConsul
+	stopCh chan struct{}
+
+	dc     string
+	filter string
+	opts   hcat.QueryOptions
+}
+
+// newCatalogNodesQuery processes options in the format of "key=value" (e.g.
+// "dc=dc1") with the exception of filters. Any option that is not a key/value
+// pair is assumed to be a filter.
+func newCatalogNodesQuery(opts []string) (*catalogNodesQuery, error) {
+	query := catalogNodesQuery{
+		stopCh: make(chan struct{}, 1),
+	}
+
+	var filters []string
+	for _, opt := range opts {
+		if strings.TrimSpace(opt) == "" {
+			continue
+		}
+
+		if queryParamOptRe.MatchString(opt) {
+			queryParam := strings.SplitN(opt, "=", 2)
+			param := strings.TrimSpace(queryParam[0])
+			value := strings.TrimSpace(queryParam[1])
+			switch param {
+			case "dc", "datacenter":
+				query.dc = value
+				continue
+			}
+		}
+
+		// Any option that was not already parsed is assumed to be a filter.
+		// Evaluate the grammar of the filter before attempting to query Consul.
+		_, err := bexpr.CreateFilter(opt)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"catalog.nodes: invalid filter: %q: %s", opt, err)
+		}
+		filters = append(filters, opt)
+	}
+
+	if len(filters) > 0 {
+		query.filter = strings.Join(filters, " and ")
+	}
+
+	return &query, nil
+}
+
+// Fetch queries the Consul API defined by the given client and returns a slice
+// of Node objects.
+func (d *catalogNodesQuery) Fetch(clients dep.Clients) (interface{}, *dep.ResponseMetadata, error) {
+	select {
+	case <-d.stopCh:
+		return nil, nil, dep.ErrStopped
+	default:
+	}
+
+	hcatOpts := d.opts.Merge(&hcat.QueryOptions{
+		Datacenter: d.dc,
+	})
+	opts := hcatOpts.ToConsulOpts()
+	opts.Filter = d.filter
+
+	entries, qm, err := clients.Consul().Catalog().Nodes(opts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, d.String())
+	}
+
+	nodes := make([]*dep.Node, 0, len(entries))
+	for _, node := range entries {
+		nodes = append(nodes, &dep.Node{
+			ID:              node.ID,
+			Node:            node.Node,
+			Address:         node.Address,
+			Datacenter:      node.Datacenter,
+			TaggedAddresses: node.TaggedAddresses,
+			Meta:            node.Meta,
+		})
+	}
+
+	sort.Stable(ByNode(nodes))
+
+	rm := &dep.ResponseMetadata{
+		LastIndex:   qm.LastIndex,
+		LastContact: qm.LastContact,
+	}
+
+	return nodes, rm, nil
+}
+
+// SetOptions satisfies the hcat.QueryOptionsSetter interface which enables
+// blocking queries.
+func (d *catalogNodesQuery) SetOptions(opts hcat.QueryOptions) {
+	d.opts = opts
+}
+
+// ID returns the human-friendly version of this query.
+func (d *catalogNodesQuery) ID() string {
+	var opts []string
+	if d.dc != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", d.dc))
+	}
+	if d.filter != "" {
+		opts = append(opts, fmt.Sprintf("filter=%s", d.filter))
+	}
+	if len(opts) > 0 {
+		sort.Strings(opts)
+		return fmt.Sprintf("catalog.nodes(%s)", strings.Join(opts, "&"))
+	}
+	return "catalog.nodes"
+}
+
+// Stringer interface reuses ID
+func (d *catalogNodesQuery) String() string {
+	return d.ID()
+}
+
+// Stop halts the query's fetch function.
+func (d *catalogNodesQuery) Stop() {
+	close(d.stopCh)
+}
+
+// ByNode is a sortable slice of Node structs, sorted by name and then by
+// address.
+type ByNode []*dep.Node
+
+func (s ByNode) Len() int      { return len(s) }
+func (s ByNode) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s ByNode) Less(i, j int) bool {
+	if s[i].Node == s[j].Node {
+		return s[i].Address < s[j].Address
+	}
+	return s[i].Node < s[j].Node
+}
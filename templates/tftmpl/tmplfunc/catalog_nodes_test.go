@@ -0,0 +1,131 @@
+package tmplfunc
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/hcat/dep"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCatalogNodesQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		opts []string
+		exp  *catalogNodesQuery
+		err  bool
+	}{
+		{
+			"no opts",
+			[]string{},
+			&catalogNodesQuery{},
+			false,
+		},
+		{
+			"dc",
+			[]string{"dc=dc1"},
+			&catalogNodesQuery{dc: "dc1"},
+			false,
+		},
+		{
+			"datacenter",
+			[]string{"datacenter=dc1"},
+			&catalogNodesQuery{dc: "dc1"},
+			false,
+		},
+		{
+			"filter",
+			[]string{"Meta.env == production"},
+			&catalogNodesQuery{filter: "Meta.env == production"},
+			false,
+		},
+		{
+			"multiple filters",
+			[]string{"Meta.env == production", "Node != web"},
+			&catalogNodesQuery{filter: "Meta.env == production and Node != web"},
+			false,
+		},
+		{
+			"multiple",
+			[]string{"dc=dc1", "Node == web"},
+			&catalogNodesQuery{dc: "dc1", filter: "Node == web"},
+			false,
+		},
+		{
+			"invalid filter",
+			[]string{"invalid"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := newCatalogNodesQuery(tc.opts)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if act != nil {
+				act.stopCh = nil
+			}
+
+			assert.NoError(t, err, err)
+			assert.Equal(t, tc.exp, act)
+		})
+	}
+}
+
+func TestCatalogNodesQuery_String(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    []string
+		exp  string
+	}{
+		{
+			"empty",
+			[]string{},
+			"catalog.nodes",
+		},
+		{
+			"dc",
+			[]string{"dc=dc1"},
+			"catalog.nodes(dc=dc1)",
+		},
+		{
+			"all parameters",
+			[]string{"Node == web", "dc=dc1"},
+			"catalog.nodes(dc=dc1&filter=Node == web)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := newCatalogNodesQuery(tc.i)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, d.String())
+		})
+	}
+}
+
+func TestByNode(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*dep.Node{
+		{Node: "node-b", Address: "10.0.0.2"},
+		{Node: "node-a", Address: "10.0.0.3"},
+		{Node: "node-a", Address: "10.0.0.1"},
+	}
+	sort.Stable(ByNode(nodes))
+
+	assert.Equal(t, []*dep.Node{
+		{Node: "node-a", Address: "10.0.0.1"},
+		{Node: "node-a", Address: "10.0.0.3"},
+		{Node: "node-b", Address: "10.0.0.2"},
+	}, nodes)
+}
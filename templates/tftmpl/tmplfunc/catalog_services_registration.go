@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	consulapi "github.com/hashicorp/consul/api"
 	"github.com/hashicorp/hcat"
 	"github.com/hashicorp/hcat/dep"
 	"github.com/pkg/errors"
@@ -19,6 +20,11 @@ var _ hcatQuery = (*catalogServicesRegistrationQuery)(nil)
 // parameters dc, ns, and node-meta. It also adds an additional layer of
 // custom functionality on the API response:
 //  - Adds regex filtering on service name option e.g. "regexp=api"
+//  - Adds querying multiple datacenters e.g. "datacenters=dc1,dc2" or
+//    "datacenters=all". The tags of a service are merged across datacenters.
+//  - Adds the admin partition and cluster peer parameters e.g.
+//    "partition=ap1" and "peer=cluster-02", which are not yet supported by
+//    hcat query options
 //
 // Endpoint: /v1/catalog/services
 // Template: {{ catalogServicesRegistration  <filter options> ... }}
@@ -45,11 +51,14 @@ type catalogServicesRegistrationQuery struct {
 	isConsul
 	stopCh chan struct{}
 
-	regexp   *regexp.Regexp // custom
-	dc       string
-	ns       string
-	nodeMeta map[string]string
-	opts     hcat.QueryOptions
+	regexp      *regexp.Regexp   // custom
+	datacenters *multiDatacenter // custom
+	partition   string           // custom
+	peer        string           // custom
+	dc          string
+	ns          string
+	nodeMeta    map[string]string
+	opts        hcat.QueryOptions
 }
 
 // newCatalogServicesRegistrationQuery processes options in the format of
@@ -78,8 +87,19 @@ func newCatalogServicesRegistrationQuery(opts []string) (*catalogServicesRegistr
 			query.regexp = r
 		case "dc", "datacenter":
 			query.dc = value
+		case "datacenters":
+			dcs, err := parseDatacenters(value)
+			if err != nil {
+				return nil, fmt.Errorf("catalog.services.registration: invalid "+
+					"datacenters: %s", err)
+			}
+			query.datacenters = newMultiDatacenter(dcs)
 		case "ns", "namespace":
 			query.ns = value
+		case "partition":
+			query.partition = value
+		case "peer":
+			query.peer = value
 		case "node-meta":
 			if query.nodeMeta == nil {
 				query.nodeMeta = make(map[string]string)
@@ -97,6 +117,16 @@ func newCatalogServicesRegistrationQuery(opts []string) (*catalogServicesRegistr
 		}
 	}
 
+	if query.dc != "" && query.datacenters != nil {
+		return nil, fmt.Errorf("catalog.services.registration: dc and " +
+			"datacenters parameters cannot both be set")
+	}
+
+	if query.peer != "" && query.datacenters != nil {
+		return nil, fmt.Errorf("catalog.services.registration: peer and " +
+			"datacenters parameters cannot both be set")
+	}
+
 	return &query, nil
 }
 
@@ -114,13 +144,35 @@ func (d *catalogServicesRegistrationQuery) Fetch(clients dep.Clients) (interface
 		Namespace:  d.ns,
 	})
 	opts := hcatOpts.ToConsulOpts()
+	opts.Partition = d.partition
+	opts.Peer = d.peer
 	if len(d.nodeMeta) != 0 {
 		opts.NodeMeta = d.nodeMeta
 	}
 
-	entries, qm, err := clients.Consul().Catalog().Services(opts)
-	if err != nil {
-		return nil, nil, errors.Wrap(err, d.String())
+	var entries map[string][]string
+	var rm *dep.ResponseMetadata
+	if d.datacenters == nil {
+		var qm *consulapi.QueryMeta
+		var err error
+		entries, qm, err = clients.Consul().Catalog().Services(opts)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, d.String())
+		}
+		rm = &dep.ResponseMetadata{
+			LastIndex:   qm.LastIndex,
+			LastContact: qm.LastContact,
+		}
+	} else {
+		data, multiRM, err := d.datacenters.fetch(clients, opts,
+			func(opts *consulapi.QueryOptions) (interface{}, *consulapi.QueryMeta, error) {
+				return clients.Consul().Catalog().Services(opts)
+			})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, d.String())
+		}
+		entries = mergeCatalogServices(data)
+		rm = multiRM
 	}
 
 	var catalogServices []*dep.CatalogSnippet
@@ -136,11 +188,6 @@ func (d *catalogServicesRegistrationQuery) Fetch(clients dep.Clients) (interface
 
 	sort.Stable(ByName(catalogServices))
 
-	rm := &dep.ResponseMetadata{
-		LastIndex:   qm.LastIndex,
-		LastContact: qm.LastContact,
-	}
-
 	// Tasks monitoring CatalogServices will likely also monitor Services
 	// information. Without a delay, CatalogServices may have services that
 	// are not yet propagated to Services template function as healthy services
@@ -178,9 +225,18 @@ func (d *catalogServicesRegistrationQuery) ID() string {
 	if d.dc != "" {
 		opts = append(opts, fmt.Sprintf("dc=%s", d.dc))
 	}
+	if d.datacenters != nil {
+		opts = append(opts, fmt.Sprintf("datacenters=%s", d.datacenters))
+	}
 	if d.ns != "" {
 		opts = append(opts, fmt.Sprintf("ns=%s", d.ns))
 	}
+	if d.partition != "" {
+		opts = append(opts, fmt.Sprintf("partition=%s", d.partition))
+	}
+	if d.peer != "" {
+		opts = append(opts, fmt.Sprintf("peer=%s", d.peer))
+	}
 	for k, v := range d.nodeMeta {
 		opts = append(opts, fmt.Sprintf("node-meta=%s:%s", k, v))
 	}
@@ -202,6 +258,30 @@ func (d *catalogServicesRegistrationQuery) Stop() {
 	close(d.stopCh)
 }
 
+// mergeCatalogServices merges the catalog services of multiple datacenters
+// into a single map of service names to the unique tags of the service across
+// the datacenters
+func mergeCatalogServices(data map[string]interface{}) map[string][]string {
+	merged := make(map[string][]string)
+	seen := make(map[string]map[string]bool)
+	for _, d := range data {
+		for name, tags := range d.(map[string][]string) {
+			if _, ok := seen[name]; !ok {
+				seen[name] = make(map[string]bool)
+				merged[name] = []string{}
+			}
+			for _, tag := range tags {
+				if seen[name][tag] {
+					continue
+				}
+				seen[name][tag] = true
+				merged[name] = append(merged[name], tag)
+			}
+		}
+	}
+	return merged
+}
+
 // ByName is a sortable slice of CatalogSnippet structs.
 type ByName []*dep.CatalogSnippet
 
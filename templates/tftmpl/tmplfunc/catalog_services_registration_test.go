@@ -46,6 +46,35 @@ func TestNewCatalogServicesRegistrationQuery(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"datacenters",
+			[]string{"datacenters=dc1,dc2"},
+			&catalogServicesRegistrationQuery{
+				datacenters: newMultiDatacenter([]string{"dc1", "dc2"}),
+			},
+			false,
+		},
+		{
+			"dc and datacenters",
+			[]string{"dc=dc1", "datacenters=dc2"},
+			nil,
+			true,
+		},
+		{
+			"partition and peer",
+			[]string{"partition=ap1", "peer=cluster-02"},
+			&catalogServicesRegistrationQuery{
+				partition: "ap1",
+				peer:      "cluster-02",
+			},
+			false,
+		},
+		{
+			"peer and datacenters",
+			[]string{"peer=cluster-02", "datacenters=all"},
+			nil,
+			true,
+		},
 		{
 			"ns",
 			[]string{"ns=namespace"},
@@ -128,6 +157,16 @@ func TestCatalogServicesRegistrationQuery_String(t *testing.T) {
 			[]string{"dc=dc1"},
 			"catalog.services.registration(dc=dc1)",
 		},
+		{
+			"datacenters",
+			[]string{"datacenters=all"},
+			"catalog.services.registration(datacenters=all)",
+		},
+		{
+			"partition and peer",
+			[]string{"peer=cluster-02", "partition=ap1"},
+			"catalog.services.registration(partition=ap1&peer=cluster-02)",
+		},
 		{
 			"namespace",
 			[]string{"ns=namespace"},
@@ -290,6 +329,7 @@ func TestCatalogServicesRegistrationQuery_Fetch(t *testing.T) {
 // to Consul and Vault
 type testClient struct {
 	consul *consulapi.Client
+	vault  *vaultapi.Client
 }
 
 // Consul returns the Consul client
@@ -302,8 +342,10 @@ func (c *testClient) Consul() *consulapi.Client {
 
 // Vault returns the Vault client
 func (c *testClient) Vault() *vaultapi.Client {
-	// no-op: currently no need to support Vault
-	return nil
+	if c == nil {
+		return nil
+	}
+	return c.vault
 }
 
 // WaitForCatalogRegistration polls and waits for a service to be registered in the Consul catalog
@@ -336,3 +378,24 @@ func WaitForCatalogRegistration(tb testing.TB, client *consulapi.Client, queryOp
 			"with in the Consul catalog", wait, serviceID)
 	}
 }
+
+func TestMergeCatalogServices(t *testing.T) {
+	t.Parallel()
+
+	data := map[string]interface{}{
+		"dc1": map[string][]string{
+			"api": {"v1", "blue"},
+			"web": {},
+		},
+		"dc2": map[string][]string{
+			"api": {"v1", "green"},
+			"db":  {"primary"},
+		},
+	}
+
+	actual := mergeCatalogServices(data)
+	assert.ElementsMatch(t, []string{"v1", "blue", "green"}, actual["api"])
+	assert.Equal(t, []string{}, actual["web"])
+	assert.Equal(t, []string{"primary"}, actual["db"])
+	assert.Len(t, actual, 3)
+}
@@ -0,0 +1,267 @@
+package tmplfunc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/pkg/errors"
+)
+
+var _ hcatQuery = (*configEntriesQuery)(nil)
+
+// ConfigEntry is a Consul config entry of the kinds service-defaults,
+// ingress-gateway, or terminating-gateway. The fields that are specific to a
+// kind are left empty for config entries of the other kinds.
+type ConfigEntry struct {
+	Kind      string
+	Name      string
+	Namespace string
+	Meta      map[string]string
+
+	// Protocol is the protocol of a service-defaults config entry
+	Protocol string
+
+	// Services are the services linked to a terminating gateway or exposed on
+	// the listeners of an ingress gateway
+	Services []ConfigEntryService
+}
+
+// ConfigEntryService is a service that is linked to a gateway config entry.
+// Port, Protocol and Hosts are set for ingress gateway services, while the
+// TLS fields are set for terminating gateway services.
+type ConfigEntryService struct {
+	Name      string
+	Namespace string
+	Port      int
+	Protocol  string
+	Hosts     []string
+	CAFile    string
+	CertFile  string
+	KeyFile   string
+	SNI       string
+}
+
+// configEntriesFunc returns the Consul config entries of a kind. It queries
+// the Config API and supports the query parameters dc and ns. It also adds an
+// additional layer of custom functionality on the API response:
+//   - Filters on the config entry name e.g. "name=ingress"
+//
+// Endpoint: /v1/config/:kind
+// Template: {{ configEntries kind=<kind> <filter options> ... }}
+func configEntriesFunc(recall hcat.Recaller) interface{} {
+	return func(opts ...string) ([]*ConfigEntry, error) {
+		result := []*ConfigEntry{}
+
+		d, err := newConfigEntriesQuery(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if value, ok := recall(d); ok {
+			return value.([]*ConfigEntry), nil
+		}
+
+		return result, nil
+	}
+}
+
+// configEntriesQuery is the representation of a requested config entries
+// query from inside a template.
+type configEntriesQuery struct {
+	isConsul
+	stopCh chan struct{}
+
+	kind  string
+	names []string // custom
+	dc    string
+	ns    string
+	opts  hcat.QueryOptions
+}
+
+// newConfigEntriesQuery processes options in the format of "key=value"
+// e.g. "kind=ingress-gateway"
+func newConfigEntriesQuery(opts []string) (*configEntriesQuery, error) {
+	query := configEntriesQuery{
+		stopCh: make(chan struct{}, 1),
+	}
+
+	for _, opt := range opts {
+		if strings.TrimSpace(opt) == "" {
+			continue
+		}
+
+		param, value, err := stringsSplit2(opt, "=")
+		if err != nil {
+			return nil, fmt.Errorf("config.entries: invalid "+
+				"query parameter format: %q", opt)
+		}
+		switch param {
+		case "kind":
+			query.kind = value
+		case "name":
+			query.names = append(query.names, value)
+		case "dc", "datacenter":
+			query.dc = value
+		case "ns", "namespace":
+			query.ns = value
+		default:
+			return nil, fmt.Errorf(
+				"config.entries: invalid query parameter: %q", opt)
+		}
+	}
+
+	switch query.kind {
+	case consulapi.ServiceDefaults, consulapi.IngressGateway, consulapi.TerminatingGateway:
+	case "":
+		return nil, fmt.Errorf("config.entries: kind option required")
+	default:
+		return nil, fmt.Errorf("config.entries: unsupported kind: %q", query.kind)
+	}
+
+	return &query, nil
+}
+
+// Fetch queries the Consul API defined by the given client and returns a slice
+// of ConfigEntry objects.
+func (d *configEntriesQuery) Fetch(clients dep.Clients) (interface{}, *dep.ResponseMetadata, error) {
+	select {
+	case <-d.stopCh:
+		return nil, nil, dep.ErrStopped
+	default:
+	}
+
+	hcatOpts := d.opts.Merge(&hcat.QueryOptions{
+		Datacenter: d.dc,
+		Namespace:  d.ns,
+	})
+
+	entries, qm, err := clients.Consul().ConfigEntries().List(d.kind, hcatOpts.ToConsulOpts())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, d.String())
+	}
+
+	configEntries := make([]*ConfigEntry, 0, len(entries))
+	for _, entry := range entries {
+		if !d.matchesName(entry.GetName()) {
+			continue
+		}
+		configEntries = append(configEntries, newConfigEntry(entry))
+	}
+
+	sort.Stable(ByConfigEntryName(configEntries))
+
+	rm := &dep.ResponseMetadata{
+		LastIndex:   qm.LastIndex,
+		LastContact: qm.LastContact,
+	}
+
+	return configEntries, rm, nil
+}
+
+// SetOptions satisfies the hcat.QueryOptionsSetter interface which enables
+// blocking queries.
+func (d *configEntriesQuery) SetOptions(opts hcat.QueryOptions) {
+	d.opts = opts
+}
+
+// ID returns the human-friendly version of this query.
+func (d *configEntriesQuery) ID() string {
+	opts := []string{fmt.Sprintf("kind=%s", d.kind)}
+	for _, name := range d.names {
+		opts = append(opts, fmt.Sprintf("name=%s", name))
+	}
+	if d.dc != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", d.dc))
+	}
+	if d.ns != "" {
+		opts = append(opts, fmt.Sprintf("ns=%s", d.ns))
+	}
+	sort.Strings(opts)
+	return fmt.Sprintf("config.entries(%s)", strings.Join(opts, "&"))
+}
+
+// Stringer interface reuses ID
+func (d *configEntriesQuery) String() string {
+	return d.ID()
+}
+
+// Stop halts the query's fetch function.
+func (d *configEntriesQuery) Stop() {
+	close(d.stopCh)
+}
+
+// matchesName returns whether the config entry name is selected by the query.
+// All names are selected when the query has no names.
+func (d *configEntriesQuery) matchesName(name string) bool {
+	if len(d.names) == 0 {
+		return true
+	}
+	for _, n := range d.names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// newConfigEntry converts a Consul config entry of a supported kind to a
+// ConfigEntry
+func newConfigEntry(entry consulapi.ConfigEntry) *ConfigEntry {
+	ce := &ConfigEntry{
+		Kind:      entry.GetKind(),
+		Name:      entry.GetName(),
+		Namespace: entry.GetNamespace(),
+		Meta:      nonNullMap(entry.GetMeta()),
+		Services:  []ConfigEntryService{},
+	}
+
+	switch e := entry.(type) {
+	case *consulapi.ServiceConfigEntry:
+		ce.Protocol = e.Protocol
+	case *consulapi.IngressGatewayConfigEntry:
+		for _, l := range e.Listeners {
+			for _, s := range l.Services {
+				hosts := []string{}
+				hosts = append(hosts, s.Hosts...)
+				ce.Services = append(ce.Services, ConfigEntryService{
+					Name:      s.Name,
+					Namespace: s.Namespace,
+					Port:      l.Port,
+					Protocol:  l.Protocol,
+					Hosts:     hosts,
+				})
+			}
+		}
+	case *consulapi.TerminatingGatewayConfigEntry:
+		for _, s := range e.Services {
+			ce.Services = append(ce.Services, ConfigEntryService{
+				Name:      s.Name,
+				Namespace: s.Namespace,
+				Hosts:     []string{},
+				CAFile:    s.CAFile,
+				CertFile:  s.CertFile,
+				KeyFile:   s.KeyFile,
+				SNI:       s.SNI,
+			})
+		}
+	}
+
+	return ce
+}
+
+// ByConfigEntryName is a sortable slice of ConfigEntry structs, sorted by
+// name and then by namespace.
+type ByConfigEntryName []*ConfigEntry
+
+func (s ByConfigEntryName) Len() int      { return len(s) }
+func (s ByConfigEntryName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s ByConfigEntryName) Less(i, j int) bool {
+	if s[i].Name == s[j].Name {
+		return s[i].Namespace < s[j].Namespace
+	}
+	return s[i].Name < s[j].Name
+}
@@ -0,0 +1,240 @@
+package tmplfunc
+
+import (
+	"sort"
+	"testing"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConfigEntriesQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		opts []string
+		exp  *configEntriesQuery
+		err  bool
+	}{
+		{
+			"kind",
+			[]string{"kind=service-defaults"},
+			&configEntriesQuery{kind: "service-defaults"},
+			false,
+		},
+		{
+			"names",
+			[]string{"kind=ingress-gateway", "name=ingress", "name=edge"},
+			&configEntriesQuery{
+				kind:  "ingress-gateway",
+				names: []string{"ingress", "edge"},
+			},
+			false,
+		},
+		{
+			"multiple",
+			[]string{"kind=terminating-gateway", "dc=dc1", "ns=namespace"},
+			&configEntriesQuery{
+				kind: "terminating-gateway",
+				dc:   "dc1",
+				ns:   "namespace",
+			},
+			false,
+		},
+		{
+			"missing kind",
+			[]string{"name=ingress"},
+			nil,
+			true,
+		},
+		{
+			"unsupported kind",
+			[]string{"kind=proxy-defaults"},
+			nil,
+			true,
+		},
+		{
+			"invalid query",
+			[]string{"kind=service-defaults", "invalid=true"},
+			nil,
+			true,
+		},
+		{
+			"invalid query format",
+			[]string{"dc1"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := newConfigEntriesQuery(tc.opts)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if act != nil {
+				act.stopCh = nil
+			}
+
+			assert.NoError(t, err, err)
+			assert.Equal(t, tc.exp, act)
+		})
+	}
+}
+
+func TestConfigEntriesQuery_String(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    []string
+		exp  string
+	}{
+		{
+			"kind",
+			[]string{"kind=service-defaults"},
+			"config.entries(kind=service-defaults)",
+		},
+		{
+			"all parameters",
+			[]string{"ns=namespace", "name=ingress", "dc=dc1", "kind=ingress-gateway"},
+			"config.entries(dc=dc1&kind=ingress-gateway&name=ingress&ns=namespace)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := newConfigEntriesQuery(tc.i)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, d.String())
+		})
+	}
+}
+
+func TestConfigEntriesQuery_matchesName(t *testing.T) {
+	t.Parallel()
+
+	d := &configEntriesQuery{}
+	assert.True(t, d.matchesName("ingress"), "no names should match all")
+
+	d.names = []string{"ingress", "edge"}
+	assert.True(t, d.matchesName("edge"))
+	assert.False(t, d.matchesName("egress"))
+}
+
+func TestNewConfigEntry(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		entry    consulapi.ConfigEntry
+		expected *ConfigEntry
+	}{
+		{
+			"service-defaults",
+			&consulapi.ServiceConfigEntry{
+				Kind:     consulapi.ServiceDefaults,
+				Name:     "web",
+				Protocol: "http",
+				Meta:     map[string]string{"env": "prod"},
+			},
+			&ConfigEntry{
+				Kind:     consulapi.ServiceDefaults,
+				Name:     "web",
+				Protocol: "http",
+				Meta:     map[string]string{"env": "prod"},
+				Services: []ConfigEntryService{},
+			},
+		},
+		{
+			"ingress-gateway",
+			&consulapi.IngressGatewayConfigEntry{
+				Kind: consulapi.IngressGateway,
+				Name: "ingress",
+				Listeners: []consulapi.IngressListener{
+					{
+						Port:     8080,
+						Protocol: "http",
+						Services: []consulapi.IngressService{
+							{Name: "web", Hosts: []string{"web.example.com"}},
+							{Name: "api"},
+						},
+					},
+					{
+						Port:     9090,
+						Protocol: "tcp",
+						Services: []consulapi.IngressService{{Name: "db"}},
+					},
+				},
+			},
+			&ConfigEntry{
+				Kind: consulapi.IngressGateway,
+				Name: "ingress",
+				Meta: map[string]string{},
+				Services: []ConfigEntryService{
+					{Name: "web", Port: 8080, Protocol: "http", Hosts: []string{"web.example.com"}},
+					{Name: "api", Port: 8080, Protocol: "http", Hosts: []string{}},
+					{Name: "db", Port: 9090, Protocol: "tcp", Hosts: []string{}},
+				},
+			},
+		},
+		{
+			"terminating-gateway",
+			&consulapi.TerminatingGatewayConfigEntry{
+				Kind: consulapi.TerminatingGateway,
+				Name: "egress",
+				Services: []consulapi.LinkedService{
+					{
+						Name:     "billing",
+						CAFile:   "ca.pem",
+						CertFile: "cert.pem",
+						KeyFile:  "key.pem",
+						SNI:      "billing.example.com",
+					},
+				},
+			},
+			&ConfigEntry{
+				Kind: consulapi.TerminatingGateway,
+				Name: "egress",
+				Meta: map[string]string{},
+				Services: []ConfigEntryService{
+					{
+						Name:     "billing",
+						Hosts:    []string{},
+						CAFile:   "ca.pem",
+						CertFile: "cert.pem",
+						KeyFile:  "key.pem",
+						SNI:      "billing.example.com",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, newConfigEntry(tc.entry))
+		})
+	}
+}
+
+func TestByConfigEntryName(t *testing.T) {
+	t.Parallel()
+
+	entries := []*ConfigEntry{
+		{Name: "web", Namespace: "ns2"},
+		{Name: "api"},
+		{Name: "web", Namespace: "ns1"},
+	}
+	sort.Stable(ByConfigEntryName(entries))
+
+	assert.Equal(t, []*ConfigEntry{
+		{Name: "api"},
+		{Name: "web", Namespace: "ns1"},
+		{Name: "web", Namespace: "ns2"},
+	}, entries)
+}
@@ -0,0 +1,235 @@
+package tmplfunc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/pkg/errors"
+)
+
+var _ hcatQuery = (*consulKVQuery)(nil)
+
+// consulKVGetFunc checks if a key exists and if the key exists, returns the
+// key-value pair. It is equivalent to hcat's keyExistsGet template function
+// with the additional support of the admin partition query parameter, which
+// hcat query options do not yet support.
+//
+// Endpoint: /v1/kv/:key
+// Template: {{ consulKVGet "key" <options> ... }}
+func consulKVGetFunc(recall hcat.Recaller) interface{} {
+	return func(key string, opts ...string) (*dep.KeyPair, error) {
+		var result *dep.KeyPair
+
+		d, err := newConsulKVQuery(key, false, opts)
+		if err != nil {
+			return result, err
+		}
+
+		if value, ok := recall(d); ok {
+			return value.(*dep.KeyPair), nil
+		}
+
+		return result, nil
+	}
+}
+
+// consulKVListFunc returns the key-value pairs of the keys with the prefix.
+// It is equivalent to hcat's keys template function with the additional
+// support of the admin partition query parameter.
+//
+// Endpoint: /v1/kv/:prefix?recurse
+// Template: {{ consulKVList "prefix" <options> ... }}
+func consulKVListFunc(recall hcat.Recaller) interface{} {
+	return func(prefix string, opts ...string) ([]*dep.KeyPair, error) {
+		result := []*dep.KeyPair{}
+
+		d, err := newConsulKVQuery(prefix, true, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if value, ok := recall(d); ok {
+			return value.([]*dep.KeyPair), nil
+		}
+
+		return result, nil
+	}
+}
+
+// consulKVQuery is the representation of a requested Consul KV query from
+// inside a template.
+type consulKVQuery struct {
+	isConsul
+	stopCh chan struct{}
+
+	key       string
+	recurse   bool
+	dc        string
+	ns        string
+	partition string // custom
+	opts      hcat.QueryOptions
+}
+
+// newConsulKVQuery processes options in the format of "key=value" e.g.
+// "partition=ap1"
+func newConsulKVQuery(key string, recurse bool, opts []string) (*consulKVQuery, error) {
+	if key == "" || key == "/" {
+		return nil, fmt.Errorf("consul.kv: key required")
+	}
+
+	query := consulKVQuery{
+		stopCh:  make(chan struct{}, 1),
+		key:     key,
+		recurse: recurse,
+	}
+	if recurse {
+		query.key = strings.TrimPrefix(key, "/")
+	}
+
+	for _, opt := range opts {
+		if strings.TrimSpace(opt) == "" {
+			continue
+		}
+
+		param, value, err := stringsSplit2(opt, "=")
+		if err != nil {
+			return nil, fmt.Errorf("consul.kv: invalid query parameter "+
+				"format: %q", opt)
+		}
+		switch param {
+		case "dc", "datacenter":
+			query.dc = value
+		case "ns", "namespace":
+			query.ns = value
+		case "partition":
+			query.partition = value
+		default:
+			return nil, fmt.Errorf("consul.kv: invalid query parameter: %q", opt)
+		}
+	}
+
+	return &query, nil
+}
+
+// Fetch queries the Consul API defined by the given client. For a recursive
+// query, it returns a slice of the KeyPair objects with the prefix. Otherwise
+// it returns the KeyPair of the key, which does not exist if the key is not
+// found.
+func (d *consulKVQuery) Fetch(clients dep.Clients) (interface{}, *dep.ResponseMetadata, error) {
+	select {
+	case <-d.stopCh:
+		return nil, nil, dep.ErrStopped
+	default:
+	}
+
+	hcatOpts := d.opts.Merge(&hcat.QueryOptions{
+		Datacenter: d.dc,
+		Namespace:  d.ns,
+	})
+	opts := hcatOpts.ToConsulOpts()
+	opts.Partition = d.partition
+
+	if d.recurse {
+		list, qm, err := clients.Consul().KV().List(d.key, opts)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, d.String())
+		}
+
+		pairs := make([]*dep.KeyPair, 0, len(list))
+		for _, pair := range list {
+			key := strings.TrimPrefix(pair.Key, d.key)
+			key = strings.TrimLeft(key, "/")
+
+			pairs = append(pairs, &dep.KeyPair{
+				Path:        pair.Key,
+				Key:         key,
+				Value:       string(pair.Value),
+				Exists:      true,
+				CreateIndex: pair.CreateIndex,
+				ModifyIndex: pair.ModifyIndex,
+				LockIndex:   pair.LockIndex,
+				Flags:       pair.Flags,
+				Session:     pair.Session,
+			})
+		}
+
+		rm := &dep.ResponseMetadata{
+			LastIndex:   qm.LastIndex,
+			LastContact: qm.LastContact,
+		}
+		return pairs, rm, nil
+	}
+
+	pair, qm, err := clients.Consul().KV().Get(d.key, opts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, d.String())
+	}
+
+	rm := &dep.ResponseMetadata{
+		LastIndex:   qm.LastIndex,
+		LastContact: qm.LastContact,
+	}
+
+	if pair == nil {
+		return &dep.KeyPair{
+			Path:   d.key,
+			Key:    d.key,
+			Exists: false,
+		}, rm, nil
+	}
+
+	return &dep.KeyPair{
+		Path:        pair.Key,
+		Key:         pair.Key,
+		Value:       string(pair.Value),
+		Exists:      true,
+		CreateIndex: pair.CreateIndex,
+		ModifyIndex: pair.ModifyIndex,
+		LockIndex:   pair.LockIndex,
+		Flags:       pair.Flags,
+		Session:     pair.Session,
+	}, rm, nil
+}
+
+// SetOptions satisfies the hcat.QueryOptionsSetter interface which enables
+// blocking queries.
+func (d *consulKVQuery) SetOptions(opts hcat.QueryOptions) {
+	d.opts = opts
+}
+
+// ID returns the human-friendly version of this query.
+func (d *consulKVQuery) ID() string {
+	var opts []string
+	if d.dc != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", d.dc))
+	}
+	if d.ns != "" {
+		opts = append(opts, fmt.Sprintf("ns=%s", d.ns))
+	}
+	if d.partition != "" {
+		opts = append(opts, fmt.Sprintf("partition=%s", d.partition))
+	}
+	if d.recurse {
+		opts = append(opts, "recurse")
+	}
+
+	key := d.key
+	if len(opts) > 0 {
+		sort.Strings(opts)
+		key = fmt.Sprintf("%s?%s", key, strings.Join(opts, "&"))
+	}
+	return fmt.Sprintf("consul.kv(%s)", key)
+}
+
+// Stringer interface reuses ID
+func (d *consulKVQuery) String() string {
+	return d.ID()
+}
+
+// Stop halts the query's fetch function.
+func (d *consulKVQuery) Stop() {
+	close(d.stopCh)
+}
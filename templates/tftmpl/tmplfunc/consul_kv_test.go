@@ -0,0 +1,132 @@
+package tmplfunc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConsulKVQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		key     string
+		recurse bool
+		opts    []string
+		exp     *consulKVQuery
+		err     bool
+	}{
+		{
+			"no opts",
+			"key",
+			false,
+			[]string{},
+			&consulKVQuery{key: "key"},
+			false,
+		},
+		{
+			"recurse trims prefix",
+			"/prefix",
+			true,
+			[]string{},
+			&consulKVQuery{key: "prefix", recurse: true},
+			false,
+		},
+		{
+			"all parameters",
+			"key",
+			false,
+			[]string{"dc=dc1", "ns=ns1", "partition=ap1"},
+			&consulKVQuery{key: "key", dc: "dc1", ns: "ns1", partition: "ap1"},
+			false,
+		},
+		{
+			"long parameter names",
+			"key",
+			false,
+			[]string{"datacenter=dc1", "namespace=ns1"},
+			&consulKVQuery{key: "key", dc: "dc1", ns: "ns1"},
+			false,
+		},
+		{
+			"empty key",
+			"",
+			false,
+			[]string{},
+			nil,
+			true,
+		},
+		{
+			"invalid parameter",
+			"key",
+			false,
+			[]string{"peer=cluster-02"},
+			nil,
+			true,
+		},
+		{
+			"invalid parameter format",
+			"key",
+			false,
+			[]string{"partition"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := newConsulKVQuery(tc.key, tc.recurse, tc.opts)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if act != nil {
+				act.stopCh = nil
+			}
+
+			assert.NoError(t, err, err)
+			assert.Equal(t, tc.exp, act)
+		})
+	}
+}
+
+func TestConsulKVQuery_String(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		recurse bool
+		i       []string
+		exp     string
+	}{
+		{
+			"key",
+			false,
+			[]string{},
+			"consul.kv(key)",
+		},
+		{
+			"recurse",
+			true,
+			[]string{},
+			"consul.kv(key?recurse)",
+		},
+		{
+			"all parameters",
+			true,
+			[]string{"partition=ap1", "ns=ns1", "dc=dc1"},
+			"consul.kv(key?dc=dc1&ns=ns1&partition=ap1&recurse)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := newConsulKVQuery("key", tc.recurse, tc.i)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, d.String())
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package tmplfunc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/hashicorp/hcat/dep"
+)
+
+// datacentersAll is the value of the datacenters query parameter to query all
+// datacenters known to the Consul agent
+const datacentersAll = "all"
+
+// datacenterFetchFunc fetches the data of a query for the datacenter set on
+// the Consul query options
+type datacenterFetchFunc func(opts *consulapi.QueryOptions) (interface{}, *consulapi.QueryMeta, error)
+
+// multiDatacenter tracks the state of a query that is fetched across multiple
+// datacenters. Raft indexes are not comparable across datacenters, so each
+// datacenter is blocked on with its own index and its latest data is cached
+// until the datacenter has a new response.
+type multiDatacenter struct {
+	datacenters []string
+
+	indexes map[string]uint64
+	data    map[string]interface{}
+}
+
+// parseDatacenters parses the value of a datacenters query parameter, a comma
+// separated list of datacenters or "all"
+func parseDatacenters(value string) ([]string, error) {
+	var dcs []string
+	for _, dc := range strings.Split(value, ",") {
+		dc = strings.TrimSpace(dc)
+		if dc == "" {
+			return nil, fmt.Errorf("datacenter cannot be empty: %q", value)
+		}
+		if dc == datacentersAll && value != datacentersAll {
+			return nil, fmt.Errorf("%q cannot be combined with other "+
+				"datacenters: %q", datacentersAll, value)
+		}
+		dcs = append(dcs, dc)
+	}
+	return dcs, nil
+}
+
+// newMultiDatacenter returns the multi-datacenter state for the datacenters
+func newMultiDatacenter(datacenters []string) *multiDatacenter {
+	return &multiDatacenter{
+		datacenters: datacenters,
+		indexes:     make(map[string]uint64),
+		data:        make(map[string]interface{}),
+	}
+}
+
+// String returns the datacenters query parameter
+func (m *multiDatacenter) String() string {
+	return strings.Join(m.datacenters, ",")
+}
+
+// resolve returns the sorted datacenters to query. The "all" value is
+// resolved to the datacenters currently known to the Consul agent.
+func (m *multiDatacenter) resolve(clients dep.Clients) ([]string, error) {
+	dcs := m.datacenters
+	if len(dcs) == 1 && dcs[0] == datacentersAll {
+		var err error
+		dcs, err = clients.Consul().Catalog().Datacenters()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sorted := make([]string, len(dcs))
+	copy(sorted, dcs)
+	sort.Strings(sorted)
+	return sorted, nil
+}
+
+// fetch fetches the query for each datacenter concurrently using the blocking
+// index of each datacenter. It returns once at least one datacenter has
+// responded and every datacenter has been fetched at least once. The data is
+// returned by datacenter alongside response metadata that aggregates the
+// indexes of all of the datacenters.
+func (m *multiDatacenter) fetch(clients dep.Clients, opts *consulapi.QueryOptions,
+	fetchFunc datacenterFetchFunc) (map[string]interface{}, *dep.ResponseMetadata, error) {
+
+	dcs, err := m.resolve(clients)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// drop the state of datacenters that are no longer queried
+	current := make(map[string]bool, len(dcs))
+	for _, dc := range dcs {
+		current[dc] = true
+	}
+	for dc := range m.data {
+		if !current[dc] {
+			delete(m.data, dc)
+			delete(m.indexes, dc)
+		}
+	}
+
+	type response struct {
+		dc   string
+		data interface{}
+		qm   *consulapi.QueryMeta
+		err  error
+	}
+
+	ctx, cancel := context.WithCancel(opts.Context())
+	defer cancel()
+
+	// buffered so that the blocking queries of the cancelled datacenters do not
+	// leak goroutines
+	respCh := make(chan response, len(dcs))
+	for _, dc := range dcs {
+		dcOpts := *opts
+		dcOpts.Datacenter = dc
+		dcOpts.WaitIndex = m.indexes[dc]
+		go func(dc string, o *consulapi.QueryOptions) {
+			data, qm, err := fetchFunc(o)
+			respCh <- response{dc: dc, data: data, qm: qm, err: err}
+		}(dc, dcOpts.WithContext(ctx))
+	}
+
+	rm := &dep.ResponseMetadata{}
+	for received := 0; received < len(dcs); received++ {
+		resp := <-respCh
+		if resp.err != nil {
+			return nil, nil, fmt.Errorf("datacenter %q: %s", resp.dc, resp.err)
+		}
+
+		m.data[resp.dc] = resp.data
+		m.indexes[resp.dc] = resp.qm.LastIndex
+		if resp.qm.LastContact > rm.LastContact {
+			rm.LastContact = resp.qm.LastContact
+		}
+
+		if len(m.data) == len(dcs) {
+			break
+		}
+	}
+
+	data := make(map[string]interface{}, len(dcs))
+	for _, dc := range dcs {
+		data[dc] = m.data[dc]
+		rm.LastIndex += m.indexes[dc]
+	}
+	return data, rm, nil
+}
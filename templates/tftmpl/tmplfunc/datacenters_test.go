@@ -0,0 +1,96 @@
+package tmplfunc
+
+import (
+	"testing"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDatacenters(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		value string
+		exp   []string
+		err   bool
+	}{
+		{
+			"single",
+			"dc1",
+			[]string{"dc1"},
+			false,
+		},
+		{
+			"multiple",
+			"dc1, dc2",
+			[]string{"dc1", "dc2"},
+			false,
+		},
+		{
+			"all",
+			"all",
+			[]string{"all"},
+			false,
+		},
+		{
+			"all with others",
+			"all,dc1",
+			nil,
+			true,
+		},
+		{
+			"empty datacenter",
+			"dc1,",
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := parseDatacenters(tc.value)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, actual)
+		})
+	}
+}
+
+func TestMultiDatacenter_fetch(t *testing.T) {
+	t.Parallel()
+
+	// dc1 changes on every blocking query, dc2 blocks until cancelled after
+	// its initial response
+	fetchFunc := func(opts *consulapi.QueryOptions) (interface{}, *consulapi.QueryMeta, error) {
+		if opts.WaitIndex == 0 {
+			return opts.Datacenter + "-0", &consulapi.QueryMeta{LastIndex: 10}, nil
+		}
+		if opts.Datacenter == "dc2" {
+			<-opts.Context().Done()
+			return nil, nil, opts.Context().Err()
+		}
+		return opts.Datacenter + "-1", &consulapi.QueryMeta{LastIndex: opts.WaitIndex + 1}, nil
+	}
+
+	m := newMultiDatacenter([]string{"dc2", "dc1"})
+	assert.Equal(t, "dc2,dc1", m.String())
+
+	// initial fetch waits for all of the datacenters
+	data, rm, err := m.fetch(nil, &consulapi.QueryOptions{}, fetchFunc)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"dc1": "dc1-0", "dc2": "dc2-0"}, data)
+	assert.Equal(t, uint64(20), rm.LastIndex)
+
+	// subsequent fetch returns on the first change and reuses the cached data
+	// of the other datacenters
+	data, rm, err = m.fetch(nil, &consulapi.QueryOptions{}, fetchFunc)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"dc1": "dc1-1", "dc2": "dc2-0"}, data)
+	assert.Equal(t, uint64(21), rm.LastIndex)
+}
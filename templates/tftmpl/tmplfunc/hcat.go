@@ -23,6 +23,12 @@ type isConsul struct{}
 
 func (isConsul) Consul() {}
 
+// isVault satisfies the hcat dependency interface to denote Vault type for
+// managing the Vault retry function.
+type isVault struct{}
+
+func (isVault) Vault() {}
+
 // deepCopyAndSortTags deep copies the tags in the given string slice and then
 // sorts and returns the copied result.
 func deepCopyAndSortTags(tags []string) []string {
@@ -0,0 +1,40 @@
+package tmplfunc
+
+import (
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// hclDecodeFunc is a template function to decode a string value formatted as
+// JSON or YAML, such as the value of a Consul KV pair, and marshal the decoded
+// value into an HCL expression. An error is returned if the value cannot be
+// decoded in the given format.
+//
+// Example: {{ HCLDecode .Value "json" }}
+func hclDecodeFunc(value, format string) (string, error) {
+	b := []byte(value)
+	switch format {
+	case "json":
+	case "yaml":
+		var err error
+		if b, err = yaml.YAMLToJSON(b); err != nil {
+			return "", fmt.Errorf("HCLDecode: unable to decode yaml value: %s", err)
+		}
+	default:
+		return "", fmt.Errorf("HCLDecode: unsupported format: %q", format)
+	}
+
+	t, err := ctyjson.ImpliedType(b)
+	if err != nil {
+		return "", fmt.Errorf("HCLDecode: unable to decode %s value: %s", format, err)
+	}
+	v, err := ctyjson.Unmarshal(b, t)
+	if err != nil {
+		return "", fmt.Errorf("HCLDecode: unable to decode %s value: %s", format, err)
+	}
+
+	return string(hclwrite.TokensForValue(v).Bytes()), nil
+}
@@ -0,0 +1,97 @@
+package tmplfunc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHCLDecodeFunc(t *testing.T) {
+	t.Parallel()
+
+	object := `{
+  enabled = true
+  port    = 8080
+  tags    = ["a", "b"]
+}`
+
+	cases := []struct {
+		name     string
+		value    string
+		format   string
+		expected string
+	}{
+		{
+			"json object",
+			`{"port": 8080, "tags": ["a", "b"], "enabled": true}`,
+			"json",
+			object,
+		},
+		{
+			"json string",
+			`"value"`,
+			"json",
+			`"value"`,
+		},
+		{
+			"json number",
+			`10`,
+			"json",
+			`10`,
+		},
+		{
+			"yaml object",
+			"port: 8080\ntags:\n  - a\n  - b\nenabled: true\n",
+			"yaml",
+			object,
+		},
+		{
+			"escapes template sequences",
+			`"${var.foo}"`,
+			"json",
+			`"$${var.foo}"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := hclDecodeFunc(tc.value, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestHCLDecodeFunc_Error(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		value  string
+		format string
+	}{
+		{
+			"invalid json",
+			`{"port": `,
+			"json",
+		},
+		{
+			"invalid yaml",
+			"port: [8080",
+			"yaml",
+		},
+		{
+			"unsupported format",
+			`{"port": 8080}`,
+			"xml",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := hclDecodeFunc(tc.value, tc.format)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -10,21 +10,36 @@ import (
 
 // hclServiceFunc is a wrapper of the template function to marshal Consul
 // service information into HCL. The function accepts a map representing
-// metadata for services in scope of a task.
-func hclServiceFunc(meta *ServicesMeta) func(sDep *dep.HealthService) string {
-	return func(sDep *dep.HealthService) string {
-		if sDep == nil {
+// metadata for services in scope of a task. The service is either an hcat
+// health service or a health service returned by servicesRegex, which also
+// includes the admin partition and cluster peer of the service.
+func hclServiceFunc(meta *ServicesMeta) func(sDep interface{}) string {
+	return func(sDep interface{}) string {
+		var hs *dep.HealthService
+		var partition, peer string
+		switch v := sDep.(type) {
+		case *dep.HealthService:
+			hs = v
+		case *HealthService:
+			if v != nil {
+				hs = &v.HealthService
+				partition, peer = v.Partition, v.Peer
+			}
+		}
+		if hs == nil {
 			return ""
 		}
 
 		// Find any user-defined metadata for this service and append to variable
 		var serviceMeta map[string]string
 		if meta != nil {
-			serviceMeta = meta.Get(sDep.Name)
+			serviceMeta = meta.Get(hs.Name)
 		}
 
 		// Convert the hcat type to an HCL marshal-able object
-		s := newHealthService(sDep, serviceMeta)
+		s := newHealthService(hs, serviceMeta)
+		s.Partition = partition
+		s.Peer = peer
 
 		f := hclwrite.NewEmptyFile()
 		gohcl.EncodeIntoBody(s, f.Body())
@@ -42,6 +57,8 @@ type healthService struct {
 	Meta      map[string]string `hcl:"meta"`
 	Tags      []string          `hcl:"tags"`
 	Namespace string            `hcl:"namespace"`
+	Partition string            `hcl:"partition"`
+	Peer      string            `hcl:"peer"`
 	Status    string            `hcl:"status"`
 
 	// Consul node information for a service
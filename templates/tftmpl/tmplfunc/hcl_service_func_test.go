@@ -28,6 +28,8 @@ port                  = 0
 meta                  = {}
 tags                  = []
 namespace             = ""
+partition             = ""
+peer                  = ""
 status                = ""
 node                  = ""
 node_id               = ""
@@ -70,6 +72,8 @@ meta = {
 }
 tags            = ["tag"]
 namespace       = ""
+partition       = ""
+peer            = ""
 status          = "passing"
 node            = "worker-01"
 node_id         = "39e5a7f5-2834-e16d-6925-78167c9f50d8"
@@ -99,6 +103,8 @@ port                  = 0
 meta                  = {}
 tags                  = []
 namespace             = "namespace"
+partition             = ""
+peer                  = ""
 status                = ""
 node                  = ""
 node_id               = ""
@@ -138,6 +144,8 @@ port                  = 8080
 meta                  = {}
 tags                  = []
 namespace             = ""
+partition             = ""
+peer                  = ""
 status                = ""
 node                  = ""
 node_id               = ""
@@ -178,3 +186,36 @@ cts_user_defined_meta = {
 		})
 	}
 }
+
+func TestHCLServiceFunc_partitionAndPeer(t *testing.T) {
+	content := &HealthService{
+		HealthService: dep.HealthService{
+			ID:   "api",
+			Name: "api",
+		},
+		Partition: "ap1",
+		Peer:      "cluster-02",
+	}
+
+	expected := `id                    = "api"
+name                  = "api"
+kind                  = ""
+address               = ""
+port                  = 0
+meta                  = {}
+tags                  = []
+namespace             = ""
+partition             = "ap1"
+peer                  = "cluster-02"
+status                = ""
+node                  = ""
+node_id               = ""
+node_address          = ""
+node_datacenter       = ""
+node_tagged_addresses = {}
+node_meta             = {}
+cts_user_defined_meta = {}`
+
+	actual := hclServiceFunc(nil)(content)
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,246 @@
+package tmplfunc
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/pkg/errors"
+)
+
+var _ hcatQuery = (*intentionsQuery)(nil)
+
+// Intention is a Consul intention that allows or denies connections from a
+// source service to a destination service. Action is empty for intentions
+// that are configured with L7 permissions instead of an action.
+type Intention struct {
+	ID                   string
+	SourceName           string
+	SourceNamespace      string
+	DestinationName      string
+	DestinationNamespace string
+	Action               string
+	Precedence           int
+}
+
+// intentionsFunc returns the Consul intentions between the source and
+// destination services. It queries the Connect Intentions API and supports
+// the query parameters dc and ns. It also adds an additional layer of custom
+// functionality on the API response:
+//   - Filters on the source service name by name or regex e.g.
+//     "source=web", "source-regexp=^web"
+//   - Filters on the destination service name by name or regex e.g.
+//     "destination=api", "destination-regexp=^api"
+//
+// Endpoint: /v1/connect/intentions
+// Template: {{ intentions <filter options> ... }}
+func intentionsFunc(recall hcat.Recaller) interface{} {
+	return func(opts ...string) ([]*Intention, error) {
+		result := []*Intention{}
+
+		d, err := newIntentionsQuery(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if value, ok := recall(d); ok {
+			return value.([]*Intention), nil
+		}
+
+		return result, nil
+	}
+}
+
+// intentionsQuery is the representation of a requested intentions query from
+// inside a template.
+type intentionsQuery struct {
+	isConsul
+	stopCh chan struct{}
+
+	source      serviceNameFilter // custom
+	destination serviceNameFilter // custom
+	dc          string
+	ns          string
+	opts        hcat.QueryOptions
+}
+
+// serviceNameFilter matches service names either by a list of names or by a
+// regular expression. An empty filter matches all service names.
+type serviceNameFilter struct {
+	names  []string
+	regexp *regexp.Regexp
+}
+
+// newIntentionsQuery processes options in the format of "key=value"
+// e.g. "dc=dc1"
+func newIntentionsQuery(opts []string) (*intentionsQuery, error) {
+	query := intentionsQuery{
+		stopCh: make(chan struct{}, 1),
+	}
+
+	for _, opt := range opts {
+		if strings.TrimSpace(opt) == "" {
+			continue
+		}
+
+		param, value, err := stringsSplit2(opt, "=")
+		if err != nil {
+			return nil, fmt.Errorf("connect.intentions: invalid "+
+				"query parameter format: %q", opt)
+		}
+		switch param {
+		case "source":
+			query.source.names = append(query.source.names, value)
+		case "source-regexp":
+			r, err := regexp.Compile(value)
+			if err != nil {
+				return nil, fmt.Errorf("connect.intentions: invalid source regexp")
+			}
+			query.source.regexp = r
+		case "destination":
+			query.destination.names = append(query.destination.names, value)
+		case "destination-regexp":
+			r, err := regexp.Compile(value)
+			if err != nil {
+				return nil, fmt.Errorf("connect.intentions: invalid destination regexp")
+			}
+			query.destination.regexp = r
+		case "dc", "datacenter":
+			query.dc = value
+		case "ns", "namespace":
+			query.ns = value
+		default:
+			return nil, fmt.Errorf(
+				"connect.intentions: invalid query parameter: %q", opt)
+		}
+	}
+
+	return &query, nil
+}
+
+// Fetch queries the Consul API defined by the given client and returns a slice
+// of Intention objects.
+func (d *intentionsQuery) Fetch(clients dep.Clients) (interface{}, *dep.ResponseMetadata, error) {
+	select {
+	case <-d.stopCh:
+		return nil, nil, dep.ErrStopped
+	default:
+	}
+
+	hcatOpts := d.opts.Merge(&hcat.QueryOptions{
+		Datacenter: d.dc,
+		Namespace:  d.ns,
+	})
+
+	entries, qm, err := clients.Consul().Connect().Intentions(hcatOpts.ToConsulOpts())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, d.String())
+	}
+
+	intentions := make([]*Intention, 0, len(entries))
+	for _, ixn := range entries {
+		if !d.source.matches(ixn.SourceName) ||
+			!d.destination.matches(ixn.DestinationName) {
+			continue
+		}
+		intentions = append(intentions, &Intention{
+			ID:                   ixn.ID,
+			SourceName:           ixn.SourceName,
+			SourceNamespace:      ixn.SourceNS,
+			DestinationName:      ixn.DestinationName,
+			DestinationNamespace: ixn.DestinationNS,
+			Action:               string(ixn.Action),
+			Precedence:           ixn.Precedence,
+		})
+	}
+
+	sort.Stable(ByPrecedence(intentions))
+
+	rm := &dep.ResponseMetadata{
+		LastIndex:   qm.LastIndex,
+		LastContact: qm.LastContact,
+	}
+
+	return intentions, rm, nil
+}
+
+// SetOptions satisfies the hcat.QueryOptionsSetter interface which enables
+// blocking queries.
+func (d *intentionsQuery) SetOptions(opts hcat.QueryOptions) {
+	d.opts = opts
+}
+
+// ID returns the human-friendly version of this query.
+func (d *intentionsQuery) ID() string {
+	var opts []string
+	for _, name := range d.source.names {
+		opts = append(opts, fmt.Sprintf("source=%s", name))
+	}
+	if d.source.regexp != nil {
+		opts = append(opts, fmt.Sprintf("source-regexp=%s", d.source.regexp.String()))
+	}
+	for _, name := range d.destination.names {
+		opts = append(opts, fmt.Sprintf("destination=%s", name))
+	}
+	if d.destination.regexp != nil {
+		opts = append(opts, fmt.Sprintf("destination-regexp=%s",
+			d.destination.regexp.String()))
+	}
+	if d.dc != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", d.dc))
+	}
+	if d.ns != "" {
+		opts = append(opts, fmt.Sprintf("ns=%s", d.ns))
+	}
+	if len(opts) > 0 {
+		sort.Strings(opts)
+		return fmt.Sprintf("connect.intentions(%s)", strings.Join(opts, "&"))
+	}
+	return "connect.intentions"
+}
+
+// Stringer interface reuses ID
+func (d *intentionsQuery) String() string {
+	return d.ID()
+}
+
+// Stop halts the query's fetch function.
+func (d *intentionsQuery) Stop() {
+	close(d.stopCh)
+}
+
+// matches returns whether the service name passes the filter
+func (f serviceNameFilter) matches(name string) bool {
+	if f.regexp != nil && !f.regexp.MatchString(name) {
+		return false
+	}
+	if len(f.names) == 0 {
+		return true
+	}
+	for _, n := range f.names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ByPrecedence is a sortable slice of Intention structs. Intentions are sorted
+// in the order Consul evaluates them, by highest precedence first, and then
+// by source and destination name.
+type ByPrecedence []*Intention
+
+func (s ByPrecedence) Len() int      { return len(s) }
+func (s ByPrecedence) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s ByPrecedence) Less(i, j int) bool {
+	if s[i].Precedence != s[j].Precedence {
+		return s[i].Precedence > s[j].Precedence
+	}
+	if s[i].SourceName != s[j].SourceName {
+		return s[i].SourceName < s[j].SourceName
+	}
+	return s[i].DestinationName < s[j].DestinationName
+}
@@ -0,0 +1,190 @@
+package tmplfunc
+
+import (
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIntentionsQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		opts []string
+		exp  *intentionsQuery
+		err  bool
+	}{
+		{
+			"no opts",
+			[]string{},
+			&intentionsQuery{},
+			false,
+		},
+		{
+			"source names",
+			[]string{"source=web", "source=api"},
+			&intentionsQuery{
+				source: serviceNameFilter{names: []string{"web", "api"}},
+			},
+			false,
+		},
+		{
+			"destination regexp",
+			[]string{"destination-regexp=^db"},
+			&intentionsQuery{
+				destination: serviceNameFilter{regexp: regexp.MustCompile("^db")},
+			},
+			false,
+		},
+		{
+			"multiple",
+			[]string{"source-regexp=.*", "destination=db", "dc=dc1", "ns=namespace"},
+			&intentionsQuery{
+				source:      serviceNameFilter{regexp: regexp.MustCompile(".*")},
+				destination: serviceNameFilter{names: []string{"db"}},
+				dc:          "dc1",
+				ns:          "namespace",
+			},
+			false,
+		},
+		{
+			"invalid regexp",
+			[]string{"source-regexp=*"},
+			nil,
+			true,
+		},
+		{
+			"invalid query",
+			[]string{"invalid=true"},
+			nil,
+			true,
+		},
+		{
+			"invalid query format",
+			[]string{"dc1"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := newIntentionsQuery(tc.opts)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if act != nil {
+				act.stopCh = nil
+			}
+
+			assert.NoError(t, err, err)
+			assert.Equal(t, tc.exp, act)
+		})
+	}
+}
+
+func TestIntentionsQuery_String(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    []string
+		exp  string
+	}{
+		{
+			"empty",
+			[]string{},
+			"connect.intentions",
+		},
+		{
+			"source and destination",
+			[]string{"source=web", "destination-regexp=^db"},
+			"connect.intentions(destination-regexp=^db&source=web)",
+		},
+		{
+			"all parameters",
+			[]string{"ns=namespace", "dc=dc1", "source-regexp=.*", "destination=db"},
+			"connect.intentions(dc=dc1&destination=db&ns=namespace&source-regexp=.*)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := newIntentionsQuery(tc.i)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, d.String())
+		})
+	}
+}
+
+func TestServiceNameFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		filter   serviceNameFilter
+		service  string
+		expected bool
+	}{
+		{
+			"empty filter",
+			serviceNameFilter{},
+			"web",
+			true,
+		},
+		{
+			"name match",
+			serviceNameFilter{names: []string{"api", "web"}},
+			"web",
+			true,
+		},
+		{
+			"name mismatch",
+			serviceNameFilter{names: []string{"api"}},
+			"web",
+			false,
+		},
+		{
+			"regexp match",
+			serviceNameFilter{regexp: regexp.MustCompile("^we")},
+			"web",
+			true,
+		},
+		{
+			"regexp mismatch",
+			serviceNameFilter{regexp: regexp.MustCompile("^api")},
+			"web",
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.filter.matches(tc.service))
+		})
+	}
+}
+
+func TestByPrecedence(t *testing.T) {
+	t.Parallel()
+
+	intentions := []*Intention{
+		{SourceName: "web", DestinationName: "db", Precedence: 9},
+		{SourceName: "*", DestinationName: "db", Precedence: 8},
+		{SourceName: "api", DestinationName: "db", Precedence: 9},
+		{SourceName: "api", DestinationName: "cache", Precedence: 9},
+	}
+	sort.Stable(ByPrecedence(intentions))
+
+	assert.Equal(t, []*Intention{
+		{SourceName: "api", DestinationName: "cache", Precedence: 9},
+		{SourceName: "api", DestinationName: "db", Precedence: 9},
+		{SourceName: "web", DestinationName: "db", Precedence: 9},
+		{SourceName: "*", DestinationName: "db", Precedence: 8},
+	}, intentions)
+}
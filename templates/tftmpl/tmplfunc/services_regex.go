@@ -23,20 +23,34 @@ var (
 	_ hcatQuery = (*servicesRegexQuery)(nil)
 )
 
+const (
+	// statusPassing only queries for passing service instances
+	statusPassing = "passing"
+
+	// statusAny queries for service instances of any health status
+	statusAny = "any"
+)
+
 // servicesRegexFunc returns information on registered Consul
 // services that have a name that match a given regex. It queries
 // the Catalog List Services API initially to get all the services
 // and then queries the Health API for each matching service.
 // It supports parameters filter, dc, ns, and node-meta on the
-// Health API query only.
+// Health API query only. By default only passing service instances are
+// returned, status=any returns instances of any health status.
+//
+// The datacenters parameter queries multiple datacenters, a comma separated
+// list or "all", and merges the service instances of the datacenters. The
+// partition and peer parameters query the services of an admin partition or
+// the services imported from a cluster peer.
 //
 // Endpoints:
 //   /v1/catalog/services
 //   /v1/health/service/:service
 // Template: {{ servicesRegex regexp=<regex> <options> ... }}
 func servicesRegexFunc(recall hcat.Recaller) interface{} {
-	return func(opts ...string) ([]*dep.HealthService, error) {
-		result := []*dep.HealthService{}
+	return func(opts ...string) ([]*HealthService, error) {
+		result := []*HealthService{}
 
 		d, err := newServicesRegexQuery(opts)
 		if err != nil {
@@ -44,7 +58,7 @@ func servicesRegexFunc(recall hcat.Recaller) interface{} {
 		}
 
 		if value, ok := recall(d); ok {
-			return value.([]*dep.HealthService), nil
+			return value.([]*HealthService), nil
 		}
 
 		return result, nil
@@ -59,11 +73,15 @@ type servicesRegexQuery struct {
 
 	regexp *regexp.Regexp
 
-	filter   string
-	dc       string
-	ns       string
-	nodeMeta map[string]string
-	opts     hcat.QueryOptions
+	filter      string
+	dc          string
+	datacenters *multiDatacenter
+	ns          string
+	partition   string
+	peer        string
+	nodeMeta    map[string]string
+	status      string
+	opts        hcat.QueryOptions
 }
 
 // newServicesRegexQuery processes options in the format of
@@ -95,9 +113,22 @@ func newServicesRegexQuery(opts []string) (*servicesRegexQuery, error) {
 			case "dc", "datacenter":
 				servicesRegexQuery.dc = value
 				continue
+			case "datacenters":
+				dcs, err := parseDatacenters(value)
+				if err != nil {
+					return nil, fmt.Errorf("service.regex: invalid datacenters: %s", err)
+				}
+				servicesRegexQuery.datacenters = newMultiDatacenter(dcs)
+				continue
 			case "ns", "namespace":
 				servicesRegexQuery.ns = value
 				continue
+			case "partition":
+				servicesRegexQuery.partition = value
+				continue
+			case "peer":
+				servicesRegexQuery.peer = value
+				continue
 			case "node-meta":
 				if servicesRegexQuery.nodeMeta == nil {
 					servicesRegexQuery.nodeMeta = make(map[string]string)
@@ -110,6 +141,16 @@ func newServicesRegexQuery(opts []string) (*servicesRegexQuery, error) {
 				}
 				servicesRegexQuery.nodeMeta[k] = v
 				continue
+			case "status":
+				switch value {
+				case statusPassing:
+				case statusAny:
+					servicesRegexQuery.status = value
+				default:
+					return nil, fmt.Errorf("service.regex: invalid status %q, "+
+						"must be %q or %q", value, statusPassing, statusAny)
+				}
+				continue
 			}
 		}
 
@@ -132,6 +173,16 @@ func newServicesRegexQuery(opts []string) (*servicesRegexQuery, error) {
 		return nil, fmt.Errorf("service.regex: regexp option required")
 	}
 
+	if servicesRegexQuery.dc != "" && servicesRegexQuery.datacenters != nil {
+		return nil, fmt.Errorf("service.regex: dc and datacenters options " +
+			"cannot both be set")
+	}
+
+	if servicesRegexQuery.peer != "" && servicesRegexQuery.datacenters != nil {
+		return nil, fmt.Errorf("service.regex: peer and datacenters options " +
+			"cannot both be set")
+	}
+
 	return &servicesRegexQuery, nil
 }
 
@@ -144,24 +195,62 @@ func (d *servicesRegexQuery) Fetch(clients dep.Clients) (interface{}, *dep.Respo
 	default:
 	}
 
-	// Fetch all services via catalog services
 	hcatOpts := d.opts.Merge(&hcat.QueryOptions{
 		Datacenter: d.dc,
 		Namespace:  d.ns,
 	})
 	opts := hcatOpts.ToConsulOpts()
+	opts.Partition = d.partition
+	opts.Peer = d.peer
 	if len(d.nodeMeta) != 0 {
 		opts.NodeMeta = d.nodeMeta
 	}
-	catalog, qm, err := clients.Consul().Catalog().Services(opts)
+
+	if d.datacenters == nil {
+		services, qm, err := d.fetchDatacenter(clients, opts)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, d.String())
+		}
+
+		// Track the indexes of catalog services, not the individual health services
+		rm := &dep.ResponseMetadata{
+			LastIndex:   qm.LastIndex,
+			LastContact: qm.LastContact,
+		}
+		return services, rm, nil
+	}
+
+	data, rm, err := d.datacenters.fetch(clients, opts,
+		func(opts *consulapi.QueryOptions) (interface{}, *consulapi.QueryMeta, error) {
+			return d.fetchDatacenter(clients, opts)
+		})
 	if err != nil {
 		return nil, nil, errors.Wrap(err, d.String())
 	}
 
-	// Track the indexes of catalog services, not the individual health services
-	rm := &dep.ResponseMetadata{
-		LastIndex:   qm.LastIndex,
-		LastContact: qm.LastContact,
+	dcs := make([]string, 0, len(data))
+	for dc := range data {
+		dcs = append(dcs, dc)
+	}
+	sort.Strings(dcs)
+
+	var services []*HealthService
+	for _, dc := range dcs {
+		services = append(services, data[dc].([]*HealthService)...)
+	}
+
+	sort.Stable(ByNodeThenID(services))
+	return services, rm, nil
+}
+
+// fetchDatacenter queries the catalog of the datacenter set on the options for
+// the services that match the regex and returns the health of each of the
+// services' instances. The returned metadata is of the catalog services query.
+func (d *servicesRegexQuery) fetchDatacenter(clients dep.Clients, opts *consulapi.QueryOptions) ([]*HealthService, *consulapi.QueryMeta, error) {
+	// Fetch all services via catalog services
+	catalog, qm, err := clients.Consul().Catalog().Services(opts)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Filter out only the services that match the regex
@@ -176,14 +265,15 @@ func (d *servicesRegexQuery) Fetch(clients dep.Clients) (interface{}, *dep.Respo
 	// Fetch the health of each matching service. We aren't tracking the latest
 	// index for each service, only for the catalog, so we'll do synchronous API
 	// requests to fetch the latest
-	hcatOpts = &hcat.QueryOptions{
-		Datacenter: d.dc,
-		Namespace:  d.ns,
+	healthOpts := &consulapi.QueryOptions{
+		Datacenter: opts.Datacenter,
+		Namespace:  opts.Namespace,
+		Partition:  opts.Partition,
+		Peer:       opts.Peer,
 		Filter:     d.filter,
 	}
-	opts = hcatOpts.ToConsulOpts()
 	if len(d.nodeMeta) != 0 {
-		opts.NodeMeta = d.nodeMeta
+		healthOpts.NodeMeta = d.nodeMeta
 	}
 
 	// This Fetch depends on multiple API calls for update. This adds an
@@ -200,43 +290,48 @@ func (d *servicesRegexQuery) Fetch(clients dep.Clients) (interface{}, *dep.Respo
 	// set as critical. https://www.consul.io/docs/discovery/checks#initial-health-check-status
 	time.Sleep(1 * time.Second)
 
-	var services []*dep.HealthService
+	passingOnly := d.status != statusAny
+	var services []*HealthService
 	for _, s := range matchServices {
 		var entries []*consulapi.ServiceEntry
-		entries, _, err = clients.Consul().Health().Service(s, "", true, opts)
+		entries, _, err = clients.Consul().Health().Service(s, "", passingOnly, healthOpts)
 		if err != nil {
-			return nil, nil, errors.Wrap(err, d.String())
+			return nil, nil, err
 		}
 		for _, entry := range entries {
 			address := entry.Service.Address
 			if address == "" {
 				address = entry.Node.Address
 			}
-			services = append(services, &dep.HealthService{
-				Node:                entry.Node.Node,
-				NodeID:              entry.Node.ID,
-				Kind:                string(entry.Service.Kind),
-				NodeAddress:         entry.Node.Address,
-				NodeDatacenter:      entry.Node.Datacenter,
-				NodeTaggedAddresses: entry.Node.TaggedAddresses,
-				NodeMeta:            entry.Node.Meta,
-				ServiceMeta:         entry.Service.Meta,
-				Address:             address,
-				ID:                  entry.Service.ID,
-				Name:                entry.Service.Service,
-				Tags: dep.ServiceTags(
-					deepCopyAndSortTags(entry.Service.Tags)),
-				Status:    entry.Checks.AggregatedStatus(),
-				Checks:    entry.Checks,
-				Port:      entry.Service.Port,
-				Weights:   entry.Service.Weights,
-				Namespace: entry.Service.Namespace,
+			services = append(services, &HealthService{
+				HealthService: dep.HealthService{
+					Node:                entry.Node.Node,
+					NodeID:              entry.Node.ID,
+					Kind:                string(entry.Service.Kind),
+					NodeAddress:         entry.Node.Address,
+					NodeDatacenter:      entry.Node.Datacenter,
+					NodeTaggedAddresses: entry.Node.TaggedAddresses,
+					NodeMeta:            entry.Node.Meta,
+					ServiceMeta:         entry.Service.Meta,
+					Address:             address,
+					ID:                  entry.Service.ID,
+					Name:                entry.Service.Service,
+					Tags: dep.ServiceTags(
+						deepCopyAndSortTags(entry.Service.Tags)),
+					Status:    entry.Checks.AggregatedStatus(),
+					Checks:    entry.Checks,
+					Port:      entry.Service.Port,
+					Weights:   entry.Service.Weights,
+					Namespace: entry.Service.Namespace,
+				},
+				Partition: entry.Service.Partition,
+				Peer:      entry.Service.PeerName,
 			})
 		}
 	}
 
 	sort.Stable(ByNodeThenID(services))
-	return services, rm, nil
+	return services, qm, nil
 }
 
 // SetOptions satisfies the hcat.QueryOptionsSetter interface which enables
@@ -253,15 +348,27 @@ func (d *servicesRegexQuery) ID() string {
 	if d.dc != "" {
 		opts = append(opts, fmt.Sprintf("dc=%s", d.dc))
 	}
+	if d.datacenters != nil {
+		opts = append(opts, fmt.Sprintf("datacenters=%s", d.datacenters))
+	}
 	if d.ns != "" {
 		opts = append(opts, fmt.Sprintf("ns=%s", d.ns))
 	}
+	if d.partition != "" {
+		opts = append(opts, fmt.Sprintf("partition=%s", d.partition))
+	}
+	if d.peer != "" {
+		opts = append(opts, fmt.Sprintf("peer=%s", d.peer))
+	}
 	for k, v := range d.nodeMeta {
 		opts = append(opts, fmt.Sprintf("node-meta=%s:%s", k, v))
 	}
 	if d.filter != "" {
 		opts = append(opts, fmt.Sprintf("filter=%s", d.filter))
 	}
+	if d.status != "" {
+		opts = append(opts, fmt.Sprintf("status=%s", d.status))
+	}
 
 	sort.Strings(opts)
 	return fmt.Sprintf("service.regex(%s)",
@@ -278,8 +385,22 @@ func (d *servicesRegexQuery) Stop() {
 	close(d.stopCh)
 }
 
+// HealthService is a service instance returned by servicesRegex. hcat's health
+// service does not include the admin partition and cluster peer of the
+// instance.
+type HealthService struct {
+	dep.HealthService
+
+	// Partition is the admin partition of the service instance
+	Partition string
+
+	// Peer is the name of the cluster peer that the service instance is
+	// imported from. It is empty for local service instances.
+	Peer string
+}
+
 // ByNodeThenID is a sortable slice of Service
-type ByNodeThenID []*dep.HealthService
+type ByNodeThenID []*HealthService
 
 // Len, Swap, and Less are used to implement the sort.Sort interface.
 func (s ByNodeThenID) Len() int      { return len(s) }
@@ -59,6 +59,60 @@ func TestNewServicesRegexQuery(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"status any",
+			[]string{"regexp=.*", "status=any"},
+			&servicesRegexQuery{
+				regexp: regexp.MustCompile(".*"),
+				status: "any",
+			},
+			false,
+		},
+		{
+			"status passing",
+			[]string{"regexp=.*", "status=passing"},
+			&servicesRegexQuery{
+				regexp: regexp.MustCompile(".*"),
+			},
+			false,
+		},
+		{
+			"datacenters",
+			[]string{"regexp=.*", "datacenters=dc1,dc2"},
+			&servicesRegexQuery{
+				regexp:      regexp.MustCompile(".*"),
+				datacenters: newMultiDatacenter([]string{"dc1", "dc2"}),
+			},
+			false,
+		},
+		{
+			"invalid dc and datacenters",
+			[]string{"regexp=.*", "dc=dc1", "datacenters=all"},
+			nil,
+			true,
+		},
+		{
+			"partition and peer",
+			[]string{"regexp=.*", "partition=ap1", "peer=cluster-02"},
+			&servicesRegexQuery{
+				regexp:    regexp.MustCompile(".*"),
+				partition: "ap1",
+				peer:      "cluster-02",
+			},
+			false,
+		},
+		{
+			"invalid peer and datacenters",
+			[]string{"regexp=.*", "peer=cluster-02", "datacenters=dc1,dc2"},
+			nil,
+			true,
+		},
+		{
+			"invalid status",
+			[]string{"regexp=.*", "status=critical"},
+			nil,
+			true,
+		},
 		{
 			"invalid query",
 			[]string{"regexp=.*", "invalid=true"},
@@ -109,6 +163,21 @@ func TestServicesRegexQuery_String(t *testing.T) {
 			[]string{"node-meta=k:v", "dc=dc1", "ns=namespace", "regexp=web", "\"my-tag\" in Service.Tags"},
 			`service.regex(dc=dc1&filter="my-tag" in Service.Tags&node-meta=k:v&ns=namespace&regexp=web)`,
 		},
+		{
+			"status",
+			[]string{"status=any", "regexp=web"},
+			"service.regex(regexp=web&status=any)",
+		},
+		{
+			"datacenters",
+			[]string{"regexp=web", "datacenters=all"},
+			"service.regex(datacenters=all&regexp=web)",
+		},
+		{
+			"partition and peer",
+			[]string{"regexp=web", "peer=cluster-02", "partition=ap1"},
+			"service.regex(partition=ap1&peer=cluster-02&regexp=web)",
+		},
 	}
 
 	for _, tc := range cases {
@@ -273,19 +342,24 @@ func TestServicesRegexQuery_Fetch(t *testing.T) {
 
 			a, _, err := d.Fetch(&testClient{consul: client})
 			require.NoError(t, err)
-			actual, ok := a.([]*dep.HealthService)
+			actual, ok := a.([]*HealthService)
 			require.True(t, ok)
 			require.Equal(t, len(tc.expected), len(actual))
 
-			sort.Stable(ByNodeThenID(tc.expected))
+			expected := make([]*HealthService, len(tc.expected))
+			for i, s := range tc.expected {
+				expected[i] = &HealthService{HealthService: *s}
+			}
+			sort.Stable(ByNodeThenID(expected))
 			sort.Stable(ByNodeThenID(actual))
 			for i, actualNode := range actual {
-				assert.Equal(t, tc.expected[i].Name, actualNode.Name)
-				assert.Equal(t, tc.expected[i].ID, actualNode.ID)
-				assert.Equal(t, tc.expected[i].Node, actualNode.Node,
+				assert.Equal(t, expected[i].Name, actualNode.Name)
+				assert.Equal(t, expected[i].ID, actualNode.ID)
+				assert.Equal(t, expected[i].Node, actualNode.Node,
 					fmt.Sprintf("unexpected node name for service %s", actualNode.Node))
-				assert.Equal(t, tc.expected[i].NodeID, actualNode.NodeID,
+				assert.Equal(t, expected[i].NodeID, actualNode.NodeID,
 					fmt.Sprintf("unexpected node id for service %s", actualNode.ID))
+				assert.Empty(t, actualNode.Peer)
 			}
 		})
 	}
@@ -11,16 +11,24 @@ import (
 )
 
 // HCLMap is the map of template functions for rendering HCL
-// to their respective implementations
-func HCLMap(meta *ServicesMeta) template.FuncMap {
+// to their respective implementations. Vault secrets read by the template are
+// stored in secrets when it is not nil.
+func HCLMap(meta *ServicesMeta, secrets *VaultSecrets) template.FuncMap {
 	tmplFuncs := tfunc.FuncMapConsulV1()
 	tmplFuncs["catalogServicesRegistration"] = catalogServicesRegistrationFunc
 	tmplFuncs["servicesRegex"] = servicesRegexFunc
+	tmplFuncs["intentions"] = intentionsFunc
+	tmplFuncs["catalogNodes"] = catalogNodesFunc
+	tmplFuncs["configEntries"] = configEntriesFunc
+	tmplFuncs["consulKVGet"] = consulKVGetFunc
+	tmplFuncs["consulKVList"] = consulKVListFunc
+	tmplFuncs["vaultSecrets"] = vaultSecretsFunc(secrets)
 	tmplFuncs["indent"] = tfunc.Helpers()["indent"]
 	tmplFuncs["subtract"] = tfunc.Math()["subtract"]
 	tmplFuncs["joinStrings"] = joinStringsFunc
 	tmplFuncs["HCLService"] = hclServiceFunc(meta)
 	tmplFuncs["HCLServiceTags"] = hclServiceTagsFunc()
+	tmplFuncs["HCLDecode"] = hclDecodeFunc
 	return tmplFuncs
 }
 
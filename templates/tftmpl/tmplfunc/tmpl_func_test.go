@@ -1,11 +1,24 @@
 package tmplfunc
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/hcat/tfunc"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestHCLMap(t *testing.T) {
+	funcs := HCLMap(nil, nil)
+
+	// hcat's nodes function is not replaced by the catalog nodes function
+	hcatNodes := tfunc.FuncMapConsulV1()["nodes"]
+	assert.Equal(t, reflect.ValueOf(hcatNodes).Pointer(),
+		reflect.ValueOf(funcs["nodes"]).Pointer())
+	assert.Equal(t, reflect.ValueOf(catalogNodesFunc).Pointer(),
+		reflect.ValueOf(funcs["catalogNodes"]).Pointer())
+}
+
 func TestJoinStringsFunc(t *testing.T) {
 	testCases := []struct {
 		name     string
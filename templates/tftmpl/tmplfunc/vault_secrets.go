@@ -0,0 +1,239 @@
+package tmplfunc
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/pkg/errors"
+)
+
+// defaultVaultSecretsPollInterval is the interval to re-read Vault secrets to
+// detect rotations. KV secrets have no lease to renew or watch.
+const defaultVaultSecretsPollInterval = 1 * time.Minute
+
+var _ dep.Dependency = (*vaultSecretsQuery)(nil)
+
+// VaultSecret is the data of a Vault KV secret. Values that are not strings
+// are JSON encoded.
+type VaultSecret struct {
+	Path string
+	Data map[string]string
+}
+
+// Keys returns the sorted keys of the secret data
+func (s *VaultSecret) Keys() []string {
+	keys := make([]string, 0, len(s.Data))
+	for k := range s.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// VaultSecrets holds the most recently fetched Vault secrets of a task's
+// template in memory. The template only renders the secret keys, and the
+// driver passes the values to Terraform as a sensitive variable.
+type VaultSecrets struct {
+	mu      sync.RWMutex
+	secrets map[string]map[string]string
+}
+
+// NewVaultSecrets creates an empty holder for Vault secrets
+func NewVaultSecrets() *VaultSecrets {
+	return &VaultSecrets{
+		secrets: make(map[string]map[string]string),
+	}
+}
+
+// Values returns a copy of the secret data mapped by the secret path
+func (s *VaultSecrets) Values() map[string]map[string]string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	values := make(map[string]map[string]string, len(s.secrets))
+	for path, data := range s.secrets {
+		d := make(map[string]string, len(data))
+		for k, v := range data {
+			d[k] = v
+		}
+		values[path] = d
+	}
+	return values
+}
+
+func (s *VaultSecrets) set(secrets []*VaultSecret) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.secrets = make(map[string]map[string]string, len(secrets))
+	for _, secret := range secrets {
+		s.secrets[secret.Path] = secret.Data
+	}
+}
+
+// vaultSecretsFunc returns a template function that reads Vault KV secrets
+// and stores them in the holder. It supports secrets of both KV version 1 and
+// version 2 engines, where paths of version 2 secrets include "data/" after
+// the mount path.
+//
+// Endpoint: /v1/:path
+// Template: {{ vaultSecrets <path> ... }}
+func vaultSecretsFunc(secrets *VaultSecrets) func(hcat.Recaller) interface{} {
+	return func(recall hcat.Recaller) interface{} {
+		return func(paths ...string) ([]*VaultSecret, error) {
+			result := []*VaultSecret{}
+
+			d, err := newVaultSecretsQuery(paths)
+			if err != nil {
+				return nil, err
+			}
+
+			if value, ok := recall(d); ok {
+				result = value.([]*VaultSecret)
+				if secrets != nil {
+					secrets.set(result)
+				}
+			}
+
+			return result, nil
+		}
+	}
+}
+
+// vaultSecretsQuery is the representation of a requested Vault secrets query
+// from inside a template.
+type vaultSecretsQuery struct {
+	isVault
+	stopCh chan struct{}
+
+	paths        []string
+	pollInterval time.Duration
+
+	// lastIndex only increments when the secrets change, so that re-reading
+	// unchanged secrets is not a new dependency value
+	lastIndex uint64
+	last      []*VaultSecret
+}
+
+// newVaultSecretsQuery creates a query for the secrets at the Vault paths
+func newVaultSecretsQuery(paths []string) (*vaultSecretsQuery, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("vault.secrets: at least one path is required")
+	}
+
+	query := vaultSecretsQuery{
+		stopCh:       make(chan struct{}, 1),
+		pollInterval: defaultVaultSecretsPollInterval,
+	}
+
+	for _, p := range paths {
+		path := strings.Trim(strings.TrimSpace(p), "/")
+		if path == "" {
+			return nil, fmt.Errorf("vault.secrets: invalid path: %q", p)
+		}
+		query.paths = append(query.paths, path)
+	}
+
+	return &query, nil
+}
+
+// Fetch reads the secrets with the Vault client. After the first fetch, it
+// waits for the poll interval before reading the secrets again.
+func (d *vaultSecretsQuery) Fetch(clients dep.Clients) (interface{}, *dep.ResponseMetadata, error) {
+	select {
+	case <-d.stopCh:
+		return nil, nil, dep.ErrStopped
+	default:
+	}
+
+	if d.last != nil {
+		select {
+		case <-d.stopCh:
+			return nil, nil, dep.ErrStopped
+		case <-time.After(d.pollInterval):
+		}
+	}
+
+	vault := clients.Vault()
+	if vault == nil {
+		return nil, nil, fmt.Errorf("%s: Vault is not configured", d.String())
+	}
+
+	secrets := make([]*VaultSecret, 0, len(d.paths))
+	for _, path := range d.paths {
+		s, err := vault.Logical().Read(path)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, d.String())
+		}
+		if s == nil {
+			return nil, nil, fmt.Errorf("%s: no secret exists at %q", d.String(), path)
+		}
+		secrets = append(secrets, newVaultSecret(path, s.Data))
+	}
+
+	if d.last == nil || !reflect.DeepEqual(d.last, secrets) {
+		d.lastIndex++
+		d.last = secrets
+	}
+
+	rm := &dep.ResponseMetadata{
+		LastIndex: d.lastIndex,
+	}
+
+	return d.last, rm, nil
+}
+
+// ID returns the human-friendly version of this query.
+func (d *vaultSecretsQuery) ID() string {
+	paths := make([]string, len(d.paths))
+	copy(paths, d.paths)
+	sort.Strings(paths)
+	return fmt.Sprintf("vault.secrets(%s)", strings.Join(paths, "&"))
+}
+
+// Stringer interface reuses ID
+func (d *vaultSecretsQuery) String() string {
+	return d.ID()
+}
+
+// Stop halts the query's fetch function.
+func (d *vaultSecretsQuery) Stop() {
+	close(d.stopCh)
+}
+
+// newVaultSecret converts the data of a secret read from Vault. The data of a
+// KV version 2 secret is nested with the secret's metadata.
+func newVaultSecret(path string, data map[string]interface{}) *VaultSecret {
+	if nested, ok := data["data"]; ok {
+		if _, ok := data["metadata"]; ok {
+			// a deleted version of a KV version 2 secret has no data
+			data, _ = nested.(map[string]interface{})
+		}
+	}
+
+	secret := &VaultSecret{
+		Path: path,
+		Data: make(map[string]string, len(data)),
+	}
+	for k, v := range data {
+		if s, ok := v.(string); ok {
+			secret.Data[k] = s
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			// values decoded from a Vault response are always valid JSON
+			b = []byte(fmt.Sprint(v))
+		}
+		secret.Data[k] = string(b)
+	}
+
+	return secret
+}
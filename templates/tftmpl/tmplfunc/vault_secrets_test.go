@@ -0,0 +1,258 @@
+package tmplfunc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/hcat/dep"
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVaultSecretsQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		i        []string
+		expected *vaultSecretsQuery
+		err      bool
+	}{
+		{
+			"no paths",
+			[]string{},
+			nil,
+			true,
+		},
+		{
+			"empty path",
+			[]string{"secret/data/app", " / "},
+			nil,
+			true,
+		},
+		{
+			"paths",
+			[]string{"secret/data/app", "/kv/db/"},
+			&vaultSecretsQuery{
+				paths:        []string{"secret/data/app", "kv/db"},
+				pollInterval: defaultVaultSecretsPollInterval,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := newVaultSecretsQuery(tc.i)
+			if act != nil {
+				act.stopCh = nil
+			}
+
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, act)
+		})
+	}
+}
+
+func TestVaultSecretsQuery_String(t *testing.T) {
+	t.Parallel()
+
+	d, err := newVaultSecretsQuery([]string{"secret/data/app", "kv/db"})
+	require.NoError(t, err)
+	assert.Equal(t, "vault.secrets(kv/db&secret/data/app)", d.String())
+}
+
+func TestNewVaultSecret(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		data     map[string]interface{}
+		expected *VaultSecret
+	}{
+		{
+			"kv v1",
+			map[string]interface{}{
+				"username": "admin",
+				"port":     json.Number("5432"),
+				"tls":      true,
+			},
+			&VaultSecret{
+				Path: "path",
+				Data: map[string]string{
+					"username": "admin",
+					"port":     "5432",
+					"tls":      "true",
+				},
+			},
+		},
+		{
+			"kv v2",
+			map[string]interface{}{
+				"data": map[string]interface{}{
+					"password": "hunter2",
+				},
+				"metadata": map[string]interface{}{
+					"version": json.Number("3"),
+				},
+			},
+			&VaultSecret{
+				Path: "path",
+				Data: map[string]string{"password": "hunter2"},
+			},
+		},
+		{
+			"kv v2 deleted version",
+			map[string]interface{}{
+				"data": nil,
+				"metadata": map[string]interface{}{
+					"version": json.Number("4"),
+				},
+			},
+			&VaultSecret{
+				Path: "path",
+				Data: map[string]string{},
+			},
+		},
+		{
+			"kv v1 data key",
+			map[string]interface{}{
+				"data": "value",
+			},
+			&VaultSecret{
+				Path: "path",
+				Data: map[string]string{"data": "value"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := newVaultSecret("path", tc.data)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestVaultSecretsQuery_Fetch(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	password := "hunter2"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch strings.TrimPrefix(r.URL.Path, "/v1/") {
+		case "secret/data/app":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{
+					"data":     map[string]string{"password": password},
+					"metadata": map[string]interface{}{"version": 1},
+				},
+			})
+		case "kv/db":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]string{"username": "admin"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	vaultConf := vaultapi.DefaultConfig()
+	vaultConf.Address = ts.URL
+	vault, err := vaultapi.NewClient(vaultConf)
+	require.NoError(t, err)
+	clients := &testClient{vault: vault}
+
+	t.Run("secrets", func(t *testing.T) {
+		d, err := newVaultSecretsQuery([]string{"secret/data/app", "kv/db"})
+		require.NoError(t, err)
+		d.pollInterval = time.Millisecond
+
+		expected := []*VaultSecret{
+			{Path: "secret/data/app", Data: map[string]string{"password": "hunter2"}},
+			{Path: "kv/db", Data: map[string]string{"username": "admin"}},
+		}
+		actual, rm, err := d.Fetch(clients)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, uint64(1), rm.LastIndex)
+
+		// unchanged secrets keep the index
+		actual, rm, err = d.Fetch(clients)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, uint64(1), rm.LastIndex)
+
+		// rotated secrets increment the index
+		mu.Lock()
+		password = "correct-horse"
+		mu.Unlock()
+		actual, rm, err = d.Fetch(clients)
+		require.NoError(t, err)
+		assert.Equal(t, "correct-horse", actual.([]*VaultSecret)[0].Data["password"])
+		assert.Equal(t, uint64(2), rm.LastIndex)
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		d, err := newVaultSecretsQuery([]string{"kv/missing"})
+		require.NoError(t, err)
+
+		_, _, err = d.Fetch(clients)
+		assert.Error(t, err)
+	})
+
+	t.Run("vault not configured", func(t *testing.T) {
+		d, err := newVaultSecretsQuery([]string{"kv/db"})
+		require.NoError(t, err)
+
+		_, _, err = d.Fetch(&testClient{})
+		assert.Error(t, err)
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		d, err := newVaultSecretsQuery([]string{"kv/db"})
+		require.NoError(t, err)
+		d.Stop()
+
+		_, _, err = d.Fetch(clients)
+		assert.Equal(t, dep.ErrStopped, err)
+	})
+}
+
+func TestVaultSecretsFunc(t *testing.T) {
+	t.Parallel()
+
+	secrets := NewVaultSecrets()
+	expected := []*VaultSecret{
+		{Path: "kv/db", Data: map[string]string{"username": "admin"}},
+	}
+	recall := func(d dep.Dependency) (interface{}, bool) {
+		return expected, true
+	}
+
+	fn := vaultSecretsFunc(secrets)(recall).(func(...string) ([]*VaultSecret, error))
+	actual, err := fn("kv/db")
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, map[string]map[string]string{
+		"kv/db": {"username": "admin"},
+	}, secrets.Values())
+
+	// values are copied
+	secrets.Values()["kv/db"]["username"] = "changed"
+	assert.Equal(t, "admin", secrets.Values()["kv/db"]["username"])
+}
@@ -19,8 +19,12 @@ var tfVersionSensitive = goVersion.Must(goVersion.NewSemver("0.14.0"))
 
 // VariableServices is versioned to track compatibility with the generated
 // root module with modules.
+//
+// v1 adds the admin partition and cluster peer of the service. Modules that
+// declare the v0 object type are still compatible since Terraform drops the
+// additional attributes on conversion.
 var VariableServices = []byte(`
-# Service definition protocol v0
+# Service definition protocol v1
 variable "services" {
   description = "Consul services monitored by Consul Terraform Sync"
   type = map(
@@ -33,6 +37,8 @@ variable "services" {
       meta      = map(string)
       tags      = list(string)
       namespace = string
+      partition = string
+      peer      = string
       status    = string
 
       node                  = string
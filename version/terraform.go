@@ -15,15 +15,31 @@ import (
 // and enhancements between versions.
 const CompatibleTerraformVersionConstraint = ">= 0.13.0, < 1.2.0"
 
-// TerraformConstraint is the go-version constraint variable for
-// CompatibleTerraformVersionConstraint
-var TerraformConstraint version.Constraints
+// CompatibleOpenTofuVersionConstraint is the version constraint imposed for
+// running OpenTofu in automation with CTS. OpenTofu versions are numbered
+// independently from Terraform starting from its first stable release, and
+// are upward bounded for the same reasons as Terraform.
+const CompatibleOpenTofuVersionConstraint = ">= 1.6.0, < 1.9.0"
+
+var (
+	// TerraformConstraint is the go-version constraint variable for
+	// CompatibleTerraformVersionConstraint
+	TerraformConstraint version.Constraints
+
+	// OpenTofuConstraint is the go-version constraint variable for
+	// CompatibleOpenTofuVersionConstraint
+	OpenTofuConstraint version.Constraints
+)
 
 func init() {
-	var err error
-	TerraformConstraint, err = version.NewConstraint(CompatibleTerraformVersionConstraint)
+	TerraformConstraint = mustConstraint(CompatibleTerraformVersionConstraint)
+	OpenTofuConstraint = mustConstraint(CompatibleOpenTofuVersionConstraint)
+}
+
+func mustConstraint(c string) version.Constraints {
+	constraint, err := version.NewConstraint(c)
 	if err != nil {
-		log.Panicf("error setting up Terraform version constraint %q: %s",
-			CompatibleTerraformVersionConstraint, err)
+		log.Panicf("error setting up Terraform version constraint %q: %s", c, err)
 	}
+	return constraint
 }